@@ -35,6 +35,10 @@ const (
 	getStatePath             = "/eth/v2/debug/beacon/states"
 	getNodeVersionPath       = "/eth/v1/node/version"
 	changeBLStoExecutionPath = "/eth/v1/beacon/pool/bls_to_execution_changes"
+
+	getPendingDepositsPath           = "/eth/v1/beacon/states/{{.Id}}/pending_deposits"
+	getPendingPartialWithdrawalsPath = "/eth/v1/beacon/states/{{.Id}}/pending_partial_withdrawals"
+	getPendingConsolidationsPath     = "/eth/v1/beacon/states/{{.Id}}/pending_consolidations"
 )
 
 // StateOrBlockId represents the block_id / state_id parameters that several of the Eth Beacon API methods accept.
@@ -334,6 +338,60 @@ func (c *Client) GetBLStoExecutionChanges(ctx context.Context) (*structs.BLSToEx
 	return poolResponse, nil
 }
 
+var getPendingDepositsTpl = idTemplate(getPendingDepositsPath)
+
+// GetPendingDeposits retrieves the pending deposits queue of the post-Electra state identified by stateId.
+// State identifier can be one of: "head" (canonical head in node's view), "genesis", "finalized",
+// <slot>, <hex encoded stateRoot with 0x prefix>. Variables of type StateOrBlockId are exported by this package
+// for the named identifiers.
+func (c *Client) GetPendingDeposits(ctx context.Context, stateId StateOrBlockId) (*structs.GetPendingDepositsResponse, error) {
+	body, err := c.Get(ctx, getPendingDepositsTpl(stateId))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error requesting pending deposits by state id = %s", stateId)
+	}
+	resp := &structs.GetPendingDepositsResponse{}
+	if err := json.Unmarshal(body, resp); err != nil {
+		return nil, errors.Wrap(err, "error decoding json response in GetPendingDeposits")
+	}
+	return resp, nil
+}
+
+var getPendingPartialWithdrawalsTpl = idTemplate(getPendingPartialWithdrawalsPath)
+
+// GetPendingPartialWithdrawals retrieves the pending partial withdrawals queue of the post-Electra state identified
+// by stateId. State identifier can be one of: "head" (canonical head in node's view), "genesis", "finalized",
+// <slot>, <hex encoded stateRoot with 0x prefix>. Variables of type StateOrBlockId are exported by this package
+// for the named identifiers.
+func (c *Client) GetPendingPartialWithdrawals(ctx context.Context, stateId StateOrBlockId) (*structs.GetPendingPartialWithdrawalsResponse, error) {
+	body, err := c.Get(ctx, getPendingPartialWithdrawalsTpl(stateId))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error requesting pending partial withdrawals by state id = %s", stateId)
+	}
+	resp := &structs.GetPendingPartialWithdrawalsResponse{}
+	if err := json.Unmarshal(body, resp); err != nil {
+		return nil, errors.Wrap(err, "error decoding json response in GetPendingPartialWithdrawals")
+	}
+	return resp, nil
+}
+
+var getPendingConsolidationsTpl = idTemplate(getPendingConsolidationsPath)
+
+// GetPendingConsolidations retrieves the pending consolidations queue of the post-Electra state identified by stateId.
+// State identifier can be one of: "head" (canonical head in node's view), "genesis", "finalized",
+// <slot>, <hex encoded stateRoot with 0x prefix>. Variables of type StateOrBlockId are exported by this package
+// for the named identifiers.
+func (c *Client) GetPendingConsolidations(ctx context.Context, stateId StateOrBlockId) (*structs.GetPendingConsolidationsResponse, error) {
+	body, err := c.Get(ctx, getPendingConsolidationsTpl(stateId))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error requesting pending consolidations by state id = %s", stateId)
+	}
+	resp := &structs.GetPendingConsolidationsResponse{}
+	if err := json.Unmarshal(body, resp); err != nil {
+		return nil, errors.Wrap(err, "error decoding json response in GetPendingConsolidations")
+	}
+	return resp, nil
+}
+
 type forkScheduleResponse struct {
 	Data []structs.Fork
 }
@@ -1,6 +1,10 @@
 package beacon
 
 import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
 	"net/url"
 	"testing"
 
@@ -137,3 +141,45 @@ func TestValidHostname(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPendingQueues(t *testing.T) {
+	ctx := context.Background()
+	trans := &testRT{rt: func(req *http.Request) (*http.Response, error) {
+		res := &http.Response{Request: req, StatusCode: http.StatusOK}
+		switch req.URL.Path {
+		case "/eth/v1/beacon/states/head/pending_deposits":
+			res.Body = io.NopCloser(bytes.NewBufferString(`{"version":"electra","execution_optimistic":false,"finalized":true,"data":[{"pubkey":"0x01","withdrawal_credentials":"0x02","amount":"32000000000","signature":"0x03","slot":"5"}]}`))
+		case "/eth/v1/beacon/states/finalized/pending_partial_withdrawals":
+			res.Body = io.NopCloser(bytes.NewBufferString(`{"version":"electra","execution_optimistic":false,"finalized":true,"data":[{"index":"1","amount":"100","withdrawable_epoch":"10"}]}`))
+		case "/eth/v1/beacon/states/10/pending_consolidations":
+			res.Body = io.NopCloser(bytes.NewBufferString(`{"version":"electra","execution_optimistic":true,"finalized":false,"data":[{"source_index":"1","target_index":"2"}]}`))
+		default:
+			res.StatusCode = http.StatusNotFound
+			res.Body = io.NopCloser(bytes.NewBufferString(`{"code":404,"message":"not found"}`))
+		}
+		return res, nil
+	}}
+	c, err := NewClient("http://localhost:3500", client.WithRoundTripper(trans))
+	require.NoError(t, err)
+
+	deposits, err := c.GetPendingDeposits(ctx, IdHead)
+	require.NoError(t, err)
+	require.Equal(t, "electra", deposits.Version)
+	require.Equal(t, true, deposits.Finalized)
+	require.Equal(t, 1, len(deposits.Data))
+	require.Equal(t, "32000000000", deposits.Data[0].Amount)
+
+	withdrawals, err := c.GetPendingPartialWithdrawals(ctx, IdFinalized)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(withdrawals.Data))
+	require.Equal(t, "10", withdrawals.Data[0].WithdrawableEpoch)
+
+	consolidations, err := c.GetPendingConsolidations(ctx, IdFromSlot(10))
+	require.NoError(t, err)
+	require.Equal(t, true, consolidations.ExecutionOptimistic)
+	require.Equal(t, 1, len(consolidations.Data))
+	require.Equal(t, "2", consolidations.Data[0].TargetIndex)
+
+	_, err = c.GetPendingConsolidations(ctx, IdGenesis)
+	require.ErrorIs(t, err, client.ErrNotFound)
+}
@@ -6,10 +6,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime"
 	"net"
 	"net/http"
 	"net/url"
 	"strings"
+	"sync/atomic"
 	"text/template"
 
 	"github.com/pkg/errors"
@@ -32,6 +34,9 @@ const (
 	getStatus                  = "/eth/v1/builder/status"
 	postBlindedBeaconBlockPath = "/eth/v1/builder/blinded_blocks"
 	postRegisterValidatorPath  = "/eth/v1/builder/validators"
+
+	// sszAcceptHeader prefers ssz encoded responses, but also accepts json.
+	sszAcceptHeader = api.OctetStreamMediaType + ";q=1.0," + api.JsonMediaType + ";q=0.9"
 )
 
 var errMalformedHostname = errors.New("hostname must include port, separated by one colon, like example.com:3500")
@@ -97,6 +102,10 @@ type Client struct {
 	hc      *http.Client
 	baseURL *url.URL
 	obvs    []observer
+	// sszEnabled makes the client request bids and submit blinded blocks with ssz encoding,
+	// until the relay answers that it does not support it.
+	sszEnabled     bool
+	sszUnsupported atomic.Bool
 }
 
 // NewClient constructs a new client with the provided options (ex WithTimeout).
@@ -108,8 +117,9 @@ func NewClient(host string, opts ...ClientOpt) (*Client, error) {
 		return nil, err
 	}
 	c := &Client{
-		hc:      &http.Client{},
-		baseURL: u,
+		hc:         &http.Client{},
+		baseURL:    u,
+		sszEnabled: true,
 	}
 	for _, o := range opts {
 		o(c)
@@ -117,6 +127,13 @@ func NewClient(host string, opts ...ClientOpt) (*Client, error) {
 	return c, nil
 }
 
+// WithoutSSZ makes the client only exchange json encoded bids and blocks with the relay.
+func WithoutSSZ() ClientOpt {
+	return func(c *Client) {
+		c.sszEnabled = false
+	}
+}
+
 func urlForHost(h string) (*url.URL, error) {
 	// try to parse as url (being permissive)
 	if u, err := url.Parse(h); err == nil && u.Host != "" {
@@ -135,10 +152,28 @@ func (c *Client) NodeURL() string {
 	return c.baseURL.String()
 }
 
+// useSSZ returns true if requests to the relay should be ssz encoded.
+func (c *Client) useSSZ() bool {
+	return c.sszEnabled && !c.sszUnsupported.Load()
+}
+
+// disableSSZ records that the relay does not support ssz, so that following requests use json.
+func (c *Client) disableSSZ(err error) {
+	if !c.sszUnsupported.Swap(true) {
+		log.WithError(err).WithField("url", c.NodeURL()).Info("Builder does not support ssz encoding, falling back to json")
+	}
+}
+
 type reqOption func(*http.Request)
 
 // do is a generic, opinionated request function to reduce boilerplate amongst the methods in this package api/client/builder.
-func (c *Client) do(ctx context.Context, method string, path string, body io.Reader, opts ...reqOption) (res []byte, err error) {
+func (c *Client) do(ctx context.Context, method string, path string, body io.Reader, opts ...reqOption) ([]byte, error) {
+	res, _, err := c.doWithHeader(ctx, method, path, body, opts...)
+	return res, err
+}
+
+// doWithHeader is like do, but also returns the headers of the response.
+func (c *Client) doWithHeader(ctx context.Context, method string, path string, body io.Reader, opts ...reqOption) (res []byte, header http.Header, err error) {
 	ctx, span := trace.StartSpan(ctx, "builder.client.do")
 	defer func() {
 		tracing.AnnotateError(span, err)
@@ -177,6 +212,7 @@ func (c *Client) do(ctx context.Context, method string, path string, body io.Rea
 		err = non200Err(r)
 		return
 	}
+	header = r.Header
 	res, err = io.ReadAll(io.LimitReader(r.Body, client.MaxBodySize))
 	if err != nil {
 		err = errors.Wrap(err, "error reading http response body from builder server")
@@ -211,10 +247,44 @@ func (c *Client) GetHeader(ctx context.Context, slot primitives.Slot, parentHash
 	if err != nil {
 		return nil, err
 	}
+	if c.useSSZ() {
+		bid, err := c.getHeaderSSZ(ctx, path, slot, parentHash, pubkey)
+		if !errors.Is(err, ErrUnsupportedMediaType) && !errors.Is(err, ErrNotAcceptable) {
+			return bid, err
+		}
+		c.disableSSZ(err)
+	}
 	hb, err := c.do(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
 	}
+	return parseHeaderJSON(hb, slot, parentHash, pubkey)
+}
+
+// getHeaderSSZ requests the header preferably ssz encoded. The relay may still answer with json.
+func (c *Client) getHeaderSSZ(ctx context.Context, path string, slot primitives.Slot, parentHash [32]byte, pubkey [48]byte) (SignedBid, error) {
+	hb, header, err := c.doWithHeader(ctx, http.MethodGet, path, nil, func(r *http.Request) {
+		r.Header.Set("Accept", sszAcceptHeader)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !isSSZResponse(header) {
+		return parseHeaderJSON(hb, slot, parentHash, pubkey)
+	}
+	v, err := version.FromString(strings.ToLower(header.Get(api.VersionHeader)))
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid %s header of ssz GetHeader response", api.VersionHeader)
+	}
+	bid, err := signedBidFromSSZ(v, hb)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error unmarshaling the ssz builder GetHeader response, using slot=%d, parentHash=%#x, pubkey=%#x", slot, parentHash, pubkey)
+	}
+	return bid, nil
+}
+
+// parseHeaderJSON decodes the json encoded bid of a GetHeader response.
+func parseHeaderJSON(hb []byte, slot primitives.Slot, parentHash [32]byte, pubkey [48]byte) (SignedBid, error) {
 	v := &VersionResponse{}
 	if err := json.Unmarshal(hb, v); err != nil {
 		return nil, errors.Wrapf(err, "error unmarshaling the builder GetHeader response, using slot=%d, parentHash=%#x, pubkey=%#x", slot, parentHash, pubkey)
@@ -294,6 +364,13 @@ func (c *Client) SubmitBlindedBlock(ctx context.Context, sb interfaces.ReadOnlyS
 	if !sb.IsBlinded() {
 		return nil, nil, errNotBlinded
 	}
+	if c.useSSZ() {
+		ed, bundle, err := c.submitBlindedBlockSSZ(ctx, sb)
+		if !errors.Is(err, ErrUnsupportedMediaType) && !errors.Is(err, ErrNotAcceptable) {
+			return ed, bundle, err
+		}
+		c.disableSSZ(err)
+	}
 
 	// massage the proto struct type data into the api response type.
 	mj, err := structs.SignedBeaconBlockMessageJsoner(sb)
@@ -316,6 +393,43 @@ func (c *Client) SubmitBlindedBlock(ctx context.Context, sb interfaces.ReadOnlyS
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "error posting the blinded block to the builder api")
 	}
+	return parsePayloadJSON(rb, sb)
+}
+
+// submitBlindedBlockSSZ posts the ssz encoded blinded block. The relay may answer with json.
+func (c *Client) submitBlindedBlockSSZ(ctx context.Context, sb interfaces.ReadOnlySignedBeaconBlock) (interfaces.ExecutionData, *v1.BlobsBundle, error) {
+	body, err := sb.MarshalSSZ()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "error marshaling blinded block post request to ssz")
+	}
+	postOpts := func(r *http.Request) {
+		r.Header.Add(api.VersionHeader, version.String(sb.Version()))
+		r.Header.Set("Content-Type", api.OctetStreamMediaType)
+		r.Header.Set("Accept", sszAcceptHeader)
+	}
+	rb, header, err := c.doWithHeader(ctx, http.MethodPost, postBlindedBeaconBlockPath, bytes.NewBuffer(body), postOpts)
+	if err != nil {
+		if errors.Is(err, ErrUnsupportedMediaType) || errors.Is(err, ErrNotAcceptable) {
+			return nil, nil, err
+		}
+		return nil, nil, errors.Wrap(err, "error posting the ssz blinded block to the builder api")
+	}
+	if !isSSZResponse(header) {
+		return parsePayloadJSON(rb, sb)
+	}
+	if v := header.Get(api.VersionHeader); v != "" && strings.ToLower(v) != version.String(sb.Version()) {
+		return nil, nil, errors.Wrapf(errResponseVersionMismatch, "req=%s, recv=%s", version.String(sb.Version()), strings.ToLower(v))
+	}
+	ed, bundle, err := payloadFromSSZ(sb.Version(), rb)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to parse ssz execution payload from builder with version=%s", version.String(sb.Version()))
+	}
+	return ed, bundle, nil
+}
+
+// parsePayloadJSON decodes the json encoded execution payload, and blobs bundle post deneb, of a
+// SubmitBlindedBlock response.
+func parsePayloadJSON(rb []byte, sb interfaces.ReadOnlySignedBeaconBlock) (interfaces.ExecutionData, *v1.BlobsBundle, error) {
 	// ExecutionPayloadResponse parses just the outer container and the Value key, enabling it to use the .Value
 	// key to determine which underlying data type to use to finish the unmarshaling.
 	ep := &ExecutionPayloadResponse{}
@@ -358,6 +472,12 @@ func (c *Client) Status(ctx context.Context) error {
 	return err
 }
 
+// isSSZResponse returns true if the response body is ssz encoded.
+func isSSZResponse(header http.Header) bool {
+	mt, _, err := mime.ParseMediaType(header.Get("Content-Type"))
+	return err == nil && mt == api.OctetStreamMediaType
+}
+
 func non200Err(response *http.Response) error {
 	bodyBytes, err := io.ReadAll(io.LimitReader(response.Body, client.MaxErrBodySize))
 	var errMessage ErrorMessage
@@ -378,6 +498,12 @@ func non200Err(response *http.Response) error {
 			return errors.Wrap(jsonErr, "unable to read response body")
 		}
 		return errors.Wrap(ErrBadRequest, errMessage.Message)
+	case http.StatusUnsupportedMediaType:
+		log.WithError(ErrUnsupportedMediaType).Debug(msg)
+		return ErrUnsupportedMediaType
+	case http.StatusNotAcceptable:
+		log.WithError(ErrNotAcceptable).Debug(msg)
+		return ErrNotAcceptable
 	case http.StatusNotFound:
 		log.WithError(ErrNotFound).Debug(msg)
 		if jsonErr := json.Unmarshal(bodyBytes, &errMessage); jsonErr != nil {
@@ -0,0 +1,116 @@
+package builder
+
+import (
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	v1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+)
+
+const sszOffsetLength = 4
+
+var errInvalidSSZ = errors.New("invalid ssz encoding")
+
+// splitSignedBidSSZ splits the ssz encoding of a SignedBuilderBid container into the encoding of its
+// variable size message and its signature.
+func splitSignedBidSSZ(b []byte) ([]byte, []byte, error) {
+	fixedSize := sszOffsetLength + fieldparams.BLSSignatureLength
+	if len(b) < fixedSize {
+		return nil, nil, errors.Wrapf(errInvalidSSZ, "signed builder bid of %d bytes is too short", len(b))
+	}
+	if o := binary.LittleEndian.Uint32(b[:sszOffsetLength]); o != uint32(fixedSize) {
+		return nil, nil, errors.Wrapf(errInvalidSSZ, "unexpected signed builder bid message offset %d", o)
+	}
+	return b[fixedSize:], b[sszOffsetLength:fixedSize], nil
+}
+
+// signedBidFromSSZ decodes the ssz encoded bid of a GetHeader response for the given fork version.
+func signedBidFromSSZ(v int, b []byte) (SignedBid, error) {
+	msg, sig, err := splitSignedBidSSZ(b)
+	if err != nil {
+		return nil, err
+	}
+	switch v {
+	case version.Deneb:
+		bid := &ethpb.BuilderBidDeneb{}
+		if err := bid.UnmarshalSSZ(msg); err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal deneb builder bid")
+		}
+		return WrappedSignedBuilderBidDeneb(&ethpb.SignedBuilderBidDeneb{Message: bid, Signature: sig})
+	case version.Capella:
+		bid := &ethpb.BuilderBidCapella{}
+		if err := bid.UnmarshalSSZ(msg); err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal capella builder bid")
+		}
+		return WrappedSignedBuilderBidCapella(&ethpb.SignedBuilderBidCapella{Message: bid, Signature: sig})
+	case version.Bellatrix:
+		bid := &ethpb.BuilderBid{}
+		if err := bid.UnmarshalSSZ(msg); err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal bellatrix builder bid")
+		}
+		return WrappedSignedBuilderBid(&ethpb.SignedBuilderBid{Message: bid, Signature: sig})
+	default:
+		return nil, errors.Errorf("unsupported header version %s", version.String(v))
+	}
+}
+
+// payloadFromSSZ decodes the ssz encoded execution payload, and blobs bundle post deneb, of a
+// SubmitBlindedBlock response for the given fork version.
+func payloadFromSSZ(v int, b []byte) (interfaces.ExecutionData, *v1.BlobsBundle, error) {
+	switch v {
+	case version.Deneb:
+		payload, bundle, err := splitPayloadAndBlobsBundleSSZ(b)
+		if err != nil {
+			return nil, nil, err
+		}
+		p := &v1.ExecutionPayloadDeneb{}
+		if err := p.UnmarshalSSZ(payload); err != nil {
+			return nil, nil, errors.Wrap(err, "could not unmarshal deneb execution payload")
+		}
+		bb := &v1.BlobsBundle{}
+		if err := bb.UnmarshalSSZ(bundle); err != nil {
+			return nil, nil, errors.Wrap(err, "could not unmarshal blobs bundle")
+		}
+		ed, err := blocks.NewWrappedExecutionData(p)
+		if err != nil {
+			return nil, nil, err
+		}
+		return ed, bb, nil
+	case version.Capella:
+		p := &v1.ExecutionPayloadCapella{}
+		if err := p.UnmarshalSSZ(b); err != nil {
+			return nil, nil, errors.Wrap(err, "could not unmarshal capella execution payload")
+		}
+		ed, err := blocks.NewWrappedExecutionData(p)
+		return ed, nil, err
+	case version.Bellatrix:
+		p := &v1.ExecutionPayload{}
+		if err := p.UnmarshalSSZ(b); err != nil {
+			return nil, nil, errors.Wrap(err, "could not unmarshal bellatrix execution payload")
+		}
+		ed, err := blocks.NewWrappedExecutionData(p)
+		return ed, nil, err
+	default:
+		return nil, nil, errors.Errorf("unsupported payload version %s", version.String(v))
+	}
+}
+
+// splitPayloadAndBlobsBundleSSZ splits the ssz encoding of an ExecutionPayloadAndBlobsBundle container
+// into the encodings of its two variable size fields.
+func splitPayloadAndBlobsBundleSSZ(b []byte) ([]byte, []byte, error) {
+	fixedSize := 2 * sszOffsetLength
+	if len(b) < fixedSize {
+		return nil, nil, errors.Wrapf(errInvalidSSZ, "execution payload and blobs bundle of %d bytes is too short", len(b))
+	}
+	o0 := binary.LittleEndian.Uint32(b[:sszOffsetLength])
+	o1 := binary.LittleEndian.Uint32(b[sszOffsetLength:fixedSize])
+	if o0 != uint32(fixedSize) || o1 < o0 || uint64(o1) > uint64(len(b)) {
+		return nil, nil, errors.Wrapf(errInvalidSSZ, "unexpected execution payload and blobs bundle offsets %d, %d", o0, o1)
+	}
+	return b[o0:o1], b[o1:], nil
+}
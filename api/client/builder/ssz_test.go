@@ -0,0 +1,259 @@
+package builder
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	v1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func sszSignedBidCapella(t *testing.T) ([]byte, SignedBid) {
+	hr := &ExecHeaderResponseCapella{}
+	require.NoError(t, json.Unmarshal([]byte(testExampleHeaderResponseCapella), hr))
+	p, err := hr.ToProto()
+	require.NoError(t, err)
+	msg, err := p.Message.MarshalSSZ()
+	require.NoError(t, err)
+	enc := binary.LittleEndian.AppendUint32(nil, uint32(sszOffsetLength+len(p.Signature)))
+	enc = append(enc, p.Signature...)
+	enc = append(enc, msg...)
+	bid, err := WrappedSignedBuilderBidCapella(p)
+	require.NoError(t, err)
+	return enc, bid
+}
+
+func sszPayloadAndBlobsBundleDeneb(t *testing.T) ([]byte, *v1.ExecutionPayloadDeneb, *v1.BlobsBundle) {
+	r := &ExecPayloadResponseDeneb{}
+	require.NoError(t, json.Unmarshal([]byte(testExampleExecutionPayloadDeneb), r))
+	pb, err := r.Data.PayloadProto()
+	require.NoError(t, err)
+	payload, ok := pb.(*v1.ExecutionPayloadDeneb)
+	require.Equal(t, true, ok)
+	bundle, err := r.Data.BundleProto()
+	require.NoError(t, err)
+	pEnc, err := payload.MarshalSSZ()
+	require.NoError(t, err)
+	bEnc, err := bundle.MarshalSSZ()
+	require.NoError(t, err)
+	enc := binary.LittleEndian.AppendUint32(nil, 2*sszOffsetLength)
+	enc = binary.LittleEndian.AppendUint32(enc, uint32(2*sszOffsetLength+len(pEnc)))
+	enc = append(enc, pEnc...)
+	enc = append(enc, bEnc...)
+	return enc, payload, bundle
+}
+
+// sszBlindedBlockDeneb returns the deneb test block without its deposits, whose proofs are too short to be ssz encoded.
+func sszBlindedBlockDeneb(t *testing.T) interfaces.ReadOnlySignedBeaconBlock {
+	b := testSignedBlindedBeaconBlockDeneb(t)
+	b.Message.Body.Deposits = nil
+	sbb, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	return sbb
+}
+
+func TestClient_GetHeader_SSZ(t *testing.T) {
+	ctx := context.Background()
+	enc, want := sszSignedBidCapella(t)
+	hc := &http.Client{
+		Transport: roundtrip(func(r *http.Request) (*http.Response, error) {
+			require.Equal(t, sszAcceptHeader, r.Header.Get("Accept"))
+			header := http.Header{}
+			header.Set("Content-Type", api.OctetStreamMediaType)
+			header.Set(api.VersionHeader, "capella")
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     header,
+				Body:       io.NopCloser(bytes.NewBuffer(enc)),
+				Request:    r.Clone(ctx),
+			}, nil
+		}),
+	}
+	c := &Client{
+		hc:         hc,
+		baseURL:    &url.URL{Host: "localhost:3500", Scheme: "http"},
+		sszEnabled: true,
+	}
+	bid, err := c.GetHeader(ctx, 23, [32]byte{}, [48]byte{})
+	require.NoError(t, err)
+	gotMsg, err := bid.Message()
+	require.NoError(t, err)
+	wantMsg, err := want.Message()
+	require.NoError(t, err)
+	require.DeepEqual(t, wantMsg.Pubkey(), gotMsg.Pubkey())
+	require.DeepEqual(t, want.Signature(), bid.Signature())
+	gotHeader, err := gotMsg.Header()
+	require.NoError(t, err)
+	wantHeader, err := wantMsg.Header()
+	require.NoError(t, err)
+	require.DeepEqual(t, wantHeader.BlockHash(), gotHeader.BlockHash())
+}
+
+func TestClient_GetHeader_SSZJsonResponse(t *testing.T) {
+	ctx := context.Background()
+	hc := &http.Client{
+		Transport: roundtrip(func(r *http.Request) (*http.Response, error) {
+			// The relay ignores the ssz preference and answers with json.
+			require.Equal(t, sszAcceptHeader, r.Header.Get("Accept"))
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewBufferString(testExampleHeaderResponseCapella)),
+				Request:    r.Clone(ctx),
+			}, nil
+		}),
+	}
+	c := &Client{
+		hc:         hc,
+		baseURL:    &url.URL{Host: "localhost:3500", Scheme: "http"},
+		sszEnabled: true,
+	}
+	_, err := c.GetHeader(ctx, 23, [32]byte{}, [48]byte{})
+	require.NoError(t, err)
+	assert.Equal(t, true, c.useSSZ())
+}
+
+func TestClient_GetHeader_SSZNotAcceptable(t *testing.T) {
+	ctx := context.Background()
+	var sszRequests, jsonRequests int
+	hc := &http.Client{
+		Transport: roundtrip(func(r *http.Request) (*http.Response, error) {
+			if r.Header.Get("Accept") == sszAcceptHeader {
+				sszRequests++
+				return &http.Response{
+					StatusCode: http.StatusNotAcceptable,
+					Body:       io.NopCloser(bytes.NewBuffer(nil)),
+					Request:    r.Clone(ctx),
+				}, nil
+			}
+			jsonRequests++
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewBufferString(testExampleHeaderResponseCapella)),
+				Request:    r.Clone(ctx),
+			}, nil
+		}),
+	}
+	c := &Client{
+		hc:         hc,
+		baseURL:    &url.URL{Host: "localhost:3500", Scheme: "http"},
+		sszEnabled: true,
+	}
+	for i := 0; i < 2; i++ {
+		_, err := c.GetHeader(ctx, 23, [32]byte{}, [48]byte{})
+		require.NoError(t, err)
+	}
+	// SSZ is only tried once, the relay then only receives json requests.
+	assert.Equal(t, 1, sszRequests)
+	assert.Equal(t, 2, jsonRequests)
+	assert.Equal(t, false, c.useSSZ())
+}
+
+func TestSubmitBlindedBlock_SSZ(t *testing.T) {
+	ctx := context.Background()
+	enc, payload, bundle := sszPayloadAndBlobsBundleDeneb(t)
+	sbb := sszBlindedBlockDeneb(t)
+	wantBody, err := sbb.MarshalSSZ()
+	require.NoError(t, err)
+	hc := &http.Client{
+		Transport: roundtrip(func(r *http.Request) (*http.Response, error) {
+			require.Equal(t, postBlindedBeaconBlockPath, r.URL.Path)
+			require.Equal(t, "deneb", r.Header.Get(api.VersionHeader))
+			require.Equal(t, api.OctetStreamMediaType, r.Header.Get("Content-Type"))
+			require.Equal(t, sszAcceptHeader, r.Header.Get("Accept"))
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			require.DeepEqual(t, wantBody, body)
+			header := http.Header{}
+			header.Set("Content-Type", api.OctetStreamMediaType)
+			header.Set(api.VersionHeader, "deneb")
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     header,
+				Body:       io.NopCloser(bytes.NewBuffer(enc)),
+				Request:    r.Clone(ctx),
+			}, nil
+		}),
+	}
+	c := &Client{
+		hc:         hc,
+		baseURL:    &url.URL{Host: "localhost:3500", Scheme: "http"},
+		sszEnabled: true,
+	}
+	ep, bb, err := c.SubmitBlindedBlock(ctx, sbb)
+	require.NoError(t, err)
+	require.DeepEqual(t, payload.BlockHash, ep.BlockHash())
+	require.DeepSSZEqual(t, bundle, bb)
+}
+
+func TestSubmitBlindedBlock_SSZUnsupportedMediaType(t *testing.T) {
+	ctx := context.Background()
+	var sszRequests, jsonRequests int
+	hc := &http.Client{
+		Transport: roundtrip(func(r *http.Request) (*http.Response, error) {
+			if r.Header.Get("Content-Type") == api.OctetStreamMediaType {
+				sszRequests++
+				return &http.Response{
+					StatusCode: http.StatusUnsupportedMediaType,
+					Body:       io.NopCloser(bytes.NewBuffer(nil)),
+					Request:    r.Clone(ctx),
+				}, nil
+			}
+			jsonRequests++
+			require.Equal(t, api.JsonMediaType, r.Header.Get("Content-Type"))
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewBufferString(testExampleExecutionPayloadDeneb)),
+				Request:    r.Clone(ctx),
+			}, nil
+		}),
+	}
+	c := &Client{
+		hc:         hc,
+		baseURL:    &url.URL{Host: "localhost:3500", Scheme: "http"},
+		sszEnabled: true,
+	}
+	sbb := sszBlindedBlockDeneb(t)
+	for i := 0; i < 2; i++ {
+		_, bb, err := c.SubmitBlindedBlock(ctx, sbb)
+		require.NoError(t, err)
+		require.NotNil(t, bb)
+	}
+	assert.Equal(t, 1, sszRequests)
+	assert.Equal(t, 2, jsonRequests)
+}
+
+func TestSplitSSZ_Invalid(t *testing.T) {
+	_, _, err := splitSignedBidSSZ(make([]byte, 10))
+	require.ErrorIs(t, err, errInvalidSSZ)
+	enc, _ := sszSignedBidCapella(t)
+	enc[0] = 0
+	_, _, err = splitSignedBidSSZ(enc)
+	require.ErrorIs(t, err, errInvalidSSZ)
+
+	_, _, err = splitPayloadAndBlobsBundleSSZ([]byte{8, 0, 0, 0, 100, 0, 0, 0})
+	require.ErrorIs(t, err, errInvalidSSZ)
+	payload, bundle, err := splitPayloadAndBlobsBundleSSZ(bytesutil.PadTo([]byte{8, 0, 0, 0, 9}, 10))
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(payload))
+	assert.Equal(t, 1, len(bundle))
+}
+
+func TestNewClient_SSZ(t *testing.T) {
+	c, err := NewClient("localhost:3500")
+	require.NoError(t, err)
+	assert.Equal(t, true, c.useSSZ())
+	c, err = NewClient("localhost:3500", WithoutSSZ())
+	require.NoError(t, err)
+	assert.Equal(t, false, c.useSSZ())
+}
@@ -0,0 +1,76 @@
+package event
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+)
+
+// ErrUnknownEventType is returned when decoding an event of a type that has no known representation.
+var ErrUnknownEventType = errors.New("unknown event type")
+
+// Decode unmarshals the data of the event into the api/server/structs value of its event type.
+// Attestations are decoded as *structs.AttestationElectra when they have committee bits, and as *structs.Attestation
+// otherwise. Attester slashings are decoded as *structs.AttesterSlashingElectra, which has the same JSON
+// representation as pre-Electra attester slashings.
+func (e *Event) Decode() (any, error) {
+	var v any
+	switch e.EventType {
+	case EventHead:
+		v = &structs.HeadEvent{}
+	case EventBlock:
+		v = &structs.BlockEvent{}
+	case EventBlockGossip:
+		v = &structs.BlockGossipEvent{}
+	case EventAttestation:
+		return decodeAttestation(e.Data)
+	case EventSingleAttestation:
+		v = &structs.SingleAttestation{}
+	case EventVoluntaryExit:
+		v = &structs.SignedVoluntaryExit{}
+	case EventBlsToExecutionChange:
+		v = &structs.SignedBLSToExecutionChange{}
+	case EventProposerSlashing:
+		v = &structs.ProposerSlashing{}
+	case EventAttesterSlashing:
+		v = &structs.AttesterSlashingElectra{}
+	case EventFinalizedCheckpoint:
+		v = &structs.FinalizedCheckpointEvent{}
+	case EventChainReorg:
+		v = &structs.ChainReorgEvent{}
+	case EventContributionAndProof:
+		v = &structs.SignedContributionAndProof{}
+	case EventLightClientFinalityUpdate:
+		v = &structs.LightClientFinalityUpdateEvent{}
+	case EventLightClientOptimisticUpdate:
+		v = &structs.LightClientOptimisticUpdateEvent{}
+	case EventPayloadAttributes:
+		v = &structs.PayloadAttributesEvent{}
+	case EventBlobSidecar:
+		v = &structs.BlobSidecarEvent{}
+	case EventDataColumnSidecar:
+		v = &structs.DataColumnSidecarEvent{}
+	default:
+		return nil, errors.Wrapf(ErrUnknownEventType, "%s", e.EventType)
+	}
+	if err := json.Unmarshal(e.Data, v); err != nil {
+		return nil, errors.Wrapf(err, "could not decode %s event", e.EventType)
+	}
+	return v, nil
+}
+
+func decodeAttestation(data []byte) (any, error) {
+	electra := &structs.AttestationElectra{}
+	if err := json.Unmarshal(data, electra); err != nil {
+		return nil, errors.Wrapf(err, "could not decode %s event", EventAttestation)
+	}
+	if electra.CommitteeBits != "" {
+		return electra, nil
+	}
+	return &structs.Attestation{
+		AggregationBits: electra.AggregationBits,
+		Data:            electra.Data,
+		Signature:       electra.Signature,
+	}, nil
+}
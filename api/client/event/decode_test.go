@@ -0,0 +1,69 @@
+package event
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestEvent_Decode(t *testing.T) {
+	t.Run("block gossip", func(t *testing.T) {
+		e := &Event{EventType: EventBlockGossip, Data: []byte(`{"slot":"10","block":"0x01"}`)}
+		v, err := e.Decode()
+		require.NoError(t, err)
+		require.DeepEqual(t, &structs.BlockGossipEvent{Slot: "10", Block: "0x01"}, v)
+	})
+	t.Run("single attestation", func(t *testing.T) {
+		e := &Event{EventType: EventSingleAttestation, Data: []byte(`{"committee_index":"1","attester_index":"2","data":{"slot":"3"},"signature":"0x04"}`)}
+		v, err := e.Decode()
+		require.NoError(t, err)
+		att, ok := v.(*structs.SingleAttestation)
+		require.Equal(t, true, ok)
+		require.Equal(t, "2", att.AttesterIndex)
+		require.Equal(t, "3", att.Data.Slot)
+	})
+	t.Run("data column sidecar", func(t *testing.T) {
+		e := &Event{EventType: EventDataColumnSidecar, Data: []byte(`{"block_root":"0x01","index":"5","slot":"6","kzg_commitments":["0x02","0x03"]}`)}
+		v, err := e.Decode()
+		require.NoError(t, err)
+		require.DeepEqual(t, &structs.DataColumnSidecarEvent{
+			BlockRoot:      "0x01",
+			Index:          "5",
+			Slot:           "6",
+			KzgCommitments: []string{"0x02", "0x03"},
+		}, v)
+	})
+	t.Run("attestation", func(t *testing.T) {
+		e := &Event{EventType: EventAttestation, Data: []byte(`{"aggregation_bits":"0x01","data":{"slot":"1"},"signature":"0x02"}`)}
+		v, err := e.Decode()
+		require.NoError(t, err)
+		_, ok := v.(*structs.Attestation)
+		require.Equal(t, true, ok)
+
+		e = &Event{EventType: EventAttestation, Data: []byte(`{"aggregation_bits":"0x01","data":{"slot":"1"},"signature":"0x02","committee_bits":"0x03"}`)}
+		v, err = e.Decode()
+		require.NoError(t, err)
+		att, ok := v.(*structs.AttestationElectra)
+		require.Equal(t, true, ok)
+		require.Equal(t, "0x03", att.CommitteeBits)
+	})
+	t.Run("attester slashing", func(t *testing.T) {
+		e := &Event{EventType: EventAttesterSlashing, Data: []byte(`{"attestation_1":{"attesting_indices":["1"]},"attestation_2":{"attesting_indices":["1"]}}`)}
+		v, err := e.Decode()
+		require.NoError(t, err)
+		s, ok := v.(*structs.AttesterSlashingElectra)
+		require.Equal(t, true, ok)
+		require.DeepEqual(t, []string{"1"}, s.Attestation1.AttestingIndices)
+	})
+	t.Run("unknown event", func(t *testing.T) {
+		e := &Event{EventType: "unknown", Data: []byte(`{}`)}
+		_, err := e.Decode()
+		require.ErrorIs(t, err, ErrUnknownEventType)
+	})
+	t.Run("invalid json", func(t *testing.T) {
+		e := &Event{EventType: EventHead, Data: []byte(`{`)}
+		_, err := e.Decode()
+		require.ErrorContains(t, "could not decode head event", err)
+	})
+}
@@ -28,6 +28,9 @@ const (
 	EventLightClientOptimisticUpdate = "light_client_optimistic_update"
 	EventPayloadAttributes           = "payload_attributes"
 	EventBlobSidecar                 = "blob_sidecar"
+	EventSingleAttestation           = "single_attestation"
+	EventBlockGossip                 = "block_gossip"
+	EventDataColumnSidecar           = "data_column_sidecar"
 	EventError                       = "error"
 	EventConnectionError             = "connection_error"
 )
@@ -64,6 +64,22 @@ type Version struct {
 	Version string `json:"version"`
 }
 
+type GetVersionV2Response struct {
+	Data *VersionV2 `json:"data"`
+}
+
+type VersionV2 struct {
+	BeaconNode      *ClientVersionV1 `json:"beacon_node"`
+	ExecutionClient *ClientVersionV1 `json:"execution_client,omitempty"`
+}
+
+type ClientVersionV1 struct {
+	Code    string `json:"code"`
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Commit  string `json:"commit"`
+}
+
 type AddrRequest struct {
 	Addr string `json:"addr"`
 }
@@ -0,0 +1,107 @@
+package blockchain
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+var errNoForkchoiceSnapshot = errors.New("no forkchoice snapshot in db")
+
+// saveForkchoiceSnapshot writes the current fork choice store to the db.
+func (s *Service) saveForkchoiceSnapshot(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "blockChain.saveForkchoiceSnapshot")
+	defer span.End()
+
+	s.cfg.ForkChoiceStore.RLock()
+	fcs, err := s.cfg.ForkChoiceStore.Snapshot()
+	s.cfg.ForkChoiceStore.RUnlock()
+	if err != nil {
+		return errors.Wrap(err, "could not snapshot forkchoice")
+	}
+	snapshot := fcs.Encode()
+	if err := s.cfg.BeaconDB.SaveForkchoiceSnapshot(ctx, snapshot); err != nil {
+		return errors.Wrap(err, "could not save forkchoice snapshot")
+	}
+	log.WithField("bytes", len(snapshot)).Debug("Saved forkchoice snapshot")
+	return nil
+}
+
+// spawnForkchoiceSnapshotRoutine saves the fork choice store at the start of every epoch that is a multiple of the
+// configured snapshot interval, so that little is lost if the node does not shut down cleanly.
+func (s *Service) spawnForkchoiceSnapshotRoutine() {
+	go func() {
+		if _, err := s.clockWaiter.WaitForClock(s.ctx); err != nil {
+			log.WithError(err).Error("spawnForkchoiceSnapshotRoutine failed to receive genesis data")
+			return
+		}
+		ticker := slots.NewSlotTicker(s.genesisTime, params.BeaconConfig().SecondsPerSlot)
+		defer ticker.Done()
+		for {
+			select {
+			case <-s.ctx.Done():
+				return
+			case slot := <-ticker.C():
+				if !slots.IsEpochStart(slot) || slots.ToEpoch(slot)%s.cfg.ForkchoiceSnapshotInterval != 0 {
+					continue
+				}
+				if err := s.saveForkchoiceSnapshot(s.ctx); err != nil {
+					log.WithError(err).Error("Could not save forkchoice snapshot")
+				}
+			}
+		}
+	}()
+}
+
+// restoreForkchoiceSnapshot replaces the fork choice store with the snapshot saved in the db. The snapshot is only
+// used if it is consistent with the checkpoints in the db and all its blocks are in the db, otherwise an error is
+// returned and the fork choice store is left unchanged. The caller must hold the fork choice lock.
+func (s *Service) restoreForkchoiceSnapshot(ctx context.Context, justified, finalized *ethpb.Checkpoint) error {
+	ctx, span := trace.StartSpan(ctx, "blockChain.restoreForkchoiceSnapshot")
+	defer span.End()
+
+	snapshot, err := s.cfg.BeaconDB.ForkchoiceSnapshot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get forkchoice snapshot")
+	}
+	if len(snapshot) == 0 {
+		return errNoForkchoiceSnapshot
+	}
+	// Decode into a scratch store first, so that the snapshot can be checked against the db.
+	scratch := doublylinkedtree.New()
+	if err := scratch.Restore(ctx, snapshot); err != nil {
+		return err
+	}
+	fcp := scratch.FinalizedCheckpoint()
+	if fcp.Epoch != finalized.Epoch || !bytes.Equal(fcp.Root[:], finalized.Root) {
+		return errors.Errorf("snapshot finalized checkpoint at epoch %d does not match the db finalized checkpoint at epoch %d",
+			fcp.Epoch, finalized.Epoch)
+	}
+	if jcp := scratch.JustifiedCheckpoint(); jcp.Epoch < justified.Epoch {
+		return errors.Errorf("snapshot justified checkpoint at epoch %d is older than the db justified checkpoint at epoch %d",
+			jcp.Epoch, justified.Epoch)
+	}
+	dump, err := scratch.ForkChoiceDump(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not dump forkchoice snapshot")
+	}
+	for _, n := range dump.ForkChoiceNodes {
+		root := bytesutil.ToBytes32(n.BlockRoot)
+		if !s.cfg.BeaconDB.HasBlock(ctx, root) {
+			return errors.Errorf("snapshot block %#x is not in the db", root)
+		}
+	}
+	if err := s.cfg.ForkChoiceStore.Restore(ctx, snapshot); err != nil {
+		return err
+	}
+	s.cfg.ForkChoiceStore.SetGenesisTime(uint64(s.genesisTime.Unix()))
+	log.WithField("nodes", len(dump.ForkChoiceNodes)).Info("Restored forkchoice from snapshot")
+	return nil
+}
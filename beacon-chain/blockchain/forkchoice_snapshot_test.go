@@ -0,0 +1,85 @@
+package blockchain
+
+import (
+	"testing"
+
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestStartFromSavedState_ForkchoiceSnapshot(t *testing.T) {
+	resetFn := features.InitWithReset(&features.Flags{
+		EnableStartOptimistic: true,
+	})
+	defer resetFn()
+	hook := logTest.NewGlobal()
+
+	genesis := util.NewBeaconBlock()
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	finalizedSlot := params.BeaconConfig().SlotsPerEpoch*2 + 1
+	headBlock := util.NewBeaconBlock()
+	headBlock.Block.Slot = finalizedSlot
+	headBlock.Block.ParentRoot = bytesutil.PadTo(genesisRoot[:], 32)
+	headState, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, headState.SetSlot(finalizedSlot))
+	require.NoError(t, headState.SetGenesisValidatorsRoot(params.BeaconConfig().ZeroHash[:]))
+	headRoot, err := headBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	c, tr := minimalTestService(t, WithFinalizedStateAtStartUp(headState), WithForkchoiceSnapshotInterval(1))
+	ctx, beaconDB, stateGen := tr.ctx, tr.db, tr.sg
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, genesisRoot))
+	util.SaveBlock(t, ctx, beaconDB, genesis)
+	require.NoError(t, beaconDB.SaveState(ctx, headState, headRoot))
+	require.NoError(t, beaconDB.SaveState(ctx, headState, genesisRoot))
+	util.SaveBlock(t, ctx, beaconDB, headBlock)
+	finalized := &ethpb.Checkpoint{Epoch: slots.ToEpoch(finalizedSlot), Root: headRoot[:]}
+	require.NoError(t, beaconDB.SaveFinalizedCheckpoint(ctx, finalized))
+	require.NoError(t, stateGen.SaveState(ctx, headRoot, headState))
+
+	// Without a snapshot, forkchoice is rebuilt from the finalized checkpoint.
+	require.NoError(t, c.StartFromSavedState(headState))
+	require.Equal(t, 1, c.cfg.ForkChoiceStore.NodeCount())
+
+	childBlock := util.NewBeaconBlock()
+	childBlock.Block.Slot = finalizedSlot + 1
+	childBlock.Block.ParentRoot = headRoot[:]
+	childRoot, err := childBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+	util.SaveBlock(t, ctx, beaconDB, childBlock)
+	st, roblock, err := prepareForkchoiceState(ctx, childBlock.Block.Slot, childRoot, headRoot, [32]byte{'c'}, finalized, finalized)
+	require.NoError(t, err)
+	c.cfg.ForkChoiceStore.Lock()
+	require.NoError(t, c.cfg.ForkChoiceStore.InsertNode(ctx, st, roblock))
+	c.cfg.ForkChoiceStore.Unlock()
+	require.NoError(t, c.saveForkchoiceSnapshot(ctx))
+
+	// Starting again with an empty forkchoice restores the snapshot.
+	restart := func() {
+		fcs := doublylinkedtree.New()
+		fcs.SetBalancesByRooter(stateGen.ActiveNonSlashedBalancesByRoot)
+		c.cfg.ForkChoiceStore = fcs
+		c.clockSetter = startup.NewClockSynchronizer()
+		require.NoError(t, c.StartFromSavedState(headState))
+	}
+	restart()
+	require.Equal(t, 2, c.cfg.ForkChoiceStore.NodeCount())
+	require.Equal(t, true, c.cfg.ForkChoiceStore.HasNode(childRoot))
+	require.LogsContain(t, hook, "Restored forkchoice from snapshot")
+
+	// A snapshot with blocks missing from the db is ignored.
+	require.NoError(t, beaconDB.DeleteBlock(ctx, childRoot))
+	restart()
+	require.Equal(t, 1, c.cfg.ForkChoiceStore.NodeCount())
+	require.LogsContain(t, hook, "Could not restore forkchoice snapshot")
+}
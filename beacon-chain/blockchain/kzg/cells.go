@@ -0,0 +1,123 @@
+package kzg
+
+import (
+	GoEthKZG "github.com/crate-crypto/go-eth-kzg"
+	"github.com/pkg/errors"
+)
+
+// BytesPerCell is the size in bytes of a single cell of an extended blob.
+const BytesPerCell = GoEthKZG.BytesPerCell
+
+// CellsPerExtBlob is the number of cells an extended blob is split into.
+const CellsPerExtBlob = GoEthKZG.CellsPerExtBlob
+
+var errUninitialized = errors.New("kzg context is not initialized, call kzg.Start first")
+
+// BlobToKZGCommitment computes the KZG commitment of the given blob.
+func BlobToKZGCommitment(blob []byte) ([]byte, error) {
+	if peerDASContext == nil {
+		return nil, errUninitialized
+	}
+	var b GoEthKZG.Blob
+	if len(blob) != len(b) {
+		return nil, errors.Errorf("invalid blob length %d, expected %d", len(blob), len(b))
+	}
+	copy(b[:], blob)
+	commitment, err := peerDASContext.BlobToKZGCommitment(&b, 0)
+	if err != nil {
+		return nil, errors.Wrap(err, "blob to kzg commitment")
+	}
+	return commitment[:], nil
+}
+
+// ComputeCellsAndKZGProofs extends the given blob and returns its cells along with the
+// KZG proof of each cell, ordered by cell index.
+func ComputeCellsAndKZGProofs(blob []byte) ([][]byte, [][]byte, error) {
+	if peerDASContext == nil {
+		return nil, nil, errUninitialized
+	}
+	var b GoEthKZG.Blob
+	if len(blob) != len(b) {
+		return nil, nil, errors.Errorf("invalid blob length %d, expected %d", len(blob), len(b))
+	}
+	copy(b[:], blob)
+	cells, proofs, err := peerDASContext.ComputeCellsAndKZGProofs(&b, 0)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "compute cells and kzg proofs")
+	}
+	return cellsToBytes(cells[:]), proofsToBytes(proofs[:]), nil
+}
+
+// RecoverCellsAndKZGProofs reconstructs all the cells and proofs of an extended blob from
+// at least half of its cells.
+func RecoverCellsAndKZGProofs(cellIndices []uint64, cells [][]byte) ([][]byte, [][]byte, error) {
+	if peerDASContext == nil {
+		return nil, nil, errUninitialized
+	}
+	if len(cellIndices) != len(cells) {
+		return nil, nil, errors.Errorf("mismatched number of cell indices (%d) and cells (%d)", len(cellIndices), len(cells))
+	}
+	c, err := bytesToCells(cells)
+	if err != nil {
+		return nil, nil, err
+	}
+	recovered, proofs, err := peerDASContext.RecoverCellsAndComputeKZGProofs(cellIndices, c, 0)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "recover cells and kzg proofs")
+	}
+	return cellsToBytes(recovered[:]), proofsToBytes(proofs[:]), nil
+}
+
+// VerifyCellKZGProofBatch checks that each cell at the given index is an evaluation of the
+// blob polynomial committed to by the commitment at the same position, using the given proof.
+func VerifyCellKZGProofBatch(commitments [][]byte, cellIndices []uint64, cells [][]byte, proofs [][]byte) error {
+	if peerDASContext == nil {
+		return errUninitialized
+	}
+	if len(commitments) != len(cells) || len(cellIndices) != len(cells) || len(proofs) != len(cells) {
+		return errors.New("mismatched number of commitments, cell indices, cells and proofs")
+	}
+	c, err := bytesToCells(cells)
+	if err != nil {
+		return err
+	}
+	cmts := make([]GoEthKZG.KZGCommitment, len(commitments))
+	for i := range commitments {
+		copy(cmts[i][:], commitments[i])
+	}
+	kzgProofs := make([]GoEthKZG.KZGProof, len(proofs))
+	for i := range proofs {
+		copy(kzgProofs[i][:], proofs[i])
+	}
+	return peerDASContext.VerifyCellKZGProofBatch(cmts, cellIndices, c, kzgProofs)
+}
+
+func bytesToCells(cells [][]byte) ([]*GoEthKZG.Cell, error) {
+	res := make([]*GoEthKZG.Cell, len(cells))
+	for i := range cells {
+		if len(cells[i]) != BytesPerCell {
+			return nil, errors.Errorf("invalid cell length %d, expected %d", len(cells[i]), BytesPerCell)
+		}
+		var c GoEthKZG.Cell
+		copy(c[:], cells[i])
+		res[i] = &c
+	}
+	return res, nil
+}
+
+func cellsToBytes(cells []*GoEthKZG.Cell) [][]byte {
+	res := make([][]byte, len(cells))
+	for i := range cells {
+		res[i] = cells[i][:]
+	}
+	return res
+}
+
+func proofsToBytes(proofs []GoEthKZG.KZGProof) [][]byte {
+	res := make([][]byte, len(proofs))
+	for i := range proofs {
+		p := proofs[i]
+		res[i] = p[:]
+	}
+	return res
+}
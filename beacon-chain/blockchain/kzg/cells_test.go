@@ -0,0 +1,54 @@
+package kzg
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestComputeAndVerifyCells(t *testing.T) {
+	require.NoError(t, Start())
+	blob := util.GetRandBlob(42)
+	commitment, _, err := GenerateCommitmentAndProof(blob)
+	require.NoError(t, err)
+	c, err := BlobToKZGCommitment(blob[:])
+	require.NoError(t, err)
+	require.DeepEqual(t, commitment[:], c)
+
+	cells, proofs, err := ComputeCellsAndKZGProofs(blob[:])
+	require.NoError(t, err)
+	require.Equal(t, CellsPerExtBlob, len(cells))
+	require.Equal(t, CellsPerExtBlob, len(proofs))
+
+	cmts := make([][]byte, 0, 2)
+	indices := []uint64{3, 100}
+	for range indices {
+		cmts = append(cmts, commitment[:])
+	}
+	require.NoError(t, VerifyCellKZGProofBatch(cmts, indices, [][]byte{cells[3], cells[100]}, [][]byte{proofs[3], proofs[100]}))
+	require.NotNil(t, VerifyCellKZGProofBatch(cmts, indices, [][]byte{cells[4], cells[100]}, [][]byte{proofs[3], proofs[100]}))
+	require.ErrorContains(t, "mismatched", VerifyCellKZGProofBatch(cmts, indices, [][]byte{cells[3]}, [][]byte{proofs[3], proofs[100]}))
+}
+
+func TestRecoverCellsAndKZGProofs(t *testing.T) {
+	require.NoError(t, Start())
+	blob := util.GetRandBlob(7)
+	cells, proofs, err := ComputeCellsAndKZGProofs(blob[:])
+	require.NoError(t, err)
+
+	half := CellsPerExtBlob / 2
+	indices := make([]uint64, 0, half)
+	partial := make([][]byte, 0, half)
+	for i := 0; i < CellsPerExtBlob; i += 2 {
+		indices = append(indices, uint64(i))
+		partial = append(partial, cells[i])
+	}
+	recovered, recoveredProofs, err := RecoverCellsAndKZGProofs(indices, partial)
+	require.NoError(t, err)
+	require.DeepEqual(t, cells, recovered)
+	require.DeepEqual(t, proofs, recoveredProofs)
+
+	_, _, err = RecoverCellsAndKZGProofs(indices[:half/2], partial[:half/2])
+	require.NotNil(t, err)
+}
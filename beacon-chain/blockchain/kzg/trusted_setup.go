@@ -4,6 +4,7 @@ import (
 	_ "embed"
 	"encoding/json"
 
+	GoEthKZG "github.com/crate-crypto/go-eth-kzg"
 	GoKZG "github.com/crate-crypto/go-kzg-4844"
 	"github.com/pkg/errors"
 )
@@ -12,6 +13,7 @@ var (
 	//go:embed trusted_setup.json
 	embeddedTrustedSetup []byte // 1.2Mb
 	kzgContext           *GoKZG.Context
+	peerDASContext       *GoEthKZG.Context
 )
 
 func Start() error {
@@ -24,5 +26,11 @@ func Start() error {
 	if err != nil {
 		return errors.Wrap(err, "could not initialize go-kzg context")
 	}
+	// The cell based PeerDAS methods require the monomial form of the setup, which the embedded
+	// setup does not provide, so the library's own copy of the ceremony output is used instead.
+	peerDASContext, err = GoEthKZG.NewContext4096Secure()
+	if err != nil {
+		return errors.Wrap(err, "could not initialize go-eth-kzg context")
+	}
 	return nil
 }
@@ -0,0 +1,87 @@
+package blockchain
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"google.golang.org/protobuf/proto"
+)
+
+// lightClientUpdate is a light client finality or optimistic update.
+type lightClientUpdate interface {
+	Proto() proto.Message
+	SignatureSlot() primitives.Slot
+}
+
+// pendingLightClientUpdates holds the light client updates produced by the node until they can be published.
+type pendingLightClientUpdates struct {
+	sync.Mutex
+	finality   lightClientUpdate
+	optimistic lightClientUpdate
+}
+
+// lightClientUpdateDue returns the time from which peers accept a light client update with the given signature slot,
+// which is a third of the way into the slot.
+func (s *Service) lightClientUpdateDue(signatureSlot primitives.Slot) time.Time {
+	cfg := params.BeaconConfig()
+	return slots.BeginsAt(signatureSlot, s.genesisTime).Add(time.Duration(cfg.SecondsPerSlot/cfg.IntervalsPerSlot) * time.Second)
+}
+
+// publishLightClientUpdate broadcasts the update if it is due, otherwise it replaces the pending update, which is
+// broadcast by the light client updates routine.
+func (s *Service) publishLightClientUpdate(ctx context.Context, pending *lightClientUpdate, update lightClientUpdate) error {
+	if !prysmTime.Now().Before(s.lightClientUpdateDue(update.SignatureSlot())) {
+		return s.cfg.P2p.Broadcast(ctx, update.Proto())
+	}
+	s.lcPending.Lock()
+	*pending = update
+	s.lcPending.Unlock()
+	return nil
+}
+
+// publishPendingLightClientUpdates broadcasts the pending light client updates which are due.
+func (s *Service) publishPendingLightClientUpdates(ctx context.Context) {
+	now := prysmTime.Now()
+	var due []lightClientUpdate
+	s.lcPending.Lock()
+	for _, pending := range []*lightClientUpdate{&s.lcPending.finality, &s.lcPending.optimistic} {
+		if *pending != nil && !now.Before(s.lightClientUpdateDue((*pending).SignatureSlot())) {
+			due = append(due, *pending)
+			*pending = nil
+		}
+	}
+	s.lcPending.Unlock()
+	for _, update := range due {
+		if err := s.cfg.P2p.Broadcast(ctx, update.Proto()); err != nil {
+			log.WithError(err).Error("Could not broadcast light client update")
+		}
+	}
+}
+
+// spawnLightClientUpdatesRoutine publishes the pending light client updates a third of the way into every slot, as
+// peers ignore the updates received before then.
+func (s *Service) spawnLightClientUpdatesRoutine() {
+	go func() {
+		if _, err := s.clockWaiter.WaitForClock(s.ctx); err != nil {
+			log.WithError(err).Error("spawnLightClientUpdatesRoutine failed to receive genesis data")
+			return
+		}
+		cfg := params.BeaconConfig()
+		offset := time.Duration(cfg.SecondsPerSlot/cfg.IntervalsPerSlot) * time.Second
+		ticker := slots.NewSlotTickerWithOffset(s.genesisTime, offset, cfg.SecondsPerSlot)
+		defer ticker.Done()
+		for {
+			select {
+			case <-s.ctx.Done():
+				return
+			case <-ticker.C():
+				s.publishPendingLightClientUpdates(s.ctx)
+			}
+		}
+	}()
+}
@@ -0,0 +1,58 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
+	"google.golang.org/protobuf/proto"
+)
+
+type testLightClientUpdate struct {
+	slot primitives.Slot
+}
+
+func (u testLightClientUpdate) Proto() proto.Message {
+	return &ethpb.LightClientOptimisticUpdateAltair{SignatureSlot: u.slot}
+}
+
+func (u testLightClientUpdate) SignatureSlot() primitives.Slot {
+	return u.slot
+}
+
+func TestPublishLightClientUpdate(t *testing.T) {
+	ctx := context.Background()
+	cfg := params.BeaconConfig()
+	propagation := time.Duration(cfg.SecondsPerSlot/cfg.IntervalsPerSlot) * time.Second
+
+	t.Run("before a third of the signature slot", func(t *testing.T) {
+		b := &mockBroadcaster{}
+		s := &Service{cfg: &config{P2p: b}, genesisTime: prysmTime.Now()}
+		require.NoError(t, s.publishLightClientUpdate(ctx, &s.lcPending.optimistic, testLightClientUpdate{slot: 0}))
+		s.publishPendingLightClientUpdates(ctx)
+		require.Equal(t, false, b.broadcastCalled)
+
+		s.genesisTime = s.genesisTime.Add(-propagation)
+		s.publishPendingLightClientUpdates(ctx)
+		require.Equal(t, true, b.broadcastCalled)
+		require.Equal(t, nil, s.lcPending.optimistic)
+	})
+	t.Run("after a third of the signature slot", func(t *testing.T) {
+		b := &mockBroadcaster{}
+		s := &Service{cfg: &config{P2p: b}, genesisTime: prysmTime.Now().Add(-propagation)}
+		require.NoError(t, s.publishLightClientUpdate(ctx, &s.lcPending.finality, testLightClientUpdate{slot: 0}))
+		require.Equal(t, true, b.broadcastCalled)
+		require.Equal(t, nil, s.lcPending.finality)
+	})
+	t.Run("newer update replaces the pending one", func(t *testing.T) {
+		s := &Service{cfg: &config{P2p: &mockBroadcaster{}}, genesisTime: prysmTime.Now().Add(-time.Duration(cfg.SecondsPerSlot) * time.Second)}
+		require.NoError(t, s.publishLightClientUpdate(ctx, &s.lcPending.optimistic, testLightClientUpdate{slot: 1}))
+		require.NoError(t, s.publishLightClientUpdate(ctx, &s.lcPending.optimistic, testLightClientUpdate{slot: 2}))
+		require.Equal(t, primitives.Slot(2), s.lcPending.optimistic.SignatureSlot())
+	})
+}
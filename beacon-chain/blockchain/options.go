@@ -4,6 +4,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/async/event"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
+	lightclient "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/light-client"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
@@ -16,6 +17,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 )
 
@@ -207,9 +209,42 @@ func WithBlobStorage(b *filesystem.BlobStorage) Option {
 	}
 }
 
+// WithDataColumnStorage sets the data column storage backend for the blockchain service.
+func WithDataColumnStorage(b *filesystem.DataColumnStorage) Option {
+	return func(s *Service) error {
+		s.dataColumnStorage = b
+		return nil
+	}
+}
+
+// WithLightClientStore sets the store that keeps the latest light client updates gossiped by the node.
+func WithLightClientStore(lcs *lightclient.Store) Option {
+	return func(s *Service) error {
+		s.lcStore = lcs
+		return nil
+	}
+}
+
+// WithCustodyManager sets the source of the custody requirements checked by data availability after Fulu.
+func WithCustodyManager(c p2p.CustodyManager) Option {
+	return func(s *Service) error {
+		s.cfg.CustodyManager = c
+		return nil
+	}
+}
+
 func WithSyncChecker(checker Checker) Option {
 	return func(s *Service) error {
 		s.cfg.SyncChecker = checker
 		return nil
 	}
 }
+
+// WithForkchoiceSnapshotInterval sets the number of epochs between fork choice store snapshots. A non zero interval
+// also enables saving a snapshot on shutdown and restoring it on startup.
+func WithForkchoiceSnapshotInterval(epochs primitives.Epoch) Option {
+	return func(s *Service) error {
+		s.cfg.ForkchoiceSnapshotInterval = epochs
+		return nil
+	}
+}
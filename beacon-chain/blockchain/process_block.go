@@ -8,6 +8,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/peerdas"
 	coreTime "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/time"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/das"
@@ -537,6 +538,9 @@ func (s *Service) isDataAvailable(ctx context.Context, root [32]byte, signed int
 	if signed.Version() < version.Deneb {
 		return nil
 	}
+	if signed.Version() >= version.Fulu {
+		return s.areDataColumnsAvailable(ctx, root, signed)
+	}
 
 	block := signed.Block()
 	if block == nil {
@@ -605,6 +609,89 @@ func (s *Service) isDataAvailable(ctx context.Context, root [32]byte, signed int
 	}
 }
 
+// areDataColumnsAvailable blocks until all the DataColumnSidecars this node custodies for the block are available,
+// or an error or context cancellation occurs. It works like isDataAvailable, reading from the dataColumnNotifier
+// channel for the given root until all the missing custody columns have been observed.
+func (s *Service) areDataColumnsAvailable(ctx context.Context, root [32]byte, signed interfaces.ReadOnlySignedBeaconBlock) error {
+	block := signed.Block()
+	if block == nil {
+		return errors.New("invalid nil beacon block")
+	}
+	// We are only required to check within MIN_EPOCHS_FOR_DATA_COLUMN_SIDECARS_REQUESTS
+	if !params.WithinDataColumnDAPeriod(slots.ToEpoch(block.Slot()), slots.ToEpoch(s.CurrentSlot())) {
+		return nil
+	}
+
+	body := block.Body()
+	if body == nil {
+		return errors.New("invalid nil beacon block body")
+	}
+	kzgCommitments, err := body.BlobKzgCommitments()
+	if err != nil {
+		return errors.Wrap(err, "could not get KZG commitments")
+	}
+	if len(kzgCommitments) == 0 {
+		return nil
+	}
+	if s.cfg.CustodyManager == nil {
+		return errors.New("custody manager is required to check data column availability")
+	}
+	custodyColumns, err := peerdas.CustodyColumnsForNode(s.cfg.CustodyManager.NodeID(), s.cfg.CustodyManager.CustodyGroupCount())
+	if err != nil {
+		return errors.Wrap(err, "could not compute custody columns")
+	}
+	indices, err := s.dataColumnStorage.Indices(root)
+	if err != nil {
+		return errors.Wrap(err, "could not get stored data column indices")
+	}
+	missing := make(map[uint64]struct{}, len(custodyColumns))
+	for column := range custodyColumns {
+		if !indices[column] {
+			missing[column] = struct{}{}
+		}
+	}
+	// If there are no missing indices, all custody columns are available.
+	if len(missing) == 0 {
+		return nil
+	}
+
+	// The gossip handler for data columns writes the index of each verified column referencing the given
+	// root to the channel returned by dataColumnNotifiers.forRoot.
+	nc := s.dataColumnNotifiers.forRoot(root)
+
+	// Log for DA checks that cross over into the next slot; helpful for debugging.
+	nextSlot := slots.BeginsAt(block.Slot()+1, s.genesisTime)
+	// Avoid logging if DA check is called after next slot start.
+	if nextSlot.After(time.Now()) {
+		expected := len(custodyColumns)
+		nst := time.AfterFunc(time.Until(nextSlot), func() {
+			if len(missing) == 0 {
+				return
+			}
+			log.WithFields(logrus.Fields{
+				"slot":            block.Slot(),
+				"root":            fmt.Sprintf("%#x", root),
+				"columnsExpected": expected,
+				"columnsWaiting":  len(missing),
+			}).Error("Still waiting for DA check at slot end.")
+		})
+		defer nst.Stop()
+	}
+	for {
+		select {
+		case idx := <-nc:
+			delete(missing, idx)
+			if len(missing) > 0 {
+				continue
+			}
+			s.dataColumnNotifiers.delete(root)
+			return nil
+		case <-ctx.Done():
+			return errors.Wrapf(ctx.Err(), "context deadline waiting for data column sidecars slot: %d, BlockRoot: %#x", block.Slot(), root)
+		}
+	}
+}
+
 func daCheckLogFields(root [32]byte, slot primitives.Slot, expected, missing int) logrus.Fields {
 	return logrus.Fields{
 		"slot":          slot,
@@ -271,6 +271,18 @@ func (s *Service) processLightClientFinalityUpdate(
 		Type: statefeed.LightClientFinalityUpdate,
 		Data: update,
 	})
+
+	if s.lcStore == nil {
+		return nil
+	}
+	// Only forward the update if it finalizes a newer header than the last one we know about.
+	if last := s.lcStore.LastFinalityUpdate(); last != nil && update.FinalizedHeader().Beacon().Slot <= last.FinalizedHeader().Beacon().Slot {
+		return nil
+	}
+	s.lcStore.SetLastFinalityUpdate(update)
+	if err := s.publishLightClientUpdate(ctx, &s.lcPending.finality, update); err != nil {
+		return errors.Wrap(err, "could not broadcast light client finality update")
+	}
 	return nil
 }
 
@@ -308,6 +320,17 @@ func (s *Service) processLightClientOptimisticUpdate(ctx context.Context, signed
 		Data: update,
 	})
 
+	if s.lcStore == nil {
+		return nil
+	}
+	// Only forward the update if it attests to a newer header than the last one we know about.
+	if last := s.lcStore.LastOptimisticUpdate(); last != nil && update.AttestedHeader().Beacon().Slot <= last.AttestedHeader().Beacon().Slot {
+		return nil
+	}
+	s.lcStore.SetLastOptimisticUpdate(update)
+	if err := s.publishLightClientUpdate(ctx, &s.lcPending.optimistic, update); err != nil {
+		return errors.Wrap(err, "could not broadcast light client optimistic update")
+	}
 	return nil
 }
 
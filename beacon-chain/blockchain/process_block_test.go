@@ -11,10 +11,13 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/blocks"
 	lightClient "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/light-client"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/peerdas"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/das"
@@ -26,6 +29,7 @@ import (
 	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
 	forkchoicetypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/types"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
 	"github.com/prysmaticlabs/prysm/v5/config/features"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
@@ -2272,6 +2276,62 @@ func TestMissingIndices(t *testing.T) {
 	}
 }
 
+type mockCustodyManager struct {
+	nodeID enode.ID
+	cgc    uint64
+}
+
+func (m *mockCustodyManager) NodeID() enode.ID                         { return m.nodeID }
+func (m *mockCustodyManager) CustodyGroupCount() uint64                { return m.cgc }
+func (m *mockCustodyManager) CustodyGroupCountFromPeer(peer.ID) uint64 { return m.cgc }
+
+func TestAreDataColumnsAvailable(t *testing.T) {
+	cm := &mockCustodyManager{nodeID: enode.ID{'a'}, cgc: params.BeaconConfig().CustodyRequirement}
+	custody, err := peerdas.CustodyColumnsForNode(cm.nodeID, cm.cgc)
+	require.NoError(t, err)
+
+	t.Run("no commitments", func(t *testing.T) {
+		s, _ := minimalTestService(t, WithDataColumnStorage(filesystem.NewEphemeralDataColumnStorage(t)), WithCustodyManager(cm))
+		s.SetGenesisTime(time.Now())
+		blk, _ := util.GenerateTestFuluBlockWithDataColumns(t, [32]byte{}, 0, 0)
+		require.NoError(t, s.isDataAvailable(context.Background(), blk.Root(), blk))
+	})
+	t.Run("all custody columns received", func(t *testing.T) {
+		s, _ := minimalTestService(t, WithDataColumnStorage(filesystem.NewEphemeralDataColumnStorage(t)), WithCustodyManager(cm))
+		s.SetGenesisTime(time.Now())
+		blk, dcs := util.GenerateTestFuluBlockWithDataColumns(t, [32]byte{}, 0, 2)
+		go func() {
+			for _, dc := range verification.FakeVerifyDataColumnSliceForTest(t, dcs) {
+				if custody[dc.ColumnIndex] {
+					require.NoError(t, s.ReceiveDataColumn(context.Background(), dc))
+				}
+			}
+		}()
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		require.NoError(t, s.isDataAvailable(ctx, blk.Root(), blk))
+	})
+	t.Run("custody column missing", func(t *testing.T) {
+		s, _ := minimalTestService(t, WithDataColumnStorage(filesystem.NewEphemeralDataColumnStorage(t)), WithCustodyManager(cm))
+		s.SetGenesisTime(time.Now())
+		blk, dcs := util.GenerateTestFuluBlockWithDataColumns(t, [32]byte{}, 0, 2)
+		skipped := false
+		for _, dc := range verification.FakeVerifyDataColumnSliceForTest(t, dcs) {
+			if !custody[dc.ColumnIndex] {
+				continue
+			}
+			if !skipped {
+				skipped = true
+				continue
+			}
+			require.NoError(t, s.ReceiveDataColumn(context.Background(), dc))
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		require.ErrorIs(t, s.isDataAvailable(ctx, blk.Root(), blk), context.DeadlineExceeded)
+	})
+}
+
 func Test_getFCUArgs(t *testing.T) {
 	s, tr := minimalTestService(t)
 	ctx := tr.ctx
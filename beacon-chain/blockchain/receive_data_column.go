@@ -0,0 +1,17 @@
+package blockchain
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+)
+
+// ReceiveDataColumn saves the data column to database and notifies any data availability check waiting for it.
+func (s *Service) ReceiveDataColumn(_ context.Context, dc blocks.VerifiedRODataColumn) error {
+	if err := s.dataColumnStorage.Save(dc); err != nil {
+		return err
+	}
+
+	s.dataColumnNotifiers.notifyIndex(dc.BlockRoot(), dc.ColumnIndex)
+	return nil
+}
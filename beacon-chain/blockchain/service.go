@@ -17,6 +17,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed"
 	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	lightclient "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/light-client"
 	coreTime "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/time"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
@@ -65,33 +66,39 @@ type Service struct {
 	blobNotifiers        *blobNotifierMap
 	blockBeingSynced     *currentlySyncingBlock
 	blobStorage          *filesystem.BlobStorage
+	dataColumnNotifiers  *dataColumnNotifierMap
+	dataColumnStorage    *filesystem.DataColumnStorage
+	lcStore              *lightclient.Store
+	lcPending            pendingLightClientUpdates
 }
 
 // config options for the service.
 type config struct {
-	BeaconBlockBuf          int
-	ChainStartFetcher       execution.ChainStartFetcher
-	BeaconDB                db.HeadAccessDatabase
-	DepositCache            cache.DepositCache
-	PayloadIDCache          *cache.PayloadIDCache
-	TrackedValidatorsCache  *cache.TrackedValidatorsCache
-	AttestationCache        *cache.AttestationCache
-	AttPool                 attestations.Pool
-	ExitPool                voluntaryexits.PoolManager
-	SlashingPool            slashings.PoolManager
-	BLSToExecPool           blstoexec.PoolManager
-	P2p                     p2p.Broadcaster
-	MaxRoutines             int
-	StateNotifier           statefeed.Notifier
-	ForkChoiceStore         f.ForkChoicer
-	AttService              *attestations.Service
-	StateGen                *stategen.State
-	SlasherAttestationsFeed *event.Feed
-	WeakSubjectivityCheckpt *ethpb.Checkpoint
-	BlockFetcher            execution.POWBlockFetcher
-	FinalizedStateAtStartUp state.BeaconState
-	ExecutionEngineCaller   execution.EngineCaller
-	SyncChecker             Checker
+	BeaconBlockBuf             int
+	ChainStartFetcher          execution.ChainStartFetcher
+	BeaconDB                   db.HeadAccessDatabase
+	DepositCache               cache.DepositCache
+	PayloadIDCache             *cache.PayloadIDCache
+	TrackedValidatorsCache     *cache.TrackedValidatorsCache
+	AttestationCache           *cache.AttestationCache
+	AttPool                    attestations.Pool
+	ExitPool                   voluntaryexits.PoolManager
+	SlashingPool               slashings.PoolManager
+	BLSToExecPool              blstoexec.PoolManager
+	P2p                        p2p.Broadcaster
+	MaxRoutines                int
+	StateNotifier              statefeed.Notifier
+	ForkChoiceStore            f.ForkChoicer
+	AttService                 *attestations.Service
+	StateGen                   *stategen.State
+	SlasherAttestationsFeed    *event.Feed
+	WeakSubjectivityCheckpt    *ethpb.Checkpoint
+	BlockFetcher               execution.POWBlockFetcher
+	FinalizedStateAtStartUp    state.BeaconState
+	ExecutionEngineCaller      execution.EngineCaller
+	SyncChecker                Checker
+	CustodyManager             p2p.CustodyManager
+	ForkchoiceSnapshotInterval primitives.Epoch
 }
 
 // Checker is an interface used to determine if a node is in initial sync
@@ -159,6 +166,61 @@ func (bn *blobNotifierMap) delete(root [32]byte) {
 	delete(bn.notifiers, root)
 }
 
+// dataColumnNotifierMap works like blobNotifierMap, but tracks DataColumnSidecar indices.
+type dataColumnNotifierMap struct {
+	sync.RWMutex
+	notifiers map[[32]byte]chan uint64
+	seenIndex map[[32]byte][]bool
+}
+
+// notifyIndex notifies a data column by its index for a given root.
+func (dn *dataColumnNotifierMap) notifyIndex(root [32]byte, idx uint64) {
+	numberOfColumns := params.BeaconConfig().NumberOfColumns
+	if idx >= numberOfColumns {
+		return
+	}
+
+	dn.Lock()
+	seen := dn.seenIndex[root]
+	if seen == nil {
+		seen = make([]bool, numberOfColumns)
+	}
+	if seen[idx] {
+		dn.Unlock()
+		return
+	}
+	seen[idx] = true
+	dn.seenIndex[root] = seen
+
+	c, ok := dn.notifiers[root]
+	if !ok {
+		c = make(chan uint64, numberOfColumns)
+		dn.notifiers[root] = c
+	}
+
+	dn.Unlock()
+
+	c <- idx
+}
+
+func (dn *dataColumnNotifierMap) forRoot(root [32]byte) chan uint64 {
+	dn.Lock()
+	defer dn.Unlock()
+	c, ok := dn.notifiers[root]
+	if !ok {
+		c = make(chan uint64, params.BeaconConfig().NumberOfColumns)
+		dn.notifiers[root] = c
+	}
+	return c
+}
+
+func (dn *dataColumnNotifierMap) delete(root [32]byte) {
+	dn.Lock()
+	defer dn.Unlock()
+	delete(dn.seenIndex, root)
+	delete(dn.notifiers, root)
+}
+
 // NewService instantiates a new block service instance that will
 // be registered into a running beacon node.
 func NewService(ctx context.Context, opts ...Option) (*Service, error) {
@@ -174,6 +236,10 @@ func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 		notifiers: make(map[[32]byte]chan uint64),
 		seenIndex: make(map[[32]byte][]bool),
 	}
+	dn := &dataColumnNotifierMap{
+		notifiers: make(map[[32]byte]chan uint64),
+		seenIndex: make(map[[32]byte][]bool),
+	}
 	srv := &Service{
 		ctx:                  ctx,
 		cancel:               cancel,
@@ -181,6 +247,7 @@ func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 		checkpointStateCache: cache.NewCheckpointStateCache(),
 		initSyncBlocks:       make(map[[32]byte]interfaces.ReadOnlySignedBeaconBlock),
 		blobNotifiers:        bn,
+		dataColumnNotifiers:  dn,
 		cfg:                  &config{},
 		blockBeingSynced:     &currentlySyncingBlock{roots: make(map[[32]byte]struct{})},
 	}
@@ -214,6 +281,12 @@ func (s *Service) Start() {
 		}
 	}
 	s.spawnProcessAttestationsRoutine()
+	if s.cfg.ForkchoiceSnapshotInterval > 0 {
+		s.spawnForkchoiceSnapshotRoutine()
+	}
+	if s.lcStore != nil {
+		s.spawnLightClientUpdatesRoutine()
+	}
 	go s.runLateBlockTasks()
 }
 
@@ -234,7 +307,16 @@ func (s *Service) Stop() error {
 		s.headLock.RUnlock()
 	}
 	// Save initial sync cached blocks to the DB before stop.
-	return s.cfg.BeaconDB.SaveBlocks(s.ctx, s.getInitSyncBlocks())
+	if err := s.cfg.BeaconDB.SaveBlocks(s.ctx, s.getInitSyncBlocks()); err != nil {
+		return err
+	}
+	// Save the forkchoice store after its blocks, so that it can be restored in the following run.
+	if s.cfg.ForkchoiceSnapshotInterval > 0 {
+		if err := s.saveForkchoiceSnapshot(s.ctx); err != nil {
+			log.WithError(err).Error("Could not save forkchoice snapshot")
+		}
+	}
+	return nil
 }
 
 // Status always returns nil unless there is an error condition that causes
@@ -290,9 +372,42 @@ func (s *Service) StartFromSavedState(saved state.BeaconState) error {
 		return errNilFinalizedCheckpoint
 	}
 
-	fRoot := s.ensureRootNotZeros(bytesutil.ToBytes32(finalized.Root))
 	s.cfg.ForkChoiceStore.Lock()
 	defer s.cfg.ForkChoiceStore.Unlock()
+	restored := false
+	if s.cfg.ForkchoiceSnapshotInterval > 0 {
+		err := s.restoreForkchoiceSnapshot(s.ctx, justified, finalized)
+		switch {
+		case err == nil:
+			restored = true
+		case !errors.Is(err, errNoForkchoiceSnapshot):
+			log.WithError(err).Warn("Could not restore forkchoice snapshot, rebuilding forkchoice from the finalized checkpoint")
+		}
+	}
+	if !restored {
+		if err := s.initializeForkchoice(justified, finalized); err != nil {
+			return err
+		}
+	}
+	// not attempting to save initial sync blocks here, because there shouldn't be any until
+	// after the statefeed.Initialized event is fired (below)
+	if err := s.wsVerifier.VerifyWeakSubjectivity(s.ctx, finalized.Epoch); err != nil {
+		// Exit run time if the node failed to verify weak subjectivity checkpoint.
+		return errors.Wrap(err, "could not verify initial checkpoint provided for chain sync")
+	}
+
+	vr := bytesutil.ToBytes32(saved.GenesisValidatorsRoot())
+	if err := s.clockSetter.SetClock(startup.NewClock(s.genesisTime, vr)); err != nil {
+		return errors.Wrap(err, "failed to initialize blockchain service")
+	}
+
+	return nil
+}
+
+// initializeForkchoice builds the fork choice store from the justified and finalized checkpoints in the db, with
+// the finalized block as its only node. The caller must hold the fork choice lock.
+func (s *Service) initializeForkchoice(justified, finalized *ethpb.Checkpoint) error {
+	fRoot := s.ensureRootNotZeros(bytesutil.ToBytes32(finalized.Root))
 	if err := s.cfg.ForkChoiceStore.UpdateJustifiedCheckpoint(s.ctx, &forkchoicetypes.Checkpoint{Epoch: justified.Epoch,
 		Root: bytesutil.ToBytes32(justified.Root)}); err != nil {
 		return errors.Wrap(err, "could not update forkchoice's justified checkpoint")
@@ -329,18 +444,6 @@ func (s *Service) StartFromSavedState(saved state.BeaconState) error {
 			}
 		}
 	}
-	// not attempting to save initial sync blocks here, because there shouldn't be any until
-	// after the statefeed.Initialized event is fired (below)
-	if err := s.wsVerifier.VerifyWeakSubjectivity(s.ctx, finalized.Epoch); err != nil {
-		// Exit run time if the node failed to verify weak subjectivity checkpoint.
-		return errors.Wrap(err, "could not verify initial checkpoint provided for chain sync")
-	}
-
-	vr := bytesutil.ToBytes32(saved.GenesisValidatorsRoot())
-	if err := s.clockSetter.SetClock(startup.NewClock(s.genesisTime, vr)); err != nil {
-		return errors.Wrap(err, "failed to initialize blockchain service")
-	}
-
 	return nil
 }
 
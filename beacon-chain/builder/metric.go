@@ -27,4 +27,49 @@ var (
 			Buckets: []float64{1, 2, 5, 10, 20, 50, 100, 200, 500, 1000},
 		},
 	)
+	relayGetHeaderLatency = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "relay_get_header_latency_milliseconds",
+			Help:    "Captures RPC latency for get header per relay in milliseconds",
+			Buckets: []float64{1, 2, 5, 10, 20, 50, 100, 200, 500, 1000},
+		},
+		[]string{"relay"},
+	)
+	relaySubmitBlindedBlockLatency = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "relay_submit_blinded_block_latency_milliseconds",
+			Help:    "Captures RPC latency for submitting blinded block per relay in milliseconds",
+			Buckets: []float64{1, 2, 5, 10, 20, 50, 100, 200, 500, 1000},
+		},
+		[]string{"relay"},
+	)
+	relayRegisterValidatorLatency = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "relay_register_validator_latency_milliseconds",
+			Help:    "Captures RPC latency for register validator per relay in milliseconds",
+			Buckets: []float64{1, 2, 5, 10, 20, 50, 100, 200, 500, 1000},
+		},
+		[]string{"relay"},
+	)
+	relayRequestFailures = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "relay_request_failures_total",
+			Help: "Number of failed or invalid responses per relay and method",
+		},
+		[]string{"relay", "method"},
+	)
+	relayBidsWon = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "relay_bids_won_total",
+			Help: "Number of times the bid of a relay was the highest valid bid",
+		},
+		[]string{"relay"},
+	)
+	relayCircuitBreakerOpen = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "relay_circuit_breaker_open",
+			Help: "Whether requests to a relay are suspended after repeated failures, 1 if suspended",
+		},
+		[]string{"relay"},
+	)
 )
@@ -1,6 +1,10 @@
 package builder
 
 import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/api/client/builder"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
@@ -13,25 +17,45 @@ type Option func(s *Service) error
 
 // FlagOptions for builder service flag configurations.
 func FlagOptions(c *cli.Context) ([]Option, error) {
-	endpoint := c.String(flags.MevRelayEndpoint.Name)
-	var client *builder.Client
-	if endpoint != "" {
-		var err error
-		client, err = builder.NewClient(endpoint)
+	var opts []Option
+	var clientOpts []builder.ClientOpt
+	if c.Bool(flags.MevRelayDisableSSZ.Name) {
+		clientOpts = append(clientOpts, builder.WithoutSSZ())
+	}
+	endpoints := append([]string{c.String(flags.MevRelayEndpoint.Name)}, c.StringSlice(flags.AdditionalMevRelayEndpoints.Name)...)
+	for _, endpoint := range endpoints {
+		endpoint = strings.TrimSpace(endpoint)
+		if endpoint == "" {
+			continue
+		}
+		client, err := builder.NewClient(endpoint, clientOpts...)
 		if err != nil {
 			return nil, err
 		}
+		opts = append(opts, WithBuilderClient(client))
 	}
-	opts := []Option{
-		WithBuilderClient(client),
+	if c.IsSet(flags.MevRelayGetHeaderTimeout.Name) {
+		opts = append(opts, WithGetHeaderTimeout(c.Duration(flags.MevRelayGetHeaderTimeout.Name)))
 	}
 	return opts, nil
 }
 
-// WithBuilderClient sets the builder client for the beacon chain builder service.
+// WithBuilderClient adds a relay client to the beacon chain builder service.
+// It can be used several times to configure multiple relays.
 func WithBuilderClient(client builder.BuilderClient) Option {
 	return func(s *Service) error {
-		s.cfg.builderClient = client
+		s.cfg.builderClients = append(s.cfg.builderClients, client)
+		return nil
+	}
+}
+
+// WithGetHeaderTimeout sets the deadline for relays to return their bids.
+func WithGetHeaderTimeout(timeout time.Duration) Option {
+	return func(s *Service) error {
+		if timeout <= 0 {
+			return errors.New("get header timeout must be positive")
+		}
+		s.cfg.getHeaderTimeout = timeout
 		return nil
 	}
 }
@@ -0,0 +1,140 @@
+package builder
+
+import (
+	"math/big"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/client/builder"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+)
+
+const (
+	// defaultRelayFailureThreshold is the number of consecutive failures after which a relay is skipped.
+	defaultRelayFailureThreshold = 5
+	// defaultRelayCooldown is how long a relay is skipped once its circuit breaker opened.
+	defaultRelayCooldown = time.Minute
+)
+
+var (
+	errNilBid          = errors.New("relay returned nil bid")
+	errZeroBid         = errors.New("relay returned header with 0 bid amount")
+	errWrongParentHash = errors.New("relay returned header with an incorrect parent hash")
+)
+
+// relay wraps the builder client of a single relay with its own circuit breaker.
+type relay struct {
+	client  builder.BuilderClient
+	name    string
+	breaker *circuitBreaker
+}
+
+func newRelay(c builder.BuilderClient, threshold int, cooldown time.Duration) *relay {
+	r := &relay{client: c, name: relayName(c.NodeURL())}
+	r.breaker = newCircuitBreaker(threshold, cooldown, func(open bool) {
+		v := 0.0
+		if open {
+			v = 1
+		}
+		relayCircuitBreakerOpen.WithLabelValues(r.name).Set(v)
+	})
+	return r
+}
+
+// relayName returns the host of the relay url, which is used to identify the relay in logs and metrics
+// without exposing the relay public key, which is usually set as the user of the url.
+func relayName(nodeURL string) string {
+	u, err := url.Parse(nodeURL)
+	if err != nil || u.Host == "" {
+		return nodeURL
+	}
+	return u.Host
+}
+
+// circuitBreaker stops requests to a relay once it failed too many times in a row. After the cooldown,
+// a single request is let through: the breaker closes if it succeeds and opens again if it fails.
+type circuitBreaker struct {
+	sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	failures  int
+	openUntil time.Time
+	probing   bool // whether the request let through after the cooldown is in flight.
+	onChange  func(open bool)
+	now       func() time.Time
+}
+
+func newCircuitBreaker(threshold int, cooldown time.Duration, onChange func(open bool)) *circuitBreaker {
+	return &circuitBreaker{threshold: threshold, cooldown: cooldown, onChange: onChange, now: time.Now}
+}
+
+// allow returns true if a request can be sent to the relay. Once the breaker opened, only one request is
+// allowed after the cooldown, until its outcome is recorded with success or failure.
+func (c *circuitBreaker) allow() bool {
+	c.Lock()
+	defer c.Unlock()
+	if c.failures < c.threshold {
+		return true
+	}
+	if c.probing || c.now().Before(c.openUntil) {
+		return false
+	}
+	c.probing = true
+	return true
+}
+
+// success records a successful request, closing the breaker.
+func (c *circuitBreaker) success() {
+	c.Lock()
+	defer c.Unlock()
+	if c.failures >= c.threshold && c.onChange != nil {
+		c.onChange(false)
+	}
+	c.failures = 0
+	c.probing = false
+}
+
+// failure records a failed request, opening the breaker once the failure threshold is reached.
+func (c *circuitBreaker) failure() {
+	c.Lock()
+	defer c.Unlock()
+	c.failures++
+	c.probing = false
+	if c.failures < c.threshold {
+		return
+	}
+	c.openUntil = c.now().Add(c.cooldown)
+	if c.failures == c.threshold && c.onChange != nil {
+		c.onChange(true)
+	}
+}
+
+// validateBid checks that the bid of a relay can be compared with the bids of the other relays for the given
+// parent hash, and returns its value. The builder signature is only verified by the proposer, for the selected bid.
+func validateBid(signedBid builder.SignedBid, parentHash [32]byte) (*big.Int, error) {
+	if signedBid == nil || signedBid.IsNil() {
+		return nil, errNilBid
+	}
+	bid, err := signedBid.Message()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get bid")
+	}
+	if bid == nil || bid.IsNil() {
+		return nil, errNilBid
+	}
+	v := primitives.WeiToBigInt(bid.Value())
+	if v == nil || v.Sign() <= 0 {
+		return nil, errZeroBid
+	}
+	header, err := bid.Header()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get bid header")
+	}
+	if bytesutil.ToBytes32(header.ParentHash()) != parentHash {
+		return nil, errors.Wrapf(errWrongParentHash, "%#x != %#x", header.ParentHash(), parentHash)
+	}
+	return v, nil
+}
@@ -2,7 +2,11 @@ package builder
 
 import (
 	"context"
+	"fmt"
+	"math/big"
 	"reflect"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -23,6 +27,13 @@ import (
 // ErrNoBuilder is used when builder endpoint is not configured.
 var ErrNoBuilder = errors.New("builder endpoint not configured")
 
+const (
+	// defaultGetHeaderTimeout is the deadline for relays to return a header, bids received later are discarded.
+	defaultGetHeaderTimeout = 950 * time.Millisecond
+	// winningBidsSlotRetention is the number of slots for which the relay of a returned header is remembered.
+	winningBidsSlotRetention = 2
+)
+
 // BlockBuilder defines the interface for interacting with the block builder
 type BlockBuilder interface {
 	SubmitBlindedBlock(ctx context.Context, block interfaces.ReadOnlySignedBeaconBlock) (interfaces.ExecutionData, *v1.BlobsBundle, error)
@@ -34,45 +45,69 @@ type BlockBuilder interface {
 
 // config defines a config struct for dependencies into the service.
 type config struct {
-	builderClient builder.BuilderClient
-	beaconDB      db.HeadAccessDatabase
-	headFetcher   blockchain.HeadFetcher
+	builderClients   []builder.BuilderClient
+	beaconDB         db.HeadAccessDatabase
+	headFetcher      blockchain.HeadFetcher
+	getHeaderTimeout time.Duration
 }
 
 // Service defines a service that provides a client for interacting with the beacon chain and MEV relay network.
 type Service struct {
 	cfg               *config
-	c                 builder.BuilderClient
+	relays            []*relay
 	ctx               context.Context
 	cancel            context.CancelFunc
 	registrationCache *cache.RegistrationCache
+	winningBidsLock   sync.Mutex
+	winningBids       map[[32]byte]*winningBid
+}
+
+// winningBid records which relay produced the header that was returned for a slot, so that the
+// corresponding blinded block is submitted to that relay.
+type winningBid struct {
+	relay *relay
+	slot  primitives.Slot
+}
+
+// relayBid is the result of a get header call to a single relay.
+type relayBid struct {
+	bid   builder.SignedBid
+	value *big.Int
+	err   error
 }
 
 // NewService instantiates a new service.
 func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 	ctx, cancel := context.WithCancel(ctx)
 	s := &Service{
-		ctx:    ctx,
-		cancel: cancel,
-		cfg:    &config{},
+		ctx:         ctx,
+		cancel:      cancel,
+		cfg:         &config{getHeaderTimeout: defaultGetHeaderTimeout},
+		winningBids: make(map[[32]byte]*winningBid),
 	}
 	for _, opt := range opts {
 		if err := opt(s); err != nil {
 			return nil, err
 		}
 	}
-	if s.cfg.builderClient != nil && !reflect.ValueOf(s.cfg.builderClient).IsNil() {
-		s.c = s.cfg.builderClient
+	for _, c := range s.cfg.builderClients {
+		if c == nil || reflect.ValueOf(c).IsNil() {
+			continue
+		}
+		r := newRelay(c, defaultRelayFailureThreshold, defaultRelayCooldown)
+		s.relays = append(s.relays, r)
 
 		// Is the builder up?
-		if err := s.c.Status(ctx); err != nil {
-			log.WithError(err).Error("Failed to check builder status")
+		if err := c.Status(ctx); err != nil {
+			log.WithError(err).WithField("relay", r.name).Error("Failed to check builder status")
 		} else {
-			log.WithField("endpoint", s.c.NodeURL()).Info("Builder has been configured")
-			log.Warn("Outsourcing block construction to external builders adds non-trivial delay to block propagation time.  " +
-				"Builder-constructed blocks or fallback blocks may get orphaned. Use at your own risk!")
+			log.WithField("relay", r.name).Info("Builder has been configured")
 		}
 	}
+	if len(s.relays) > 0 {
+		log.Warn("Outsourcing block construction to external builders adds non-trivial delay to block propagation time.  " +
+			"Builder-constructed blocks or fallback blocks may get orphaned. Use at your own risk!")
+	}
 	return s, nil
 }
 
@@ -87,7 +122,8 @@ func (s *Service) Stop() error {
 	return nil
 }
 
-// SubmitBlindedBlock submits a blinded block to the builder relay network.
+// SubmitBlindedBlock submits a blinded block to the builder relay network. The block is submitted to the relay
+// which produced its header, or to all relays if that relay is not known.
 func (s *Service) SubmitBlindedBlock(ctx context.Context, b interfaces.ReadOnlySignedBeaconBlock) (interfaces.ExecutionData, *v1.BlobsBundle, error) {
 	ctx, span := trace.StartSpan(ctx, "builder.SubmitBlindedBlock")
 	defer span.End()
@@ -95,14 +131,60 @@ func (s *Service) SubmitBlindedBlock(ctx context.Context, b interfaces.ReadOnlyS
 	defer func() {
 		submitBlindedBlockLatency.Observe(float64(time.Since(start).Milliseconds()))
 	}()
-	if s.c == nil {
+	if len(s.relays) == 0 {
 		return nil, nil, ErrNoBuilder
 	}
+	if b == nil || b.IsNil() {
+		return nil, nil, errors.New("nil blinded block")
+	}
+	h, err := b.Block().Body().Execution()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not get execution payload header")
+	}
 
-	return s.c.SubmitBlindedBlock(ctx, b)
+	relays := s.relays
+	s.winningBidsLock.Lock()
+	w, ok := s.winningBids[bytesutil.ToBytes32(h.BlockHash())]
+	s.winningBidsLock.Unlock()
+	if ok {
+		relays = []*relay{w.relay}
+	} else if len(relays) > 1 {
+		log.WithField("blockHash", fmt.Sprintf("%#x", h.BlockHash())).Warn("Unknown relay for blinded block, submitting it to all relays")
+	}
+
+	type result struct {
+		payload interfaces.ExecutionData
+		bundle  *v1.BlobsBundle
+		err     error
+	}
+	results := make(chan result, len(relays))
+	for _, r := range relays {
+		go func(r *relay) {
+			rStart := time.Now()
+			payload, bundle, err := r.client.SubmitBlindedBlock(ctx, b)
+			relaySubmitBlindedBlockLatency.WithLabelValues(r.name).Observe(float64(time.Since(rStart).Milliseconds()))
+			if err != nil {
+				relayRequestFailures.WithLabelValues(r.name, "submit_blinded_block").Inc()
+				err = errors.Wrapf(err, "relay %s", r.name)
+			}
+			results <- result{payload: payload, bundle: bundle, err: err}
+		}(r)
+	}
+	errs := make([]string, 0, len(relays))
+	for range relays {
+		res := <-results
+		if res.err == nil {
+			return res.payload, res.bundle, nil
+		}
+		errs = append(errs, res.err.Error())
+	}
+	err = errors.Errorf("could not submit blinded block: %s", strings.Join(errs, "; "))
+	tracing.AnnotateError(span, err)
+	return nil, nil, err
 }
 
 // GetHeader retrieves the header for a given slot and parent hash from the builder relay network.
+// All available relays are queried in parallel and the valid bid with the highest value is returned.
 func (s *Service) GetHeader(ctx context.Context, slot primitives.Slot, parentHash [32]byte, pubKey [48]byte) (builder.SignedBid, error) {
 	ctx, span := trace.StartSpan(ctx, "builder.GetHeader")
 	defer span.End()
@@ -110,20 +192,129 @@ func (s *Service) GetHeader(ctx context.Context, slot primitives.Slot, parentHas
 	defer func() {
 		getHeaderLatency.Observe(float64(time.Since(start).Milliseconds()))
 	}()
-	if s.c == nil {
+	if len(s.relays) == 0 {
 		tracing.AnnotateError(span, ErrNoBuilder)
 		return nil, ErrNoBuilder
 	}
 
-	h, err := s.c.GetHeader(ctx, slot, parentHash, pubKey)
-	tracing.AnnotateError(span, err)
-	return h, err
+	relays := make([]*relay, 0, len(s.relays))
+	for _, r := range s.relays {
+		if r.breaker.allow() {
+			relays = append(relays, r)
+		}
+	}
+	if len(relays) == 0 {
+		err := errors.New("all relays are unavailable")
+		tracing.AnnotateError(span, err)
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.getHeaderTimeout)
+	defer cancel()
+	bids := make([]relayBid, len(relays))
+	var wg sync.WaitGroup
+	for i, r := range relays {
+		wg.Add(1)
+		go func(i int, r *relay) {
+			defer wg.Done()
+			bids[i] = s.relayHeader(ctx, r, slot, parentHash, pubKey)
+		}(i, r)
+	}
+	wg.Wait()
+
+	var best *relayBid
+	var winner *relay
+	noContent := true
+	validBids := 0
+	errs := make([]string, 0)
+	for i := range bids {
+		b := &bids[i]
+		if b.err != nil {
+			if !errors.Is(b.err, builder.ErrNoContent) {
+				noContent = false
+				errs = append(errs, b.err.Error())
+			}
+			continue
+		}
+		validBids++
+		if best == nil || b.value.Cmp(best.value) > 0 {
+			best = b
+			winner = relays[i]
+		}
+	}
+	if best == nil {
+		if noContent {
+			return nil, builder.ErrNoContent
+		}
+		err := errors.Errorf("no valid bid received from relays: %s", strings.Join(errs, "; "))
+		tracing.AnnotateError(span, err)
+		return nil, err
+	}
+
+	if err := s.recordWinningBid(winner, slot, best.bid); err != nil {
+		tracing.AnnotateError(span, err)
+		return nil, err
+	}
+	relayBidsWon.WithLabelValues(winner.name).Inc()
+	log.WithFields(log.Fields{
+		"relay": winner.name,
+		"slot":  slot,
+		"value": best.value.String(),
+		"bids":  validBids,
+	}).Debug("Selected builder bid")
+	return best.bid, nil
+}
+
+// relayHeader retrieves and validates the header of a single relay, updating its circuit breaker.
+func (s *Service) relayHeader(ctx context.Context, r *relay, slot primitives.Slot, parentHash [32]byte, pubKey [48]byte) relayBid {
+	start := time.Now()
+	bid, err := r.client.GetHeader(ctx, slot, parentHash, pubKey)
+	relayGetHeaderLatency.WithLabelValues(r.name).Observe(float64(time.Since(start).Milliseconds()))
+	if errors.Is(err, builder.ErrNoContent) {
+		// The relay has no bid for this slot, which is not a failure of the relay.
+		r.breaker.success()
+		return relayBid{err: err}
+	}
+	var v *big.Int
+	if err == nil {
+		v, err = validateBid(bid, parentHash)
+	}
+	if err != nil {
+		relayRequestFailures.WithLabelValues(r.name, "get_header").Inc()
+		r.breaker.failure()
+		log.WithError(err).WithField("relay", r.name).Debug("Could not get header from relay")
+		return relayBid{err: errors.Wrapf(err, "relay %s", r.name)}
+	}
+	r.breaker.success()
+	return relayBid{bid: bid, value: v}
+}
+
+// recordWinningBid remembers the relay of the given bid by its payload block hash, and forgets about
+// the bids of older slots.
+func (s *Service) recordWinningBid(r *relay, slot primitives.Slot, signedBid builder.SignedBid) error {
+	bid, err := signedBid.Message()
+	if err != nil {
+		return errors.Wrap(err, "could not get bid")
+	}
+	header, err := bid.Header()
+	if err != nil {
+		return errors.Wrap(err, "could not get bid header")
+	}
+	s.winningBidsLock.Lock()
+	defer s.winningBidsLock.Unlock()
+	for h, w := range s.winningBids {
+		if w.slot+winningBidsSlotRetention < slot {
+			delete(s.winningBids, h)
+		}
+	}
+	s.winningBids[bytesutil.ToBytes32(header.BlockHash())] = &winningBid{relay: r, slot: slot}
+	return nil
 }
 
 // Status retrieves the status of the builder relay network.
 func (s *Service) Status() error {
 	// Return early if builder isn't initialized in service.
-	if s.c == nil {
+	if len(s.relays) == 0 {
 		return nil
 	}
 
@@ -139,7 +330,7 @@ func (s *Service) RegisterValidator(ctx context.Context, reg []*ethpb.SignedVali
 	defer func() {
 		registerValidatorLatency.Observe(float64(time.Since(start).Milliseconds()))
 	}()
-	if s.c == nil {
+	if len(s.relays) == 0 {
 		return ErrNoBuilder
 	}
 
@@ -164,7 +355,7 @@ func (s *Service) RegisterValidator(ctx context.Context, reg []*ethpb.SignedVali
 		valid = append(valid, r)
 		indexToRegistration[nx] = r.Message
 	}
-	if err := s.c.RegisterValidator(ctx, valid); err != nil {
+	if err := s.registerWithRelays(ctx, valid); err != nil {
 		return errors.Wrap(err, "could not register validator(s)")
 	}
 
@@ -179,6 +370,37 @@ func (s *Service) RegisterValidator(ctx context.Context, reg []*ethpb.SignedVali
 	}
 }
 
+// registerWithRelays sends the registrations to all relays in parallel. It only fails if no relay
+// accepted the registrations.
+func (s *Service) registerWithRelays(ctx context.Context, reg []*ethpb.SignedValidatorRegistrationV1) error {
+	errs := make([]error, len(s.relays))
+	var wg sync.WaitGroup
+	for i, r := range s.relays {
+		wg.Add(1)
+		go func(i int, r *relay) {
+			defer wg.Done()
+			start := time.Now()
+			err := r.client.RegisterValidator(ctx, reg)
+			relayRegisterValidatorLatency.WithLabelValues(r.name).Observe(float64(time.Since(start).Milliseconds()))
+			if err != nil {
+				relayRequestFailures.WithLabelValues(r.name, "register_validator").Inc()
+				log.WithError(err).WithField("relay", r.name).Error("Could not register validator(s) with relay")
+				errs[i] = errors.Wrapf(err, "relay %s", r.name)
+			}
+		}(i, r)
+	}
+	wg.Wait()
+
+	msgs := make([]string, 0, len(errs))
+	for _, err := range errs {
+		if err == nil {
+			return nil
+		}
+		msgs = append(msgs, err.Error())
+	}
+	return errors.New(strings.Join(msgs, "; "))
+}
+
 // RegistrationByValidatorID returns either the values from the cache or db.
 func (s *Service) RegistrationByValidatorID(ctx context.Context, id primitives.ValidatorIndex) (*ethpb.ValidatorRegistrationV1, error) {
 	if s.registrationCache != nil {
@@ -191,9 +413,9 @@ func (s *Service) RegistrationByValidatorID(ctx context.Context, id primitives.V
 	}
 }
 
-// Configured returns true if the user has configured a builder client.
+// Configured returns true if the user has configured at least one builder relay.
 func (s *Service) Configured() bool {
-	return s.c != nil && !reflect.ValueOf(s.c).IsNil()
+	return len(s.relays) > 0
 }
 
 func (s *Service) pollRelayerStatus(ctx context.Context) {
@@ -202,9 +424,10 @@ func (s *Service) pollRelayerStatus(ctx context.Context) {
 	for {
 		select {
 		case <-ticker.C:
-			if s.c != nil {
-				if err := s.c.Status(ctx); err != nil {
-					log.WithError(err).Error("Failed to call relayer status endpoint, perhaps mev-boost or relayers are down")
+			for _, r := range s.relays {
+				if err := r.client.Status(ctx); err != nil {
+					relayRequestFailures.WithLabelValues(r.name, "status").Inc()
+					log.WithError(err).WithField("relay", r.name).Error("Failed to call relayer status endpoint, perhaps mev-boost or relayers are down")
 				}
 			}
 		case <-ctx.Done():
@@ -2,16 +2,31 @@ package builder
 
 import (
 	"context"
+	"flag"
+	"math/big"
 	"testing"
 	"time"
 
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/client/builder"
 	buildertesting "github.com/prysmaticlabs/prysm/v5/api/client/builder/testing"
 	blockchainTesting "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
 	dbtesting "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	v1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
 	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"github.com/urfave/cli/v2"
 )
 
 func Test_NewServiceWithBuilder(t *testing.T) {
@@ -68,3 +83,228 @@ func Test_BuilderMethodsWithouClient(t *testing.T) {
 	err = s.RegisterValidator(context.Background(), nil)
 	assert.ErrorContains(t, ErrNoBuilder.Error(), err)
 }
+
+type testRelayClient struct {
+	buildertesting.MockClient
+	url       string
+	bid       builder.SignedBid
+	err       error
+	delay     time.Duration
+	getHeader int
+	submitted bool
+}
+
+func (c *testRelayClient) NodeURL() string {
+	return c.url
+}
+
+func (c *testRelayClient) GetHeader(ctx context.Context, _ primitives.Slot, _ [32]byte, _ [48]byte) (builder.SignedBid, error) {
+	c.getHeader++
+	select {
+	case <-time.After(c.delay):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	return c.bid, c.err
+}
+
+func (c *testRelayClient) SubmitBlindedBlock(_ context.Context, _ interfaces.ReadOnlySignedBeaconBlock) (interfaces.ExecutionData, *v1.BlobsBundle, error) {
+	c.submitted = true
+	return nil, nil, c.err
+}
+
+func (c *testRelayClient) RegisterValidator(ctx context.Context, reg []*eth.SignedValidatorRegistrationV1) error {
+	if c.err != nil {
+		return c.err
+	}
+	return c.MockClient.RegisterValidator(ctx, reg)
+}
+
+func signedTestBid(t *testing.T, parentHash, blockHash [32]byte, value uint64) builder.SignedBid {
+	sk, err := bls.RandKey()
+	require.NoError(t, err)
+	v := bytesutil.ReverseByteOrder(big.NewInt(0).SetUint64(value).FillBytes(make([]byte, 32)))
+	bid := &eth.BuilderBidCapella{
+		Header: &v1.ExecutionPayloadHeaderCapella{
+			ParentHash:       parentHash[:],
+			FeeRecipient:     make([]byte, fieldparams.FeeRecipientLength),
+			StateRoot:        make([]byte, fieldparams.RootLength),
+			ReceiptsRoot:     make([]byte, fieldparams.RootLength),
+			LogsBloom:        make([]byte, fieldparams.LogsBloomLength),
+			PrevRandao:       make([]byte, fieldparams.RootLength),
+			ExtraData:        make([]byte, 0),
+			BaseFeePerGas:    make([]byte, fieldparams.RootLength),
+			BlockHash:        blockHash[:],
+			TransactionsRoot: make([]byte, fieldparams.RootLength),
+			WithdrawalsRoot:  make([]byte, fieldparams.RootLength),
+		},
+		Pubkey: sk.PublicKey().Marshal(),
+		Value:  v,
+	}
+	domain, err := signing.ComputeDomain(params.BeaconConfig().DomainApplicationBuilder, nil, nil)
+	require.NoError(t, err)
+	sr, err := signing.ComputeSigningRoot(bid, domain)
+	require.NoError(t, err)
+	sBid, err := builder.WrappedSignedBuilderBidCapella(&eth.SignedBuilderBidCapella{Message: bid, Signature: sk.Sign(sr[:]).Marshal()})
+	require.NoError(t, err)
+	return sBid
+}
+
+func blindedBlockWithHash(t *testing.T, blockHash [32]byte) interfaces.ReadOnlySignedBeaconBlock {
+	b := util.NewBlindedBeaconBlockCapella()
+	b.Block.Body.ExecutionPayloadHeader.BlockHash = blockHash[:]
+	sb, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	return sb
+}
+
+func Test_GetHeader_HighestValidBid(t *testing.T) {
+	ctx := context.Background()
+	parentHash := [32]byte{'p'}
+	low := &testRelayClient{url: "http://low", bid: signedTestBid(t, parentHash, [32]byte{'l'}, 1)}
+	high := &testRelayClient{url: "http://high", bid: signedTestBid(t, parentHash, [32]byte{'h'}, 3)}
+	wrongParent := &testRelayClient{url: "http://wrong", bid: signedTestBid(t, [32]byte{'x'}, [32]byte{'w'}, 10)}
+	slow := &testRelayClient{url: "http://slow", bid: signedTestBid(t, parentHash, [32]byte{'s'}, 100), delay: time.Second}
+	failing := &testRelayClient{url: "http://failing", err: errors.New("bad")}
+	s, err := NewService(ctx,
+		WithBuilderClient(low), WithBuilderClient(high), WithBuilderClient(wrongParent),
+		WithBuilderClient(slow), WithBuilderClient(failing), WithGetHeaderTimeout(100*time.Millisecond))
+	require.NoError(t, err)
+	require.Equal(t, 5, len(s.relays))
+
+	bid, err := s.GetHeader(ctx, 1, parentHash, [48]byte{})
+	require.NoError(t, err)
+	m, err := bid.Message()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), primitives.WeiToBigInt(m.Value()).Uint64())
+
+	// The blinded block is only submitted to the relay of the winning bid.
+	_, _, err = s.SubmitBlindedBlock(ctx, blindedBlockWithHash(t, [32]byte{'h'}))
+	require.NoError(t, err)
+	assert.Equal(t, true, high.submitted)
+	assert.Equal(t, false, low.submitted)
+	assert.Equal(t, false, slow.submitted)
+
+	// An unknown block is submitted to all relays, the first success is used.
+	_, _, err = s.SubmitBlindedBlock(ctx, blindedBlockWithHash(t, [32]byte{'u'}))
+	require.NoError(t, err)
+}
+
+func Test_SubmitBlindedBlock_AllRelaysFail(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewService(ctx,
+		WithBuilderClient(&testRelayClient{url: "http://a", err: errors.New("bad")}),
+		WithBuilderClient(&testRelayClient{url: "http://b", err: errors.New("bad")}))
+	require.NoError(t, err)
+	_, _, err = s.SubmitBlindedBlock(ctx, blindedBlockWithHash(t, [32]byte{'u'}))
+	require.ErrorContains(t, "relay a: bad", err)
+	require.ErrorContains(t, "relay b: bad", err)
+}
+
+func Test_GetHeader_NoBids(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewService(ctx,
+		WithBuilderClient(&testRelayClient{url: "http://a", err: builder.ErrNoContent}),
+		WithBuilderClient(&testRelayClient{url: "http://b", err: builder.ErrNoContent}))
+	require.NoError(t, err)
+	_, err = s.GetHeader(ctx, 1, [32]byte{}, [48]byte{})
+	require.ErrorIs(t, err, builder.ErrNoContent)
+
+	s, err = NewService(ctx,
+		WithBuilderClient(&testRelayClient{url: "http://a", err: builder.ErrNoContent}),
+		WithBuilderClient(&testRelayClient{url: "http://b"}))
+	require.NoError(t, err)
+	_, err = s.GetHeader(ctx, 1, [32]byte{}, [48]byte{})
+	require.ErrorContains(t, "no valid bid received from relays", err)
+}
+
+func Test_GetHeader_CircuitBreaker(t *testing.T) {
+	ctx := context.Background()
+	failing := &testRelayClient{url: "http://failing", err: errors.New("bad")}
+	s, err := NewService(ctx, WithBuilderClient(failing))
+	require.NoError(t, err)
+	for i := 0; i < defaultRelayFailureThreshold; i++ {
+		_, err = s.GetHeader(ctx, 1, [32]byte{}, [48]byte{})
+		require.ErrorContains(t, "bad", err)
+	}
+	// The relay is skipped while the breaker is open.
+	_, err = s.GetHeader(ctx, 1, [32]byte{}, [48]byte{})
+	require.ErrorContains(t, "all relays are unavailable", err)
+	assert.Equal(t, defaultRelayFailureThreshold, failing.getHeader)
+}
+
+func Test_CircuitBreaker(t *testing.T) {
+	now := time.Now()
+	var open bool
+	c := newCircuitBreaker(2, time.Minute, func(o bool) { open = o })
+	c.now = func() time.Time { return now }
+
+	c.failure()
+	assert.Equal(t, true, c.allow())
+	c.failure()
+	assert.Equal(t, false, c.allow())
+	assert.Equal(t, true, open)
+
+	// Half open after the cooldown, a single request is let through and a failure opens the breaker again.
+	now = now.Add(time.Minute)
+	assert.Equal(t, true, c.allow())
+	assert.Equal(t, false, c.allow())
+	c.failure()
+	assert.Equal(t, false, c.allow())
+
+	now = now.Add(time.Minute)
+	assert.Equal(t, true, c.allow())
+	assert.Equal(t, false, c.allow())
+	c.success()
+	assert.Equal(t, false, open)
+	assert.Equal(t, true, c.allow())
+}
+
+func Test_RegisterValidator_MultipleRelays(t *testing.T) {
+	ctx := context.Background()
+	headFetcher := &blockchainTesting.ChainService{}
+	ok := &testRelayClient{MockClient: buildertesting.NewClient(), url: "http://ok"}
+	failing := &testRelayClient{MockClient: buildertesting.NewClient(), url: "http://failing", err: errors.New("bad")}
+	s, err := NewService(ctx, WithRegistrationCache(), WithHeadFetcher(headFetcher), WithBuilderClient(ok), WithBuilderClient(failing))
+	require.NoError(t, err)
+	pubkey := bytesutil.ToBytes48([]byte("pubkey"))
+	reg := &eth.ValidatorRegistrationV1{Pubkey: pubkey[:], FeeRecipient: make([]byte, 20)}
+
+	// A relay failing does not prevent the registration as long as one relay accepted it.
+	require.NoError(t, s.RegisterValidator(ctx, []*eth.SignedValidatorRegistrationV1{{Message: reg}}))
+	assert.Equal(t, true, ok.RegisteredVals[pubkey])
+	registration, err := s.registrationCache.RegistrationByIndex(0)
+	require.NoError(t, err)
+	require.DeepEqual(t, reg, registration)
+
+	ok.err = errors.New("also bad")
+	err = s.RegisterValidator(ctx, []*eth.SignedValidatorRegistrationV1{{Message: reg}})
+	require.ErrorContains(t, "could not register validator(s)", err)
+}
+
+func Test_RelayName(t *testing.T) {
+	assert.Equal(t, "relay.example.com", relayName("https://0xabcd@relay.example.com"))
+	assert.Equal(t, "127.0.0.1:18550", relayName("http://127.0.0.1:18550"))
+	assert.Equal(t, "", relayName(""))
+}
+
+func Test_FlagOptions(t *testing.T) {
+	set := flag.NewFlagSet("test", 0)
+	set.String(flags.MevRelayEndpoint.Name, "http://127.0.0.1:18550", "")
+	set.Var(cli.NewStringSlice("http://127.0.0.1:18551", "http://127.0.0.1:18552"), flags.AdditionalMevRelayEndpoints.Name, "")
+	set.Duration(flags.MevRelayGetHeaderTimeout.Name, 0, "")
+	require.NoError(t, set.Set(flags.MevRelayGetHeaderTimeout.Name, "2s"))
+	opts, err := FlagOptions(cli.NewContext(&cli.App{}, set, nil))
+	require.NoError(t, err)
+	s, err := NewService(context.Background(), opts...)
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(s.cfg.builderClients))
+	assert.Equal(t, 2*time.Second, s.cfg.getHeaderTimeout)
+
+	set = flag.NewFlagSet("test", 0)
+	opts, err = FlagOptions(cli.NewContext(&cli.App{}, set, nil))
+	require.NoError(t, err)
+	s, err = NewService(context.Background(), opts...)
+	require.NoError(t, err)
+	assert.Equal(t, defaultGetHeaderTimeout, s.cfg.getHeaderTimeout)
+}
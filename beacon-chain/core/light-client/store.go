@@ -0,0 +1,48 @@
+package light_client
+
+import (
+	"sync"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+)
+
+// Store keeps the latest light client finality and optimistic updates produced or forwarded by the node,
+// so that they can be served over req/resp and used to validate the updates received over gossip.
+type Store struct {
+	mu                   sync.RWMutex
+	lastFinalityUpdate   interfaces.LightClientFinalityUpdate
+	lastOptimisticUpdate interfaces.LightClientOptimisticUpdate
+}
+
+// NewLightClientStore returns an empty light client store.
+func NewLightClientStore() *Store {
+	return &Store{}
+}
+
+// SetLastFinalityUpdate sets the latest light client finality update.
+func (s *Store) SetLastFinalityUpdate(update interfaces.LightClientFinalityUpdate) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastFinalityUpdate = update
+}
+
+// LastFinalityUpdate returns the latest light client finality update, or nil if there is none.
+func (s *Store) LastFinalityUpdate() interfaces.LightClientFinalityUpdate {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.lastFinalityUpdate
+}
+
+// SetLastOptimisticUpdate sets the latest light client optimistic update.
+func (s *Store) SetLastOptimisticUpdate(update interfaces.LightClientOptimisticUpdate) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastOptimisticUpdate = update
+}
+
+// LastOptimisticUpdate returns the latest light client optimistic update, or nil if there is none.
+func (s *Store) LastOptimisticUpdate() interfaces.LightClientOptimisticUpdate {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.lastOptimisticUpdate
+}
@@ -0,0 +1,26 @@
+package light_client_test
+
+import (
+	"testing"
+
+	lightClient "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/light-client"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestStore_LastUpdates(t *testing.T) {
+	l := util.NewTestLightClient(t).SetupTestAltair()
+	store := lightClient.NewLightClientStore()
+	require.Equal(t, nil, store.LastFinalityUpdate())
+	require.Equal(t, nil, store.LastOptimisticUpdate())
+
+	finality, err := lightClient.NewLightClientFinalityUpdateFromBeaconState(l.Ctx, l.State.Slot(), l.State, l.Block, l.AttestedState, l.AttestedBlock, l.FinalizedBlock)
+	require.NoError(t, err)
+	store.SetLastFinalityUpdate(finality)
+	require.DeepSSZEqual(t, finality.Proto(), store.LastFinalityUpdate().Proto())
+
+	optimistic, err := lightClient.NewLightClientOptimisticUpdateFromBeaconState(l.Ctx, l.State.Slot(), l.State, l.Block, l.AttestedState, l.AttestedBlock)
+	require.NoError(t, err)
+	store.SetLastOptimisticUpdate(optimistic)
+	require.DeepSSZEqual(t, optimistic.Proto(), store.LastOptimisticUpdate().Proto())
+}
@@ -0,0 +1,122 @@
+package peerdas
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/kzg"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+)
+
+var (
+	ErrNoKzgCommitments         = errors.New("no KZG commitments found")
+	ErrMismatchLength           = errors.New("mismatch in the length of the column, commitments or proofs")
+	ErrBlobsCommitmentsMismatch = errors.New("number of blobs does not match the number of commitments")
+	ErrInvalidKZGProof          = errors.New("invalid KZG proof")
+)
+
+// DataColumnSidecars computes the data column sidecars of a signed block from its blobs.
+// The i-th blob must correspond to the i-th KZG commitment of the block.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/fulu/das-core.md#get_data_column_sidecars
+func DataColumnSidecars(signedBlock interfaces.ReadOnlySignedBeaconBlock, blobs [][]byte) ([]*ethpb.DataColumnSidecar, error) {
+	if len(blobs) == 0 {
+		return nil, nil
+	}
+	if signedBlock == nil || signedBlock.IsNil() {
+		return nil, errors.New("nil signed block")
+	}
+	body := signedBlock.Block().Body()
+	commitments, err := body.BlobKzgCommitments()
+	if err != nil {
+		return nil, errors.Wrap(err, "blob KZG commitments")
+	}
+	if len(commitments) != len(blobs) {
+		return nil, ErrBlobsCommitmentsMismatch
+	}
+	header, err := signedBlock.Header()
+	if err != nil {
+		return nil, errors.Wrap(err, "signed block header")
+	}
+	inclusionProof, err := blocks.MerkleProofKZGCommitments(body)
+	if err != nil {
+		return nil, errors.Wrap(err, "merkle proof KZG commitments")
+	}
+
+	numberOfColumns := params.BeaconConfig().NumberOfColumns
+	if numberOfColumns != kzg.CellsPerExtBlob {
+		return nil, errors.Errorf("number of columns %d does not match the number of cells per extended blob %d", numberOfColumns, kzg.CellsPerExtBlob)
+	}
+
+	// cells[i][j] is the j-th cell of the i-th blob, and likewise for proofs.
+	cells := make([][][]byte, len(blobs))
+	proofs := make([][][]byte, len(blobs))
+	for i, blob := range blobs {
+		cells[i], proofs[i], err = kzg.ComputeCellsAndKZGProofs(blob)
+		if err != nil {
+			return nil, errors.Wrapf(err, "compute cells and KZG proofs for blob %d", i)
+		}
+	}
+
+	sidecars := make([]*ethpb.DataColumnSidecar, 0, numberOfColumns)
+	for columnIndex := uint64(0); columnIndex < numberOfColumns; columnIndex++ {
+		column := make([][]byte, 0, len(blobs))
+		kzgProofs := make([][]byte, 0, len(blobs))
+		for rowIndex := range blobs {
+			column = append(column, cells[rowIndex][columnIndex])
+			kzgProofs = append(kzgProofs, proofs[rowIndex][columnIndex])
+		}
+		sidecars = append(sidecars, &ethpb.DataColumnSidecar{
+			ColumnIndex:                  columnIndex,
+			DataColumn:                   column,
+			KzgCommitments:               commitments,
+			KzgProof:                     kzgProofs,
+			SignedBlockHeader:            header,
+			KzgCommitmentsInclusionProof: inclusionProof,
+		})
+	}
+	return sidecars, nil
+}
+
+// VerifyDataColumnSidecar performs the structural checks of a data column sidecar.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/fulu/p2p-interface.md#verify_data_column_sidecar
+func VerifyDataColumnSidecar(sidecar blocks.RODataColumn) error {
+	if sidecar.ColumnIndex >= params.BeaconConfig().NumberOfColumns {
+		return ErrInvalidColumnIndex
+	}
+	if len(sidecar.KzgCommitments) == 0 {
+		return ErrNoKzgCommitments
+	}
+	if len(sidecar.DataColumn) != len(sidecar.KzgCommitments) || len(sidecar.DataColumn) != len(sidecar.KzgProof) {
+		return ErrMismatchLength
+	}
+	return nil
+}
+
+// VerifyDataColumnsSidecarKZGProofs batch verifies the KZG proofs of the cells of all the given sidecars.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/fulu/p2p-interface.md#verify_data_column_sidecar_kzg_proofs
+func VerifyDataColumnsSidecarKZGProofs(sidecars []blocks.RODataColumn) error {
+	count := 0
+	for _, sidecar := range sidecars {
+		count += len(sidecar.DataColumn)
+	}
+	commitments := make([][]byte, 0, count)
+	indices := make([]uint64, 0, count)
+	cells := make([][]byte, 0, count)
+	proofs := make([][]byte, 0, count)
+	for _, sidecar := range sidecars {
+		if err := VerifyDataColumnSidecar(sidecar); err != nil {
+			return err
+		}
+		for i := range sidecar.DataColumn {
+			commitments = append(commitments, sidecar.KzgCommitments[i])
+			indices = append(indices, sidecar.ColumnIndex)
+			cells = append(cells, sidecar.DataColumn[i])
+			proofs = append(proofs, sidecar.KzgProof[i])
+		}
+	}
+	if err := kzg.VerifyCellKZGProofBatch(commitments, indices, cells, proofs); err != nil {
+		return errors.Wrap(ErrInvalidKZGProof, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package peerdas_test
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/kzg"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/peerdas"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestDataColumnSidecars(t *testing.T) {
+	require.NoError(t, kzg.Start())
+
+	const blobCount = 2
+	blobs := make([][]byte, blobCount)
+	pb := util.NewBeaconBlockFulu()
+	for i := range blobs {
+		blob := util.GetRandBlob(int64(i))
+		blobs[i] = blob[:]
+		commitment, err := kzg.BlobToKZGCommitment(blobs[i])
+		require.NoError(t, err)
+		pb.Block.Body.BlobKzgCommitments = append(pb.Block.Body.BlobKzgCommitments, commitment)
+	}
+	signed, err := blocks.NewSignedBeaconBlock(pb)
+	require.NoError(t, err)
+
+	_, err = peerdas.DataColumnSidecars(signed, blobs[:1])
+	require.ErrorIs(t, err, peerdas.ErrBlobsCommitmentsMismatch)
+
+	sidecars, err := peerdas.DataColumnSidecars(signed, blobs)
+	require.NoError(t, err)
+	require.Equal(t, int(params.BeaconConfig().NumberOfColumns), len(sidecars))
+
+	roSidecars := make([]blocks.RODataColumn, 0, len(sidecars))
+	for i, sidecar := range sidecars {
+		require.Equal(t, uint64(i), sidecar.ColumnIndex)
+		ro, err := blocks.NewRODataColumn(sidecar)
+		require.NoError(t, err)
+		require.NoError(t, peerdas.VerifyDataColumnSidecar(ro))
+		require.NoError(t, blocks.VerifyKZGCommitmentsInclusionProof(ro))
+		roSidecars = append(roSidecars, ro)
+	}
+	require.NoError(t, peerdas.VerifyDataColumnsSidecarKZGProofs(roSidecars[:4]))
+
+	// Swapping two cells invalidates the proofs.
+	tampered := roSidecars[1]
+	tampered.DataColumn = [][]byte{roSidecars[1].DataColumn[1], roSidecars[1].DataColumn[0]}
+	require.ErrorIs(t, peerdas.VerifyDataColumnsSidecarKZGProofs([]blocks.RODataColumn{tampered}), peerdas.ErrInvalidKZGProof)
+}
+
+func TestVerifyDataColumnSidecar(t *testing.T) {
+	_, sidecars := util.GenerateTestFuluBlockWithDataColumns(t, [32]byte{}, 1, 2)
+	require.NoError(t, peerdas.VerifyDataColumnSidecar(sidecars[0]))
+
+	sidecars[1].ColumnIndex = params.BeaconConfig().NumberOfColumns
+	require.ErrorIs(t, peerdas.VerifyDataColumnSidecar(sidecars[1]), peerdas.ErrInvalidColumnIndex)
+
+	sidecars[2].KzgCommitments = nil
+	require.ErrorIs(t, peerdas.VerifyDataColumnSidecar(sidecars[2]), peerdas.ErrNoKzgCommitments)
+
+	sidecars[3].KzgProof = sidecars[3].KzgProof[:1]
+	require.ErrorIs(t, peerdas.VerifyDataColumnSidecar(sidecars[3]), peerdas.ErrMismatchLength)
+}
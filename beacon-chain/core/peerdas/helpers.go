@@ -0,0 +1,126 @@
+package peerdas
+
+import (
+	"encoding/binary"
+	"math"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/holiman/uint256"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/crypto/hash"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+)
+
+var (
+	ErrCustodyGroupTooLarge      = errors.New("custody group too large")
+	ErrCustodyGroupCountTooLarge = errors.New("custody group count too large")
+	ErrInvalidColumnIndex        = errors.New("invalid column index")
+
+	maxUint256 = &uint256.Int{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+)
+
+// CustodyGroups computes the custody groups a node with the given node ID should custody.
+// The returned slice is sorted in ascending order.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/fulu/das-core.md#get_custody_groups
+func CustodyGroups(nodeId enode.ID, custodyGroupCount uint64) ([]uint64, error) {
+	numberOfCustodyGroups := params.BeaconConfig().NumberOfCustodyGroups
+	if custodyGroupCount > numberOfCustodyGroups {
+		return nil, ErrCustodyGroupCountTooLarge
+	}
+
+	// Shortcut: all the groups are custodied, no need to hash anything.
+	if custodyGroupCount == numberOfCustodyGroups {
+		groups := make([]uint64, numberOfCustodyGroups)
+		for i := range groups {
+			groups[i] = uint64(i)
+		}
+		return groups, nil
+	}
+
+	seen := make(map[uint64]bool, custodyGroupCount)
+	groups := make([]uint64, 0, custodyGroupCount)
+	one := uint256.NewInt(1)
+	currentId := new(uint256.Int).SetBytes(nodeId.Bytes())
+	for uint64(len(groups)) < custodyGroupCount {
+		// uint_to_bytes(current_id) is little endian.
+		currentIdBytesBigEndian := currentId.Bytes32()
+		currentIdBytesLittleEndian := bytesutil.ReverseByteOrder(currentIdBytesBigEndian[:])
+		hashedCurrentId := hash.Hash(currentIdBytesLittleEndian)
+		group := binary.LittleEndian.Uint64(hashedCurrentId[:8]) % numberOfCustodyGroups
+		if !seen[group] {
+			seen[group] = true
+			groups = append(groups, group)
+		}
+		if currentId.Cmp(maxUint256) == 0 {
+			currentId = uint256.NewInt(0)
+		} else {
+			currentId.Add(currentId, one)
+		}
+	}
+	slices.Sort(groups)
+	return groups, nil
+}
+
+// ComputeColumnsForCustodyGroup returns the columns belonging to the given custody group.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/fulu/das-core.md#compute_columns_for_custody_group
+func ComputeColumnsForCustodyGroup(custodyGroup uint64) ([]uint64, error) {
+	beaconConfig := params.BeaconConfig()
+	numberOfCustodyGroups := beaconConfig.NumberOfCustodyGroups
+	if custodyGroup >= numberOfCustodyGroups {
+		return nil, ErrCustodyGroupTooLarge
+	}
+	columnsPerGroup := beaconConfig.NumberOfColumns / numberOfCustodyGroups
+	columns := make([]uint64, 0, columnsPerGroup)
+	for i := uint64(0); i < columnsPerGroup; i++ {
+		columns = append(columns, numberOfCustodyGroups*i+custodyGroup)
+	}
+	return columns, nil
+}
+
+// CustodyColumns returns the set of columns covered by the given custody groups.
+func CustodyColumns(custodyGroups []uint64) (map[uint64]bool, error) {
+	columns := make(map[uint64]bool)
+	for _, group := range custodyGroups {
+		groupColumns, err := ComputeColumnsForCustodyGroup(group)
+		if err != nil {
+			return nil, errors.Wrapf(err, "compute columns for custody group %d", group)
+		}
+		for _, column := range groupColumns {
+			columns[column] = true
+		}
+	}
+	return columns, nil
+}
+
+// CustodyColumnsForNode is a helper combining CustodyGroups and CustodyColumns.
+func CustodyColumnsForNode(nodeId enode.ID, custodyGroupCount uint64) (map[uint64]bool, error) {
+	groups, err := CustodyGroups(nodeId, custodyGroupCount)
+	if err != nil {
+		return nil, errors.Wrap(err, "custody groups")
+	}
+	return CustodyColumns(groups)
+}
+
+// ComputeSubnetForDataColumnSidecar returns the gossip subnet of the given column.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/fulu/p2p-interface.md#compute_subnet_for_data_column_sidecar
+func ComputeSubnetForDataColumnSidecar(columnIndex uint64) uint64 {
+	return columnIndex % params.BeaconConfig().DataColumnSidecarSubnetCount
+}
+
+// DataColumnSubnets returns the set of gossip subnets covering the given columns.
+func DataColumnSubnets(columns map[uint64]bool) map[uint64]bool {
+	subnets := make(map[uint64]bool, len(columns))
+	for column := range columns {
+		subnets[ComputeSubnetForDataColumnSidecar(column)] = true
+	}
+	return subnets
+}
+
+// CustodyGroupSamplingSize returns the number of custody groups a node should sample
+// from, given the number of groups it custodies.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/fulu/das-core.md#custody-sampling
+func CustodyGroupSamplingSize(custodyGroupCount uint64) uint64 {
+	return max(params.BeaconConfig().SamplesPerSlot, custodyGroupCount)
+}
@@ -0,0 +1,95 @@
+package peerdas_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/peerdas"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestCustodyGroups(t *testing.T) {
+	cfg := params.BeaconConfig()
+	nodeID := enode.ID{0x01, 0x02, 0x03}
+
+	_, err := peerdas.CustodyGroups(nodeID, cfg.NumberOfCustodyGroups+1)
+	require.ErrorIs(t, err, peerdas.ErrCustodyGroupCountTooLarge)
+
+	groups, err := peerdas.CustodyGroups(nodeID, cfg.CustodyRequirement)
+	require.NoError(t, err)
+	require.Equal(t, int(cfg.CustodyRequirement), len(groups))
+	for i := 1; i < len(groups); i++ {
+		require.Equal(t, true, groups[i-1] < groups[i], "groups must be sorted and unique")
+	}
+	for _, g := range groups {
+		require.Equal(t, true, g < cfg.NumberOfCustodyGroups)
+	}
+
+	// Deterministic.
+	again, err := peerdas.CustodyGroups(nodeID, cfg.CustodyRequirement)
+	require.NoError(t, err)
+	require.DeepEqual(t, groups, again)
+
+	// Growing the count only adds groups.
+	more, err := peerdas.CustodyGroups(nodeID, cfg.CustodyRequirement+4)
+	require.NoError(t, err)
+	set := make(map[uint64]bool, len(more))
+	for _, g := range more {
+		set[g] = true
+	}
+	for _, g := range groups {
+		require.Equal(t, true, set[g])
+	}
+
+	all, err := peerdas.CustodyGroups(nodeID, cfg.NumberOfCustodyGroups)
+	require.NoError(t, err)
+	require.Equal(t, int(cfg.NumberOfCustodyGroups), len(all))
+
+	// The maximal node ID wraps around to zero.
+	var maxID enode.ID
+	for i := range maxID {
+		maxID[i] = 0xff
+	}
+	groups, err = peerdas.CustodyGroups(maxID, cfg.NumberOfCustodyGroups-1)
+	require.NoError(t, err)
+	require.Equal(t, int(cfg.NumberOfCustodyGroups-1), len(groups))
+}
+
+func TestComputeColumnsForCustodyGroup(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.NumberOfCustodyGroups = 32
+	cfg.NumberOfColumns = 128
+	params.OverrideBeaconConfig(cfg)
+
+	_, err := peerdas.ComputeColumnsForCustodyGroup(32)
+	require.ErrorIs(t, err, peerdas.ErrCustodyGroupTooLarge)
+
+	columns, err := peerdas.ComputeColumnsForCustodyGroup(5)
+	require.NoError(t, err)
+	require.DeepEqual(t, []uint64{5, 37, 69, 101}, columns)
+
+	custody, err := peerdas.CustodyColumns([]uint64{0, 5})
+	require.NoError(t, err)
+	require.Equal(t, 8, len(custody))
+	require.Equal(t, true, custody[69])
+	require.Equal(t, false, custody[1])
+}
+
+func TestDataColumnSubnets(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.DataColumnSidecarSubnetCount = 64
+	params.OverrideBeaconConfig(cfg)
+
+	require.Equal(t, uint64(3), peerdas.ComputeSubnetForDataColumnSidecar(67))
+	subnets := peerdas.DataColumnSubnets(map[uint64]bool{1: true, 65: true, 70: true})
+	require.DeepEqual(t, map[uint64]bool{1: true, 6: true}, subnets)
+}
+
+func TestCustodyGroupSamplingSize(t *testing.T) {
+	samplesPerSlot := params.BeaconConfig().SamplesPerSlot
+	require.Equal(t, samplesPerSlot, peerdas.CustodyGroupSamplingSize(samplesPerSlot-1))
+	require.Equal(t, samplesPerSlot+1, peerdas.CustodyGroupSamplingSize(samplesPerSlot+1))
+}
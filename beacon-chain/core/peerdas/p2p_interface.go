@@ -0,0 +1,26 @@
+package peerdas
+
+import (
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+)
+
+// Cgc is the `cgc` (custody group count) ENR entry.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/fulu/p2p-interface.md#custody-group-count
+type Cgc uint64
+
+// ENRKey returns the key of the custody group count entry in the ENR.
+func (Cgc) ENRKey() string { return params.BeaconNetworkConfig().CustodyGroupCountKey }
+
+// CustodyGroupCountFromRecord extracts the custody group count from an ENR record.
+func CustodyGroupCountFromRecord(record *enr.Record) (uint64, error) {
+	if record == nil {
+		return 0, errors.New("nil record")
+	}
+	var cgc Cgc
+	if err := record.Load(&cgc); err != nil {
+		return 0, errors.Wrap(err, "load custody group count from record")
+	}
+	return uint64(cgc), nil
+}
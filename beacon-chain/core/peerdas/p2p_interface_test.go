@@ -0,0 +1,23 @@
+package peerdas_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/peerdas"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestCustodyGroupCountFromRecord(t *testing.T) {
+	_, err := peerdas.CustodyGroupCountFromRecord(nil)
+	require.ErrorContains(t, "nil record", err)
+
+	record := &enr.Record{}
+	_, err = peerdas.CustodyGroupCountFromRecord(record)
+	require.ErrorContains(t, "load custody group count", err)
+
+	record.Set(peerdas.Cgc(8))
+	cgc, err := peerdas.CustodyGroupCountFromRecord(record)
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), cgc)
+}
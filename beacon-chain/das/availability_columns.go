@@ -0,0 +1,161 @@
+package das
+
+import (
+	"context"
+	"fmt"
+
+	errors "github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/runtime/logging"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	errMixedColumnRoots    = errors.New("DataColumnSidecars must all be for the same block")
+	errBlobsNotSupported   = errors.New("BlobSidecars cannot be persisted in a column based AvailabilityStore")
+	errCommitmentsMismatch = errors.New("KzgCommitments of data column in cache did not match block commitments")
+)
+
+// LazilyPersistentStoreColumn is an implementation of AvailabilityStore to be used when batch syncing Fulu blocks.
+// It works like LazilyPersistentStore, but holds the DataColumnSidecars this node custodies instead of BlobSidecars.
+// The columns passed to PersistColumns are held until IsDataAvailable is called for their block, at which time they
+// undergo full verification and are saved to disk.
+type LazilyPersistentStoreColumn struct {
+	store          *filesystem.DataColumnStorage
+	cache          *dataColumnCache
+	verifier       DataColumnBatchVerifier
+	custodyColumns map[uint64]bool
+}
+
+var _ AvailabilityStore = &LazilyPersistentStoreColumn{}
+
+// DataColumnBatchVerifier enables LazilyPersistentStoreColumn to manage the verification process
+// going from RODataColumn->VerifiedRODataColumn, like BlobBatchVerifier does for blobs.
+type DataColumnBatchVerifier interface {
+	VerifiedRODataColumns(ctx context.Context, blk blocks.ROBlock, sc []blocks.RODataColumn) ([]blocks.VerifiedRODataColumn, error)
+}
+
+// NewLazilyPersistentStoreColumn creates a new LazilyPersistentStoreColumn. custodyColumns is the set of column
+// indices the node is required to custody; IsDataAvailable succeeds once all of them are persisted.
+func NewLazilyPersistentStoreColumn(store *filesystem.DataColumnStorage, verifier DataColumnBatchVerifier, custodyColumns map[uint64]bool) *LazilyPersistentStoreColumn {
+	return &LazilyPersistentStoreColumn{
+		store:          store,
+		cache:          newDataColumnCache(),
+		verifier:       verifier,
+		custodyColumns: custodyColumns,
+	}
+}
+
+// Persist satisfies the AvailabilityStore interface. Blobs do not exist past the Fulu fork, so any
+// attempt to persist them in a column based store is an error.
+func (s *LazilyPersistentStoreColumn) Persist(_ primitives.Slot, sc ...blocks.ROBlob) error {
+	if len(sc) == 0 {
+		return nil
+	}
+	return errBlobsNotSupported
+}
+
+// PersistColumns adds columns to the working column cache. Columns stored in this cache will be persisted
+// for at least as long as the node is running. Once IsDataAvailable succeeds, all custody columns for
+// the given block are guaranteed to be persisted for the remainder of the retention period.
+func (s *LazilyPersistentStoreColumn) PersistColumns(current primitives.Slot, sc ...blocks.RODataColumn) error {
+	if len(sc) == 0 {
+		return nil
+	}
+	if len(sc) > 1 {
+		first := sc[0].BlockRoot()
+		for i := 1; i < len(sc); i++ {
+			if first != sc[i].BlockRoot() {
+				return errMixedColumnRoots
+			}
+		}
+	}
+	if !params.WithinDataColumnDAPeriod(slots.ToEpoch(sc[0].Slot()), slots.ToEpoch(current)) {
+		return nil
+	}
+	key := cacheKey{slot: sc[0].Slot(), root: sc[0].BlockRoot()}
+	entry := s.cache.ensure(key)
+	for i := range sc {
+		if err := entry.stash(&sc[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsDataAvailable returns nil if all the custody columns of the given block are persisted to the db and have
+// been verified. DataColumnSidecars already in the db are assumed to have been previously verified against the block.
+func (s *LazilyPersistentStoreColumn) IsDataAvailable(ctx context.Context, current primitives.Slot, b blocks.ROBlock) error {
+	blockCommitments, err := columnCommitmentsToCheck(b, current)
+	if err != nil {
+		return errors.Wrapf(err, "could not check data availability for block %#x", b.Root())
+	}
+	// Return early for blocks that are pre-fulu or which do not have any commitments.
+	if len(blockCommitments) == 0 {
+		return nil
+	}
+
+	key := keyFromBlock(b)
+	entry := s.cache.ensure(key)
+	defer s.cache.delete(key)
+	root := b.Root()
+	onDisk, err := s.store.Indices(root)
+	if err != nil {
+		return errors.Wrapf(err, "could not read stored data column indices for block %#x", root)
+	}
+
+	// Verify we have all the expected sidecars, and fail fast if any are missing or inconsistent.
+	sidecars, err := entry.filter(root, blockCommitments, s.custodyColumns, onDisk)
+	if err != nil {
+		return errors.Wrap(err, "incomplete DataColumnSidecar batch")
+	}
+	if len(sidecars) == 0 {
+		return nil
+	}
+	vscs, err := s.verifier.VerifiedRODataColumns(ctx, b, sidecars)
+	if err != nil {
+		var me verification.VerificationMultiError
+		if errors.As(err, &me) {
+			fails := me.Failures()
+			lf := make(log.Fields, len(fails))
+			for i := range fails {
+				lf[fmt.Sprintf("fail_%d", i)] = fails[i].Error()
+			}
+			log.WithFields(lf).WithFields(logging.DataColumnFields(sidecars[0])).
+				Debug("invalid DataColumnSidecars received")
+		}
+		return errors.Wrapf(err, "invalid DataColumnSidecars received for block %#x", root)
+	}
+	// Ensure that each DataColumnSidecar is written to disk.
+	for i := range vscs {
+		if err := s.store.Save(vscs[i]); err != nil {
+			return errors.Wrapf(err, "failed to save DataColumnSidecar index %d for block %#x", vscs[i].ColumnIndex, root)
+		}
+	}
+	// All custody columns are persisted - da check succeeds.
+	return nil
+}
+
+func columnCommitmentsToCheck(b blocks.ROBlock, current primitives.Slot) ([][]byte, error) {
+	if b.Version() < version.Fulu {
+		return nil, nil
+	}
+	// We are only required to check within MIN_EPOCHS_FOR_DATA_COLUMN_SIDECARS_REQUESTS
+	if !params.WithinDataColumnDAPeriod(slots.ToEpoch(b.Block().Slot()), slots.ToEpoch(current)) {
+		return nil, nil
+	}
+	kzgCommitments, err := b.Block().Body().BlobKzgCommitments()
+	if err != nil {
+		return nil, err
+	}
+	if len(kzgCommitments) > params.BeaconConfig().MaxBlobsPerBlock(b.Block().Slot()) {
+		return nil, errIndexOutOfBounds
+	}
+	return kzgCommitments, nil
+}
@@ -0,0 +1,113 @@
+package das
+
+import (
+	"context"
+	"testing"
+
+	errors "github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+func TestLazilyPersistentColumn_Missing(t *testing.T) {
+	ctx := context.Background()
+	store := filesystem.NewEphemeralDataColumnStorage(t)
+	blk, dcs := util.GenerateTestFuluBlockWithDataColumns(t, [32]byte{}, 1, 2)
+
+	custody := map[uint64]bool{1: true, 5: true}
+	mbv := &mockDataColumnBatchVerifier{t: t}
+	as := NewLazilyPersistentStoreColumn(store, mbv, custody)
+
+	// Only one custody column persisted, should return error.
+	require.NoError(t, as.PersistColumns(1, dcs[1]))
+	require.ErrorIs(t, as.IsDataAvailable(ctx, 1, blk), errMissingSidecar)
+
+	// Non custody columns don't count.
+	require.NoError(t, as.PersistColumns(1, dcs[2], dcs[3]))
+	require.ErrorIs(t, as.IsDataAvailable(ctx, 1, blk), errMissingSidecar)
+
+	// All custody columns persisted, return nil and only verify custody columns.
+	require.NoError(t, as.PersistColumns(1, dcs[1], dcs[5]))
+	mbv.expected = []uint64{1, 5}
+	require.NoError(t, as.IsDataAvailable(ctx, 1, blk))
+
+	// Columns are on disk now, so the check succeeds without the cache.
+	mbv.err = errors.New("verifier should not run")
+	require.NoError(t, as.IsDataAvailable(ctx, 1, blk))
+	indices, err := store.Indices(blk.Root())
+	require.NoError(t, err)
+	require.Equal(t, true, indices[1])
+	require.Equal(t, true, indices[5])
+	require.Equal(t, false, indices[2])
+}
+
+func TestLazilyPersistentColumn_Mismatch(t *testing.T) {
+	ctx := context.Background()
+	blk, dcs := util.GenerateTestFuluBlockWithDataColumns(t, [32]byte{}, 1, 2)
+	mbv := &mockDataColumnBatchVerifier{t: t, err: errors.New("kzg check should not run")}
+	as := NewLazilyPersistentStoreColumn(filesystem.NewEphemeralDataColumnStorage(t), mbv, map[uint64]bool{0: true})
+
+	commitments := make([][]byte, len(dcs[0].KzgCommitments))
+	copy(commitments, dcs[0].KzgCommitments)
+	commitments[0] = bytesutil.PadTo([]byte("nope"), 48)
+	dcs[0].KzgCommitments = commitments
+	require.NoError(t, as.PersistColumns(1, dcs[0]))
+	require.ErrorIs(t, as.IsDataAvailable(ctx, 1, blk), errCommitmentsMismatch)
+}
+
+func TestLazilyPersistentColumn_NoCommitments(t *testing.T) {
+	blk, _ := util.GenerateTestFuluBlockWithDataColumns(t, [32]byte{}, 1, 0)
+	as := NewLazilyPersistentStoreColumn(filesystem.NewEphemeralDataColumnStorage(t), &mockDataColumnBatchVerifier{t: t}, map[uint64]bool{0: true})
+	require.NoError(t, as.IsDataAvailable(context.Background(), 1, blk))
+
+	// Pre-fulu blocks are ignored by the column store.
+	denebBlk, _ := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, 1, 2)
+	require.NoError(t, as.IsDataAvailable(context.Background(), 1, denebBlk))
+}
+
+func TestLazilyPersistentColumn_PersistColumns(t *testing.T) {
+	_, dcs := util.GenerateTestFuluBlockWithDataColumns(t, [32]byte{}, 1, 1)
+	as := NewLazilyPersistentStoreColumn(filesystem.NewEphemeralDataColumnStorage(t), &mockDataColumnBatchVerifier{t: t}, nil)
+	require.NoError(t, as.PersistColumns(1, dcs...))
+	// ignores duplicates
+	require.ErrorIs(t, as.PersistColumns(1, dcs[0]), ErrDuplicateSidecar)
+
+	// ignores sidecars before the retention period
+	_, more := util.GenerateTestFuluBlockWithDataColumns(t, [32]byte{}, 1, 2)
+	slotOOB, err := slots.EpochStart(params.BeaconConfig().MinEpochsForDataColumnSidecarsRequest)
+	require.NoError(t, err)
+	require.NoError(t, as.PersistColumns(32+slotOOB, more[0]))
+
+	// columns for different roots can't be mixed
+	require.ErrorIs(t, as.PersistColumns(1, dcs[1], more[1]), errMixedColumnRoots)
+
+	// blobs are not supported
+	_, blobs := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, 1, 1)
+	require.ErrorIs(t, as.Persist(1, blobs...), errBlobsNotSupported)
+	require.NoError(t, as.Persist(1))
+}
+
+type mockDataColumnBatchVerifier struct {
+	t        *testing.T
+	expected []uint64
+	err      error
+}
+
+var _ DataColumnBatchVerifier = &mockDataColumnBatchVerifier{}
+
+func (m *mockDataColumnBatchVerifier) VerifiedRODataColumns(_ context.Context, _ blocks.ROBlock, scs []blocks.RODataColumn) ([]blocks.VerifiedRODataColumn, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	require.Equal(m.t, len(m.expected), len(scs))
+	for i := range m.expected {
+		require.Equal(m.t, m.expected[i], scs[i].ColumnIndex)
+	}
+	return verification.FakeVerifyDataColumnSliceForTest(m.t, scs), nil
+}
@@ -0,0 +1,89 @@
+package das
+
+import (
+	"bytes"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+)
+
+var errColumnIndexOutOfBounds = errors.New("sidecar.index >= NUMBER_OF_COLUMNS")
+
+type dataColumnCache struct {
+	entries map[cacheKey]*dataColumnCacheEntry
+}
+
+func newDataColumnCache() *dataColumnCache {
+	return &dataColumnCache{entries: make(map[cacheKey]*dataColumnCacheEntry)}
+}
+
+// ensure returns the entry for the given key, creating it if it isn't already present.
+func (c *dataColumnCache) ensure(key cacheKey) *dataColumnCacheEntry {
+	e, ok := c.entries[key]
+	if !ok {
+		e = &dataColumnCacheEntry{}
+		c.entries[key] = e
+	}
+	return e
+}
+
+// delete removes the cache entry from the cache.
+func (c *dataColumnCache) delete(key cacheKey) {
+	delete(c.entries, key)
+}
+
+// dataColumnCacheEntry holds a fixed-length cache of DataColumnSidecars.
+type dataColumnCacheEntry struct {
+	scs []*blocks.RODataColumn
+}
+
+// stash adds an item to the in-memory cache of DataColumnSidecars.
+// Only the first DataColumnSidecar of a given index will be kept in the cache.
+// stash will return an error if the given column is already in the cache, or if the index is out of bounds.
+func (e *dataColumnCacheEntry) stash(sc *blocks.RODataColumn) error {
+	numberOfColumns := params.BeaconConfig().NumberOfColumns
+	if sc.ColumnIndex >= numberOfColumns {
+		return errors.Wrapf(errColumnIndexOutOfBounds, "index=%d", sc.ColumnIndex)
+	}
+	if e.scs == nil {
+		e.scs = make([]*blocks.RODataColumn, numberOfColumns)
+	}
+	if e.scs[sc.ColumnIndex] != nil {
+		return errors.Wrapf(ErrDuplicateSidecar, "root=%#x, index=%d", sc.BlockRoot(), sc.ColumnIndex)
+	}
+	e.scs[sc.ColumnIndex] = sc
+	return nil
+}
+
+// filter returns the cached custody columns that still need to be verified and written to disk. Columns that
+// are already on disk are skipped. An error is returned if a custody column is missing from the cache, or if
+// the commitments of a cached column do not match those found in the block.
+func (e *dataColumnCacheEntry) filter(root [32]byte, kc [][]byte, custody map[uint64]bool, onDisk []bool) ([]blocks.RODataColumn, error) {
+	scs := make([]blocks.RODataColumn, 0, len(custody))
+	for i := uint64(0); i < uint64(len(onDisk)); i++ {
+		if !custody[i] || onDisk[i] {
+			continue
+		}
+		if e.scs == nil || e.scs[i] == nil {
+			return nil, errors.Wrapf(errMissingSidecar, "root=%#x, index=%#x", root, i)
+		}
+		if !commitmentsEqual(kc, e.scs[i].KzgCommitments) {
+			return nil, errors.Wrapf(errCommitmentsMismatch, "root=%#x, index=%#x", root, i)
+		}
+		scs = append(scs, *e.scs[i])
+	}
+	return scs, nil
+}
+
+func commitmentsEqual(a, b [][]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !bytes.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
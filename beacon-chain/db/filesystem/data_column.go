@@ -0,0 +1,316 @@
+package filesystem
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/logging"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/afero"
+)
+
+var (
+	errColumnIndexOutOfBounds = errors.New("data column index in file name >= NumberOfColumns")
+	errEmptyColumnWritten     = errors.New("zero bytes written to disk when saving data column sidecar")
+	errNoColumnBasePath       = errors.New("DataColumnStorage base path not specified in init")
+)
+
+// DataColumnStorageOption is a functional option for configuring a DataColumnStorage.
+type DataColumnStorageOption func(*DataColumnStorage) error
+
+// WithDataColumnBasePath is a required option that sets the base path of data column storage.
+func WithDataColumnBasePath(base string) DataColumnStorageOption {
+	return func(s *DataColumnStorage) error {
+		s.base = base
+		return nil
+	}
+}
+
+// WithDataColumnRetentionEpochs is an option that changes the number of epochs data columns will be persisted.
+// When unset, data columns are kept for MIN_EPOCHS_FOR_DATA_COLUMN_SIDECARS_REQUESTS epochs.
+func WithDataColumnRetentionEpochs(e primitives.Epoch) DataColumnStorageOption {
+	return func(s *DataColumnStorage) error {
+		s.retentionEpochs = e
+		return nil
+	}
+}
+
+// WithDataColumnSaveFsync is an option that causes Save to call fsync before renaming part files for improved durability.
+func WithDataColumnSaveFsync(fsync bool) DataColumnStorageOption {
+	return func(s *DataColumnStorage) error {
+		s.fsync = fsync
+		return nil
+	}
+}
+
+// NewDataColumnStorage creates a new instance of the DataColumnStorage object. Like BlobStorage, it should only be
+// initialized once per beacon node.
+func NewDataColumnStorage(opts ...DataColumnStorageOption) (*DataColumnStorage, error) {
+	s := &DataColumnStorage{retentionEpochs: params.BeaconConfig().MinEpochsForDataColumnSidecarsRequest}
+	for _, o := range opts {
+		if err := o(s); err != nil {
+			return nil, errors.Wrap(err, "failed to create data column storage")
+		}
+	}
+	if s.base == "" {
+		return nil, errNoColumnBasePath
+	}
+	s.base = path.Clean(s.base)
+	if err := file.MkdirAll(s.base); err != nil {
+		return nil, errors.Wrapf(err, "failed to create data column storage at %s", s.base)
+	}
+	s.fs = afero.NewBasePathFs(afero.NewOsFs(), s.base)
+	pruner, err := newDataColumnPruner(s.fs, s.retentionEpochs)
+	if err != nil {
+		return nil, err
+	}
+	s.pruner = pruner
+	return s, nil
+}
+
+// DataColumnStorage is the concrete implementation of the filesystem backend for saving and retrieving
+// DataColumnSidecars. Columns are laid out the same way as blobs: one directory per block root, containing
+// one ssz file per column index.
+type DataColumnStorage struct {
+	base            string
+	retentionEpochs primitives.Epoch
+	fsync           bool
+	fs              afero.Fs
+	pruner          *dataColumnPruner
+}
+
+// WarmCache runs the prune routine with an expiration of slot of 0, so nothing will be pruned, but the pruner's cache
+// will be populated at node startup.
+func (dcs *DataColumnStorage) WarmCache() {
+	if dcs.pruner == nil {
+		return
+	}
+	go func() {
+		start := time.Now()
+		log.Info("Data column filesystem cache warm-up started. This may take a few minutes.")
+		if err := dcs.pruner.warmCache(); err != nil {
+			log.WithError(err).Error("Error encountered while warming up data column pruner cache")
+		}
+		log.WithField("elapsed", time.Since(start)).Info("Data column filesystem cache warm-up complete")
+	}()
+}
+
+// ErrDataColumnStorageSummarizerUnavailable is a sentinel error returned when there is no pruner/cache available.
+// Callers that only use the summarizer as an optimization should fall back to Indices.
+var ErrDataColumnStorageSummarizerUnavailable = errors.New("DataColumnStorage not initialized with a pruner or cache")
+
+// WaitForSummarizer blocks until the DataColumnStorageSummarizer is ready to use.
+// DataColumnStorageSummarizer is not ready immediately on node startup because it needs to sample the filesystem to
+// determine which data columns are available.
+func (dcs *DataColumnStorage) WaitForSummarizer(ctx context.Context) (DataColumnStorageSummarizer, error) {
+	if dcs == nil || dcs.pruner == nil {
+		return nil, ErrDataColumnStorageSummarizerUnavailable
+	}
+	return dcs.pruner.waitForCache(ctx)
+}
+
+// Save saves the given verified data column sidecar.
+func (dcs *DataColumnStorage) Save(sidecar blocks.VerifiedRODataColumn) error {
+	startTime := time.Now()
+	fname := namerForDataColumn(sidecar)
+	sszPath := fname.path()
+	exists, err := afero.Exists(dcs.fs, sszPath)
+	if err != nil {
+		return err
+	}
+	if exists {
+		log.WithFields(logging.DataColumnFields(sidecar.RODataColumn)).Debug("Ignoring a duplicate data column sidecar save attempt")
+		return nil
+	}
+	if dcs.pruner != nil {
+		if err := dcs.pruner.notify(sidecar.BlockRoot(), sidecar.Slot(), sidecar.ColumnIndex); err != nil {
+			return errors.Wrapf(err, "problem maintaining pruning cache/metrics for data column with root=%#x", sidecar.BlockRoot())
+		}
+	}
+
+	sidecarData, err := sidecar.MarshalSSZ()
+	if err != nil {
+		return errors.Wrap(err, "failed to serialize data column sidecar")
+	} else if len(sidecarData) == 0 {
+		return errSidecarEmptySSZData
+	}
+
+	if err := dcs.fs.MkdirAll(fname.dir(), directoryPermissions); err != nil {
+		return err
+	}
+	partPath := fname.partPath(fmt.Sprintf("%p", sidecarData))
+
+	partialMoved := false
+	// Ensure the partial file is deleted.
+	defer func() {
+		if partialMoved {
+			return
+		}
+		// It's expected to error if the save is successful.
+		if err := dcs.fs.Remove(partPath); err == nil {
+			log.WithFields(logrus.Fields{
+				"partPath": partPath,
+			}).Debugf("Removed partial file")
+		}
+	}()
+
+	partialFile, err := dcs.fs.Create(partPath)
+	if err != nil {
+		return errors.Wrap(err, "failed to create partial file")
+	}
+	n, err := partialFile.Write(sidecarData)
+	if err != nil {
+		if closeErr := partialFile.Close(); closeErr != nil {
+			return closeErr
+		}
+		return errors.Wrap(err, "failed to write to partial file")
+	}
+	if dcs.fsync {
+		if err := partialFile.Sync(); err != nil {
+			return err
+		}
+	}
+	if err := partialFile.Close(); err != nil {
+		return err
+	}
+	if n != len(sidecarData) {
+		return fmt.Errorf("failed to write the full bytes of sidecarData, wrote only %d of %d bytes", n, len(sidecarData))
+	}
+	if n == 0 {
+		return errEmptyColumnWritten
+	}
+
+	// Atomically rename the partial file to its final name.
+	if err := dcs.fs.Rename(partPath, sszPath); err != nil {
+		return errors.Wrap(err, "failed to rename partial file to final name")
+	}
+	partialMoved = true
+	dataColumnsWrittenCounter.Inc()
+	dataColumnSaveLatency.Observe(float64(time.Since(startTime).Milliseconds()))
+	return nil
+}
+
+// Get retrieves a single DataColumnSidecar by its root and index.
+// Since DataColumnStorage only writes columns that have undergone full verification, the return
+// value is always a VerifiedRODataColumn.
+func (dcs *DataColumnStorage) Get(root [32]byte, idx uint64) (blocks.VerifiedRODataColumn, error) {
+	startTime := time.Now()
+	expected := dataColumnNamer{root: root, index: idx}
+	encoded, err := afero.ReadFile(dcs.fs, expected.path())
+	if err != nil {
+		return blocks.VerifiedRODataColumn{}, err
+	}
+	s := &ethpb.DataColumnSidecar{}
+	if err := s.UnmarshalSSZ(encoded); err != nil {
+		return blocks.VerifiedRODataColumn{}, err
+	}
+	ro, err := blocks.NewRODataColumnWithRoot(s, root)
+	if err != nil {
+		return blocks.VerifiedRODataColumn{}, err
+	}
+	defer func() {
+		dataColumnFetchLatency.Observe(float64(time.Since(startTime).Milliseconds()))
+	}()
+	return verification.DataColumnSidecarNoop(ro)
+}
+
+// Remove removes all data columns for a given root.
+func (dcs *DataColumnStorage) Remove(root [32]byte) error {
+	if dcs.pruner != nil {
+		dcs.pruner.cache.evict(root)
+	}
+	return dcs.fs.RemoveAll(dataColumnNamer{root: root}.dir())
+}
+
+// Indices generates a bitmap representing which DataColumnSidecar.Index values are present on disk for a given root.
+func (dcs *DataColumnStorage) Indices(root [32]byte) ([]bool, error) {
+	numberOfColumns := params.BeaconConfig().NumberOfColumns
+	mask := make([]bool, numberOfColumns)
+
+	entries, err := afero.ReadDir(dcs.fs, dataColumnNamer{root: root}.dir())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return mask, nil
+		}
+		return mask, err
+	}
+	for i := range entries {
+		if entries[i].IsDir() {
+			continue
+		}
+		name := entries[i].Name()
+		if !strings.HasSuffix(name, sszExt) {
+			continue
+		}
+		parts := strings.Split(name, ".")
+		if len(parts) != 2 {
+			continue
+		}
+		u, err := strconv.ParseUint(parts[0], 10, 64)
+		if err != nil {
+			return mask, errors.Wrapf(err, "unexpected directory entry breaks listing, %s", parts[0])
+		}
+		if u >= numberOfColumns {
+			return mask, errColumnIndexOutOfBounds
+		}
+		mask[u] = true
+	}
+	return mask, nil
+}
+
+// Clear deletes all files on the filesystem.
+func (dcs *DataColumnStorage) Clear() error {
+	dirs, err := listDir(dcs.fs, ".")
+	if err != nil {
+		return err
+	}
+	for _, dir := range dirs {
+		if err := dcs.fs.RemoveAll(dir); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WithinRetentionPeriod checks if the requested epoch is within the data column retention period.
+func (dcs *DataColumnStorage) WithinRetentionPeriod(requested, current primitives.Epoch) bool {
+	if requested > math.MaxUint64-dcs.retentionEpochs {
+		// If there is an overflow, then the retention period was set to an extremely large number.
+		return true
+	}
+	return requested+dcs.retentionEpochs >= current
+}
+
+type dataColumnNamer struct {
+	root  [32]byte
+	index uint64
+}
+
+func namerForDataColumn(dc blocks.VerifiedRODataColumn) dataColumnNamer {
+	return dataColumnNamer{root: dc.BlockRoot(), index: dc.ColumnIndex}
+}
+
+func (p dataColumnNamer) dir() string {
+	return rootString(p.root)
+}
+
+func (p dataColumnNamer) partPath(entropy string) string {
+	return path.Join(p.dir(), fmt.Sprintf("%s-%d.%s", entropy, p.index, partExt))
+}
+
+func (p dataColumnNamer) path() string {
+	return path.Join(p.dir(), fmt.Sprintf("%d.%s", p.index, sszExt))
+}
@@ -0,0 +1,125 @@
+package filesystem
+
+import (
+	"sync"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+)
+
+// DataColumnStorageSummary represents cached information about the DataColumnSidecars on disk for each root the cache knows about.
+type DataColumnStorageSummary struct {
+	slot primitives.Slot
+	mask []bool
+}
+
+// HasIndex returns true if the DataColumnSidecar at the given index is available in the filesystem.
+func (s DataColumnStorageSummary) HasIndex(idx uint64) bool {
+	if idx >= uint64(len(s.mask)) {
+		return false
+	}
+	return s.mask[idx]
+}
+
+// AllAvailable returns true if we have all the data columns for the given set of indices.
+func (s DataColumnStorageSummary) AllAvailable(indices map[uint64]bool) bool {
+	for idx := range indices {
+		if !s.HasIndex(idx) {
+			return false
+		}
+	}
+	return true
+}
+
+// Count returns the number of data columns stored for the root.
+func (s DataColumnStorageSummary) Count() uint64 {
+	var count uint64
+	for i := range s.mask {
+		if s.mask[i] {
+			count++
+		}
+	}
+	return count
+}
+
+// DataColumnStorageSummarizer can be used to receive a summary of metadata about data columns on disk for a given root.
+// The DataColumnStorageSummary can be used to check which indices (if any) are available for a given block by root.
+type DataColumnStorageSummarizer interface {
+	Summary(root [32]byte) DataColumnStorageSummary
+}
+
+type dataColumnStorageCache struct {
+	mu       sync.RWMutex
+	nColumns float64
+	cache    map[[32]byte]DataColumnStorageSummary
+}
+
+var _ DataColumnStorageSummarizer = &dataColumnStorageCache{}
+
+func newDataColumnStorageCache() *dataColumnStorageCache {
+	return &dataColumnStorageCache{
+		cache: make(map[[32]byte]DataColumnStorageSummary, params.BeaconConfig().MinEpochsForDataColumnSidecarsRequest*fieldparams.SlotsPerEpoch),
+	}
+}
+
+// Summary returns the DataColumnStorageSummary for `root`. The DataColumnStorageSummary can be used to check for the presence of
+// DataColumnSidecars based on Index.
+func (s *dataColumnStorageCache) Summary(root [32]byte) DataColumnStorageSummary {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.cache[root]
+}
+
+func (s *dataColumnStorageCache) ensure(key [32]byte, slot primitives.Slot, idx uint64) error {
+	numberOfColumns := params.BeaconConfig().NumberOfColumns
+	if idx >= numberOfColumns {
+		return errColumnIndexOutOfBounds
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v := s.cache[key]
+	v.slot = slot
+	if v.mask == nil {
+		v.mask = make([]bool, numberOfColumns)
+	}
+	if !v.mask[idx] {
+		s.updateMetrics(1)
+	}
+	v.mask[idx] = true
+	s.cache[key] = v
+	return nil
+}
+
+func (s *dataColumnStorageCache) slot(key [32]byte) (primitives.Slot, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	v, ok := s.cache[key]
+	if !ok {
+		return 0, false
+	}
+	return v.slot, ok
+}
+
+func (s *dataColumnStorageCache) evict(key [32]byte) {
+	var deleted float64
+	s.mu.Lock()
+	v, ok := s.cache[key]
+	if ok {
+		for i := range v.mask {
+			if v.mask[i] {
+				deleted += 1
+			}
+		}
+	}
+	delete(s.cache, key)
+	s.mu.Unlock()
+	if deleted > 0 {
+		s.updateMetrics(-deleted)
+	}
+}
+
+func (s *dataColumnStorageCache) updateMetrics(delta float64) {
+	s.nColumns += delta
+	dataColumnDiskCount.Set(s.nColumns)
+}
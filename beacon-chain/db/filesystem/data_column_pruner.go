@@ -0,0 +1,226 @@
+package filesystem
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"path"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/afero"
+)
+
+var errColumnPruningFailures = errors.New("data columns could not be pruned for some roots")
+
+type dataColumnPruner struct {
+	sync.Mutex
+	prunedBefore atomic.Uint64
+	windowSize   primitives.Slot
+	cache        *dataColumnStorageCache
+	cacheReady   chan struct{}
+	warmed       bool
+	fs           afero.Fs
+}
+
+type dataColumnPrunerOpt func(*dataColumnPruner) error
+
+func withWarmedDataColumnCache() dataColumnPrunerOpt {
+	return func(p *dataColumnPruner) error {
+		return p.warmCache()
+	}
+}
+
+func newDataColumnPruner(fs afero.Fs, retain primitives.Epoch, opts ...dataColumnPrunerOpt) (*dataColumnPruner, error) {
+	r, err := slots.EpochStart(retain + retentionBuffer)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not set retentionSlots")
+	}
+	cw := make(chan struct{})
+	p := &dataColumnPruner{fs: fs, windowSize: r, cache: newDataColumnStorageCache(), cacheReady: cw}
+	for _, o := range opts {
+		if err := o(p); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// notify updates the pruner's view of root->column mappings and triggers a prune of expired roots
+// whenever a column is saved for a slot in a new epoch.
+func (p *dataColumnPruner) notify(root [32]byte, latest primitives.Slot, idx uint64) error {
+	if err := p.cache.ensure(root, latest, idx); err != nil {
+		return err
+	}
+	pruned := uint64(windowMin(latest, p.windowSize))
+	if p.prunedBefore.Swap(pruned) == pruned {
+		return nil
+	}
+	go func() {
+		p.Lock()
+		defer p.Unlock()
+		if err := p.prune(primitives.Slot(pruned)); err != nil {
+			log.WithError(err).Errorf("Failed to prune data columns from slot %d", latest)
+		}
+	}()
+	return nil
+}
+
+func (p *dataColumnPruner) warmCache() error {
+	p.Lock()
+	defer func() {
+		if !p.warmed {
+			p.warmed = true
+			close(p.cacheReady)
+		}
+		p.Unlock()
+	}()
+	if err := p.prune(0); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *dataColumnPruner) waitForCache(ctx context.Context) (*dataColumnStorageCache, error) {
+	select {
+	case <-p.cacheReady:
+		return p.cache, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// prune removes the data columns of every root whose slot is lower than pruneBefore.
+// Calling prune with a slot of 0 removes nothing, but populates the cache from disk.
+func (p *dataColumnPruner) prune(pruneBefore primitives.Slot) error {
+	start := time.Now()
+	totalPruned, totalErr := 0, 0
+	if pruneBefore == 0 {
+		defer func() {
+			log.WithField("duration", time.Since(start).String()).Debug("Warmed up data column pruner cache")
+		}()
+	} else {
+		defer func() {
+			log.WithFields(logrus.Fields{
+				"upToEpoch":    slots.ToEpoch(pruneBefore),
+				"duration":     time.Since(start).String(),
+				"filesRemoved": totalPruned,
+			}).Debug("Pruned old data columns")
+			dataColumnsPrunedCounter.Add(float64(totalPruned))
+		}()
+	}
+
+	entries, err := listDir(p.fs, ".")
+	if err != nil {
+		return errors.Wrap(err, "unable to list root data columns directory")
+	}
+	dirs := filter(entries, filterRoot)
+	for _, dir := range dirs {
+		pruned, err := p.tryPruneDir(dir, pruneBefore)
+		if err != nil {
+			totalErr += 1
+			log.WithError(err).WithField("directory", dir).Error("Unable to prune directory")
+		}
+		totalPruned += pruned
+	}
+
+	if totalErr > 0 {
+		return errors.Wrapf(errColumnPruningFailures, "pruning failed for %d root directories", totalErr)
+	}
+	return nil
+}
+
+func (p *dataColumnPruner) tryPruneDir(dir string, pruneBefore primitives.Slot) (int, error) {
+	root, err := rootFromDir(dir)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid directory, could not parse subdir as root %s", dir)
+	}
+	slot, slotCached := p.cache.slot(root)
+	// Return early if the slot is cached and doesn't need pruning.
+	if slotCached && shouldRetain(slot, pruneBefore) {
+		return 0, nil
+	}
+
+	// entries will include things that aren't ssz files, like dangling .part files. We need these to
+	// completely clean up the directory.
+	entries, err := listDir(p.fs, dir)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to list data columns in directory %s", dir)
+	}
+	scFiles := filter(entries, filterSsz)
+	if len(scFiles) == 0 {
+		log.WithField("dir", dir).Warn("Pruner ignoring directory with no data column files")
+		return 0, nil
+	}
+	if !slotCached {
+		slot, err = slotFromDataColumnFile(path.Join(dir, scFiles[0]), p.fs)
+		if err != nil {
+			return 0, errors.Wrapf(err, "slot could not be read from data column file %s", scFiles[0])
+		}
+		for i := range scFiles {
+			idx, err := idxFromPath(scFiles[i])
+			if err != nil {
+				return 0, errors.Wrapf(err, "index could not be determined for data column file %s", scFiles[i])
+			}
+			if err := p.cache.ensure(root, slot, idx); err != nil {
+				return 0, errors.Wrapf(err, "could not update prune cache for data column file %s", scFiles[i])
+			}
+		}
+		if shouldRetain(slot, pruneBefore) {
+			return 0, nil
+		}
+	}
+
+	removed := 0
+	for _, fname := range entries {
+		fullName := path.Join(dir, fname)
+		if err := p.fs.Remove(fullName); err != nil {
+			return removed, errors.Wrapf(err, "unable to remove %s", fullName)
+		}
+		// Don't count other files that happen to be in the dir, like dangling .part files.
+		if filterSsz(fname) {
+			removed += 1
+		}
+		if filterPart(fullName) {
+			log.WithField("file", fullName).Warn("Deleting abandoned data column .part file")
+		}
+	}
+	if err := p.fs.Remove(dir); err != nil {
+		return removed, errors.Wrapf(err, "unable to remove data column directory %s", dir)
+	}
+
+	p.cache.evict(root)
+	return len(scFiles), nil
+}
+
+// Read slot from marshaled DataColumnSidecar data in the given file. See slotFromDataColumn for details.
+func slotFromDataColumnFile(file string, fs afero.Fs) (primitives.Slot, error) {
+	f, err := fs.Open(file)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Errorf("Could not close data column file")
+		}
+	}()
+	return slotFromDataColumn(f)
+}
+
+// slotFromDataColumn reads the ssz data of a file at the specified offset (8 + 4 + 4 + 4 = 20 bytes),
+// which skips the column index and the offsets of the three variable length fields that precede
+// the SignedBeaconBlockHeader, whose first field is the slot.
+func slotFromDataColumn(at io.ReaderAt) (primitives.Slot, error) {
+	b := make([]byte, 8)
+	_, err := at.ReadAt(b, 20)
+	if err != nil {
+		return 0, err
+	}
+	rawSlot := binary.LittleEndian.Uint64(b)
+	return primitives.Slot(rawSlot), nil
+}
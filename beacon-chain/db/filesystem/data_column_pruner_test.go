@@ -0,0 +1,93 @@
+package filesystem
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestSlotFromDataColumn(t *testing.T) {
+	cases := []primitives.Slot{0, 1, 31, 1 << 20}
+	for _, slot := range cases {
+		_, columns := util.GenerateTestFuluBlockWithDataColumns(t, [32]byte{}, slot, 1)
+		enc, err := columns[0].MarshalSSZ()
+		require.NoError(t, err)
+		s, err := slotFromDataColumn(bytes.NewReader(enc))
+		require.NoError(t, err)
+		require.Equal(t, slot, s)
+	}
+}
+
+func TestDataColumnTryPruneDir(t *testing.T) {
+	t.Run("cached and not expired", func(t *testing.T) {
+		fs, dcs := NewEphemeralDataColumnStorageWithFs(t)
+		var slot primitives.Slot = 10
+		_, columns := util.GenerateTestFuluBlockWithDataColumns(t, [32]byte{}, slot, 1)
+		verified := verification.FakeVerifyDataColumnSliceForTest(t, columns)
+		require.NoError(t, dcs.Save(verified[0]))
+
+		rootStr := rootString(verified[0].BlockRoot())
+		pruned, err := dcs.pruner.tryPruneDir(rootStr, slot)
+		require.NoError(t, err)
+		require.Equal(t, 0, pruned)
+		files, err := listDir(fs, rootStr)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(files))
+	})
+	t.Run("cached and expired", func(t *testing.T) {
+		fs, dcs := NewEphemeralDataColumnStorageWithFs(t)
+		var slot primitives.Slot = 0
+		_, columns := util.GenerateTestFuluBlockWithDataColumns(t, [32]byte{}, slot, 1)
+		verified := verification.FakeVerifyDataColumnSliceForTest(t, columns)
+		require.NoError(t, dcs.Save(verified[0]))
+		require.NoError(t, dcs.Save(verified[1]))
+
+		root := verified[0].BlockRoot()
+		rootStr := rootString(root)
+		pruned, err := dcs.pruner.tryPruneDir(rootStr, slot+1)
+		require.NoError(t, err)
+		require.Equal(t, 2, pruned)
+		_, err = listDir(fs, rootStr)
+		require.ErrorIs(t, err, os.ErrNotExist)
+		require.Equal(t, uint64(0), dcs.pruner.cache.Summary(root).Count())
+	})
+	t.Run("slot read from file", func(t *testing.T) {
+		fs, dcs := NewEphemeralDataColumnStorageWithFs(t)
+		var slot primitives.Slot = 0
+		_, columns := util.GenerateTestFuluBlockWithDataColumns(t, [32]byte{}, slot, 1)
+		verified := verification.FakeVerifyDataColumnSliceForTest(t, columns)
+		require.NoError(t, dcs.Save(verified[3]))
+
+		// Drop the cached entry so the pruner needs to read the slot from disk.
+		root := verified[3].BlockRoot()
+		dcs.pruner.cache.evict(root)
+		pruned, err := dcs.pruner.tryPruneDir(rootString(root), slot+1)
+		require.NoError(t, err)
+		require.Equal(t, 1, pruned)
+		_, err = listDir(fs, rootString(root))
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
+
+func TestDataColumnPrune(t *testing.T) {
+	fs, dcs := NewEphemeralDataColumnStorageWithFs(t)
+	var oldSlot, newSlot primitives.Slot = 5, dcs.pruner.windowSize + 100
+	_, oldColumns := util.GenerateTestFuluBlockWithDataColumns(t, [32]byte{}, oldSlot, 1)
+	_, newColumns := util.GenerateTestFuluBlockWithDataColumns(t, [32]byte{0x01}, newSlot, 1)
+	oldVerified := verification.FakeVerifyDataColumnSliceForTest(t, oldColumns)
+	newVerified := verification.FakeVerifyDataColumnSliceForTest(t, newColumns)
+	require.NoError(t, dcs.Save(oldVerified[0]))
+	require.NoError(t, dcs.Save(newVerified[0]))
+
+	require.NoError(t, dcs.pruner.prune(windowMin(newSlot, dcs.pruner.windowSize)))
+	_, err := listDir(fs, rootString(oldVerified[0].BlockRoot()))
+	require.ErrorIs(t, err, os.ErrNotExist)
+	files, err := listDir(fs, rootString(newVerified[0].BlockRoot()))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(files))
+}
@@ -0,0 +1,101 @@
+package filesystem
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestDataColumnStorage_SaveGet(t *testing.T) {
+	_, columns := util.GenerateTestFuluBlockWithDataColumns(t, [32]byte{}, 1, 2)
+	verified := verification.FakeVerifyDataColumnSliceForTest(t, columns)
+
+	t.Run("round trip write then read", func(t *testing.T) {
+		s := NewEphemeralDataColumnStorage(t)
+		require.NoError(t, s.Save(verified[3]))
+		// No error when attempting to write twice.
+		require.NoError(t, s.Save(verified[3]))
+
+		actual, err := s.Get(verified[3].BlockRoot(), verified[3].ColumnIndex)
+		require.NoError(t, err)
+		require.DeepSSZEqual(t, verified[3].DataColumnSidecar, actual.DataColumnSidecar)
+	})
+
+	t.Run("indices", func(t *testing.T) {
+		s := NewEphemeralDataColumnStorage(t)
+		require.NoError(t, s.Save(verified[1]))
+		require.NoError(t, s.Save(verified[5]))
+		expected := make([]bool, params.BeaconConfig().NumberOfColumns)
+		expected[1], expected[5] = true, true
+		actual, err := s.Indices(verified[1].BlockRoot())
+		require.NoError(t, err)
+		require.DeepEqual(t, expected, actual)
+
+		empty, err := s.Indices([32]byte{0xff})
+		require.NoError(t, err)
+		require.DeepEqual(t, make([]bool, params.BeaconConfig().NumberOfColumns), empty)
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		s := NewEphemeralDataColumnStorage(t)
+		require.NoError(t, s.Save(verified[0]))
+		require.NoError(t, s.Remove(verified[0].BlockRoot()))
+		_, err := s.Get(verified[0].BlockRoot(), verified[0].ColumnIndex)
+		require.ErrorContains(t, "file does not exist", err)
+	})
+
+	t.Run("clear", func(t *testing.T) {
+		s := NewEphemeralDataColumnStorage(t)
+		require.NoError(t, s.Save(verified[0]))
+		require.NoError(t, s.Clear())
+		indices, err := s.Indices(verified[0].BlockRoot())
+		require.NoError(t, err)
+		require.Equal(t, false, indices[0])
+	})
+}
+
+func TestDataColumnStorage_Summary(t *testing.T) {
+	_, columns := util.GenerateTestFuluBlockWithDataColumns(t, [32]byte{}, 1, 2)
+	verified := verification.FakeVerifyDataColumnSliceForTest(t, columns)
+	root := verified[0].BlockRoot()
+
+	s := NewEphemeralDataColumnStorage(t)
+	require.NoError(t, s.Save(verified[2]))
+	require.NoError(t, s.Save(verified[7]))
+
+	sumz, err := s.WaitForSummarizer(context.Background())
+	require.NoError(t, err)
+	sum := sumz.Summary(root)
+	require.Equal(t, true, sum.HasIndex(2))
+	require.Equal(t, true, sum.HasIndex(7))
+	require.Equal(t, false, sum.HasIndex(3))
+	require.Equal(t, false, sum.HasIndex(params.BeaconConfig().NumberOfColumns))
+	require.Equal(t, uint64(2), sum.Count())
+	require.Equal(t, true, sum.AllAvailable(map[uint64]bool{2: true, 7: true}))
+	require.Equal(t, false, sum.AllAvailable(map[uint64]bool{2: true, 3: true}))
+
+	require.NoError(t, s.Remove(root))
+	require.Equal(t, uint64(0), sumz.Summary(root).Count())
+
+	var nilStorage *DataColumnStorage
+	_, err = nilStorage.WaitForSummarizer(context.Background())
+	require.ErrorIs(t, err, ErrDataColumnStorageSummarizerUnavailable)
+}
+
+func TestDataColumnStorage_WarmCache(t *testing.T) {
+	_, columns := util.GenerateTestFuluBlockWithDataColumns(t, [32]byte{}, 1, 2)
+	verified := verification.FakeVerifyDataColumnSliceForTest(t, columns)
+	fs, s := NewEphemeralDataColumnStorageWithFs(t)
+	require.NoError(t, s.Save(verified[4]))
+
+	// A fresh pruner over the same filesystem learns about existing columns when warming up.
+	pruner, err := newDataColumnPruner(fs, s.retentionEpochs, withWarmedDataColumnCache())
+	require.NoError(t, err)
+	sum := pruner.cache.Summary(verified[4].BlockRoot())
+	require.Equal(t, true, sum.HasIndex(4))
+	require.Equal(t, uint64(1), sum.Count())
+}
@@ -30,6 +30,25 @@ func NewEphemeralBlobStorageWithFs(t testing.TB) (afero.Fs, *BlobStorage) {
 	return fs, &BlobStorage{fs: fs, pruner: pruner}
 }
 
+// NewEphemeralDataColumnStorage should only be used for tests.
+// The instance of DataColumnStorage returned is backed by an in-memory virtual filesystem.
+func NewEphemeralDataColumnStorage(t testing.TB) *DataColumnStorage {
+	_, dcs := NewEphemeralDataColumnStorageWithFs(t)
+	return dcs
+}
+
+// NewEphemeralDataColumnStorageWithFs can be used by tests that want access to the virtual filesystem
+// in order to interact with it outside the parameters of the DataColumnStorage api.
+func NewEphemeralDataColumnStorageWithFs(t testing.TB) (afero.Fs, *DataColumnStorage) {
+	fs := afero.NewMemMapFs()
+	retention := params.BeaconConfig().MinEpochsForDataColumnSidecarsRequest
+	pruner, err := newDataColumnPruner(fs, retention, withWarmedDataColumnCache())
+	if err != nil {
+		t.Fatal("test setup issue", err)
+	}
+	return fs, &DataColumnStorage{fs: fs, retentionEpochs: retention, pruner: pruner}
+}
+
 type BlobMocker struct {
 	fs afero.Fs
 	bs *BlobStorage
@@ -73,3 +92,16 @@ func NewMockBlobStorageSummarizer(t *testing.T, set map[[32]byte][]int) BlobStor
 	}
 	return c
 }
+
+// NewMockDataColumnStorageSummarizer returns a DataColumnStorageSummarizer reporting the given column indices as present.
+func NewMockDataColumnStorageSummarizer(t *testing.T, set map[[32]byte][]uint64) DataColumnStorageSummarizer {
+	c := newDataColumnStorageCache()
+	for k, v := range set {
+		for i := range v {
+			if err := c.ensure(k, 0, v[i]); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	return c
+}
@@ -0,0 +1,33 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	bolt "go.etcd.io/bbolt"
+)
+
+// SaveForkchoiceSnapshot overwrites the saved fork choice store snapshot with the given encoded snapshot.
+func (s *Store) SaveForkchoiceSnapshot(ctx context.Context, snapshot []byte) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveForkchoiceSnapshot")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(forkchoiceSnapshotBucket)
+		return bucket.Put(forkchoiceSnapshotKey, snapshot)
+	})
+}
+
+// ForkchoiceSnapshot retrieves the most recently saved fork choice store snapshot.
+// It returns nil if no snapshot was saved.
+func (s *Store) ForkchoiceSnapshot(ctx context.Context) ([]byte, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.ForkchoiceSnapshot")
+	defer span.End()
+	var snapshot []byte
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(forkchoiceSnapshotBucket)
+		snapshot = bytesutil.SafeCopyBytes(bucket.Get(forkchoiceSnapshotKey))
+		return nil
+	})
+	return snapshot, err
+}
@@ -0,0 +1,252 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/proto/dbval"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	bolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+)
+
+var errInvalidPruneBatchSize = errors.New("prune batch size must be positive")
+
+// PruneHistory deletes the finalized blocks and states older than the given cutoff slot, together with their
+// state summaries and their slot, parent root and finalized indices.
+// The most recent finalized state saved at or before the cutoff is kept along with its block, so that later states
+// can still be regenerated. That block becomes the lowest available block: it is recorded in the backfill status,
+// and as the origin checkpoint block root if the previous origin block is deleted. The genesis block and state are
+// never deleted. Data is deleted in transactions covering at most batchSize slots of the slot indices.
+// The number of deleted blocks is returned.
+func (s *Store) PruneHistory(ctx context.Context, cutoff primitives.Slot, batchSize int) (int, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PruneHistory")
+	defer span.End()
+
+	if batchSize <= 0 {
+		return 0, errInvalidPruneBatchSize
+	}
+	finalized, err := s.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return 0, err
+	}
+	finalizedSlot, err := slots.EpochStart(finalized.Epoch)
+	if err != nil {
+		return 0, err
+	}
+	if cutoff > finalizedSlot {
+		cutoff = finalizedSlot
+	}
+
+	lowSlot, lowRoot, err := s.lowestRetainedRoot(ctx, cutoff)
+	if err != nil {
+		return 0, err
+	}
+	if lowSlot == 0 {
+		// Nothing can be deleted without losing the ability to regenerate states.
+		return 0, nil
+	}
+	// The low-water mark is moved before deleting any data, so that nothing attempts to read the deleted range
+	// if the node stops while pruning.
+	if err := s.saveLowWaterMark(ctx, lowSlot, lowRoot); err != nil {
+		return 0, errors.Wrap(err, "could not update lowest available block")
+	}
+
+	deleted := 0
+	for {
+		if ctx.Err() != nil {
+			return deleted, ctx.Err()
+		}
+		n, done, err := s.pruneHistoryBatch(ctx, lowSlot, batchSize)
+		deleted += n
+		if err != nil {
+			return deleted, err
+		}
+		if done {
+			return deleted, nil
+		}
+	}
+}
+
+// lowestRetainedRoot returns the slot and block root of the most recent finalized state saved at or before the
+// given slot. A zero slot is returned if there is no such state other than the genesis state.
+func (s *Store) lowestRetainedRoot(ctx context.Context, slot primitives.Slot) (primitives.Slot, [32]byte, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.lowestRetainedRoot")
+	defer span.End()
+
+	var lowSlot primitives.Slot
+	var lowRoot [32]byte
+	err := s.db.View(func(tx *bolt.Tx) error {
+		blks := tx.Bucket(blocksBucket)
+		finalized := tx.Bucket(finalizedBlockRootsIndexBucket)
+		states := tx.Bucket(stateBucket)
+		c := tx.Bucket(stateSlotIndicesBucket).Cursor()
+		k, v := c.Seek(bytesutil.SlotToBytesBigEndian(slot + 1))
+		if k == nil {
+			k, v = c.Last()
+		} else {
+			k, v = c.Prev()
+		}
+		for ; k != nil; k, v = c.Prev() {
+			sl := bytesutil.BytesToSlotBigEndian(k)
+			if sl == 0 {
+				return nil
+			}
+			if len(v)%32 != 0 {
+				return errMisalignedRootList
+			}
+			for i := 0; i < len(v); i += 32 {
+				r := v[i : i+32]
+				if finalized.Get(r) != nil && blks.Get(r) != nil && states.Get(r) != nil {
+					lowSlot = sl
+					copy(lowRoot[:], r)
+					return nil
+				}
+			}
+		}
+		return nil
+	})
+	return lowSlot, lowRoot, err
+}
+
+// saveLowWaterMark records the block with the given slot and root as the lowest available block in the backfill
+// status, and as the origin checkpoint block when the current origin block is older.
+func (s *Store) saveLowWaterMark(ctx context.Context, lowSlot primitives.Slot, lowRoot [32]byte) error {
+	blk, err := s.Block(ctx, lowRoot)
+	if err != nil {
+		return err
+	}
+	if blk == nil || blk.IsNil() {
+		return errors.Wrapf(ErrNotFound, "block with root %#x", lowRoot)
+	}
+	parentRoot := blk.Block().ParentRoot()
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(blocksBucket)
+		// A node synced from genesis has no backfill status yet, in which case it is created here.
+		bf := &dbval.BackfillStatus{}
+		if enc := bkt.Get(backfillStatusKey); len(enc) > 0 {
+			if err := proto.Unmarshal(enc, bf); err != nil {
+				return err
+			}
+		}
+		if bf.LowSlot < uint64(lowSlot) {
+			bf.LowSlot = uint64(lowSlot)
+			bf.LowRoot = bytesutil.SafeCopyBytes(lowRoot[:])
+			bf.LowParentRoot = bytesutil.SafeCopyBytes(parentRoot[:])
+		}
+		origin := bkt.Get(originCheckpointBlockRootKey)
+		if origin == nil || bf.OriginSlot < uint64(lowSlot) {
+			bf.OriginSlot = uint64(lowSlot)
+			bf.OriginRoot = bytesutil.SafeCopyBytes(lowRoot[:])
+			if err := bkt.Put(originCheckpointBlockRootKey, lowRoot[:]); err != nil {
+				return err
+			}
+		}
+		enc, err := proto.Marshal(bf)
+		if err != nil {
+			return err
+		}
+		return bkt.Put(backfillStatusKey, enc)
+	})
+}
+
+// pruneHistoryBatch deletes the blocks and states indexed at up to batchSize slots between slot 1 and lowSlot,
+// and returns the number of deleted blocks and whether there is nothing left to delete.
+func (s *Store) pruneHistoryBatch(ctx context.Context, lowSlot primitives.Slot, batchSize int) (int, bool, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.pruneHistoryBatch")
+	defer span.End()
+
+	deleted := 0
+	done := true
+	var prunedRoots [][]byte
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		genesisRoot := tx.Bucket(blocksBucket).Get(genesisBlockRootKey)
+		for _, idxBucket := range [][]byte{blockSlotIndicesBucket, stateSlotIndicesBucket} {
+			idx := tx.Bucket(idxBucket)
+			keys, roots, more, err := indexedRootsBelowSlot(idx, lowSlot, batchSize)
+			if err != nil {
+				return err
+			}
+			if more {
+				done = false
+			}
+			for _, r := range roots {
+				if genesisRoot != nil && bytes.Equal(r, genesisRoot) {
+					continue
+				}
+				n, err := deleteHistoricalRoot(tx, r)
+				if err != nil {
+					return err
+				}
+				deleted += n
+				prunedRoots = append(prunedRoots, r)
+			}
+			for _, k := range keys {
+				if err := idx.Delete(k); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, false, err
+	}
+	for _, r := range prunedRoots {
+		s.blockCache.Del(string(r))
+		s.stateSummaryCache.delete(bytesutil.ToBytes32(r))
+	}
+	return deleted, done, nil
+}
+
+// indexedRootsBelowSlot returns the keys and roots of a slot index bucket for up to limit slots between slot 1 and
+// the given slot, and whether more slots remain after them.
+func indexedRootsBelowSlot(idx *bolt.Bucket, slot primitives.Slot, limit int) ([][]byte, [][]byte, bool, error) {
+	keys := make([][]byte, 0)
+	roots := make([][]byte, 0)
+	c := idx.Cursor()
+	max := bytesutil.SlotToBytesBigEndian(slot)
+	for k, v := c.Seek(bytesutil.SlotToBytesBigEndian(1)); k != nil && bytes.Compare(k, max) < 0; k, v = c.Next() {
+		if len(keys) == limit {
+			return keys, roots, true, nil
+		}
+		if len(v)%32 != 0 {
+			return nil, nil, false, errMisalignedRootList
+		}
+		keys = append(keys, bytesutil.SafeCopyBytes(k))
+		for i := 0; i < len(v); i += 32 {
+			roots = append(roots, bytesutil.SafeCopyBytes(v[i:i+32]))
+		}
+	}
+	return keys, roots, false, nil
+}
+
+// deleteHistoricalRoot deletes the block, state and state summary of the given root along with the indices keyed by
+// this root, and returns the number of deleted blocks.
+func deleteHistoricalRoot(tx *bolt.Tx, root []byte) (int, error) {
+	deleted := 0
+	blks := tx.Bucket(blocksBucket)
+	if blks.Get(root) != nil {
+		deleted = 1
+		if err := blks.Delete(root); err != nil {
+			return 0, err
+		}
+	}
+	for _, b := range [][]byte{
+		blockParentRootIndicesBucket,
+		finalizedBlockRootsIndexBucket,
+		stateBucket,
+		stateSummaryBucket,
+		blockRootValidatorHashesBucket,
+	} {
+		if err := tx.Bucket(b).Delete(root); err != nil {
+			return 0, err
+		}
+	}
+	return deleted, nil
+}
@@ -0,0 +1,149 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/proto/dbval"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+// setupPruneDB saves a finalized chain of blocks at slots 0 to 40 with their state summaries, with states at
+// slot 0 and every 8 slots, finalized at slot 32.
+func setupPruneDB(t *testing.T) (*Store, [32]byte, []interfaces.ReadOnlySignedBeaconBlock) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	genesis := util.NewBeaconBlock()
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	util.SaveBlock(t, ctx, db, genesis)
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, st, genesisRoot))
+
+	blks := makeBlocks(t, 0, 40, genesisRoot)
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	for _, b := range blks {
+		r, err := b.Block().HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, db.SaveStateSummary(ctx, &ethpb.StateSummary{Slot: b.Block().Slot(), Root: r[:]}))
+		if b.Block().Slot()%8 != 0 {
+			continue
+		}
+		st, err := util.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, st.SetSlot(b.Block().Slot()))
+		require.NoError(t, db.SaveState(ctx, st, r))
+	}
+	finalizedRoot, err := blks[31].Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 1, Root: finalizedRoot[:]}))
+	return db, genesisRoot, blks
+}
+
+func TestStore_PruneHistory(t *testing.T) {
+	ctx := context.Background()
+	db, genesisRoot, blks := setupPruneDB(t)
+
+	_, err := db.PruneHistory(ctx, 20, 0)
+	require.ErrorIs(t, err, errInvalidPruneBatchSize)
+
+	// The state at slot 16 is the most recent one at or before the cutoff.
+	n, err := db.PruneHistory(ctx, 20, 4)
+	require.NoError(t, err)
+	require.Equal(t, 15, n)
+	for _, b := range blks {
+		r, err := b.Block().HashTreeRoot()
+		require.NoError(t, err)
+		want := b.Block().Slot() >= 16
+		require.Equal(t, want, db.HasBlock(ctx, r))
+		require.Equal(t, want, db.IsFinalizedBlock(ctx, r))
+		require.Equal(t, want, db.HasStateSummary(ctx, r))
+		if b.Block().Slot()%8 == 0 {
+			require.Equal(t, want, db.HasState(ctx, r))
+		}
+	}
+	require.Equal(t, true, db.HasBlock(ctx, genesisRoot))
+	require.Equal(t, true, db.HasState(ctx, genesisRoot))
+	roots, err := db.BlockRoots(ctx, filters.NewFilter().SetStartSlot(1).SetEndSlot(15))
+	require.NoError(t, err)
+	require.Equal(t, 0, len(roots))
+
+	lowRoot, err := blks[15].Block().HashTreeRoot()
+	require.NoError(t, err)
+	lowParent := blks[15].Block().ParentRoot()
+	bs, err := db.BackfillStatus(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(16), bs.LowSlot)
+	require.DeepEqual(t, lowRoot[:], bs.LowRoot)
+	require.DeepEqual(t, lowParent[:], bs.LowParentRoot)
+	require.Equal(t, uint64(16), bs.OriginSlot)
+	origin, err := db.OriginCheckpointBlockRoot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, lowRoot, origin)
+
+	// The cutoff is clipped to the finalized checkpoint at slot 32.
+	n, err = db.PruneHistory(ctx, 100, 1)
+	require.NoError(t, err)
+	require.Equal(t, 16, n)
+	finalizedRoot, err := blks[31].Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, true, db.HasBlock(ctx, finalizedRoot))
+	require.Equal(t, true, db.HasState(ctx, finalizedRoot))
+	bs, err = db.BackfillStatus(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(32), bs.LowSlot)
+	origin, err = db.OriginCheckpointBlockRoot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, finalizedRoot, origin)
+}
+
+func TestStore_PruneHistory_NoRetainedState(t *testing.T) {
+	ctx := context.Background()
+	db, _, blks := setupPruneDB(t)
+
+	// Only the genesis state is at or before the cutoff, so nothing can be deleted.
+	n, err := db.PruneHistory(ctx, 7, 8)
+	require.NoError(t, err)
+	require.Equal(t, 0, n)
+	for _, b := range blks {
+		r, err := b.Block().HashTreeRoot()
+		require.NoError(t, err)
+		require.Equal(t, true, db.HasBlock(ctx, r))
+	}
+	_, err = db.BackfillStatus(ctx)
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestStore_PruneHistory_KeepsOlderOrigin(t *testing.T) {
+	ctx := context.Background()
+	db, _, blks := setupPruneDB(t)
+
+	originRoot, err := blks[31].Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveOriginCheckpointBlockRoot(ctx, originRoot))
+	parentRoot := blks[31].Block().ParentRoot()
+	require.NoError(t, db.SaveBackfillStatus(ctx, &dbval.BackfillStatus{
+		LowSlot:       32,
+		LowRoot:       originRoot[:],
+		LowParentRoot: parentRoot[:],
+		OriginSlot:    32,
+		OriginRoot:    originRoot[:],
+	}))
+
+	_, err = db.PruneHistory(ctx, 20, 8)
+	require.NoError(t, err)
+	origin, err := db.OriginCheckpointBlockRoot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, originRoot, origin)
+	bs, err := db.BackfillStatus(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(32), bs.OriginSlot)
+	require.Equal(t, uint64(32), bs.LowSlot)
+}
@@ -0,0 +1,236 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/statediff"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	bolt "go.etcd.io/bbolt"
+)
+
+// The first byte of a state diff entry tells whether it is a full snapshot, or a diff against the state stored at the
+// slot encoded in the next 8 bytes.
+const (
+	stateDiffSnapshot byte = iota
+	stateDiffLayer
+)
+
+// maxStateDiffDepth bounds the number of diffs applied to reconstruct a state, as a safeguard against corrupted
+// entries.
+const maxStateDiffDepth = 64
+
+var (
+	errStateDiffsDisabled         = errors.New("finalized states are not stored as state diffs")
+	errStateDiffExponentsMismatch = errors.New("state diff exponents differ from the ones the db was created with")
+	errStateDiffPopulatedDB       = errors.New("state diffs can only be enabled on a new database")
+)
+
+// WithStateDiffExponents enables storing finalized states as hierarchical state diffs, with one layer of diffs per
+// exponent. See statediff.Schedule for the meaning of the exponents.
+func WithStateDiffExponents(exponents []uint64) KVStoreOption {
+	return func(s *Store) {
+		s.stateDiffExponents = exponents
+	}
+}
+
+// setupStateDiffs enables the state diff storage with the exponents the db was created with, or with the requested
+// exponents which are then recorded in the db. State diffs can only be enabled on a db without blocks, and once enabled,
+// the exponents of a db cannot change.
+func (s *Store) setupStateDiffs() error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(chainMetadataBucket)
+		var exponents []uint64
+		if enc := bkt.Get(stateDiffExponentsKey); len(enc) > 0 {
+			for _, e := range enc {
+				exponents = append(exponents, uint64(e))
+			}
+			if len(s.stateDiffExponents) > 0 && !equalExponents(exponents, s.stateDiffExponents) {
+				return errors.Wrapf(errStateDiffExponentsMismatch, "db exponents: %v, requested: %v", exponents, s.stateDiffExponents)
+			}
+		} else if len(s.stateDiffExponents) > 0 {
+			if k, _ := tx.Bucket(blocksBucket).Cursor().First(); k != nil {
+				return errStateDiffPopulatedDB
+			}
+			exponents = s.stateDiffExponents
+		}
+		if len(exponents) == 0 {
+			return nil
+		}
+		schedule, err := statediff.NewSchedule(exponents)
+		if err != nil {
+			return err
+		}
+		enc := make([]byte, len(exponents))
+		for i, e := range exponents {
+			enc[i] = byte(e)
+		}
+		if err := bkt.Put(stateDiffExponentsKey, enc); err != nil {
+			return err
+		}
+		s.stateDiffs = schedule
+		log.WithField("exponents", exponents).Info("Storing finalized states as hierarchical state diffs")
+		return nil
+	})
+}
+
+func equalExponents(a, b []uint64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// StateDiffInterval returns the number of slots between two finalized states stored as state diffs, or zero when
+// finalized states are not stored as state diffs.
+func (s *Store) StateDiffInterval() primitives.Slot {
+	if s.stateDiffs == nil {
+		return 0
+	}
+	return s.stateDiffs.Interval()
+}
+
+// SaveStateDiff stores a finalized state, whose slot must be one of the slots of the state diff hierarchy. The state is
+// stored as a diff against the state of the preceding layer, or as a snapshot when that state is not available, e.g.
+// because the state diffs were enabled after it was finalized.
+func (s *Store) SaveStateDiff(ctx context.Context, st state.ReadOnlyBeaconState) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveStateDiff")
+	defer span.End()
+
+	if s.stateDiffs == nil {
+		return errStateDiffsDisabled
+	}
+	if st == nil || st.IsNil() {
+		return errors.New("nil state")
+	}
+	slot := st.Slot()
+	level, ok := s.stateDiffs.Level(slot)
+	if !ok {
+		return errors.Errorf("slot %d is not part of the state diff hierarchy", slot)
+	}
+
+	entry := []byte{stateDiffSnapshot}
+	var base state.BeaconState
+	if level > 0 {
+		baseSlot, err := s.stateDiffs.BaseSlot(slot)
+		if err != nil {
+			return err
+		}
+		base, err = s.stateDiffAt(ctx, baseSlot)
+		switch {
+		case errors.Is(err, ErrNotFoundState):
+		case err != nil:
+			return errors.Wrapf(err, "could not reconstruct base state at slot %d", baseSlot)
+		default:
+			entry = append([]byte{stateDiffLayer}, bytesutil.SlotToBytesBigEndian(baseSlot)...)
+		}
+	}
+	diff, err := statediff.Diff(base, st)
+	if err != nil {
+		return errors.Wrapf(err, "could not compute state diff at slot %d", slot)
+	}
+	entry = append(entry, diff...)
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(stateDiffBucket).Put(bytesutil.SlotToBytesBigEndian(slot), entry)
+	})
+}
+
+// StateFromDiffs reconstructs the most recent state stored as a state diff at or before the given slot.
+// ErrNotFoundState is returned when there is no such state.
+func (s *Store) StateFromDiffs(ctx context.Context, slot primitives.Slot) (state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.StateFromDiffs")
+	defer span.End()
+
+	if s.stateDiffs == nil {
+		return nil, errors.Wrap(ErrNotFoundState, errStateDiffsDisabled.Error())
+	}
+	var found bool
+	var diffSlot primitives.Slot
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(stateDiffBucket).Cursor()
+		k, _ := c.Seek(bytesutil.SlotToBytesBigEndian(slot + 1))
+		if k == nil {
+			k, _ = c.Last()
+		} else {
+			k, _ = c.Prev()
+		}
+		if k != nil {
+			found = true
+			diffSlot = bytesutil.BytesToSlotBigEndian(k)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, errors.Wrapf(ErrNotFoundState, "no state diff at or before slot %d", slot)
+	}
+	return s.stateDiffAt(ctx, diffSlot)
+}
+
+// stateDiffAt reconstructs the state stored as a state diff at exactly the given slot, by applying the diffs of every
+// layer to the snapshot they descend from.
+func (s *Store) stateDiffAt(ctx context.Context, slot primitives.Slot) (state.BeaconState, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.stateDiffAt")
+	defer span.End()
+
+	// The diffs are collected from the requested slot down to the snapshot, and applied in the reverse order.
+	var diffs [][]byte
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(stateDiffBucket)
+		cur := slot
+		for {
+			if len(diffs) == maxStateDiffDepth {
+				return errors.Errorf("state diff at slot %d is more than %d layers deep", slot, maxStateDiffDepth)
+			}
+			entry := bkt.Get(bytesutil.SlotToBytesBigEndian(cur))
+			if len(entry) == 0 {
+				if len(diffs) == 0 {
+					return errors.Wrapf(ErrNotFoundState, "no state diff at slot %d", slot)
+				}
+				return errors.Errorf("missing base state diff at slot %d", cur)
+			}
+			switch entry[0] {
+			case stateDiffSnapshot:
+				diffs = append(diffs, bytes.Clone(entry[1:]))
+				return nil
+			case stateDiffLayer:
+				if len(entry) < 9 {
+					return errors.Wrapf(statediff.ErrInvalidDiff, "truncated entry at slot %d", cur)
+				}
+				diffs = append(diffs, bytes.Clone(entry[9:]))
+				base := bytesutil.BytesToSlotBigEndian(entry[1:9])
+				if base >= cur {
+					return errors.Wrapf(statediff.ErrInvalidDiff, "base slot %d of entry at slot %d", base, cur)
+				}
+				cur = base
+			default:
+				return errors.Wrapf(statediff.ErrInvalidDiff, "unknown entry type %d at slot %d", entry[0], cur)
+			}
+		}
+	}); err != nil {
+		return nil, err
+	}
+
+	var st state.BeaconState
+	for i := len(diffs) - 1; i >= 0; i-- {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		var err error
+		st, err = statediff.Apply(st, diffs[i])
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not apply state diff %d of state at slot %d", i, slot)
+		}
+	}
+	return st, nil
+}
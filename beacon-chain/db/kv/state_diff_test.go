@@ -0,0 +1,119 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestStore_setupStateDiffs(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	db, err := NewKVStore(ctx, dir)
+	require.NoError(t, err)
+	require.Equal(t, primitives.Slot(0), db.StateDiffInterval())
+	require.NoError(t, db.Close())
+
+	db, err = NewKVStore(ctx, dir, WithStateDiffExponents([]uint64{6, 4}))
+	require.NoError(t, err)
+	require.Equal(t, primitives.Slot(16), db.StateDiffInterval())
+	require.NoError(t, db.Close())
+
+	// The exponents recorded in the db are used when none are requested.
+	db, err = NewKVStore(ctx, dir)
+	require.NoError(t, err)
+	require.Equal(t, primitives.Slot(16), db.StateDiffInterval())
+	require.NoError(t, db.Close())
+
+	_, err = NewKVStore(ctx, dir, WithStateDiffExponents([]uint64{6, 3}))
+	require.ErrorIs(t, err, errStateDiffExponentsMismatch)
+
+	_, err = NewKVStore(ctx, t.TempDir(), WithStateDiffExponents([]uint64{3, 4}))
+	require.ErrorContains(t, "strictly decreasing", err)
+
+	// State diffs cannot be enabled on a db which already has blocks.
+	dir = t.TempDir()
+	db, err = NewKVStore(ctx, dir)
+	require.NoError(t, err)
+	blk, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlock())
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, blk))
+	require.NoError(t, db.Close())
+	_, err = NewKVStore(ctx, dir, WithStateDiffExponents([]uint64{6, 4}))
+	require.ErrorIs(t, err, errStateDiffPopulatedDB)
+}
+
+func TestStore_StateDiffs(t *testing.T) {
+	ctx := context.Background()
+	db, err := NewKVStore(ctx, t.TempDir(), WithStateDiffExponents([]uint64{4, 2}))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+
+	genesis, _ := util.DeterministicGenesisStateElectra(t, 32)
+	statesBySlot := make(map[primitives.Slot]state.BeaconState)
+	for _, slot := range []primitives.Slot{0, 4, 8, 16, 20} {
+		st := genesis.Copy()
+		require.NoError(t, st.SetSlot(slot))
+		require.NoError(t, st.UpdateBalancesAtIndex(primitives.ValidatorIndex(slot), uint64(slot)))
+		statesBySlot[slot] = st
+	}
+
+	// The state at slot 4 is saved before its base state at slot 0, so it is stored as a snapshot.
+	require.NoError(t, db.SaveStateDiff(ctx, statesBySlot[4]))
+	for _, slot := range []primitives.Slot{0, 8, 16, 20} {
+		require.NoError(t, db.SaveStateDiff(ctx, statesBySlot[slot]))
+	}
+	require.NoError(t, db.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(stateDiffBucket)
+		require.Equal(t, stateDiffSnapshot, bkt.Get([]byte{0, 0, 0, 0, 0, 0, 0, 4})[0])
+		require.Equal(t, stateDiffLayer, bkt.Get([]byte{0, 0, 0, 0, 0, 0, 0, 8})[0])
+		require.Equal(t, stateDiffSnapshot, bkt.Get([]byte{0, 0, 0, 0, 0, 0, 0, 16})[0])
+		require.Equal(t, stateDiffLayer, bkt.Get([]byte{0, 0, 0, 0, 0, 0, 0, 20})[0])
+		return nil
+	}))
+
+	tests := []struct {
+		slot primitives.Slot
+		want primitives.Slot
+	}{
+		{slot: 0, want: 0},
+		{slot: 3, want: 0},
+		{slot: 4, want: 4},
+		{slot: 11, want: 8},
+		{slot: 19, want: 16},
+		{slot: 100, want: 20},
+	}
+	for _, tt := range tests {
+		st, err := db.StateFromDiffs(ctx, tt.slot)
+		require.NoError(t, err)
+		require.Equal(t, tt.want, st.Slot())
+		wantRoot, err := statesBySlot[tt.want].HashTreeRoot(ctx)
+		require.NoError(t, err)
+		gotRoot, err := st.HashTreeRoot(ctx)
+		require.NoError(t, err)
+		require.Equal(t, wantRoot, gotRoot)
+	}
+
+	st := genesis.Copy()
+	require.NoError(t, st.SetSlot(5))
+	require.ErrorContains(t, "not part of the state diff hierarchy", db.SaveStateDiff(ctx, st))
+}
+
+func TestStore_StateDiffs_Disabled(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.ErrorIs(t, db.SaveStateDiff(ctx, st), errStateDiffsDisabled)
+	_, err = db.StateFromDiffs(ctx, 0)
+	require.ErrorIs(t, err, ErrNotFoundState)
+}
@@ -0,0 +1,22 @@
+package pruner
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	blocksPrunedCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "db_pruner_blocks_pruned_total",
+		Help: "Number of historical blocks deleted from the beacon db.",
+	})
+	pruneLatency = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "db_pruner_duration_seconds",
+		Help:    "Duration of a historical block and state pruning run in seconds.",
+		Buckets: []float64{0.1, 0.5, 1, 5, 10, 30, 60, 300},
+	})
+	pruneCutoffSlot = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "db_pruner_cutoff_slot",
+		Help: "Slot before which historical blocks and states are pruned.",
+	})
+)
@@ -0,0 +1,182 @@
+package pruner
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+// defaultBatchSize is the number of slots of blocks and states deleted in a single db transaction.
+const defaultBatchSize = 64
+
+var errNoClockWaiter = errors.New("pruner requires a clock waiter")
+
+// Database describes the db methods needed by the pruner.
+type Database interface {
+	PruneHistory(ctx context.Context, cutoff primitives.Slot, batchSize int) (int, error)
+}
+
+// LowWaterMarkReloader is notified after blocks were pruned, to reload the lowest available block from the db.
+type LowWaterMarkReloader interface {
+	Reload(ctx context.Context) error
+}
+
+// Service periodically deletes the finalized blocks and states older than the retention period from the beacon db.
+type Service struct {
+	ctx             context.Context
+	cancel          context.CancelFunc
+	db              Database
+	retentionEpochs primitives.Epoch
+	batchSize       int
+	clockWaiter     startup.ClockWaiter
+	initSyncWaiter  func() error
+	lowWaterMark    LowWaterMarkReloader
+	done            chan struct{}
+}
+
+// ServiceOption represents a functional option for the pruner service.
+type ServiceOption func(*Service) error
+
+// WithBatchSize sets the number of slots of blocks and states deleted in a single db transaction.
+func WithBatchSize(n int) ServiceOption {
+	return func(s *Service) error {
+		if n <= 0 {
+			return errors.New("batch size must be positive")
+		}
+		s.batchSize = n
+		return nil
+	}
+}
+
+// WithInitSyncWaiter delays pruning until initial sync is complete.
+func WithInitSyncWaiter(w func() error) ServiceOption {
+	return func(s *Service) error {
+		s.initSyncWaiter = w
+		return nil
+	}
+}
+
+// WithLowWaterMarkReloader sets the component to notify when the lowest available block changed.
+func WithLowWaterMarkReloader(r LowWaterMarkReloader) ServiceOption {
+	return func(s *Service) error {
+		s.lowWaterMark = r
+		return nil
+	}
+}
+
+// New initializes a pruner service which keeps the blocks and states of the last retentionEpochs epochs.
+func New(ctx context.Context, db Database, retentionEpochs primitives.Epoch, cw startup.ClockWaiter, opts ...ServiceOption) (*Service, error) {
+	if cw == nil {
+		return nil, errNoClockWaiter
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Service{
+		ctx:             ctx,
+		cancel:          cancel,
+		db:              db,
+		retentionEpochs: retentionEpochs,
+		batchSize:       defaultBatchSize,
+		clockWaiter:     cw,
+		done:            make(chan struct{}),
+	}
+	for _, o := range opts {
+		if err := o(s); err != nil {
+			cancel()
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// Start runs the pruner in the background, once at startup and then at the start of every epoch.
+func (s *Service) Start() {
+	go s.run()
+}
+
+// Stop stops the pruner, waiting for an ongoing pruning run to stop.
+func (s *Service) Stop() error {
+	s.cancel()
+	<-s.done
+	return nil
+}
+
+// Status is part of the service interface.
+func (*Service) Status() error {
+	return nil
+}
+
+func (s *Service) run() {
+	defer close(s.done)
+	clock, err := s.clockWaiter.WaitForClock(s.ctx)
+	if err != nil {
+		log.WithError(err).Error("Pruner failed to receive genesis data")
+		return
+	}
+	if s.initSyncWaiter != nil {
+		if err := s.initSyncWaiter(); err != nil {
+			log.WithError(err).Error("Pruner failed to wait for initial sync to complete")
+			return
+		}
+	}
+	log.WithField("retentionEpochs", s.retentionEpochs).Info("Pruning historical blocks and states")
+	if err := s.prune(clock.CurrentSlot()); err != nil {
+		log.WithError(err).Error("Failed to prune historical blocks and states")
+	}
+
+	ticker := slots.NewSlotTicker(clock.GenesisTime(), params.BeaconConfig().SecondsPerSlot)
+	defer ticker.Done()
+	for {
+		select {
+		case slot := <-ticker.C():
+			if slot%params.BeaconConfig().SlotsPerEpoch != 0 {
+				continue
+			}
+			if err := s.prune(slot); err != nil {
+				log.WithError(err).Error("Failed to prune historical blocks and states")
+			}
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// prune deletes the blocks and states before the retention period ending at the given slot.
+func (s *Service) prune(slot primitives.Slot) error {
+	epoch := slots.ToEpoch(slot)
+	if epoch <= s.retentionEpochs {
+		return nil
+	}
+	cutoff, err := slots.EpochStart(epoch - s.retentionEpochs)
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	n, err := s.db.PruneHistory(s.ctx, cutoff, s.batchSize)
+	blocksPrunedCounter.Add(float64(n))
+	if err != nil {
+		return errors.Wrapf(err, "could not prune history before slot %d", cutoff)
+	}
+	pruneLatency.Observe(time.Since(start).Seconds())
+	pruneCutoffSlot.Set(float64(cutoff))
+	if n == 0 {
+		return nil
+	}
+	if s.lowWaterMark != nil {
+		if err := s.lowWaterMark.Reload(s.ctx); err != nil {
+			return errors.Wrap(err, "could not reload lowest available block")
+		}
+	}
+	log.WithFields(logrus.Fields{
+		"cutoffSlot":    cutoff,
+		"blocksRemoved": n,
+		"duration":      time.Since(start).String(),
+	}).Info("Pruned historical blocks and states")
+	return nil
+}
@@ -0,0 +1,112 @@
+package pruner
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+type mockDB struct {
+	cutoffs  chan primitives.Slot
+	batch    int
+	deleted  int
+	pruneErr error
+}
+
+func (m *mockDB) PruneHistory(_ context.Context, cutoff primitives.Slot, batchSize int) (int, error) {
+	m.batch = batchSize
+	if m.cutoffs != nil {
+		m.cutoffs <- cutoff
+	}
+	return m.deleted, m.pruneErr
+}
+
+type mockReloader struct {
+	reloads int
+}
+
+func (m *mockReloader) Reload(_ context.Context) error {
+	m.reloads++
+	return nil
+}
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+	_, err := New(ctx, &mockDB{}, 10, nil)
+	require.ErrorIs(t, err, errNoClockWaiter)
+
+	cw := startup.NewClockSynchronizer()
+	_, err = New(ctx, &mockDB{}, 10, cw, WithBatchSize(0))
+	require.ErrorContains(t, "batch size must be positive", err)
+
+	s, err := New(ctx, &mockDB{}, 10, cw, WithBatchSize(8))
+	require.NoError(t, err)
+	require.Equal(t, 8, s.batchSize)
+}
+
+func TestService_prune(t *testing.T) {
+	ctx := context.Background()
+	db := &mockDB{}
+	r := &mockReloader{}
+	s, err := New(ctx, db, 2, startup.NewClockSynchronizer(), WithLowWaterMarkReloader(r))
+	require.NoError(t, err)
+	spe := params.BeaconConfig().SlotsPerEpoch
+
+	// Nothing is pruned until the chain is older than the retention period.
+	db.cutoffs = make(chan primitives.Slot, 1)
+	require.NoError(t, s.prune(2*spe))
+	require.Equal(t, 0, len(db.cutoffs))
+
+	require.NoError(t, s.prune(5*spe+3))
+	require.Equal(t, 3*spe, <-db.cutoffs)
+	require.Equal(t, defaultBatchSize, db.batch)
+	require.Equal(t, 0, r.reloads)
+
+	db.deleted = 10
+	require.NoError(t, s.prune(6*spe))
+	require.Equal(t, 4*spe, <-db.cutoffs)
+	require.Equal(t, 1, r.reloads)
+
+	db.pruneErr = errors.New("db error")
+	require.ErrorContains(t, "db error", s.prune(7*spe))
+	require.Equal(t, 1, r.reloads)
+}
+
+func TestService_StartStop(t *testing.T) {
+	ctx := context.Background()
+	db := &mockDB{cutoffs: make(chan primitives.Slot, 1)}
+	cw := startup.NewClockSynchronizer()
+	initSync := make(chan struct{})
+	s, err := New(ctx, db, 1, cw, WithInitSyncWaiter(func() error {
+		<-initSync
+		return nil
+	}))
+	require.NoError(t, err)
+	s.Start()
+
+	spe := params.BeaconConfig().SlotsPerEpoch
+	secondsPerEpoch := time.Duration(uint64(spe)*params.BeaconConfig().SecondsPerSlot) * time.Second
+	genesis := time.Now().Add(-3 * secondsPerEpoch)
+	require.NoError(t, cw.SetClock(startup.NewClock(genesis, [32]byte{})))
+
+	// Pruning waits for initial sync to complete.
+	select {
+	case <-db.cutoffs:
+		t.Fatal("pruned before initial sync completed")
+	case <-time.After(50 * time.Millisecond):
+	}
+	close(initSync)
+	select {
+	case cutoff := <-db.cutoffs:
+		require.Equal(t, 2*spe, cutoff)
+	case <-time.After(5 * time.Second):
+		t.Fatal("pruner did not run")
+	}
+	require.NoError(t, s.Stop())
+}
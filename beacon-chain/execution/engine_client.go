@@ -83,6 +83,8 @@ const (
 	ExchangeCapabilities = "engine_exchangeCapabilities"
 	// GetBlobsV1 request string for JSON-RPC.
 	GetBlobsV1 = "engine_getBlobsV1"
+	// GetClientVersionV1 request string for JSON-RPC.
+	GetClientVersionV1 = "engine_getClientVersionV1"
 	// Defines the seconds before timing out engine endpoints with non-block execution semantics.
 	defaultEngineTimeout = time.Second
 )
@@ -131,41 +133,34 @@ func (s *Service) NewPayload(ctx context.Context, payload interfaces.ExecutionDa
 		newPayloadLatency.Observe(float64(time.Since(start).Milliseconds()))
 	}()
 
-	d := time.Now().Add(time.Duration(params.BeaconConfig().ExecutionEngineTimeoutValue) * time.Second)
-	ctx, cancel := context.WithDeadline(ctx, d)
-	defer cancel()
 	result := &pb.PayloadStatus{}
-
+	var method string
+	var args []interface{}
 	switch payloadPb := payload.Proto().(type) {
 	case *pb.ExecutionPayload:
-		err := s.rpcClient.CallContext(ctx, result, NewPayloadMethod, payloadPb)
-		if err != nil {
-			return nil, handleRPCError(err)
-		}
+		method, args = NewPayloadMethod, []interface{}{payloadPb}
 	case *pb.ExecutionPayloadCapella:
-		err := s.rpcClient.CallContext(ctx, result, NewPayloadMethodV2, payloadPb)
-		if err != nil {
-			return nil, handleRPCError(err)
-		}
+		method, args = NewPayloadMethodV2, []interface{}{payloadPb}
 	case *pb.ExecutionPayloadDeneb:
 		if executionRequests == nil {
-			err := s.rpcClient.CallContext(ctx, result, NewPayloadMethodV3, payloadPb, versionedHashes, parentBlockRoot)
-			if err != nil {
-				return nil, handleRPCError(err)
-			}
+			method, args = NewPayloadMethodV3, []interface{}{payloadPb, versionedHashes, parentBlockRoot}
 		} else {
 			flattenedRequests, err := pb.EncodeExecutionRequests(executionRequests)
 			if err != nil {
 				return nil, errors.Wrap(err, "failed to encode execution requests")
 			}
-			err = s.rpcClient.CallContext(ctx, result, NewPayloadMethodV4, payloadPb, versionedHashes, parentBlockRoot, flattenedRequests)
-			if err != nil {
-				return nil, handleRPCError(err)
-			}
+			method, args = NewPayloadMethodV4, []interface{}{payloadPb, versionedHashes, parentBlockRoot, flattenedRequests}
 		}
 	default:
 		return nil, errors.New("unknown execution data type")
 	}
+	served, err := s.callEngine(ctx, engineTimeout(), result, method, args...)
+	if len(s.engines.others) > 0 {
+		go s.forwardNewPayload(served, result, err, payload.BlockHash(), method, args...)
+	}
+	if err != nil {
+		return nil, handleRPCError(err)
+	}
 	if result.ValidationError != "" {
 		log.WithError(errors.New(result.ValidationError)).Error("Got a validation error in newPayload")
 	}
@@ -194,45 +189,40 @@ func (s *Service) ForkchoiceUpdated(
 		forkchoiceUpdatedLatency.Observe(float64(time.Since(start).Milliseconds()))
 	}()
 
-	d := time.Now().Add(time.Duration(params.BeaconConfig().ExecutionEngineTimeoutValue) * time.Second)
-	ctx, cancel := context.WithDeadline(ctx, d)
-	defer cancel()
 	result := &ForkchoiceUpdatedResponse{}
 
 	if attrs == nil {
 		return nil, nil, errors.New("nil payload attributer")
 	}
+	var method string
+	var a interface{}
+	var err error
 	switch attrs.Version() {
 	case version.Bellatrix:
-		a, err := attrs.PbV1()
-		if err != nil {
-			return nil, nil, err
-		}
-		err = s.rpcClient.CallContext(ctx, result, ForkchoiceUpdatedMethod, state, a)
-		if err != nil {
-			return nil, nil, handleRPCError(err)
-		}
+		method = ForkchoiceUpdatedMethod
+		a, err = attrs.PbV1()
 	case version.Capella:
-		a, err := attrs.PbV2()
-		if err != nil {
-			return nil, nil, err
-		}
-		err = s.rpcClient.CallContext(ctx, result, ForkchoiceUpdatedMethodV2, state, a)
-		if err != nil {
-			return nil, nil, handleRPCError(err)
-		}
+		method = ForkchoiceUpdatedMethodV2
+		a, err = attrs.PbV2()
 	case version.Deneb, version.Electra, version.Fulu:
-		a, err := attrs.PbV3()
-		if err != nil {
-			return nil, nil, err
-		}
-		err = s.rpcClient.CallContext(ctx, result, ForkchoiceUpdatedMethodV3, state, a)
-		if err != nil {
-			return nil, nil, handleRPCError(err)
-		}
+		method = ForkchoiceUpdatedMethodV3
+		a, err = attrs.PbV3()
 	default:
 		return nil, nil, fmt.Errorf("unknown payload attribute version: %v", attrs.Version())
 	}
+	if err != nil {
+		return nil, nil, err
+	}
+	served, err := s.callEngine(ctx, engineTimeout(), result, method, state, a)
+	if len(s.engines.others) > 0 {
+		s.forwardForkchoiceUpdated(served, method, state)
+		if err == nil && result.PayloadId != nil {
+			s.engines.payloadBuilt(*result.PayloadId, served)
+		}
+	}
+	if err != nil {
+		return nil, nil, handleRPCError(err)
+	}
 
 	if result.Status == nil {
 		return nil, nil, ErrNilResponse
@@ -276,12 +266,14 @@ func (s *Service) GetPayload(ctx context.Context, payloadId [8]byte, slot primit
 	defer func() {
 		getPayloadLatency.Observe(float64(time.Since(start).Milliseconds()))
 	}()
-	d := time.Now().Add(defaultEngineTimeout)
-	ctx, cancel := context.WithDeadline(ctx, d)
-	defer cancel()
-
 	method, result := getPayloadMethodAndMessage(slot)
-	err := s.rpcClient.CallContext(ctx, result, method, pb.PayloadIDBytes(payloadId))
+	var err error
+	if idx, ok := s.engines.payloadBuilder(payloadId); ok {
+		// Only the engine which returned the payload ID knows the payload, so the request is not failed over.
+		err = callWithTimeout(ctx, s.allEngines()[idx].client, defaultEngineTimeout, result, method, pb.PayloadIDBytes(payloadId))
+	} else {
+		_, err = s.callEngine(ctx, defaultEngineTimeout, result, method, pb.PayloadIDBytes(payloadId))
+	}
 	if err != nil {
 		return nil, handleRPCError(err)
 	}
@@ -297,7 +289,7 @@ func (s *Service) ExchangeCapabilities(ctx context.Context) ([]string, error) {
 	defer span.End()
 
 	var result []string
-	err := s.rpcClient.CallContext(ctx, &result, ExchangeCapabilities, supportedEngineEndpoints)
+	err := s.engineClient().CallContext(ctx, &result, ExchangeCapabilities, supportedEngineEndpoints)
 	if err != nil {
 		return nil, handleRPCError(err)
 	}
@@ -321,6 +313,22 @@ func (s *Service) ExchangeCapabilities(ctx context.Context) ([]string, error) {
 	return result, handleRPCError(err)
 }
 
+// GetClientVersion calls the engine_getClientVersionV1 method via JSON-RPC, identifying this client
+// to the execution client and returning the client versions of the execution client.
+func (s *Service) GetClientVersion(ctx context.Context) ([]*version.ClientVersion, error) {
+	ctx, span := trace.StartSpan(ctx, "powchain.engine-api-client.GetClientVersion")
+	defer span.End()
+
+	var result []*version.ClientVersion
+	if _, err := s.callEngine(ctx, defaultEngineTimeout, &result, GetClientVersionV1, version.Client()); err != nil {
+		return nil, handleRPCError(err)
+	}
+	if len(result) == 0 {
+		return nil, errors.New("execution client returned no client version")
+	}
+	return result, nil
+}
+
 // GetTerminalBlockHash returns the valid terminal block hash based on total difficulty.
 //
 // Spec code:
@@ -411,7 +419,7 @@ func (s *Service) LatestExecutionBlock(ctx context.Context) (*pb.ExecutionBlock,
 	defer span.End()
 
 	result := &pb.ExecutionBlock{}
-	err := s.rpcClient.CallContext(
+	err := s.engineClient().CallContext(
 		ctx,
 		result,
 		BlockByNumberMethod,
@@ -427,7 +435,7 @@ func (s *Service) ExecutionBlockByHash(ctx context.Context, hash common.Hash, wi
 	ctx, span := trace.StartSpan(ctx, "powchain.engine-api-client.ExecutionBlockByHash")
 	defer span.End()
 	result := &pb.ExecutionBlock{}
-	err := s.rpcClient.CallContext(ctx, result, BlockByHashMethod, hash, withTxs)
+	err := s.engineClient().CallContext(ctx, result, BlockByHashMethod, hash, withTxs)
 	return result, handleRPCError(err)
 }
 
@@ -453,7 +461,7 @@ func (s *Service) ExecutionBlocksByHashes(ctx context.Context, hashes []common.H
 		})
 		execBlks = append(execBlks, blk)
 	}
-	ioErr := s.rpcClient.BatchCall(elems)
+	ioErr := s.engineClient().BatchCall(elems)
 	if ioErr != nil {
 		return nil, ioErr
 	}
@@ -468,7 +476,7 @@ func (s *Service) ExecutionBlocksByHashes(ctx context.Context, hashes []common.H
 // HeaderByHash returns the relevant header details for the provided block hash.
 func (s *Service) HeaderByHash(ctx context.Context, hash common.Hash) (*types.HeaderInfo, error) {
 	var hdr *types.HeaderInfo
-	err := s.rpcClient.CallContext(ctx, &hdr, BlockByHashMethod, hash, false /* no transactions */)
+	err := s.engineClient().CallContext(ctx, &hdr, BlockByHashMethod, hash, false /* no transactions */)
 	if err == nil && hdr == nil {
 		err = ethereum.NotFound
 	}
@@ -478,7 +486,7 @@ func (s *Service) HeaderByHash(ctx context.Context, hash common.Hash) (*types.He
 // HeaderByNumber returns the relevant header details for the provided block number.
 func (s *Service) HeaderByNumber(ctx context.Context, number *big.Int) (*types.HeaderInfo, error) {
 	var hdr *types.HeaderInfo
-	err := s.rpcClient.CallContext(ctx, &hdr, BlockByNumberMethod, toBlockNumArg(number), false /* no transactions */)
+	err := s.engineClient().CallContext(ctx, &hdr, BlockByNumberMethod, toBlockNumArg(number), false /* no transactions */)
 	if err == nil && hdr == nil {
 		err = ethereum.NotFound
 	}
@@ -495,7 +503,7 @@ func (s *Service) GetBlobs(ctx context.Context, versionedHashes []common.Hash) (
 	}
 
 	result := make([]*pb.BlobAndProof, len(versionedHashes))
-	err := s.rpcClient.CallContext(ctx, &result, GetBlobsV1, versionedHashes)
+	err := s.engineClient().CallContext(ctx, &result, GetBlobsV1, versionedHashes)
 	return result, handleRPCError(err)
 }
 
@@ -519,7 +527,7 @@ func (s *Service) ReconstructFullBlock(
 func (s *Service) ReconstructFullBellatrixBlockBatch(
 	ctx context.Context, blindedBlocks []interfaces.ReadOnlySignedBeaconBlock,
 ) ([]interfaces.SignedBeaconBlock, error) {
-	unb, err := reconstructBlindedBlockBatch(ctx, s.rpcClient, blindedBlocks)
+	unb, err := reconstructBlindedBlockBatch(ctx, s.engineClient(), blindedBlocks)
 	if err != nil {
 		return nil, err
 	}
@@ -12,6 +12,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
@@ -2395,6 +2396,94 @@ func Test_ExchangeCapabilities(t *testing.T) {
 	})
 }
 
+func TestGetClientVersion(t *testing.T) {
+	var calls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		defer func() {
+			require.NoError(t, r.Body.Close())
+		}()
+		calls++
+		enc, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		jsonRequestString := string(enc)
+		require.Equal(t, true, strings.Contains(jsonRequestString, GetClientVersionV1))
+		require.Equal(t, true, strings.Contains(jsonRequestString, `"code":"PM"`))
+
+		resp := map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      1,
+			"result": []map[string]string{
+				{"code": "GE", "name": "Geth", "version": "v1.14.11", "commit": "0xf8d0a1b2"},
+			},
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	defer srv.Close()
+
+	rpcClient, err := rpc.DialHTTP(srv.URL)
+	require.NoError(t, err)
+	defer rpcClient.Close()
+	service := &Service{rpcClient: rpcClient}
+
+	versions, err := service.GetClientVersion(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, len(versions))
+	require.DeepEqual(t, &version.ClientVersion{Code: "GE", Name: "Geth", Version: "v1.14.11", Commit: "0xf8d0a1b2"}, versions[0])
+
+	// The version is only returned once it was requested by the service.
+	_, err = service.ExecutionClientVersion(context.Background())
+	require.ErrorContains(t, "execution client version is not known yet", err)
+	service.updateClientVersion(context.Background())
+	cv, err := service.ExecutionClientVersion(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "GE", cv.Code)
+	require.Equal(t, 2, calls)
+
+	// The version is cached until the connection to the execution client changes.
+	service.updateClientVersion(context.Background())
+	require.Equal(t, 2, calls)
+	service.clientVersionCache.reset()
+	service.updateClientVersion(context.Background())
+	require.Equal(t, 3, calls)
+}
+
+func TestExecutionClientVersion_CachesFailure(t *testing.T) {
+	var calls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		defer func() {
+			require.NoError(t, r.Body.Close())
+		}()
+		calls++
+		resp := map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      1,
+			"error":   map[string]interface{}{"code": -32601, "message": "the method engine_getClientVersionV1 does not exist"},
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	defer srv.Close()
+
+	rpcClient, err := rpc.DialHTTP(srv.URL)
+	require.NoError(t, err)
+	defer rpcClient.Close()
+	service := &Service{rpcClient: rpcClient}
+
+	// The failure is returned without asking the execution client again.
+	service.updateClientVersion(context.Background())
+	_, err = service.ExecutionClientVersion(context.Background())
+	require.ErrorContains(t, "could not get execution client version", err)
+	service.updateClientVersion(context.Background())
+	require.Equal(t, 1, calls)
+
+	service.clientVersionCache.updatedAt = time.Now().Add(-clientVersionErrCacheTTL - time.Second)
+	service.updateClientVersion(context.Background())
+	_, err = service.ExecutionClientVersion(context.Background())
+	require.ErrorContains(t, "could not get execution client version", err)
+	require.Equal(t, 2, calls)
+}
+
 func TestReconstructBlobSidecars(t *testing.T) {
 	client := &Service{capabilityCache: &capabilityCache{}}
 	b := util.NewBeaconBlockDeneb()
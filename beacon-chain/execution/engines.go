@@ -0,0 +1,348 @@
+package execution
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/io/logs"
+	"github.com/prysmaticlabs/prysm/v5/network"
+	pb "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	"github.com/sirupsen/logrus"
+)
+
+// EngineMode defines how engine API requests are dispatched when several execution engines are configured.
+type EngineMode string
+
+const (
+	// EngineModeFailover sends requests to the active engine, which is the primary one unless it failed, and
+	// switches to the next engine when a request fails or times out.
+	EngineModeFailover EngineMode = "failover"
+	// EngineModeComparison works like EngineModeFailover, and additionally compares the status returned by every
+	// engine for each payload, reporting when some engines consider a payload valid and others invalid.
+	EngineModeComparison EngineMode = "comparison"
+)
+
+// failbackPeriod is the time after a failure of the primary engine before requests are sent to it again.
+var failbackPeriod = time.Minute
+
+// payloadBuildersSize is the number of payload IDs for which the engine which built the payload is remembered.
+const payloadBuildersSize = 16
+
+var errUnknownEngineMode = errors.New("unknown execution engine mode")
+
+// engine is an execution engine connection.
+type engine struct {
+	name   string // the endpoint url, without credentials, used in logs and metrics.
+	client RPCClient
+}
+
+// engineSet holds the execution engines configured in addition to the primary one, which is the connection
+// of the service, and tracks which engine serves requests.
+type engineSet struct {
+	mode            EngineMode
+	endpoints       []network.Endpoint
+	others          []*engine
+	lock            sync.Mutex
+	active          int // index of the engine serving requests, 0 being the primary.
+	primaryFailedAt time.Time
+	builders        [payloadBuildersSize]payloadBuilder // engines which returned the latest payload IDs.
+	nextBuilder     int
+	forwarding      map[int]bool // engines with a forwarded request still in flight.
+}
+
+// payloadBuilder is the index of the engine which returned a payload ID, only this engine can return the payload.
+type payloadBuilder struct {
+	id  pb.PayloadIDBytes
+	idx int
+	set bool
+}
+
+// ParseEngineMode returns the engine mode with the given name.
+func ParseEngineMode(mode string) (EngineMode, error) {
+	switch m := EngineMode(mode); m {
+	case EngineModeFailover, EngineModeComparison:
+		return m, nil
+	default:
+		return "", errors.Wrapf(errUnknownEngineMode, "%q", mode)
+	}
+}
+
+// connectAdditionalEngines creates the clients of the additional engines. Connections over http are only
+// established when a request is sent.
+func (s *Service) connectAdditionalEngines(ctx context.Context) {
+	for _, endpoint := range s.engines.endpoints {
+		name := logs.MaskCredentialsLogging(endpoint.Url)
+		client, err := s.newRPCClientWithAuth(ctx, endpoint)
+		if err != nil {
+			log.WithError(err).WithField("endpoint", name).Error("Could not connect to additional execution endpoint")
+			continue
+		}
+		s.engines.others = append(s.engines.others, &engine{name: name, client: client})
+	}
+	if len(s.engines.others) > 0 {
+		log.WithFields(logrus.Fields{
+			"mode":    s.engines.mode,
+			"engines": len(s.engines.others) + 1,
+		}).Info("Using multiple execution engines")
+	}
+}
+
+// allEngines returns the primary engine followed by the additional ones.
+func (s *Service) allEngines() []*engine {
+	primary := &engine{name: logs.MaskCredentialsLogging(s.cfg.currHttpEndpoint.Url), client: s.rpcClient}
+	return append([]*engine{primary}, s.engines.others...)
+}
+
+// callEngine sends an engine API request with the given timeout to the active engine and returns the index of
+// the engine which answered. A request that fails without a JSON-RPC error response, like on a timeout or when
+// the engine cannot be reached, is sent to the next engine, which becomes the active one.
+func (s *Service) callEngine(ctx context.Context, timeout time.Duration, result interface{}, method string, args ...interface{}) (int, error) {
+	return s.dispatch(ctx, method, func(client RPCClient) error {
+		return callWithTimeout(ctx, client, timeout, result, method, args...)
+	})
+}
+
+// dispatch runs call against the active engine, and against the next engines while it fails without a
+// JSON-RPC error response. It returns the index of the engine which answered.
+func (s *Service) dispatch(ctx context.Context, method string, call func(RPCClient) error) (int, error) {
+	if len(s.engines.others) == 0 {
+		return 0, call(s.rpcClient)
+	}
+	all := s.allEngines()
+	first := s.engines.first()
+	var err error
+	for i := range all {
+		idx := (first + i) % len(all)
+		err = call(all[idx].client)
+		if err == nil || !isEngineFailure(err) {
+			s.engines.served(idx, all)
+			return idx, err
+		}
+		if ctx.Err() != nil {
+			return idx, err
+		}
+		s.engines.failed(idx)
+		engineFailuresCount.WithLabelValues(all[idx].name).Inc()
+		log.WithError(err).WithFields(logrus.Fields{
+			"engine": all[idx].name,
+			"method": method,
+		}).Warn("Execution engine request failed")
+	}
+	return first, err
+}
+
+// engineClient returns a client sending its requests to the execution engines the way callEngine does, for the
+// requests made without a timeout of their own and for batch requests.
+func (s *Service) engineClient() RPCClient {
+	return failoverClient{s: s}
+}
+
+type failoverClient struct {
+	s *Service
+}
+
+// Close does nothing, the connections are closed by the service.
+func (failoverClient) Close() {}
+
+// CallContext sends the request to the active engine, failing over to the next ones.
+func (c failoverClient) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	_, err := c.s.dispatch(ctx, method, func(client RPCClient) error {
+		return client.CallContext(ctx, result, method, args...)
+	})
+	return err
+}
+
+// BatchCall sends the batch to the active engine, failing over to the next ones when the batch cannot be sent
+// or its response cannot be read. Errors of the individual requests are set in the batch elements.
+func (c failoverClient) BatchCall(b []gethRPC.BatchElem) error {
+	method := "batch"
+	if len(b) > 0 {
+		method = b[0].Method
+	}
+	_, err := c.s.dispatch(c.s.ctx, method, func(client RPCClient) error {
+		return client.BatchCall(b)
+	})
+	return err
+}
+
+// forwardNewPayload sends a payload to the engines other than the one which served the request, so that they
+// stay in sync. Engines still processing a previously forwarded request are skipped. In comparison mode, the
+// statuses returned by the engines are compared.
+func (s *Service) forwardNewPayload(served int, status *pb.PayloadStatus, servedErr error, blockHash []byte, method string, args ...interface{}) {
+	all := s.allEngines()
+	statuses := make([]string, len(all))
+	statuses[served] = payloadStatusName(status, servedErr)
+	var wg sync.WaitGroup
+	for i := range all {
+		if i == served || !s.engines.startForward(i, all) {
+			continue
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer s.engines.endForward(i)
+			result := &pb.PayloadStatus{}
+			err := callWithTimeout(s.ctx, all[i].client, engineTimeout(), result, method, args...)
+			if err != nil {
+				log.WithError(err).WithField("engine", all[i].name).Debug("Could not forward payload to execution engine")
+			}
+			statuses[i] = payloadStatusName(result, err)
+		}(i)
+	}
+	wg.Wait()
+	if s.engines.mode != EngineModeComparison {
+		return
+	}
+	byEngine := make(map[string]string, len(all))
+	var valid, invalid bool
+	for i, e := range all {
+		if statuses[i] == "" {
+			// The payload was not forwarded to the engine.
+			continue
+		}
+		newPayloadStatusCount.WithLabelValues(e.name, statuses[i]).Inc()
+		byEngine[e.name] = statuses[i]
+		switch statuses[i] {
+		case pb.PayloadStatus_VALID.String():
+			valid = true
+		case pb.PayloadStatus_INVALID.String(), pb.PayloadStatus_INVALID_BLOCK_HASH.String():
+			invalid = true
+		}
+	}
+	if valid && invalid {
+		payloadStatusDisagreementCount.Inc()
+		log.WithFields(logrus.Fields{
+			"blockHash": fmt.Sprintf("%#x", blockHash),
+			"statuses":  byEngine,
+		}).Error("Execution engines disagree on the validity of a payload")
+	}
+}
+
+// forwardForkchoiceUpdated sends a forkchoice update, without payload attributes, to the engines other than
+// the one which served the request, so that they follow the same head. Engines still processing a previously
+// forwarded request are skipped, the next update brings them to the latest head.
+func (s *Service) forwardForkchoiceUpdated(served int, method string, state *pb.ForkchoiceState) {
+	all := s.allEngines()
+	for i := range all {
+		if i == served || !s.engines.startForward(i, all) {
+			continue
+		}
+		go func(i int, e *engine) {
+			defer s.engines.endForward(i)
+			result := &ForkchoiceUpdatedResponse{}
+			if err := callWithTimeout(s.ctx, e.client, engineTimeout(), result, method, state, nil); err != nil {
+				log.WithError(err).WithField("engine", e.name).Debug("Could not forward forkchoice update to execution engine")
+			}
+		}(i, all[i])
+	}
+}
+
+// first returns the index of the engine a request is sent to first. This is the primary engine, unless it
+// failed within the failback period.
+func (e *engineSet) first() int {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if e.active != 0 && time.Since(e.primaryFailedAt) >= failbackPeriod {
+		return 0
+	}
+	return e.active
+}
+
+func (e *engineSet) failed(idx int) {
+	if idx != 0 {
+		return
+	}
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.primaryFailedAt = time.Now()
+}
+
+func (e *engineSet) served(idx int, all []*engine) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if idx == e.active {
+		return
+	}
+	log.WithFields(logrus.Fields{
+		"previous": all[e.active].name,
+		"engine":   all[idx].name,
+	}).Warn("Switched execution engine")
+	e.active = idx
+	activeEngineGauge.Set(float64(idx))
+}
+
+// startForward returns whether a request can be forwarded to the engine, which is the case when no request
+// previously forwarded to it is in flight. endForward must be called once the forwarded request is done.
+func (e *engineSet) startForward(idx int, all []*engine) bool {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if e.forwarding[idx] {
+		log.WithField("engine", all[idx].name).Debug("Execution engine is still processing a forwarded request, skipping it")
+		return false
+	}
+	if e.forwarding == nil {
+		e.forwarding = make(map[int]bool)
+	}
+	e.forwarding[idx] = true
+	return true
+}
+
+func (e *engineSet) endForward(idx int) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	delete(e.forwarding, idx)
+}
+
+// payloadBuilt records the engine which returned the payload ID.
+func (e *engineSet) payloadBuilt(id pb.PayloadIDBytes, idx int) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.builders[e.nextBuilder] = payloadBuilder{id: id, idx: idx, set: true}
+	e.nextBuilder = (e.nextBuilder + 1) % len(e.builders)
+}
+
+// payloadBuilder returns the index of the engine which returned the payload ID, if it is known.
+func (e *engineSet) payloadBuilder(id pb.PayloadIDBytes) (int, bool) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	for _, b := range e.builders {
+		if b.set && b.id == id {
+			return b.idx, true
+		}
+	}
+	return 0, false
+}
+
+func (e *engineSet) close() {
+	for _, o := range e.others {
+		o.client.Close()
+	}
+}
+
+func callWithTimeout(ctx context.Context, client RPCClient, timeout time.Duration, result interface{}, method string, args ...interface{}) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	return client.CallContext(ctx, result, method, args...)
+}
+
+// isEngineFailure returns whether a request failed without a JSON-RPC error response from the engine.
+func isEngineFailure(err error) bool {
+	var e gethRPC.Error
+	return !errors.As(err, &e)
+}
+
+func engineTimeout() time.Duration {
+	return time.Duration(params.BeaconConfig().ExecutionEngineTimeoutValue) * time.Second
+}
+
+func payloadStatusName(status *pb.PayloadStatus, err error) string {
+	if err != nil {
+		return "ERROR"
+	}
+	return status.Status.String()
+}
@@ -0,0 +1,254 @@
+package execution
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	payloadattribute "github.com/prysmaticlabs/prysm/v5/consensus-types/payload-attribute"
+	"github.com/prysmaticlabs/prysm/v5/network"
+	pb "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+// engineStub is an execution engine returning the same payload status, or error, to every request.
+type engineStub struct {
+	calls           atomic.Int32
+	getPayloadCalls atomic.Int32
+	status          pb.PayloadStatus_Status
+	payloadID       *pb.PayloadIDBytes
+	err             error
+	block           chan struct{} // requests wait for the channel to be closed, when set.
+}
+
+func (*engineStub) Close() {}
+
+func (e *engineStub) BatchCall([]rpc.BatchElem) error {
+	e.calls.Add(1)
+	return e.err
+}
+
+func (e *engineStub) CallContext(_ context.Context, result interface{}, method string, _ ...interface{}) error {
+	e.calls.Add(1)
+	if strings.HasPrefix(method, GetPayloadMethod) {
+		e.getPayloadCalls.Add(1)
+	}
+	if e.block != nil {
+		<-e.block
+	}
+	if e.err != nil {
+		return e.err
+	}
+	switch r := result.(type) {
+	case *pb.PayloadStatus:
+		r.Status = e.status
+	case *ForkchoiceUpdatedResponse:
+		r.Status = &pb.PayloadStatus{Status: e.status}
+		r.PayloadId = e.payloadID
+	}
+	return nil
+}
+
+func counterValue(t *testing.T, c prometheus.Counter) float64 {
+	m := &dto.Metric{}
+	require.NoError(t, c.Write(m))
+	return m.GetCounter().GetValue()
+}
+
+func multiEngineService(mode EngineMode, primary *engineStub, others ...*engineStub) *Service {
+	s := &Service{
+		ctx:       context.Background(),
+		rpcClient: primary,
+		cfg:       &config{currHttpEndpoint: network.HttpEndpoint("http://primary:8551")},
+		engines:   engineSet{mode: mode},
+	}
+	for i, o := range others {
+		s.engines.others = append(s.engines.others, &engine{name: fmt.Sprintf("http://other-%d:8551", i), client: o})
+	}
+	return s
+}
+
+func TestCallEngine_Failover(t *testing.T) {
+	primary := &engineStub{err: errors.New("connection refused")}
+	fallback := &engineStub{status: pb.PayloadStatus_VALID}
+	s := multiEngineService(EngineModeFailover, primary, fallback)
+	ctx := context.Background()
+
+	result := &pb.PayloadStatus{}
+	served, err := s.callEngine(ctx, engineTimeout(), result, NewPayloadMethod)
+	require.NoError(t, err)
+	require.Equal(t, 1, served)
+	require.Equal(t, pb.PayloadStatus_VALID, result.Status)
+	require.Equal(t, int32(1), primary.calls.Load())
+
+	// The fallback engine stays active until the failback period is over.
+	served, err = s.callEngine(ctx, engineTimeout(), result, NewPayloadMethod)
+	require.NoError(t, err)
+	require.Equal(t, 1, served)
+	require.Equal(t, int32(1), primary.calls.Load())
+
+	// The primary engine is tried again after the failback period.
+	defer func(p time.Duration) { failbackPeriod = p }(failbackPeriod)
+	failbackPeriod = 0
+	primary.err = nil
+	primary.status = pb.PayloadStatus_SYNCING
+	served, err = s.callEngine(ctx, engineTimeout(), result, NewPayloadMethod)
+	require.NoError(t, err)
+	require.Equal(t, 0, served)
+	require.Equal(t, pb.PayloadStatus_SYNCING, result.Status)
+	require.Equal(t, 0, s.engines.first())
+}
+
+func TestCallEngine_RPCErrorDoesNotFailover(t *testing.T) {
+	primary := &engineStub{err: &customError{code: -32602}}
+	fallback := &engineStub{status: pb.PayloadStatus_VALID}
+	s := multiEngineService(EngineModeFailover, primary, fallback)
+
+	served, err := s.callEngine(context.Background(), engineTimeout(), &pb.PayloadStatus{}, NewPayloadMethod)
+	require.ErrorIs(t, handleRPCError(err), ErrInvalidParams)
+	require.Equal(t, 0, served)
+	require.Equal(t, int32(0), fallback.calls.Load())
+}
+
+func TestCallEngine_AllEnginesFail(t *testing.T) {
+	primary := &engineStub{err: errors.New("connection refused")}
+	fallback := &engineStub{err: errors.New("connection reset")}
+	s := multiEngineService(EngineModeFailover, primary, fallback)
+
+	_, err := s.callEngine(context.Background(), engineTimeout(), &pb.PayloadStatus{}, NewPayloadMethod)
+	require.ErrorContains(t, "connection reset", err)
+	require.Equal(t, int32(1), primary.calls.Load())
+	require.Equal(t, int32(1), fallback.calls.Load())
+}
+
+func TestEngineClient_Failover(t *testing.T) {
+	ctx := context.Background()
+	t.Run("requests", func(t *testing.T) {
+		primary := &engineStub{err: errors.New("connection refused")}
+		fallback := &engineStub{}
+		s := multiEngineService(EngineModeFailover, primary, fallback)
+
+		_, err := s.ExecutionBlockByHash(ctx, common.Hash{}, false)
+		require.NoError(t, err)
+		require.Equal(t, int32(1), primary.calls.Load())
+		require.Equal(t, int32(1), fallback.calls.Load())
+		require.Equal(t, 1, s.engines.first())
+	})
+	t.Run("batch requests", func(t *testing.T) {
+		primary := &engineStub{err: errors.New("connection refused")}
+		fallback := &engineStub{}
+		s := multiEngineService(EngineModeFailover, primary, fallback)
+
+		_, err := s.ExecutionBlocksByHashes(ctx, []common.Hash{{'a'}, {'b'}}, false)
+		require.NoError(t, err)
+		require.Equal(t, int32(1), primary.calls.Load())
+		require.Equal(t, int32(1), fallback.calls.Load())
+		require.Equal(t, 1, s.engines.first())
+	})
+}
+
+func TestForwardNewPayload(t *testing.T) {
+	payload, err := blocks.WrappedExecutionPayload(fixturesStruct().ExecutionPayload)
+	require.NoError(t, err)
+
+	t.Run("comparison mode reports disagreements", func(t *testing.T) {
+		hook := logTest.NewGlobal()
+		primary := &engineStub{status: pb.PayloadStatus_VALID}
+		agreeing := &engineStub{status: pb.PayloadStatus_VALID}
+		disagreeing := &engineStub{status: pb.PayloadStatus_INVALID}
+		s := multiEngineService(EngineModeComparison, primary, agreeing, disagreeing)
+		before := counterValue(t, payloadStatusDisagreementCount)
+
+		s.forwardNewPayload(0, &pb.PayloadStatus{Status: pb.PayloadStatus_VALID}, nil, payload.BlockHash(), NewPayloadMethod)
+		require.Equal(t, int32(0), primary.calls.Load())
+		require.Equal(t, int32(1), agreeing.calls.Load())
+		require.Equal(t, int32(1), disagreeing.calls.Load())
+		require.Equal(t, before+1, counterValue(t, payloadStatusDisagreementCount))
+		require.LogsContain(t, hook, "Execution engines disagree on the validity of a payload")
+	})
+	t.Run("syncing engines do not disagree", func(t *testing.T) {
+		hook := logTest.NewGlobal()
+		syncing := &engineStub{status: pb.PayloadStatus_SYNCING}
+		failing := &engineStub{err: errors.New("connection refused")}
+		s := multiEngineService(EngineModeComparison, &engineStub{}, syncing, failing)
+
+		s.forwardNewPayload(0, &pb.PayloadStatus{Status: pb.PayloadStatus_INVALID}, nil, payload.BlockHash(), NewPayloadMethod)
+		require.LogsDoNotContain(t, hook, "disagree")
+	})
+	t.Run("failover mode only forwards", func(t *testing.T) {
+		hook := logTest.NewGlobal()
+		other := &engineStub{status: pb.PayloadStatus_INVALID}
+		s := multiEngineService(EngineModeFailover, &engineStub{}, other)
+
+		s.forwardNewPayload(0, &pb.PayloadStatus{Status: pb.PayloadStatus_VALID}, nil, payload.BlockHash(), NewPayloadMethod)
+		require.Equal(t, int32(1), other.calls.Load())
+		require.LogsDoNotContain(t, hook, "disagree")
+	})
+}
+
+func TestGetPayload_SentToPayloadBuilder(t *testing.T) {
+	ctx := context.Background()
+	primary := &engineStub{err: errors.New("connection refused")}
+	fallback := &engineStub{status: pb.PayloadStatus_VALID, payloadID: &pb.PayloadIDBytes{1}}
+	s := multiEngineService(EngineModeFailover, primary, fallback)
+
+	attrs, err := payloadattribute.New(&pb.PayloadAttributes{})
+	require.NoError(t, err)
+	id, _, err := s.ForkchoiceUpdated(ctx, &pb.ForkchoiceState{}, attrs)
+	require.NoError(t, err)
+	require.DeepEqual(t, fallback.payloadID, id)
+
+	// The primary engine is back, but only the fallback engine knows the payload.
+	defer func(p time.Duration) { failbackPeriod = p }(failbackPeriod)
+	failbackPeriod = 0
+	primary.err = nil
+	require.Equal(t, 0, s.engines.first())
+	_, err = s.GetPayload(ctx, *id, 0)
+	require.NoError(t, err)
+	require.Equal(t, int32(0), primary.getPayloadCalls.Load())
+	require.Equal(t, int32(1), fallback.getPayloadCalls.Load())
+
+	// Payloads built by an unknown engine are requested from the active engine.
+	_, err = s.GetPayload(ctx, [8]byte{2}, 0)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), primary.getPayloadCalls.Load())
+}
+
+func TestForward_SkipsEnginesWithRequestInFlight(t *testing.T) {
+	other := &engineStub{block: make(chan struct{})}
+	s := multiEngineService(EngineModeFailover, &engineStub{}, other)
+
+	s.forwardForkchoiceUpdated(0, ForkchoiceUpdatedMethod, &pb.ForkchoiceState{})
+	s.forwardForkchoiceUpdated(0, ForkchoiceUpdatedMethod, &pb.ForkchoiceState{})
+	close(other.block)
+	for !s.engines.startForward(1, s.allEngines()) {
+		time.Sleep(time.Millisecond)
+	}
+	s.engines.endForward(1)
+	require.Equal(t, int32(1), other.calls.Load())
+
+	// Requests are forwarded again once the previous one is done.
+	s.forwardForkchoiceUpdated(0, ForkchoiceUpdatedMethod, &pb.ForkchoiceState{})
+	for !s.engines.startForward(1, s.allEngines()) {
+		time.Sleep(time.Millisecond)
+	}
+	require.Equal(t, int32(2), other.calls.Load())
+}
+
+func TestParseEngineMode(t *testing.T) {
+	mode, err := ParseEngineMode("comparison")
+	require.NoError(t, err)
+	require.Equal(t, EngineModeComparison, mode)
+	_, err = ParseEngineMode("roundrobin")
+	require.ErrorIs(t, err, errUnknownEngineMode)
+}
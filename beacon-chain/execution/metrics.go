@@ -71,4 +71,20 @@ var (
 		Name: "execution_payload_bodies_count",
 		Help: "The number of requested payload bodies is too large",
 	})
+	engineFailuresCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "execution_engine_request_failures_count",
+		Help: "The number of engine API requests that failed or timed out, by execution engine",
+	}, []string{"engine"})
+	activeEngineGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "execution_engine_active_index",
+		Help: "The index of the execution engine serving engine API requests, 0 being the primary one",
+	})
+	newPayloadStatusCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "execution_engine_new_payload_status_count",
+		Help: "The number of payload statuses returned by each execution engine in comparison mode",
+	}, []string{"engine", "status"})
+	payloadStatusDisagreementCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "execution_engine_payload_status_disagreement_count",
+		Help: "The number of payloads that some execution engines consider valid and others invalid",
+	})
 )
@@ -38,6 +38,31 @@ func WithHttpEndpointAndJWTSecret(endpointString string, secret []byte) Option {
 	}
 }
 
+// WithAdditionalEngineEndpoint adds an execution engine endpoint, used along with the primary one as defined by
+// the engine mode. The secret is used for JWT authentication when it is not empty.
+func WithAdditionalEngineEndpoint(endpointString string, secret []byte) Option {
+	return func(s *Service) error {
+		endpoint := network.HttpEndpoint(endpointString)
+		if len(secret) > 0 {
+			endpoint.Auth.Method = authorization.Bearer
+			endpoint.Auth.Value = string(secret)
+		}
+		s.engines.endpoints = append(s.engines.endpoints, endpoint)
+		return nil
+	}
+}
+
+// WithEngineMode sets how engine API requests are dispatched when additional execution engines are configured.
+func WithEngineMode(mode EngineMode) Option {
+	return func(s *Service) error {
+		if _, err := ParseEngineMode(string(mode)); err != nil {
+			return err
+		}
+		s.engines.mode = mode
+		return nil
+	}
+}
+
 // WithHeaders adds headers to the execution node JSON-RPC requests.
 func WithHeaders(headers []string) Option {
 	return func(s *Service) error {
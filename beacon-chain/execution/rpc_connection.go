@@ -84,6 +84,8 @@ func (s *Service) pollConnectionStatus(ctx context.Context) {
 				errorLogger(err, "Could not exchange capabilities with execution client")
 			}
 			s.capabilityCache.save(c)
+			s.clientVersionCache.reset()
+			s.updateClientVersion(ctx)
 
 			return
 		case <-s.ctx.Done():
@@ -38,6 +38,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/monitoring/clientstats"
 	"github.com/prysmaticlabs/prysm/v5/network"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 	"github.com/sirupsen/logrus"
@@ -81,6 +82,7 @@ type ChainInfoFetcher interface {
 	ExecutionClientConnected() bool
 	ExecutionClientEndpoint() string
 	ExecutionClientConnectionErr() error
+	ExecutionClientVersion(ctx context.Context) (*version.ClientVersion, error)
 }
 
 // POWBlockFetcher defines a struct that can retrieve mainchain blocks.
@@ -160,6 +162,8 @@ type Service struct {
 	verifierWaiter          *verification.InitializerWaiter
 	blobVerifier            verification.NewBlobVerifier
 	capabilityCache         *capabilityCache
+	clientVersionCache      clientVersionCache
+	engines                 engineSet
 }
 
 // NewService sets up a new instance with an ethclient when given a web3 endpoint as a string in the config.
@@ -198,6 +202,7 @@ func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 		preGenesisState:         genState,
 		eth1HeadTicker:          time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerETH1Block) * time.Second),
 		capabilityCache:         &capabilityCache{},
+		engines:                 engineSet{mode: EngineModeFailover},
 	}
 
 	for _, opt := range opts {
@@ -221,6 +226,7 @@ func (s *Service) Start() {
 	if err := s.setupExecutionClientConnections(s.ctx, s.cfg.currHttpEndpoint); err != nil {
 		log.WithError(err).Error("Could not connect to execution endpoint")
 	}
+	s.connectAdditionalEngines(s.ctx)
 	// If the chain has not started already and we don't have access to eth1 nodes, we will not be
 	// able to generate the genesis state.
 	if !s.chainStartData.Chainstarted && s.cfg.currHttpEndpoint.Url == "" {
@@ -258,6 +264,7 @@ func (s *Service) Stop() error {
 	if s.rpcClient != nil {
 		s.rpcClient.Close()
 	}
+	s.engines.close()
 	return nil
 }
 
@@ -303,6 +310,44 @@ func (s *Service) ExecutionClientConnectionErr() error {
 	return s.runError
 }
 
+// ExecutionClientVersion returns the cached client version of the connected execution client. The version is
+// requested off the block production path by updateClientVersion.
+func (s *Service) ExecutionClientVersion(_ context.Context) (*version.ClientVersion, error) {
+	cv, _, err := s.clientVersionCache.get()
+	if err != nil {
+		return nil, err
+	}
+	if cv == nil {
+		return nil, errors.New("execution client version is not known yet")
+	}
+	return cv, nil
+}
+
+// updateClientVersion requests the client version of the connected execution client once the cached one is
+// older than clientVersionCacheTTL, to notice upgrades. A failure is cached for clientVersionErrCacheTTL, as
+// execution clients may not support the method at all.
+func (s *Service) updateClientVersion(ctx context.Context) {
+	if _, ok, _ := s.clientVersionCache.get(); ok {
+		return
+	}
+	versions, err := s.GetClientVersion(ctx)
+	if err == nil && len(versions) == 0 {
+		err = errors.New("empty client version list")
+	}
+	if err != nil {
+		err = errors.Wrap(err, "could not get execution client version")
+		log.WithError(err).Debug("Could not update execution client version")
+		// The execution client is asked again at the next update when the caller gave up on the request.
+		if ctx.Err() == nil {
+			s.clientVersionCache.save(nil, err)
+		}
+		return
+	}
+	// A multiplexer in front of several execution clients returns one version per client,
+	// the first one is used.
+	s.clientVersionCache.save(versions[0], nil)
+}
+
 func (s *Service) updateBeaconNodeStats() {
 	bs := clientstats.BeaconNodeStats{}
 	if s.ExecutionClientConnected() {
@@ -430,7 +475,7 @@ func (s *Service) batchRequestHeaders(startBlock, endBlock uint64) ([]*types.Hea
 		})
 		headers = append(headers, header)
 	}
-	ioErr := s.rpcClient.BatchCall(elems)
+	ioErr := s.engineClient().BatchCall(elems)
 	if ioErr != nil {
 		return nil, ioErr
 	}
@@ -610,6 +655,7 @@ func (s *Service) run(done <-chan struct{}) {
 			}
 			s.processBlockHeader(head)
 			s.handleETH1FollowDistance()
+			s.updateClientVersion(s.ctx)
 		case <-chainstartTicker.C:
 			if s.chainStartData.Chainstarted {
 				chainstartTicker.Stop()
@@ -935,3 +981,52 @@ func (c *capabilityCache) has(capability string) bool {
 	_, ok := c.capabilities[capability]
 	return ok
 }
+
+const (
+	// clientVersionCacheTTL is the duration after which the execution client version is requested again.
+	clientVersionCacheTTL = time.Hour
+	// clientVersionErrCacheTTL is the duration after which the execution client version is requested again
+	// when the previous request failed.
+	clientVersionErrCacheTTL = 5 * time.Minute
+)
+
+type clientVersionCache struct {
+	clientVersion *version.ClientVersion
+	err           error
+	updatedAt     time.Time
+	versionLock   sync.RWMutex
+}
+
+// save caches the version of the execution client, or the error returned when requesting it.
+func (c *clientVersionCache) save(cv *version.ClientVersion, err error) {
+	c.versionLock.Lock()
+	defer c.versionLock.Unlock()
+
+	c.clientVersion = cv
+	c.err = err
+	c.updatedAt = time.Now()
+}
+
+// reset drops the cached version, e.g. after connecting to another execution client.
+func (c *clientVersionCache) reset() {
+	c.versionLock.Lock()
+	defer c.versionLock.Unlock()
+
+	c.clientVersion = nil
+	c.err = nil
+}
+
+// get returns the cached version or error, ok being false when the version must be requested again.
+func (c *clientVersionCache) get() (cv *version.ClientVersion, ok bool, err error) {
+	c.versionLock.RLock()
+	defer c.versionLock.RUnlock()
+
+	switch {
+	case c.err != nil:
+		return nil, time.Since(c.updatedAt) <= clientVersionErrCacheTTL, c.err
+	case c.clientVersion != nil:
+		return c.clientVersion, time.Since(c.updatedAt) <= clientVersionCacheTTL, nil
+	default:
+		return nil, false, nil
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 )
 
 // Chain defines a properly functioning mock for the powchain service.
@@ -37,6 +38,7 @@ type Chain struct {
 	CurrError         error
 	Endpoints         []string
 	Errors            []error
+	ClientVersion     *version.ClientVersion
 }
 
 // GenesisTime represents a static past date - JAN 01 2000.
@@ -131,6 +133,14 @@ func (m *Chain) ExecutionClientConnectionErr() error {
 	return m.CurrError
 }
 
+// ExecutionClientVersion returns the configured client version, or an error when none is set.
+func (m *Chain) ExecutionClientVersion(_ context.Context) (*version.ClientVersion, error) {
+	if m.ClientVersion == nil {
+		return nil, errors.New("no execution client version")
+	}
+	return m.ClientVersion, nil
+}
+
 func (m *Chain) ETH1Endpoints() []string {
 	return m.Endpoints
 }
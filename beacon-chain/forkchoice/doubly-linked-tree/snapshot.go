@@ -0,0 +1,314 @@
+package doublylinkedtree
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"slices"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/types"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// snapshotVersion is the first byte of a snapshot, to be bumped whenever the encoding changes.
+const snapshotVersion byte = 1
+
+var errInvalidSnapshot = errors.New("invalid forkchoice snapshot")
+
+// snapshot is a copy of the fork choice store. The checkpoints and nodes are encoded right away, the votes and
+// balances, which make up most of the snapshot, are only copied and encoded by Encode.
+type snapshot struct {
+	header            []byte
+	votes             []Vote
+	balances          []uint64
+	justifiedBalances []uint64
+	slashed           []uint64
+}
+
+// Snapshot copies the fork choice store: the nodes with their weights, the latest votes, the balances and the
+// checkpoints, so that it can be restored after a restart. The caller must hold the read lock, which can be
+// released before encoding the snapshot.
+func (f *ForkChoice) Snapshot() (forkchoice.Snapshot, error) {
+	s := f.store
+	if s.treeRootNode == nil {
+		return nil, errors.Wrap(ErrNilNode, "empty forkchoice store")
+	}
+	b := []byte{snapshotVersion}
+	b = binary.LittleEndian.AppendUint64(b, s.genesisTime)
+	b = append(b, s.originRoot[:]...)
+	for _, cp := range []*forkchoicetypes.Checkpoint{s.justifiedCheckpoint, s.prevJustifiedCheckpoint,
+		s.unrealizedJustifiedCheckpoint, s.unrealizedFinalizedCheckpoint, s.finalizedCheckpoint} {
+		if cp == nil {
+			return nil, errInvalidNilCheckpoint
+		}
+		b = binary.LittleEndian.AppendUint64(b, uint64(cp.Epoch))
+		b = append(b, cp.Root[:]...)
+	}
+	b = append(b, s.proposerBoostRoot[:]...)
+	b = append(b, s.previousProposerBoostRoot[:]...)
+	b = binary.LittleEndian.AppendUint64(b, s.previousProposerBoostScore)
+	b = binary.LittleEndian.AppendUint64(b, s.committeeWeight)
+	b = binary.LittleEndian.AppendUint64(b, f.numActiveValidators)
+	var headRoot [32]byte
+	if s.headNode != nil {
+		headRoot = s.headNode.root
+	}
+	b = append(b, headRoot[:]...)
+
+	// Nodes are written parents first, so that every parent is known when restoring a node.
+	b = binary.LittleEndian.AppendUint32(b, uint32(len(s.nodeByRoot)))
+	queue := []*Node{s.treeRootNode}
+	count := 0
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		var parentRoot [32]byte
+		if n.parent != nil {
+			parentRoot = n.parent.root
+		}
+		b = append(b, n.root[:]...)
+		b = append(b, parentRoot[:]...)
+		b = append(b, n.payloadHash[:]...)
+		for _, v := range []uint64{uint64(n.slot), uint64(n.justifiedEpoch), uint64(n.unrealizedJustifiedEpoch),
+			uint64(n.finalizedEpoch), uint64(n.unrealizedFinalizedEpoch), n.balance, n.weight, n.timestamp} {
+			b = binary.LittleEndian.AppendUint64(b, v)
+		}
+		if n.optimistic {
+			b = append(b, 1)
+		} else {
+			b = append(b, 0)
+		}
+		for _, child := range n.children {
+			// Children that compete with the finalized checkpoint are unindexed when pruned, but not unlinked.
+			if s.nodeByRoot[child.root] == child {
+				queue = append(queue, child)
+			}
+		}
+		count++
+	}
+	if count != len(s.nodeByRoot) {
+		return nil, errors.Errorf("forkchoice tree has %d nodes but %d are indexed", count, len(s.nodeByRoot))
+	}
+
+	slashed := make([]uint64, 0, len(s.slashedIndices))
+	for idx := range s.slashedIndices {
+		slashed = append(slashed, uint64(idx))
+	}
+	return &snapshot{
+		header:            b,
+		votes:             slices.Clone(f.votes),
+		balances:          slices.Clone(f.balances),
+		justifiedBalances: slices.Clone(f.justifiedBalances),
+		slashed:           slashed,
+	}, nil
+}
+
+// Encode encodes the snapshot, it does not need the fork choice lock.
+func (s *snapshot) Encode() []byte {
+	b := make([]byte, 0, len(s.header)+4+len(s.votes)*(2*fieldparams.RootLength+8)+
+		8*(len(s.balances)+len(s.justifiedBalances)+len(s.slashed)+3))
+	b = append(b, s.header...)
+	b = binary.LittleEndian.AppendUint32(b, uint32(len(s.votes)))
+	for _, v := range s.votes {
+		b = append(b, v.currentRoot[:]...)
+		b = append(b, v.nextRoot[:]...)
+		b = binary.LittleEndian.AppendUint64(b, uint64(v.nextEpoch))
+	}
+	b = appendUint64s(b, s.balances)
+	b = appendUint64s(b, s.justifiedBalances)
+	return appendUint64s(b, s.slashed)
+}
+
+// Restore replaces the content of the fork choice store with the given snapshot, after checking that it is
+// consistent. The store is left unchanged when the snapshot is invalid. The caller must hold the lock.
+func (f *ForkChoice) Restore(ctx context.Context, snapshot []byte) error {
+	r := &snapshotReader{b: snapshot}
+	if v := r.byte(); v != snapshotVersion {
+		return errors.Wrapf(errInvalidSnapshot, "unsupported version %d", v)
+	}
+	s := &Store{
+		nodeByRoot:     make(map[[fieldparams.RootLength]byte]*Node),
+		nodeByPayload:  make(map[[fieldparams.RootLength]byte]*Node),
+		slashedIndices: make(map[primitives.ValidatorIndex]bool),
+		nower:          f.store.nower,
+	}
+	s.genesisTime = r.uint64()
+	s.originRoot = r.root()
+	cps := make([]*forkchoicetypes.Checkpoint, 5)
+	for i := range cps {
+		cps[i] = &forkchoicetypes.Checkpoint{Epoch: primitives.Epoch(r.uint64()), Root: r.root()}
+	}
+	s.justifiedCheckpoint, s.prevJustifiedCheckpoint = cps[0], cps[1]
+	s.unrealizedJustifiedCheckpoint, s.unrealizedFinalizedCheckpoint, s.finalizedCheckpoint = cps[2], cps[3], cps[4]
+	s.proposerBoostRoot = r.root()
+	s.previousProposerBoostRoot = r.root()
+	s.previousProposerBoostScore = r.uint64()
+	s.committeeWeight = r.uint64()
+	numActiveValidators := r.uint64()
+	headRoot := r.root()
+
+	nodeCount := r.length(3*fieldparams.RootLength + 8*8 + 1)
+	for i := 0; i < nodeCount && r.err == nil; i++ {
+		n := &Node{root: r.root()}
+		parentRoot := r.root()
+		n.payloadHash = r.root()
+		n.slot = primitives.Slot(r.uint64())
+		n.justifiedEpoch = primitives.Epoch(r.uint64())
+		n.unrealizedJustifiedEpoch = primitives.Epoch(r.uint64())
+		n.finalizedEpoch = primitives.Epoch(r.uint64())
+		n.unrealizedFinalizedEpoch = primitives.Epoch(r.uint64())
+		n.balance = r.uint64()
+		n.weight = r.uint64()
+		n.timestamp = r.uint64()
+		n.optimistic = r.byte() == 1
+		if _, ok := s.nodeByRoot[n.root]; ok {
+			return errors.Wrapf(errInvalidSnapshot, "duplicate node %#x", n.root)
+		}
+		if i == 0 {
+			s.treeRootNode = n
+		} else {
+			parent, ok := s.nodeByRoot[parentRoot]
+			if !ok {
+				return errors.Wrapf(errInvalidSnapshot, "unknown parent %#x of node %#x", parentRoot, n.root)
+			}
+			if parent.slot >= n.slot {
+				return errors.Wrapf(errInvalidSnapshot, "node %#x is not after its parent", n.root)
+			}
+			n.parent = parent
+			parent.children = append(parent.children, n)
+		}
+		setTarget(n)
+		s.nodeByRoot[n.root] = n
+		s.nodeByPayload[n.payloadHash] = n
+		if s.highestReceivedNode == nil || n.slot > s.highestReceivedNode.slot {
+			s.highestReceivedNode = n
+		}
+	}
+
+	voteCount := r.length(2*fieldparams.RootLength + 8)
+	votes := make([]Vote, 0, voteCount)
+	for i := 0; i < voteCount && r.err == nil; i++ {
+		votes = append(votes, Vote{currentRoot: r.root(), nextRoot: r.root(), nextEpoch: primitives.Epoch(r.uint64())})
+	}
+	balances := r.uint64s()
+	justifiedBalances := r.uint64s()
+	for _, idx := range r.uint64s() {
+		s.slashedIndices[primitives.ValidatorIndex(idx)] = true
+	}
+	if r.err != nil {
+		return errors.Wrap(errInvalidSnapshot, r.err.Error())
+	}
+	if len(r.b) != r.off {
+		return errors.Wrapf(errInvalidSnapshot, "%d trailing bytes", len(r.b)-r.off)
+	}
+	if s.treeRootNode == nil {
+		return errors.Wrap(errInvalidSnapshot, "no nodes")
+	}
+	if _, ok := s.nodeByRoot[s.finalizedCheckpoint.Root]; !ok && s.finalizedCheckpoint.Root != [32]byte{} {
+		return errors.Wrapf(errInvalidSnapshot, "unknown finalized root %#x", s.finalizedCheckpoint.Root)
+	}
+	// A stale proposer boost is harmless, it is reset at the start of the next slot.
+	if _, ok := s.nodeByRoot[s.proposerBoostRoot]; !ok {
+		s.proposerBoostRoot = [32]byte{}
+	}
+	if _, ok := s.nodeByRoot[s.previousProposerBoostRoot]; !ok {
+		s.previousProposerBoostRoot, s.previousProposerBoostScore = [32]byte{}, 0
+	}
+	head, ok := s.nodeByRoot[headRoot]
+	if !ok {
+		return errors.Wrapf(errInvalidSnapshot, "unknown head root %#x", headRoot)
+	}
+	s.headNode = head
+	currentEpoch := slots.ToEpoch(s.currentSlot())
+	if err := s.treeRootNode.updateBestDescendant(ctx, s.justifiedCheckpoint.Epoch, s.finalizedCheckpoint.Epoch, currentEpoch); err != nil {
+		return errors.Wrap(err, "could not update best descendant")
+	}
+
+	f.store = s
+	f.votes = votes
+	f.balances = balances
+	f.justifiedBalances = justifiedBalances
+	f.numActiveValidators = numActiveValidators
+	return nil
+}
+
+// setTarget sets the target checkpoint node of a node whose parent is set, the same way as when it is inserted.
+func setTarget(n *Node) {
+	if slots.SinceEpochStarts(n.slot) == 0 {
+		n.target = n
+	} else if n.parent != nil {
+		if slots.ToEpoch(n.slot) == slots.ToEpoch(n.parent.slot) {
+			n.target = n.parent.target
+		} else {
+			n.target = n.parent
+		}
+	}
+}
+
+func appendUint64s(b []byte, values []uint64) []byte {
+	b = binary.LittleEndian.AppendUint32(b, uint32(len(values)))
+	for _, v := range values {
+		b = binary.LittleEndian.AppendUint64(b, v)
+	}
+	return b
+}
+
+// snapshotReader decodes a snapshot, recording the first out of bounds read.
+type snapshotReader struct {
+	b   []byte
+	off int
+	err error
+}
+
+func (r *snapshotReader) next(n int) []byte {
+	if r.err != nil {
+		return make([]byte, n)
+	}
+	if len(r.b)-r.off < n {
+		r.err = fmt.Errorf("truncated at offset %d", r.off)
+		return make([]byte, n)
+	}
+	v := r.b[r.off : r.off+n]
+	r.off += n
+	return v
+}
+
+func (r *snapshotReader) byte() byte {
+	return r.next(1)[0]
+}
+
+func (r *snapshotReader) uint64() uint64 {
+	return binary.LittleEndian.Uint64(r.next(8))
+}
+
+func (r *snapshotReader) root() [32]byte {
+	var root [32]byte
+	copy(root[:], r.next(fieldparams.RootLength))
+	return root
+}
+
+// length reads the number of items of a list, bounded by the remaining bytes so that a corrupted length cannot
+// cause a large allocation.
+func (r *snapshotReader) length(itemSize int) int {
+	n := int(binary.LittleEndian.Uint32(r.next(4)))
+	if r.err == nil && n > (len(r.b)-r.off)/itemSize {
+		r.err = fmt.Errorf("list of %d items at offset %d exceeds the snapshot", n, r.off)
+	}
+	if r.err != nil {
+		return 0
+	}
+	return n
+}
+
+func (r *snapshotReader) uint64s() []uint64 {
+	n := r.length(8)
+	values := make([]uint64, n)
+	for i := range values {
+		values[i] = r.uint64()
+	}
+	return values
+}
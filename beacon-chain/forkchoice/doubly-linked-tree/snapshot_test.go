@@ -0,0 +1,130 @@
+package doublylinkedtree
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+// snapshotTestForkchoice returns a fork choice store with two branches and votes for both of them:
+//
+//	0 <- 1 <- 2 <- 3
+//	       \- 4
+func snapshotTestForkchoice(t *testing.T) *ForkChoice {
+	ctx := context.Background()
+	f := setup(0, 0)
+	zero := params.BeaconConfig().ZeroHash
+	for _, b := range []struct{ slot, root, parent uint64 }{{1, 1, 0}, {2, 2, 1}, {3, 3, 2}, {4, 4, 1}} {
+		parent := indexToHash(b.parent)
+		if b.parent == 0 {
+			parent = zero
+		}
+		st, blk, err := prepareForkchoiceState(ctx, primitives.Slot(b.slot), indexToHash(b.root), parent, indexToHash(b.root+100), 0, 0)
+		require.NoError(t, err)
+		require.NoError(t, f.InsertNode(ctx, st, blk))
+	}
+	f.justifiedBalances = []uint64{10, 20, 30}
+	f.store.proposerBoostRoot = indexToHash(4)
+	f.InsertSlashedIndex(ctx, 2)
+	f.ProcessAttestation(ctx, []uint64{0}, indexToHash(3), 1)
+	f.ProcessAttestation(ctx, []uint64{1}, indexToHash(4), 1)
+	_, err := f.Head(ctx)
+	require.NoError(t, err)
+	return f
+}
+
+func TestForkChoice_SnapshotRestore(t *testing.T) {
+	ctx := context.Background()
+	f := snapshotTestForkchoice(t)
+	fcs, err := f.Snapshot()
+	require.NoError(t, err)
+	snapshot := fcs.Encode()
+
+	restored := New()
+	require.NoError(t, restored.Restore(ctx, snapshot))
+	require.Equal(t, f.NodeCount(), restored.NodeCount())
+	require.Equal(t, f.CachedHeadRoot(), restored.CachedHeadRoot())
+	require.DeepEqual(t, f.JustifiedCheckpoint(), restored.JustifiedCheckpoint())
+	require.DeepEqual(t, f.FinalizedCheckpoint(), restored.FinalizedCheckpoint())
+	require.DeepEqual(t, f.votes, restored.votes)
+	require.DeepEqual(t, f.balances, restored.balances)
+	require.DeepEqual(t, f.justifiedBalances, restored.justifiedBalances)
+	require.DeepEqual(t, f.store.slashedIndices, restored.store.slashedIndices)
+	require.Equal(t, f.store.proposerBoostRoot, restored.store.proposerBoostRoot)
+	for root := range f.store.nodeByRoot {
+		w, err := f.Weight(root)
+		require.NoError(t, err)
+		rw, err := restored.Weight(root)
+		require.NoError(t, err)
+		require.Equal(t, w, rw)
+		tr, err := f.TargetRootForEpoch(root, 0)
+		require.NoError(t, err)
+		rtr, err := restored.TargetRootForEpoch(root, 0)
+		require.NoError(t, err)
+		require.Equal(t, tr, rtr)
+	}
+	dump, err := f.ForkChoiceDump(ctx)
+	require.NoError(t, err)
+	restoredDump, err := restored.ForkChoiceDump(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, dump, restoredDump)
+
+	// The restored store keeps working the same way.
+	f.ProcessAttestation(ctx, []uint64{0}, indexToHash(4), 2)
+	restored.ProcessAttestation(ctx, []uint64{0}, indexToHash(4), 2)
+	head, err := f.Head(ctx)
+	require.NoError(t, err)
+	restoredHead, err := restored.Head(ctx)
+	require.NoError(t, err)
+	require.Equal(t, head, restoredHead)
+}
+
+func TestForkChoice_SnapshotIsCopy(t *testing.T) {
+	ctx := context.Background()
+	f := snapshotTestForkchoice(t)
+	fcs, err := f.Snapshot()
+	require.NoError(t, err)
+	votes := slices.Clone(f.votes)
+	balances := slices.Clone(f.justifiedBalances)
+
+	// Changes made after the snapshot is taken are not part of it.
+	f.ProcessAttestation(ctx, []uint64{2}, indexToHash(4), 2)
+	f.justifiedBalances[0] = 100
+
+	restored := New()
+	require.NoError(t, restored.Restore(ctx, fcs.Encode()))
+	require.DeepEqual(t, votes, restored.votes)
+	require.DeepEqual(t, balances, restored.justifiedBalances)
+}
+
+func TestForkChoice_RestoreInvalid(t *testing.T) {
+	ctx := context.Background()
+	f := snapshotTestForkchoice(t)
+	fcs, err := f.Snapshot()
+	require.NoError(t, err)
+	snapshot := fcs.Encode()
+
+	restored := setup(1, 1)
+	count := restored.NodeCount()
+	require.ErrorIs(t, restored.Restore(ctx, snapshot[:len(snapshot)-1]), errInvalidSnapshot)
+	assert.ErrorContains(t, "trailing bytes", restored.Restore(ctx, append(snapshot, 0)))
+	bad := append([]byte{}, snapshot...)
+	bad[0] = snapshotVersion + 1
+	assert.ErrorContains(t, "unsupported version", restored.Restore(ctx, bad))
+
+	// Corrupt the parent root of the last node.
+	headerLength := 1 + 8 + 32 + 5*40 + 32 + 32 + 8 + 8 + 8 + 32
+	nodeLength := 3*32 + 8*8 + 1
+	bad = append([]byte{}, snapshot...)
+	bad[headerLength+4+(f.NodeCount()-1)*nodeLength+32] ^= 0xff
+	assert.ErrorContains(t, "unknown parent", restored.Restore(ctx, bad))
+
+	// The store is unchanged by the failed attempts.
+	require.Equal(t, count, restored.NodeCount())
+	require.Equal(t, uint64(1), uint64(restored.JustifiedCheckpoint().Epoch))
+}
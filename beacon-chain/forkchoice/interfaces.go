@@ -58,6 +58,13 @@ type Getter interface {
 	CommonAncestor(ctx context.Context, root1 [32]byte, root2 [32]byte) ([32]byte, primitives.Slot, error)
 	ForkChoiceDump(context.Context) (*forkchoice2.Dump, error)
 	Tips() ([][32]byte, []primitives.Slot)
+	Snapshot() (Snapshot, error)
+}
+
+// Snapshot is a copy of the fork choice store taken while holding the fork choice lock. It is encoded
+// without holding the lock.
+type Snapshot interface {
+	Encode() []byte
 }
 
 type FastGetter interface {
@@ -95,4 +102,5 @@ type Setter interface {
 	NewSlot(context.Context, primitives.Slot) error
 	SetBalancesByRooter(BalancesByRooter)
 	InsertSlashedIndex(context.Context, primitives.ValidatorIndex)
+	Restore(context.Context, []byte) error
 }
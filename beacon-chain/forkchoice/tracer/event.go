@@ -0,0 +1,201 @@
+package tracer
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	coreTime "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/time"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/types"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	enginev1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// EventType identifies the fork choice operation recorded by an Event.
+type EventType string
+
+const (
+	// EventSnapshot holds the encoded fork choice store when recording starts. It is always the first event.
+	EventSnapshot EventType = "snapshot"
+	// EventInsertNode records a call to InsertNode.
+	EventInsertNode EventType = "insert_node"
+	// EventInsertChain records a call to InsertChain.
+	EventInsertChain EventType = "insert_chain"
+	// EventProcessAttestation records a call to ProcessAttestation.
+	EventProcessAttestation EventType = "process_attestation"
+	// EventNewSlot records a call to NewSlot.
+	EventNewSlot EventType = "new_slot"
+	// EventSlashedIndex records a call to InsertSlashedIndex.
+	EventSlashedIndex EventType = "slashed_index"
+	// EventInvalidPayload records a call to SetOptimisticToInvalid.
+	EventInvalidPayload EventType = "invalid_payload"
+	// EventValidPayload records a call to SetOptimisticToValid.
+	EventValidPayload EventType = "valid_payload"
+	// EventJustifiedCheckpoint records a call to UpdateJustifiedCheckpoint.
+	EventJustifiedCheckpoint EventType = "justified_checkpoint"
+	// EventFinalizedCheckpoint records a call to UpdateFinalizedCheckpoint.
+	EventFinalizedCheckpoint EventType = "finalized_checkpoint"
+	// EventJustifiedBalances records the balances returned to fork choice for a justified checkpoint root.
+	EventJustifiedBalances EventType = "justified_balances"
+	// EventHead records a call to Head and the head it returned.
+	EventHead EventType = "head"
+)
+
+// Event is a single fork choice operation, written as one JSON object per line of a trace.
+// Only the fields used by the event type are set.
+type Event struct {
+	Type           EventType                 `json:"type"`
+	Time           time.Time                 `json:"time"`
+	ConfigName     string                    `json:"config_name,omitempty"`
+	Snapshot       []byte                    `json:"snapshot,omitempty"`
+	Block          *Block                    `json:"block,omitempty"`
+	State          *State                    `json:"state,omitempty"`
+	Chain          []*ChainBlock             `json:"chain,omitempty"`
+	Root           hexutil.Bytes             `json:"root,omitempty"`
+	ParentRoot     hexutil.Bytes             `json:"parent_root,omitempty"`
+	PayloadHash    hexutil.Bytes             `json:"payload_hash,omitempty"`
+	Checkpoint     *Checkpoint               `json:"checkpoint,omitempty"`
+	Indices        []uint64                  `json:"indices,omitempty"`
+	TargetEpoch    primitives.Epoch          `json:"target_epoch,omitempty"`
+	Slot           primitives.Slot           `json:"slot,omitempty"`
+	ValidatorIndex primitives.ValidatorIndex `json:"validator_index,omitempty"`
+	Balances       []uint64                  `json:"balances,omitempty"`
+	Error          string                    `json:"error,omitempty"`
+}
+
+// Block holds the fields of a block used by fork choice.
+type Block struct {
+	Slot        primitives.Slot `json:"slot"`
+	Root        hexutil.Bytes   `json:"root"`
+	ParentRoot  hexutil.Bytes   `json:"parent_root"`
+	PayloadHash hexutil.Bytes   `json:"payload_hash"`
+}
+
+// ChainBlock is a block inserted with InsertChain, along with the checkpoints of its post state.
+type ChainBlock struct {
+	Block     *Block      `json:"block"`
+	Justified *Checkpoint `json:"justified"`
+	Finalized *Checkpoint `json:"finalized"`
+}
+
+// Checkpoint is the JSON representation of a checkpoint.
+type Checkpoint struct {
+	Epoch primitives.Epoch `json:"epoch"`
+	Root  hexutil.Bytes    `json:"root"`
+}
+
+// State holds the fields of a post state read by fork choice when inserting a node, which are the
+// inputs to the computation of the unrealized checkpoints.
+type State struct {
+	Slot               primitives.Slot          `json:"slot"`
+	PreviousJustified  *Checkpoint              `json:"previous_justified"`
+	CurrentJustified   *Checkpoint              `json:"current_justified"`
+	Finalized          *Checkpoint              `json:"finalized"`
+	JustificationBits  hexutil.Bytes            `json:"justification_bits"`
+	UnrealizedBalances *UnrealizedBalances      `json:"unrealized_balances,omitempty"`
+	EpochStartRoots    map[uint64]hexutil.Bytes `json:"epoch_start_roots,omitempty"`
+}
+
+// UnrealizedBalances holds the balances returned by the UnrealizedCheckpointBalances method of a state.
+type UnrealizedBalances struct {
+	Active         uint64 `json:"active"`
+	PreviousTarget uint64 `json:"previous_target"`
+	CurrentTarget  uint64 `json:"current_target"`
+}
+
+func blockFromROBlock(b blocks.ROBlock) (*Block, error) {
+	blk := b.Block()
+	root := b.Root()
+	parentRoot := blk.ParentRoot()
+	var payloadHash [32]byte
+	if blk.Version() >= version.Bellatrix {
+		execution, err := blk.Body().Execution()
+		if err != nil {
+			return nil, err
+		}
+		copy(payloadHash[:], execution.BlockHash())
+	}
+	return &Block{Slot: blk.Slot(), Root: root[:], ParentRoot: parentRoot[:], PayloadHash: payloadHash[:]}, nil
+}
+
+// roBlock builds a block with the recorded fields, which is all that fork choice reads from a block.
+func (b *Block) roBlock() (blocks.ROBlock, error) {
+	blk, err := blocks.NewSignedBeaconBlock(&ethpb.SignedBeaconBlockBellatrix{
+		Block: &ethpb.BeaconBlockBellatrix{
+			Slot:       b.Slot,
+			ParentRoot: bytesutil.SafeCopyBytes(b.ParentRoot),
+			Body: &ethpb.BeaconBlockBodyBellatrix{
+				ExecutionPayload: &enginev1.ExecutionPayload{BlockHash: bytesutil.SafeCopyBytes(b.PayloadHash)},
+			},
+		},
+	})
+	if err != nil {
+		return blocks.ROBlock{}, err
+	}
+	return blocks.NewROBlockWithRoot(blk, bytesutil.ToBytes32(b.Root))
+}
+
+func checkpointFromProto(cp *ethpb.Checkpoint) *Checkpoint {
+	if cp == nil {
+		return nil
+	}
+	return &Checkpoint{Epoch: cp.Epoch, Root: bytesutil.SafeCopyBytes(cp.Root)}
+}
+
+func (cp *Checkpoint) proto() *ethpb.Checkpoint {
+	if cp == nil {
+		return nil
+	}
+	return &ethpb.Checkpoint{Epoch: cp.Epoch, Root: bytesutil.PadTo(bytesutil.SafeCopyBytes(cp.Root), 32)}
+}
+
+func checkpointFromForkchoice(cp *forkchoicetypes.Checkpoint) *Checkpoint {
+	if cp == nil {
+		return nil
+	}
+	return &Checkpoint{Epoch: cp.Epoch, Root: bytesutil.SafeCopyBytes(cp.Root[:])}
+}
+
+func (cp *Checkpoint) forkchoice() *forkchoicetypes.Checkpoint {
+	if cp == nil {
+		return nil
+	}
+	return &forkchoicetypes.Checkpoint{Epoch: cp.Epoch, Root: bytesutil.ToBytes32(cp.Root)}
+}
+
+// stateFromBeaconState records the fields of st read by fork choice. The unrealized balances and the epoch start
+// roots are left out when the state cannot provide them, in which case the replay fails the same way.
+func stateFromBeaconState(st state.BeaconState) (*State, error) {
+	s := &State{
+		Slot:              st.Slot(),
+		PreviousJustified: checkpointFromProto(st.PreviousJustifiedCheckpoint()),
+		CurrentJustified:  checkpointFromProto(st.CurrentJustifiedCheckpoint()),
+		Finalized:         checkpointFromProto(st.FinalizedCheckpoint()),
+		JustificationBits: bytesutil.SafeCopyBytes(st.JustificationBits()),
+		EpochStartRoots:   make(map[uint64]hexutil.Bytes),
+	}
+	if s.CurrentJustified == nil || s.Finalized == nil {
+		return nil, errors.New("state has nil checkpoints")
+	}
+	active, prevTarget, currTarget, err := st.UnrealizedCheckpointBalances()
+	if err == nil {
+		s.UnrealizedBalances = &UnrealizedBalances{Active: active, PreviousTarget: prevTarget, CurrentTarget: currTarget}
+	}
+	for _, e := range []primitives.Epoch{coreTime.PrevEpoch(st), coreTime.CurrentEpoch(st)} {
+		start, err := slots.EpochStart(e)
+		if err != nil {
+			continue
+		}
+		if root, err := helpers.BlockRoot(st, e); err == nil {
+			s.EpochStartRoots[uint64(start)] = root
+		}
+	}
+	return s, nil
+}
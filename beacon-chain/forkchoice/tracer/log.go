@@ -0,0 +1,5 @@
+package tracer
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "forkchoice-tracer")
@@ -0,0 +1,208 @@
+package tracer
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/types"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	state_native "github.com/prysmaticlabs/prysm/v5/beacon-chain/state/state-native"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+)
+
+// maxEventSize bounds the size of a line of a trace. Justified balances events hold one number per validator.
+const maxEventSize = 64 << 20
+
+var errNoSnapshot = errors.New("trace does not start with a snapshot event")
+
+// Reader reads the events of a trace.
+type Reader struct {
+	s *bufio.Scanner
+}
+
+// NewReader returns a reader for the trace in r.
+func NewReader(r io.Reader) *Reader {
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 0, 1<<20), maxEventSize)
+	return &Reader{s: s}
+}
+
+// Next returns the next event of the trace, or io.EOF at the end of the trace.
+func (r *Reader) Next() (*Event, error) {
+	for r.s.Scan() {
+		if len(r.s.Bytes()) == 0 {
+			continue
+		}
+		e := &Event{}
+		if err := json.Unmarshal(r.s.Bytes(), e); err != nil {
+			return nil, errors.Wrap(err, "could not decode trace event")
+		}
+		return e, nil
+	}
+	if err := r.s.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+// Replayer applies the events of a trace to a new fork choice store, with its clock set to the time of
+// each event so that time dependent decisions, like proposer boost and late block reorgs, are made the same way.
+type Replayer struct {
+	fc       *doublylinkedtree.ForkChoice
+	now      time.Time
+	balances map[[32]byte][]uint64
+	started  bool
+}
+
+// NewReplayer returns a replayer with an empty fork choice store.
+func NewReplayer() *Replayer {
+	r := &Replayer{fc: doublylinkedtree.New(), balances: make(map[[32]byte][]uint64)}
+	r.fc.SetNower(func() time.Time { return r.now })
+	r.fc.SetBalancesByRooter(r.justifiedBalances)
+	return r
+}
+
+// ForkChoice returns the fork choice store the events are applied to.
+func (r *Replayer) ForkChoice() *doublylinkedtree.ForkChoice {
+	return r.fc
+}
+
+// Now returns the time of the last applied event.
+func (r *Replayer) Now() time.Time {
+	return r.now
+}
+
+func (r *Replayer) justifiedBalances(_ context.Context, root [32]byte) ([]uint64, error) {
+	balances, ok := r.balances[root]
+	if !ok {
+		return nil, errors.Errorf("no justified balances recorded for root %#x", root)
+	}
+	return balances, nil
+}
+
+// Apply applies an event to the fork choice store. Head events compute the head, which can then be compared
+// with the recorded one.
+func (r *Replayer) Apply(ctx context.Context, e *Event) error {
+	if !r.started && e.Type != EventSnapshot {
+		return errNoSnapshot
+	}
+	r.now = e.Time
+	switch e.Type {
+	case EventSnapshot:
+		if err := r.fc.Restore(ctx, e.Snapshot); err != nil {
+			return errors.Wrap(err, "could not restore snapshot")
+		}
+		r.started = true
+		return nil
+	case EventInsertNode:
+		if e.Block == nil || e.State == nil {
+			return errors.New("insert_node event without block or state")
+		}
+		roblock, err := e.Block.roBlock()
+		if err != nil {
+			return err
+		}
+		st, err := e.State.beaconState()
+		if err != nil {
+			return err
+		}
+		return r.fc.InsertNode(ctx, st, roblock)
+	case EventInsertChain:
+		chain := make([]*forkchoicetypes.BlockAndCheckpoints, len(e.Chain))
+		for i, c := range e.Chain {
+			if c.Block == nil || c.Justified == nil || c.Finalized == nil {
+				return errors.New("insert_chain event with incomplete block")
+			}
+			roblock, err := c.Block.roBlock()
+			if err != nil {
+				return err
+			}
+			chain[i] = &forkchoicetypes.BlockAndCheckpoints{Block: roblock, JustifiedCheckpoint: c.Justified.proto(), FinalizedCheckpoint: c.Finalized.proto()}
+		}
+		return r.fc.InsertChain(ctx, chain)
+	case EventProcessAttestation:
+		r.fc.ProcessAttestation(ctx, e.Indices, bytesutil.ToBytes32(e.Root), e.TargetEpoch)
+		return nil
+	case EventNewSlot:
+		return r.fc.NewSlot(ctx, e.Slot)
+	case EventSlashedIndex:
+		r.fc.InsertSlashedIndex(ctx, e.ValidatorIndex)
+		return nil
+	case EventInvalidPayload:
+		_, err := r.fc.SetOptimisticToInvalid(ctx, bytesutil.ToBytes32(e.Root), bytesutil.ToBytes32(e.ParentRoot), bytesutil.ToBytes32(e.PayloadHash))
+		return err
+	case EventValidPayload:
+		return r.fc.SetOptimisticToValid(ctx, bytesutil.ToBytes32(e.Root))
+	case EventJustifiedCheckpoint:
+		if e.Checkpoint == nil {
+			return errors.New("justified_checkpoint event without checkpoint")
+		}
+		return r.fc.UpdateJustifiedCheckpoint(ctx, e.Checkpoint.forkchoice())
+	case EventFinalizedCheckpoint:
+		if e.Checkpoint == nil {
+			return errors.New("finalized_checkpoint event without checkpoint")
+		}
+		return r.fc.UpdateFinalizedCheckpoint(e.Checkpoint.forkchoice())
+	case EventJustifiedBalances:
+		r.balances[bytesutil.ToBytes32(e.Root)] = e.Balances
+		return nil
+	case EventHead:
+		_, err := r.fc.Head(ctx)
+		return err
+	default:
+		return errors.Errorf("unknown event type %q", e.Type)
+	}
+}
+
+// replayState is a beacon state holding the recorded fields read by fork choice. The unrealized checkpoint
+// balances are computed from the validators and their participation, which are not recorded, so the recorded
+// balances are returned instead.
+type replayState struct {
+	state.BeaconState
+	balances *UnrealizedBalances
+}
+
+// UnrealizedCheckpointBalances returns the recorded balances.
+func (s *replayState) UnrealizedCheckpointBalances() (uint64, uint64, uint64, error) {
+	if s.balances == nil {
+		return 0, 0, 0, errors.New("unrealized checkpoint balances were not recorded")
+	}
+	return s.balances.Active, s.balances.PreviousTarget, s.balances.CurrentTarget, nil
+}
+
+func (s *State) beaconState() (state.BeaconState, error) {
+	if s.CurrentJustified == nil || s.Finalized == nil {
+		return nil, errors.New("state without checkpoints")
+	}
+	prevJustified := s.PreviousJustified
+	if prevJustified == nil {
+		prevJustified = &Checkpoint{}
+	}
+	rootsLength := uint64(params.BeaconConfig().SlotsPerHistoricalRoot)
+	roots := make([][]byte, rootsLength)
+	for i := range roots {
+		roots[i] = make([]byte, 32)
+	}
+	for slot, root := range s.EpochStartRoots {
+		roots[slot%rootsLength] = bytesutil.PadTo(bytesutil.SafeCopyBytes(root), 32)
+	}
+	st, err := state_native.InitializeFromProtoUnsafePhase0(&ethpb.BeaconState{
+		Slot:                        s.Slot,
+		BlockRoots:                  roots,
+		JustificationBits:           bytesutil.PadTo(bytesutil.SafeCopyBytes(s.JustificationBits), 1),
+		PreviousJustifiedCheckpoint: prevJustified.proto(),
+		CurrentJustifiedCheckpoint:  s.CurrentJustified.proto(),
+		FinalizedCheckpoint:         s.Finalized.proto(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &replayState{BeaconState: st, balances: s.UnrealizedBalances}, nil
+}
@@ -0,0 +1,260 @@
+// Package tracer records the operations applied to the fork choice store, along with the data fork choice reads
+// from blocks and states, so that they can be replayed offline against the fork choice code.
+package tracer
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/types"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
+)
+
+// Tracer is a fork choice store that records the operations it forwards to the wrapped store.
+// Recording starts with a snapshot of the store, taken at the first operation applied once the store
+// holds a node, and starts again with a new snapshot when the store is restored.
+type Tracer struct {
+	forkchoice.ForkChoicer
+	mu      sync.Mutex
+	f       *os.File
+	w       *bufio.Writer
+	enc     *json.Encoder
+	started bool
+	err     error
+}
+
+var _ forkchoice.ForkChoicer = &Tracer{}
+
+// New wraps the given fork choice store with a tracer writing to the file at path, which is truncated.
+func New(fc forkchoice.ForkChoicer, path string) (*Tracer, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, params.BeaconIoConfig().ReadWritePermissions) // #nosec G304
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create forkchoice trace file")
+	}
+	w := bufio.NewWriter(f)
+	return &Tracer{ForkChoicer: fc, f: f, w: w, enc: json.NewEncoder(w)}, nil
+}
+
+// Close flushes the recorded events and closes the trace file.
+func (t *Tracer) Close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.f == nil {
+		return nil
+	}
+	err := t.w.Flush()
+	if closeErr := t.f.Close(); err == nil {
+		err = closeErr
+	}
+	t.f = nil
+	return err
+}
+
+// start records the snapshot event if recording has not started yet. It returns false when the
+// event must not be recorded. The caller must hold the fork choice lock.
+func (t *Tracer) start() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.f == nil || t.err != nil {
+		return false
+	}
+	if t.started {
+		return true
+	}
+	if t.NodeCount() == 0 {
+		return false
+	}
+	fcs, err := t.Snapshot()
+	if err != nil {
+		log.WithError(err).Error("Could not snapshot forkchoice, not recording its operations")
+		t.err = err
+		return false
+	}
+	t.started = true
+	t.writeLocked(&Event{Type: EventSnapshot, Time: prysmTime.Now(), ConfigName: params.BeaconConfig().ConfigName, Snapshot: fcs.Encode()})
+	return true
+}
+
+// write records an event, if recording has started.
+func (t *Tracer) write(e *Event) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.f == nil || !t.started || t.err != nil {
+		return
+	}
+	t.writeLocked(e)
+}
+
+func (t *Tracer) writeLocked(e *Event) {
+	if err := t.enc.Encode(e); err != nil {
+		log.WithError(err).Error("Could not write forkchoice trace, not recording its operations anymore")
+		t.err = err
+		return
+	}
+	// Events are flushed every slot, so that a trace is complete up to the last slot after a crash.
+	if e.Type == EventNewSlot {
+		if err := t.w.Flush(); err != nil {
+			log.WithError(err).Error("Could not flush forkchoice trace, not recording its operations anymore")
+			t.err = err
+		}
+	}
+}
+
+func errString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+// InsertNode records the block and the fields of its post state read by fork choice.
+func (t *Tracer) InsertNode(ctx context.Context, st state.BeaconState, roblock blocks.ROBlock) error {
+	if !t.start() {
+		return t.ForkChoicer.InsertNode(ctx, st, roblock)
+	}
+	now := prysmTime.Now()
+	blk, err := blockFromROBlock(roblock)
+	if err != nil {
+		return err
+	}
+	s, err := stateFromBeaconState(st)
+	if err != nil {
+		return err
+	}
+	err = t.ForkChoicer.InsertNode(ctx, st, roblock)
+	t.write(&Event{Type: EventInsertNode, Time: now, Block: blk, State: s, Error: errString(err)})
+	return err
+}
+
+// InsertChain records the blocks and their checkpoints.
+func (t *Tracer) InsertChain(ctx context.Context, chain []*forkchoicetypes.BlockAndCheckpoints) error {
+	if !t.start() {
+		return t.ForkChoicer.InsertChain(ctx, chain)
+	}
+	now := prysmTime.Now()
+	recorded := make([]*ChainBlock, len(chain))
+	for i, c := range chain {
+		blk, err := blockFromROBlock(c.Block)
+		if err != nil {
+			return err
+		}
+		recorded[i] = &ChainBlock{Block: blk, Justified: checkpointFromProto(c.JustifiedCheckpoint), Finalized: checkpointFromProto(c.FinalizedCheckpoint)}
+	}
+	err := t.ForkChoicer.InsertChain(ctx, chain)
+	t.write(&Event{Type: EventInsertChain, Time: now, Chain: recorded, Error: errString(err)})
+	return err
+}
+
+// ProcessAttestation records the votes.
+func (t *Tracer) ProcessAttestation(ctx context.Context, indices []uint64, root [32]byte, targetEpoch primitives.Epoch) {
+	if t.start() {
+		t.write(&Event{Type: EventProcessAttestation, Time: prysmTime.Now(), Indices: indices, Root: root[:], TargetEpoch: targetEpoch})
+	}
+	t.ForkChoicer.ProcessAttestation(ctx, indices, root, targetEpoch)
+}
+
+// NewSlot records the start of a slot.
+func (t *Tracer) NewSlot(ctx context.Context, slot primitives.Slot) error {
+	if !t.start() {
+		return t.ForkChoicer.NewSlot(ctx, slot)
+	}
+	now := prysmTime.Now()
+	err := t.ForkChoicer.NewSlot(ctx, slot)
+	t.write(&Event{Type: EventNewSlot, Time: now, Slot: slot, Error: errString(err)})
+	return err
+}
+
+// InsertSlashedIndex records the slashed validator.
+func (t *Tracer) InsertSlashedIndex(ctx context.Context, index primitives.ValidatorIndex) {
+	if t.start() {
+		t.write(&Event{Type: EventSlashedIndex, Time: prysmTime.Now(), ValidatorIndex: index})
+	}
+	t.ForkChoicer.InsertSlashedIndex(ctx, index)
+}
+
+// SetOptimisticToInvalid records the invalid payload.
+func (t *Tracer) SetOptimisticToInvalid(ctx context.Context, root, parentRoot, payloadHash [32]byte) ([][32]byte, error) {
+	if !t.start() {
+		return t.ForkChoicer.SetOptimisticToInvalid(ctx, root, parentRoot, payloadHash)
+	}
+	now := prysmTime.Now()
+	invalid, err := t.ForkChoicer.SetOptimisticToInvalid(ctx, root, parentRoot, payloadHash)
+	t.write(&Event{Type: EventInvalidPayload, Time: now, Root: root[:], ParentRoot: parentRoot[:], PayloadHash: payloadHash[:], Error: errString(err)})
+	return invalid, err
+}
+
+// SetOptimisticToValid records the valid payload.
+func (t *Tracer) SetOptimisticToValid(ctx context.Context, root [32]byte) error {
+	if !t.start() {
+		return t.ForkChoicer.SetOptimisticToValid(ctx, root)
+	}
+	now := prysmTime.Now()
+	err := t.ForkChoicer.SetOptimisticToValid(ctx, root)
+	t.write(&Event{Type: EventValidPayload, Time: now, Root: root[:], Error: errString(err)})
+	return err
+}
+
+// UpdateJustifiedCheckpoint records the new justified checkpoint.
+func (t *Tracer) UpdateJustifiedCheckpoint(ctx context.Context, jc *forkchoicetypes.Checkpoint) error {
+	if !t.start() {
+		return t.ForkChoicer.UpdateJustifiedCheckpoint(ctx, jc)
+	}
+	now := prysmTime.Now()
+	err := t.ForkChoicer.UpdateJustifiedCheckpoint(ctx, jc)
+	t.write(&Event{Type: EventJustifiedCheckpoint, Time: now, Checkpoint: checkpointFromForkchoice(jc), Error: errString(err)})
+	return err
+}
+
+// UpdateFinalizedCheckpoint records the new finalized checkpoint.
+func (t *Tracer) UpdateFinalizedCheckpoint(fc *forkchoicetypes.Checkpoint) error {
+	if !t.start() {
+		return t.ForkChoicer.UpdateFinalizedCheckpoint(fc)
+	}
+	now := prysmTime.Now()
+	err := t.ForkChoicer.UpdateFinalizedCheckpoint(fc)
+	t.write(&Event{Type: EventFinalizedCheckpoint, Time: now, Checkpoint: checkpointFromForkchoice(fc), Error: errString(err)})
+	return err
+}
+
+// Head records the computed head.
+func (t *Tracer) Head(ctx context.Context) ([32]byte, error) {
+	if !t.start() {
+		return t.ForkChoicer.Head(ctx)
+	}
+	now := prysmTime.Now()
+	head, err := t.ForkChoicer.Head(ctx)
+	t.write(&Event{Type: EventHead, Time: now, Root: head[:], Error: errString(err)})
+	return head, err
+}
+
+// SetBalancesByRooter records the balances returned by the handler, so that they can be returned
+// again when replaying. They are recorded before the operation that requested them.
+func (t *Tracer) SetBalancesByRooter(handler forkchoice.BalancesByRooter) {
+	t.ForkChoicer.SetBalancesByRooter(func(ctx context.Context, root [32]byte) ([]uint64, error) {
+		balances, err := handler(ctx, root)
+		if err == nil {
+			t.write(&Event{Type: EventJustifiedBalances, Time: prysmTime.Now(), Root: root[:], Balances: balances})
+		}
+		return balances, err
+	})
+}
+
+// Restore restores the store and starts recording again from a new snapshot.
+func (t *Tracer) Restore(ctx context.Context, snapshot []byte) error {
+	if err := t.ForkChoicer.Restore(ctx, snapshot); err != nil {
+		return err
+	}
+	t.mu.Lock()
+	t.started = false
+	t.mu.Unlock()
+	return nil
+}
@@ -0,0 +1,160 @@
+package tracer
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/types"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func testBlock(t *testing.T, slot primitives.Slot, parent [32]byte, graffiti byte) (state.BeaconState, blocks.ROBlock) {
+	b := util.NewBeaconBlockBellatrix()
+	b.Block.Slot = slot
+	b.Block.ParentRoot = parent[:]
+	b.Block.Body.Graffiti[0] = graffiti
+	b.Block.Body.ExecutionPayload.BlockHash[0] = graffiti
+	signed, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	roblock, err := blocks.NewROBlock(signed)
+	require.NoError(t, err)
+	st, err := util.NewBeaconStateBellatrix()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(slot))
+	return st, roblock
+}
+
+func TestTracer_RecordAndReplay(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "trace.jsonl")
+	fc := doublylinkedtree.New()
+	fc.SetGenesisTime(uint64(time.Now().Unix()) - 2*params.BeaconConfig().SecondsPerSlot - 1)
+	tr, err := New(fc, path)
+	require.NoError(t, err)
+	tr.SetBalancesByRooter(func(context.Context, [32]byte) ([]uint64, error) {
+		return []uint64{10, 20, 30, 40}, nil
+	})
+
+	// Operations on the empty store are not recorded, the snapshot taken at the first recorded operation holds them.
+	st, genesis := testBlock(t, 0, [32]byte{}, 0)
+	require.NoError(t, tr.InsertNode(ctx, st, genesis))
+	require.NoError(t, tr.UpdateJustifiedCheckpoint(ctx, &forkchoicetypes.Checkpoint{Root: genesis.Root()}))
+
+	st, b1 := testBlock(t, 1, genesis.Root(), 1)
+	require.NoError(t, tr.InsertNode(ctx, st, b1))
+	st, b2 := testBlock(t, 2, b1.Root(), 2)
+	require.NoError(t, tr.InsertNode(ctx, st, b2))
+	require.NoError(t, tr.SetOptimisticToValid(ctx, b2.Root()))
+	_, err = tr.Head(ctx)
+	require.NoError(t, err)
+	st, b3 := testBlock(t, 2, b1.Root(), 3)
+	require.NoError(t, tr.InsertNode(ctx, st, b3))
+	tr.ProcessAttestation(ctx, []uint64{0, 1}, b2.Root(), 0)
+	tr.ProcessAttestation(ctx, []uint64{2, 3}, b3.Root(), 0)
+	_, err = tr.Head(ctx)
+	require.NoError(t, err)
+	tr.InsertSlashedIndex(ctx, 3)
+	_, err = tr.Head(ctx)
+	require.NoError(t, err)
+	require.NoError(t, tr.NewSlot(ctx, 3))
+	// The first block of the chain is not inserted, it is the block being processed.
+	_, b4 := testBlock(t, 3, b2.Root(), 4)
+	_, b5 := testBlock(t, 4, b4.Root(), 5)
+	cp := &ethpb.Checkpoint{Root: make([]byte, 32)}
+	require.NoError(t, tr.InsertChain(ctx, []*forkchoicetypes.BlockAndCheckpoints{
+		{Block: b5, JustifiedCheckpoint: cp, FinalizedCheckpoint: cp},
+		{Block: b4, JustifiedCheckpoint: cp, FinalizedCheckpoint: cp},
+	}))
+	require.NoError(t, tr.UpdateJustifiedCheckpoint(ctx, &forkchoicetypes.Checkpoint{Root: b1.Root()}))
+	require.NoError(t, tr.UpdateFinalizedCheckpoint(&forkchoicetypes.Checkpoint{Root: genesis.Root()}))
+	head, err := tr.Head(ctx)
+	require.NoError(t, err)
+	require.NoError(t, tr.Close())
+
+	f, err := os.Open(path) // #nosec G304
+	require.NoError(t, err)
+	defer func() { require.NoError(t, f.Close()) }()
+	r := NewReader(f)
+	rp := NewReplayer()
+	heads := 0
+	for {
+		e, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		require.NoError(t, rp.Apply(ctx, e))
+		require.Equal(t, "", e.Error)
+		if e.Type == EventHead {
+			heads++
+			require.Equal(t, bytesutil.ToBytes32(e.Root), rp.ForkChoice().CachedHeadRoot())
+		}
+	}
+	require.Equal(t, 4, heads)
+	require.Equal(t, head, rp.ForkChoice().CachedHeadRoot())
+	require.Equal(t, fc.NodeCount(), rp.ForkChoice().NodeCount())
+	require.Equal(t, fc.ProposerBoost(), rp.ForkChoice().ProposerBoost())
+	require.DeepEqual(t, fc.JustifiedCheckpoint(), rp.ForkChoice().JustifiedCheckpoint())
+	require.DeepEqual(t, fc.FinalizedCheckpoint(), rp.ForkChoice().FinalizedCheckpoint())
+	for _, root := range [][32]byte{b1.Root(), b2.Root(), b3.Root()} {
+		optimistic, err := fc.IsOptimistic(root)
+		require.NoError(t, err)
+		replayed, err := rp.ForkChoice().IsOptimistic(root)
+		require.NoError(t, err)
+		require.Equal(t, optimistic, replayed)
+	}
+	optimistic, err := rp.ForkChoice().IsOptimistic(b2.Root())
+	require.NoError(t, err)
+	require.Equal(t, false, optimistic)
+	for _, root := range [][32]byte{b1.Root(), b2.Root(), b3.Root(), b4.Root()} {
+		w, err := fc.Weight(root)
+		require.NoError(t, err)
+		rw, err := rp.ForkChoice().Weight(root)
+		require.NoError(t, err)
+		require.Equal(t, w, rw)
+	}
+}
+
+func TestReplayer_RequiresSnapshot(t *testing.T) {
+	rp := NewReplayer()
+	require.ErrorIs(t, rp.Apply(context.Background(), &Event{Type: EventNewSlot, Slot: 1}), errNoSnapshot)
+}
+
+func TestState_ReplayUnrealizedCheckpoints(t *testing.T) {
+	prevRoot, root := [32]byte{'p'}, [32]byte{'r'}
+	s := &State{
+		Slot:              params.BeaconConfig().SlotsPerEpoch*3 + 1,
+		PreviousJustified: &Checkpoint{Epoch: 1, Root: make([]byte, 32)},
+		CurrentJustified:  &Checkpoint{Epoch: 1, Root: make([]byte, 32)},
+		Finalized:         &Checkpoint{Root: make([]byte, 32)},
+		JustificationBits: []byte{0},
+		EpochStartRoots: map[uint64]hexutil.Bytes{
+			uint64(params.BeaconConfig().SlotsPerEpoch * 2): prevRoot[:],
+			uint64(params.BeaconConfig().SlotsPerEpoch * 3): root[:],
+		},
+	}
+	st, err := s.beaconState()
+	require.NoError(t, err)
+	_, _, _, err = st.UnrealizedCheckpointBalances()
+	require.ErrorContains(t, "not recorded", err)
+
+	s.UnrealizedBalances = &UnrealizedBalances{Active: 90, PreviousTarget: 0, CurrentTarget: 60}
+	st, err = s.beaconState()
+	require.NoError(t, err)
+	recorded, err := stateFromBeaconState(st)
+	require.NoError(t, err)
+	require.DeepEqual(t, s, recorded)
+}
@@ -26,13 +26,16 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/builder"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache/depositsnapshot"
+	lightclient "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/light-client"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/pruner"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/slasherkv"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
+	fctracer "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/tracer"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/monitor"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/node/registration"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/attestations"
@@ -46,6 +49,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/statediff"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
 	regularsync "github.com/prysmaticlabs/prysm/v5/beacon-chain/sync"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/sync/backfill"
@@ -56,6 +60,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
 	"github.com/prysmaticlabs/prysm/v5/cmd"
 	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
+	bflags "github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/sync/backfill/flags"
 	"github.com/prysmaticlabs/prysm/v5/config/features"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
@@ -85,42 +90,45 @@ type serviceFlagOpts struct {
 // full PoS node. It handles the lifecycle of the entire system and registers
 // services to a service registry.
 type BeaconNode struct {
-	cliCtx                  *cli.Context
-	ctx                     context.Context
-	cancel                  context.CancelFunc
-	services                *runtime.ServiceRegistry
-	lock                    sync.RWMutex
-	stop                    chan struct{} // Channel to wait for termination notifications.
-	db                      db.Database
-	slasherDB               db.SlasherDatabase
-	attestationCache        *cache.AttestationCache
-	attestationPool         attestations.Pool
-	exitPool                voluntaryexits.PoolManager
-	slashingsPool           slashings.PoolManager
-	syncCommitteePool       synccommittee.Pool
-	blsToExecPool           blstoexec.PoolManager
-	depositCache            cache.DepositCache
-	trackedValidatorsCache  *cache.TrackedValidatorsCache
-	payloadIDCache          *cache.PayloadIDCache
-	stateFeed               *event.Feed
-	blockFeed               *event.Feed
-	opFeed                  *event.Feed
-	stateGen                *stategen.State
-	collector               *bcnodeCollector
-	slasherBlockHeadersFeed *event.Feed
-	slasherAttestationsFeed *event.Feed
-	finalizedStateAtStartUp state.BeaconState
-	serviceFlagOpts         *serviceFlagOpts
-	GenesisInitializer      genesis.Initializer
-	CheckpointInitializer   checkpoint.Initializer
-	forkChoicer             forkchoice.ForkChoicer
-	clockWaiter             startup.ClockWaiter
-	BackfillOpts            []backfill.ServiceOption
-	initialSyncComplete     chan struct{}
-	BlobStorage             *filesystem.BlobStorage
-	BlobStorageOptions      []filesystem.BlobStorageOption
-	verifyInitWaiter        *verification.InitializerWaiter
-	syncChecker             *initialsync.SyncChecker
+	cliCtx                   *cli.Context
+	ctx                      context.Context
+	cancel                   context.CancelFunc
+	services                 *runtime.ServiceRegistry
+	lock                     sync.RWMutex
+	stop                     chan struct{} // Channel to wait for termination notifications.
+	db                       db.Database
+	slasherDB                db.SlasherDatabase
+	attestationCache         *cache.AttestationCache
+	attestationPool          attestations.Pool
+	exitPool                 voluntaryexits.PoolManager
+	slashingsPool            slashings.PoolManager
+	syncCommitteePool        synccommittee.Pool
+	blsToExecPool            blstoexec.PoolManager
+	depositCache             cache.DepositCache
+	trackedValidatorsCache   *cache.TrackedValidatorsCache
+	payloadIDCache           *cache.PayloadIDCache
+	lcStore                  *lightclient.Store
+	stateFeed                *event.Feed
+	blockFeed                *event.Feed
+	opFeed                   *event.Feed
+	stateGen                 *stategen.State
+	collector                *bcnodeCollector
+	slasherBlockHeadersFeed  *event.Feed
+	slasherAttestationsFeed  *event.Feed
+	finalizedStateAtStartUp  state.BeaconState
+	serviceFlagOpts          *serviceFlagOpts
+	GenesisInitializer       genesis.Initializer
+	CheckpointInitializer    checkpoint.Initializer
+	forkChoicer              forkchoice.ForkChoicer
+	clockWaiter              startup.ClockWaiter
+	BackfillOpts             []backfill.ServiceOption
+	initialSyncComplete      chan struct{}
+	BlobStorage              *filesystem.BlobStorage
+	BlobStorageOptions       []filesystem.BlobStorageOption
+	DataColumnStorage        *filesystem.DataColumnStorage
+	DataColumnStorageOptions []filesystem.DataColumnStorageOption
+	verifyInitWaiter         *verification.InitializerWaiter
+	syncChecker              *initialsync.SyncChecker
 }
 
 // New creates a new node instance, sets up configuration options, and registers
@@ -153,6 +161,7 @@ func New(cliCtx *cli.Context, cancel context.CancelFunc, opts ...Option) (*Beaco
 		blsToExecPool:           blstoexec.NewPool(),
 		trackedValidatorsCache:  cache.NewTrackedValidatorsCache(),
 		payloadIDCache:          cache.NewPayloadIDCache(),
+		lcStore:                 lightclient.NewLightClientStore(),
 		slasherBlockHeadersFeed: new(event.Feed),
 		slasherAttestationsFeed: new(event.Feed),
 		serviceFlagOpts:         &serviceFlagOpts{},
@@ -169,6 +178,14 @@ func New(cliCtx *cli.Context, cancel context.CancelFunc, opts ...Option) (*Beaco
 	synchronizer := startup.NewClockSynchronizer()
 	beacon.clockWaiter = synchronizer
 	beacon.forkChoicer = doublylinkedtree.New()
+	if path := cliCtx.String(flags.ForkchoiceTraceFile.Name); path != "" {
+		t, err := fctracer.New(beacon.forkChoicer, path)
+		if err != nil {
+			return nil, err
+		}
+		log.WithField("path", path).Warn("Recording forkchoice operations")
+		beacon.forkChoicer = t
+	}
 
 	depositAddress, err := execution.DepositContractAddress()
 	if err != nil {
@@ -184,6 +201,14 @@ func New(cliCtx *cli.Context, cancel context.CancelFunc, opts ...Option) (*Beaco
 		}
 		beacon.BlobStorage = blobs
 	}
+	if beacon.DataColumnStorage == nil {
+		beacon.DataColumnStorageOptions = append(beacon.DataColumnStorageOptions, filesystem.WithDataColumnSaveFsync(features.Get().BlobSaveFsync))
+		columns, err := filesystem.NewDataColumnStorage(beacon.DataColumnStorageOptions...)
+		if err != nil {
+			return nil, err
+		}
+		beacon.DataColumnStorage = columns
+	}
 
 	bfs, err := startBaseServices(cliCtx, beacon, depositAddress)
 	if err != nil {
@@ -276,6 +301,7 @@ func startBaseServices(cliCtx *cli.Context, beacon *BeaconNode, depositAddress s
 		return nil, errors.Wrap(err, "could not start DB")
 	}
 	beacon.BlobStorage.WarmCache()
+	beacon.DataColumnStorage.WarmCache()
 
 	log.Debugln("Starting Slashing DB")
 	if err := beacon.startSlasherDB(cliCtx); err != nil {
@@ -341,6 +367,11 @@ func registerServices(cliCtx *cli.Context, beacon *BeaconNode, synchronizer *sta
 		return errors.Wrap(err, "could not register slasher service")
 	}
 
+	log.Debugln("Registering Pruner Service")
+	if err := beacon.registerPrunerService(cliCtx, bfs); err != nil {
+		return errors.Wrap(err, "could not register pruner service")
+	}
+
 	log.Debugln("Registering builder service")
 	if err := beacon.registerBuilderService(cliCtx); err != nil {
 		return errors.Wrap(err, "could not register builder service")
@@ -439,6 +470,11 @@ func (b *BeaconNode) Close() {
 
 	log.Info("Stopping beacon node")
 	b.services.StopAll()
+	if t, ok := b.forkChoicer.(*fctracer.Tracer); ok {
+		if err := t.Close(); err != nil {
+			log.WithError(err).Error("Failed to close forkchoice trace")
+		}
+	}
 	if err := b.db.Close(); err != nil {
 		log.WithError(err).Error("Failed to close database")
 	}
@@ -447,7 +483,7 @@ func (b *BeaconNode) Close() {
 	close(b.stop)
 }
 
-func (b *BeaconNode) clearDB(clearDB, forceClearDB bool, d *kv.Store, dbPath string) (*kv.Store, error) {
+func (b *BeaconNode) clearDB(clearDB, forceClearDB bool, d *kv.Store, dbPath string, opts ...kv.KVStoreOption) (*kv.Store, error) {
 	var err error
 	clearDBConfirmed := false
 
@@ -475,7 +511,11 @@ func (b *BeaconNode) clearDB(clearDB, forceClearDB bool, d *kv.Store, dbPath str
 			return nil, errors.Wrap(err, "could not clear blob storage")
 		}
 
-		d, err = kv.NewKVStore(b.ctx, dbPath)
+		if err := b.DataColumnStorage.Clear(); err != nil {
+			return nil, errors.Wrap(err, "could not clear data column storage")
+		}
+
+		d, err = kv.NewKVStore(b.ctx, dbPath, opts...)
 		if err != nil {
 			return nil, errors.Wrap(err, "could not create new database")
 		}
@@ -484,6 +524,39 @@ func (b *BeaconNode) clearDB(clearDB, forceClearDB bool, d *kv.Store, dbPath str
 	return d, nil
 }
 
+// checkStateDiffs ensures that finalized states are stored as state diffs if and only if the user enabled them, as
+// they cannot be enabled on a populated database nor disabled afterwards.
+func checkStateDiffs(cliCtx *cli.Context, d interface{ StateDiffInterval() primitives.Slot }) error {
+	enabled := cliCtx.Bool(flags.EnableStateDiff.Name)
+	if enabled && d.StateDiffInterval() == 0 {
+		return fmt.Errorf("--%s can only be used with a new database, run again with --%s to start from an empty database",
+			flags.EnableStateDiff.Name, cmd.ClearDB.Name)
+	}
+	if !enabled && d.StateDiffInterval() != 0 {
+		return fmt.Errorf("the database stores finalized states as state diffs, --%s cannot be disabled", flags.EnableStateDiff.Name)
+	}
+	return nil
+}
+
+// beaconDBOptions returns the beacon db options configured by the user.
+func beaconDBOptions(cliCtx *cli.Context) ([]kv.KVStoreOption, error) {
+	if !cliCtx.Bool(flags.EnableStateDiff.Name) {
+		return nil, nil
+	}
+	values := cliCtx.IntSlice(flags.StateDiffExponents.Name)
+	exponents := make([]uint64, len(values))
+	for i, v := range values {
+		if v < 0 {
+			return nil, fmt.Errorf("--%s cannot contain negative values", flags.StateDiffExponents.Name)
+		}
+		exponents[i] = uint64(v)
+	}
+	if _, err := statediff.NewSchedule(exponents); err != nil {
+		return nil, errors.Wrapf(err, "invalid --%s", flags.StateDiffExponents.Name)
+	}
+	return []kv.KVStoreOption{kv.WithStateDiffExponents(exponents)}, nil
+}
+
 func (b *BeaconNode) checkAndSaveDepositContract(depositAddress string) error {
 	knownContract, err := b.db.DepositContractAddress(b.ctx)
 	if err != nil {
@@ -517,18 +590,32 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context, depositAddress string) error {
 
 	log.WithField("databasePath", dbPath).Info("Checking DB")
 
-	d, err := kv.NewKVStore(b.ctx, dbPath)
+	dbOpts, err := beaconDBOptions(cliCtx)
+	if err != nil {
+		return err
+	}
+	// When the db is cleared, the options are only applied to the new database, as state diffs cannot be enabled
+	// on a populated one.
+	openOpts := dbOpts
+	if clearDBRequired || forceClearDBRequired {
+		openOpts = nil
+	}
+	d, err := kv.NewKVStore(b.ctx, dbPath, openOpts...)
 	if err != nil {
 		return errors.Wrapf(err, "could not create database at %s", dbPath)
 	}
 
 	if clearDBRequired || forceClearDBRequired {
-		d, err = b.clearDB(clearDBRequired, forceClearDBRequired, d, dbPath)
+		d, err = b.clearDB(clearDBRequired, forceClearDBRequired, d, dbPath, dbOpts...)
 		if err != nil {
 			return errors.Wrap(err, "could not clear database")
 		}
 	}
 
+	if err := checkStateDiffs(cliCtx, d); err != nil {
+		return err
+	}
+
 	if err := d.RunMigrations(b.ctx); err != nil {
 		return err
 	}
@@ -749,9 +836,12 @@ func (b *BeaconNode) registerBlockchainService(fc forkchoice.ForkChoicer, gs *st
 		blockchain.WithClockSynchronizer(gs),
 		blockchain.WithSyncComplete(syncComplete),
 		blockchain.WithBlobStorage(b.BlobStorage),
+		blockchain.WithDataColumnStorage(b.DataColumnStorage),
+		blockchain.WithCustodyManager(b.fetchP2P()),
 		blockchain.WithTrackedValidatorsCache(b.trackedValidatorsCache),
 		blockchain.WithPayloadIDCache(b.payloadIDCache),
 		blockchain.WithSyncChecker(b.syncChecker),
+		blockchain.WithLightClientStore(b.lcStore),
 	)
 
 	blockchainService, err := blockchain.NewService(b.ctx, opts...)
@@ -834,8 +924,10 @@ func (b *BeaconNode) registerSyncService(initialSyncComplete chan struct{}, bFil
 		regularsync.WithInitialSyncComplete(initialSyncComplete),
 		regularsync.WithStateNotifier(b),
 		regularsync.WithBlobStorage(b.BlobStorage),
+		regularsync.WithDataColumnStorage(b.DataColumnStorage),
 		regularsync.WithVerifierWaiter(b.verifyInitWaiter),
 		regularsync.WithAvailableBlocker(bFillStore),
+		regularsync.WithLightClientStore(b.lcStore),
 	)
 	return b.services.RegisterService(rs)
 }
@@ -1099,6 +1191,50 @@ func (b *BeaconNode) RegisterBackfillService(cliCtx *cli.Context, bfs *backfill.
 	return b.services.RegisterService(bf)
 }
 
+var errInvalidPrunerRetentionEpochs = errors.New("value is smaller than spec minimum")
+
+func (b *BeaconNode) registerPrunerService(cliCtx *cli.Context, bfs *backfill.Store) error {
+	if !cliCtx.Bool(flags.BeaconDBPruning.Name) {
+		return nil
+	}
+	if cliCtx.Bool(bflags.EnableExperimentalBackfill.Name) {
+		return fmt.Errorf("--%s cannot be used together with --%s", flags.BeaconDBPruning.Name, bflags.EnableExperimentalBackfill.Name)
+	}
+	if b.db.StateDiffInterval() > 0 {
+		return fmt.Errorf("--%s cannot be used with a database storing finalized states as state diffs", flags.BeaconDBPruning.Name)
+	}
+	retention, err := prunerRetentionEpochs(cliCtx)
+	if err != nil {
+		return err
+	}
+	p, err := pruner.New(
+		b.ctx,
+		b.db,
+		retention,
+		b.clockWaiter,
+		pruner.WithInitSyncWaiter(initSyncWaiter(b.ctx, b.initialSyncComplete)),
+		pruner.WithLowWaterMarkReloader(bfs),
+	)
+	if err != nil {
+		return errors.Wrap(err, "error initializing pruner service")
+	}
+	return b.services.RegisterService(p)
+}
+
+// prunerRetentionEpochs returns the spec MIN_EPOCHS_FOR_BLOCK_REQUESTS or a user-specified flag overriding
+// this value. If a user-specified override is smaller than the spec value, an error will be returned.
+func prunerRetentionEpochs(cliCtx *cli.Context) (primitives.Epoch, error) {
+	spec := primitives.Epoch(params.BeaconConfig().MinEpochsForBlockRequests)
+	if !cliCtx.IsSet(flags.PrunerRetentionEpochs.Name) {
+		return spec, nil
+	}
+	re := primitives.Epoch(cliCtx.Uint64(flags.PrunerRetentionEpochs.Name))
+	if re < spec {
+		return spec, errors.Wrapf(errInvalidPrunerRetentionEpochs, "%s=%d, spec=%d", flags.PrunerRetentionEpochs.Name, re, spec)
+	}
+	return re, nil
+}
+
 func hasNetworkFlag(cliCtx *cli.Context) bool {
 	for _, flag := range features.NetworkFlags {
 		for _, name := range flag.Names() {
@@ -19,7 +19,10 @@ import (
 	mockExecution "github.com/prysmaticlabs/prysm/v5/beacon-chain/execution/testing"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/monitor"
 	"github.com/prysmaticlabs/prysm/v5/cmd"
+	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/v5/config/features"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/runtime"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
@@ -54,7 +57,8 @@ func TestNodeClose_OK(t *testing.T) {
 	cmd.ValidatorMonitorIndicesFlag.Value.SetInt(1)
 	ctx, cancel := newCliContextWithCancel(&app, set)
 
-	node, err := New(ctx, cancel, WithBlobStorage(filesystem.NewEphemeralBlobStorage(t)))
+	node, err := New(ctx, cancel, WithBlobStorage(filesystem.NewEphemeralBlobStorage(t)),
+		WithDataColumnStorage(filesystem.NewEphemeralDataColumnStorage(t)))
 	require.NoError(t, err)
 
 	node.Close()
@@ -75,7 +79,8 @@ func TestNodeStart_Ok(t *testing.T) {
 	node, err := New(ctx, cancel, WithBlockchainFlagOptions([]blockchain.Option{}),
 		WithBuilderFlagOptions([]builder.Option{}),
 		WithExecutionChainOptions([]execution.Option{}),
-		WithBlobStorage(filesystem.NewEphemeralBlobStorage(t)))
+		WithBlobStorage(filesystem.NewEphemeralBlobStorage(t)),
+		WithDataColumnStorage(filesystem.NewEphemeralDataColumnStorage(t)))
 	require.NoError(t, err)
 	node.services = &runtime.ServiceRegistry{}
 	go func() {
@@ -99,7 +104,8 @@ func TestNodeStart_SyncChecker(t *testing.T) {
 	node, err := New(ctx, cancel, WithBlockchainFlagOptions([]blockchain.Option{}),
 		WithBuilderFlagOptions([]builder.Option{}),
 		WithExecutionChainOptions([]execution.Option{}),
-		WithBlobStorage(filesystem.NewEphemeralBlobStorage(t)))
+		WithBlobStorage(filesystem.NewEphemeralBlobStorage(t)),
+		WithDataColumnStorage(filesystem.NewEphemeralDataColumnStorage(t)))
 	require.NoError(t, err)
 	go func() {
 		node.Start()
@@ -131,6 +137,7 @@ func TestClearDB(t *testing.T) {
 	options := []Option{
 		WithExecutionChainOptions([]execution.Option{execution.WithHttpEndpoint(endpoint)}),
 		WithBlobStorage(filesystem.NewEphemeralBlobStorage(t)),
+		WithDataColumnStorage(filesystem.NewEphemeralDataColumnStorage(t)),
 	}
 	_, err = New(context, cancel, options...)
 	require.NoError(t, err)
@@ -196,6 +203,64 @@ func Test_hasNetworkFlag(t *testing.T) {
 	}
 }
 
+func Test_prunerRetentionEpochs(t *testing.T) {
+	spec := primitives.Epoch(params.BeaconConfig().MinEpochsForBlockRequests)
+
+	set := flag.NewFlagSet("test", 0)
+	set.Uint64(flags.PrunerRetentionEpochs.Name, 0, "")
+	cliCtx := cli.NewContext(&cli.App{}, set, nil)
+	re, err := prunerRetentionEpochs(cliCtx)
+	require.NoError(t, err)
+	require.Equal(t, spec, re)
+
+	require.NoError(t, cliCtx.Set(flags.PrunerRetentionEpochs.Name, fmt.Sprintf("%d", spec+10)))
+	re, err = prunerRetentionEpochs(cliCtx)
+	require.NoError(t, err)
+	require.Equal(t, spec+10, re)
+
+	require.NoError(t, cliCtx.Set(flags.PrunerRetentionEpochs.Name, fmt.Sprintf("%d", spec-1)))
+	_, err = prunerRetentionEpochs(cliCtx)
+	require.ErrorIs(t, err, errInvalidPrunerRetentionEpochs)
+}
+
+func Test_beaconDBOptions(t *testing.T) {
+	set := flag.NewFlagSet("test", 0)
+	set.Bool(flags.EnableStateDiff.Name, false, "")
+	require.NoError(t, flags.StateDiffExponents.Apply(set))
+	cliCtx := cli.NewContext(&cli.App{}, set, nil)
+	opts, err := beaconDBOptions(cliCtx)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(opts))
+
+	require.NoError(t, cliCtx.Set(flags.EnableStateDiff.Name, "true"))
+	opts, err = beaconDBOptions(cliCtx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(opts))
+
+	require.NoError(t, cliCtx.Set(flags.StateDiffExponents.Name, "5"))
+	require.NoError(t, cliCtx.Set(flags.StateDiffExponents.Name, "8"))
+	_, err = beaconDBOptions(cliCtx)
+	require.ErrorContains(t, "strictly decreasing", err)
+}
+
+type stateDiffIntervalDB primitives.Slot
+
+func (d stateDiffIntervalDB) StateDiffInterval() primitives.Slot {
+	return primitives.Slot(d)
+}
+
+func Test_checkStateDiffs(t *testing.T) {
+	set := flag.NewFlagSet("test", 0)
+	set.Bool(flags.EnableStateDiff.Name, false, "")
+	cliCtx := cli.NewContext(&cli.App{}, set, nil)
+	require.NoError(t, checkStateDiffs(cliCtx, stateDiffIntervalDB(0)))
+	require.ErrorContains(t, "cannot be disabled", checkStateDiffs(cliCtx, stateDiffIntervalDB(16)))
+
+	require.NoError(t, cliCtx.Set(flags.EnableStateDiff.Name, "true"))
+	require.NoError(t, checkStateDiffs(cliCtx, stateDiffIntervalDB(16)))
+	require.ErrorContains(t, "can only be used with a new database", checkStateDiffs(cliCtx, stateDiffIntervalDB(0)))
+}
+
 func TestCORS(t *testing.T) {
 	router := http.NewServeMux()
 	// Ensure a test route exists
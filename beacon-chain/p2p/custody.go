@@ -0,0 +1,64 @@
+package p2p
+
+import (
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/peerdas"
+	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/sirupsen/logrus"
+)
+
+// NodeID returns the discovery node ID of the local node, from which its custody groups are derived.
+func (s *Service) NodeID() enode.ID {
+	return enode.PubkeyToIDV4(&s.privKey.PublicKey)
+}
+
+// CustodyGroupCount returns the number of custody groups this node is responsible for.
+// A node subscribed to all data subnets custodies every group, otherwise it custodies the
+// spec minimum of CUSTODY_REQUIREMENT groups.
+func (s *Service) CustodyGroupCount() uint64 {
+	if flags.Get().SubscribeAllDataSubnets {
+		return params.BeaconConfig().NumberOfCustodyGroups
+	}
+	return params.BeaconConfig().CustodyRequirement
+}
+
+// CustodyGroupCountFromPeer retrieves the custody group count advertised in the ENR of the given peer.
+// If the peer is unknown or does not advertise a custody group count, the spec minimum is returned.
+func (s *Service) CustodyGroupCountFromPeer(pid peer.ID) uint64 {
+	custodyRequirement := params.BeaconConfig().CustodyRequirement
+
+	record, err := s.peers.ENR(pid)
+	if err != nil || record == nil {
+		return custodyRequirement
+	}
+	cgc, err := peerdas.CustodyGroupCountFromRecord(record)
+	if err != nil {
+		log.WithError(err).WithField("peerID", pid).Trace("Could not retrieve custody group count from peer ENR, using default")
+		return custodyRequirement
+	}
+	return cgc
+}
+
+// filterPeerForDataColumnsSubnet returns a method which filters peers custodying the given data column subnet.
+func (s *Service) filterPeerForDataColumnsSubnet(index uint64) func(node *enode.Node) bool {
+	return func(node *enode.Node) bool {
+		if !s.filterPeer(node) {
+			return false
+		}
+		cgc, err := peerdas.CustodyGroupCountFromRecord(node.Record())
+		if err != nil {
+			return false
+		}
+		columns, err := peerdas.CustodyColumnsForNode(node.ID(), cgc)
+		if err != nil {
+			log.WithError(err).WithFields(logrus.Fields{
+				"nodeID": node.ID(),
+				"cgc":    cgc,
+			}).Debug("Could not compute custody columns for node")
+			return false
+		}
+		return peerdas.DataColumnSubnets(columns)[index]
+	}
+}
@@ -0,0 +1,64 @@
+package p2p
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/peerdas"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/scorers"
+	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestCustodyGroupCount(t *testing.T) {
+	s := &Service{}
+	require.Equal(t, params.BeaconConfig().CustodyRequirement, s.CustodyGroupCount())
+
+	resetFlags := flags.Get()
+	defer flags.Init(resetFlags)
+	flags.Init(&flags.GlobalFlags{SubscribeAllDataSubnets: true})
+	require.Equal(t, params.BeaconConfig().NumberOfCustodyGroups, s.CustodyGroupCount())
+}
+
+func TestCustodyGroupCountFromPeer(t *testing.T) {
+	const expected uint64 = 7
+	s := &Service{
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			ScorerParams: &scorers.Config{},
+		}),
+	}
+
+	priv, _, err := crypto.GenerateSecp256k1Key(rand.Reader)
+	require.NoError(t, err)
+	pid, err := peer.IDFromPrivateKey(priv)
+	require.NoError(t, err)
+
+	// Unknown peer falls back to the spec minimum.
+	require.Equal(t, params.BeaconConfig().CustodyRequirement, s.CustodyGroupCountFromPeer(pid))
+
+	// Known peer without a cgc entry falls back to the spec minimum.
+	record := &enr.Record{}
+	s.peers.Add(record, pid, nil, network.DirOutbound)
+	require.Equal(t, params.BeaconConfig().CustodyRequirement, s.CustodyGroupCountFromPeer(pid))
+
+	// Known peer advertising a cgc entry.
+	record.Set(peerdas.Cgc(expected))
+	s.peers.Add(record, pid, nil, network.DirOutbound)
+	require.Equal(t, expected, s.CustodyGroupCountFromPeer(pid))
+}
+
+func TestNodeFilter_DataColumnSubnet(t *testing.T) {
+	s := &Service{}
+	filter, err := s.nodeFilter(DataColumnSubnetTopicFormat, 3)
+	require.NoError(t, err)
+	require.NotNil(t, filter)
+	// Nil nodes are always filtered out.
+	require.Equal(t, false, filter(nil))
+}
@@ -130,3 +130,35 @@ func TestGossipTopicMappings_CorrectType(t *testing.T) {
 	_, ok = pMessage.(*ethpb.SignedAggregateAttestationAndProofElectra)
 	assert.Equal(t, true, ok)
 }
+
+func TestGossipTopicMappings_LightClientUpdates(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	bCfg := params.BeaconConfig().Copy()
+	bCfg.AltairForkEpoch = 100
+	bCfg.BellatrixForkEpoch = 200
+	bCfg.CapellaForkEpoch = 300
+	bCfg.DenebForkEpoch = 400
+	bCfg.ElectraForkEpoch = 500
+	bCfg.FuluForkEpoch = 600
+	params.OverrideBeaconConfig(bCfg)
+
+	tests := []struct {
+		epoch      primitives.Epoch
+		finality   interface{}
+		optimistic interface{}
+	}{
+		{epoch: 100, finality: &ethpb.LightClientFinalityUpdateAltair{}, optimistic: &ethpb.LightClientOptimisticUpdateAltair{}},
+		{epoch: 200, finality: &ethpb.LightClientFinalityUpdateAltair{}, optimistic: &ethpb.LightClientOptimisticUpdateAltair{}},
+		{epoch: 300, finality: &ethpb.LightClientFinalityUpdateCapella{}, optimistic: &ethpb.LightClientOptimisticUpdateCapella{}},
+		{epoch: 400, finality: &ethpb.LightClientFinalityUpdateDeneb{}, optimistic: &ethpb.LightClientOptimisticUpdateDeneb{}},
+		{epoch: 500, finality: &ethpb.LightClientFinalityUpdateElectra{}, optimistic: &ethpb.LightClientOptimisticUpdateDeneb{}},
+		{epoch: 600, finality: &ethpb.LightClientFinalityUpdateElectra{}, optimistic: &ethpb.LightClientOptimisticUpdateDeneb{}},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, reflect.TypeOf(tt.finality), reflect.TypeOf(GossipTopicMappings(LightClientFinalityUpdateTopicFormat, tt.epoch)))
+		assert.Equal(t, reflect.TypeOf(tt.optimistic), reflect.TypeOf(GossipTopicMappings(LightClientOptimisticUpdateTopicFormat, tt.epoch)))
+		// Every versioned update can be broadcast to its topic.
+		assert.Equal(t, LightClientFinalityUpdateTopicFormat, GossipTypeMapping[reflect.TypeOf(tt.finality)])
+		assert.Equal(t, LightClientOptimisticUpdateTopicFormat, GossipTypeMapping[reflect.TypeOf(tt.optimistic)])
+	}
+}
@@ -72,6 +72,14 @@ var (
 		Name: "p2p_blob_sidecar_committee_attempted_broadcasts",
 		Help: "The number of blob sidecar committee messages that were attempted to be broadcast.",
 	})
+	dataColumnSidecarCommitteeBroadcasts = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "p2p_data_column_sidecar_committee_broadcasts",
+		Help: "The number of data column sidecar messages that were broadcast with no peer on.",
+	})
+	dataColumnSidecarCommitteeBroadcastAttempts = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "p2p_data_column_sidecar_committee_attempted_broadcasts",
+		Help: "The number of data column sidecar messages that were attempted to be broadcast.",
+	})
 
 	// Gossip Tracer Metrics
 	pubsubTopicsActive = promauto.NewGaugeVec(prometheus.GaugeOpts{
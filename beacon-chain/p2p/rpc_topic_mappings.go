@@ -43,6 +43,24 @@ const BlobSidecarsByRangeName = "/blob_sidecars_by_range"
 // BlobSidecarsByRootName is the name for the BlobSidecarsByRoot v1 message topic.
 const BlobSidecarsByRootName = "/blob_sidecars_by_root"
 
+// DataColumnSidecarsByRootName is the name for the DataColumnSidecarsByRoot v1 message topic.
+const DataColumnSidecarsByRootName = "/data_column_sidecars_by_root"
+
+// DataColumnSidecarsByRangeName is the name for the DataColumnSidecarsByRange v1 message topic.
+const DataColumnSidecarsByRangeName = "/data_column_sidecars_by_range"
+
+// LightClientBootstrapName is the name for the LightClientBootstrap v1 message topic.
+const LightClientBootstrapName = "/light_client_bootstrap"
+
+// LightClientUpdatesByRangeName is the name for the LightClientUpdatesByRange v1 message topic.
+const LightClientUpdatesByRangeName = "/light_client_updates_by_range"
+
+// LightClientFinalityUpdateName is the name for the GetLightClientFinalityUpdate v1 message topic.
+const LightClientFinalityUpdateName = "/light_client_finality_update"
+
+// LightClientOptimisticUpdateName is the name for the GetLightClientOptimisticUpdate v1 message topic.
+const LightClientOptimisticUpdateName = "/light_client_optimistic_update"
+
 const (
 	// V1 RPC Topics
 	// RPCStatusTopicV1 defines the v1 topic for the status rpc method.
@@ -65,6 +83,26 @@ const (
 	// RPCBlobSidecarsByRootTopicV1 is a topic for requesting blob sidecars by their block root. New in deneb.
 	// /eth2/beacon_chain/req/blob_sidecars_by_root/1/
 	RPCBlobSidecarsByRootTopicV1 = protocolPrefix + BlobSidecarsByRootName + SchemaVersionV1
+	// RPCDataColumnSidecarsByRootTopicV1 is a topic for requesting data column sidecars by their block root. New in fulu.
+	// /eth2/beacon_chain/req/data_column_sidecars_by_root/1/
+	RPCDataColumnSidecarsByRootTopicV1 = protocolPrefix + DataColumnSidecarsByRootName + SchemaVersionV1
+	// RPCDataColumnSidecarsByRangeTopicV1 is a topic for requesting data column sidecars
+	// in the slot range [start_slot, start_slot + count), leading up to the current head block as selected by fork choice.
+	// /eth2/beacon_chain/req/data_column_sidecars_by_range/1/ - New in fulu.
+	RPCDataColumnSidecarsByRangeTopicV1 = protocolPrefix + DataColumnSidecarsByRangeName + SchemaVersionV1
+	// RPCLightClientBootstrapTopicV1 is a topic for requesting the light client bootstrap of a trusted block root.
+	// /eth2/beacon_chain/req/light_client_bootstrap/1/ - New in altair.
+	RPCLightClientBootstrapTopicV1 = protocolPrefix + LightClientBootstrapName + SchemaVersionV1
+	// RPCLightClientUpdatesByRangeTopicV1 is a topic for requesting the best light client update of each
+	// sync committee period in the range [start_period, start_period + count).
+	// /eth2/beacon_chain/req/light_client_updates_by_range/1/ - New in altair.
+	RPCLightClientUpdatesByRangeTopicV1 = protocolPrefix + LightClientUpdatesByRangeName + SchemaVersionV1
+	// RPCLightClientFinalityUpdateTopicV1 is a topic for requesting the latest light client finality update.
+	// /eth2/beacon_chain/req/light_client_finality_update/1/ - New in altair.
+	RPCLightClientFinalityUpdateTopicV1 = protocolPrefix + LightClientFinalityUpdateName + SchemaVersionV1
+	// RPCLightClientOptimisticUpdateTopicV1 is a topic for requesting the latest light client optimistic update.
+	// /eth2/beacon_chain/req/light_client_optimistic_update/1/ - New in altair.
+	RPCLightClientOptimisticUpdateTopicV1 = protocolPrefix + LightClientOptimisticUpdateName + SchemaVersionV1
 
 	// V2 RPC Topics
 	// RPCBlocksByRangeTopicV2 defines v2 the topic for the blocks by range rpc method.
@@ -101,6 +139,32 @@ var RPCTopicMappings = map[string]interface{}{
 	RPCBlobSidecarsByRangeTopicV1: new(pb.BlobSidecarsByRangeRequest),
 	// BlobSidecarsByRoot v1 Message
 	RPCBlobSidecarsByRootTopicV1: new(p2ptypes.BlobSidecarsByRootReq),
+	// DataColumnSidecarsByRoot v1 Message
+	RPCDataColumnSidecarsByRootTopicV1: new(p2ptypes.DataColumnSidecarsByRootReq),
+	// DataColumnSidecarsByRange v1 Message
+	RPCDataColumnSidecarsByRangeTopicV1: new(pb.DataColumnSidecarsByRangeRequest),
+	// LightClientBootstrap v1 Message
+	RPCLightClientBootstrapTopicV1: new(p2ptypes.LightClientBootstrapReq),
+	// LightClientUpdatesByRange v1 Message
+	RPCLightClientUpdatesByRangeTopicV1: new(p2ptypes.LightClientUpdatesByRangeReq),
+	// GetLightClientFinalityUpdate v1 Message
+	RPCLightClientFinalityUpdateTopicV1: new(interface{}),
+	// GetLightClientOptimisticUpdate v1 Message
+	RPCLightClientOptimisticUpdateTopicV1: new(interface{}),
+}
+
+// emptyRequestTopics are the RPC topics whose requests carry no payload.
+var emptyRequestTopics = map[string]bool{
+	RPCMetaDataTopicV1:                    true,
+	RPCMetaDataTopicV2:                    true,
+	RPCLightClientFinalityUpdateTopicV1:   true,
+	RPCLightClientOptimisticUpdateTopicV1: true,
+}
+
+// HasEmptyRequest returns true if requests on the given base topic carry no payload,
+// so nothing should be encoded or decoded for them.
+func HasEmptyRequest(baseTopic string) bool {
+	return emptyRequestTopics[baseTopic]
 }
 
 // Maps all registered protocol prefixes.
@@ -111,14 +175,20 @@ var protocolMapping = map[string]bool{
 // Maps all the protocol message names for the different rpc
 // topics.
 var messageMapping = map[string]bool{
-	StatusMessageName:              true,
-	GoodbyeMessageName:             true,
-	BeaconBlocksByRangeMessageName: true,
-	BeaconBlocksByRootsMessageName: true,
-	PingMessageName:                true,
-	MetadataMessageName:            true,
-	BlobSidecarsByRangeName:        true,
-	BlobSidecarsByRootName:         true,
+	StatusMessageName:               true,
+	GoodbyeMessageName:              true,
+	BeaconBlocksByRangeMessageName:  true,
+	BeaconBlocksByRootsMessageName:  true,
+	PingMessageName:                 true,
+	MetadataMessageName:             true,
+	BlobSidecarsByRangeName:         true,
+	BlobSidecarsByRootName:          true,
+	DataColumnSidecarsByRootName:    true,
+	DataColumnSidecarsByRangeName:   true,
+	LightClientBootstrapName:        true,
+	LightClientUpdatesByRangeName:   true,
+	LightClientFinalityUpdateName:   true,
+	LightClientOptimisticUpdateName: true,
 }
 
 // Maps all the RPC messages which are to updated in altair.
@@ -25,6 +25,16 @@ func TestVerifyRPCMappings(t *testing.T) {
 	assert.NoError(t, VerifyTopicMapping(RPCBlocksByRootTopicV1, new(types.BeaconBlockByRootsReq)), "Failed to verify blocks by root rpc topic")
 }
 
+func TestHasEmptyRequest(t *testing.T) {
+	assert.Equal(t, true, HasEmptyRequest(RPCMetaDataTopicV1))
+	assert.Equal(t, true, HasEmptyRequest(RPCMetaDataTopicV2))
+	assert.Equal(t, true, HasEmptyRequest(RPCLightClientFinalityUpdateTopicV1))
+	assert.Equal(t, true, HasEmptyRequest(RPCLightClientOptimisticUpdateTopicV1))
+	assert.Equal(t, false, HasEmptyRequest(RPCLightClientBootstrapTopicV1))
+	assert.Equal(t, false, HasEmptyRequest(RPCLightClientUpdatesByRangeTopicV1))
+	assert.Equal(t, false, HasEmptyRequest(RPCStatusTopicV1))
+}
+
 func TestTopicDeconstructor(t *testing.T) {
 	params.SetupTestConfigCleanup(t)
 	tt := []struct {
@@ -207,7 +207,150 @@ func (s BlobSidecarsByRootReq) Len() int {
 	return len(s)
 }
 
+// DataColumnSidecarsByRootReq is used to specify a list of data column targets (root+index) in a DataColumnSidecarsByRoot RPC request.
+type DataColumnSidecarsByRootReq []*eth.DataColumnIdentifier
+
+// DataColumnIdentifier is a fixed size value, so we can compute its fixed size at start time (see init below)
+var dataColumnIdSize int
+
+// SizeSSZ returns the size of the serialized representation.
+func (d *DataColumnSidecarsByRootReq) SizeSSZ() int {
+	return len(*d) * dataColumnIdSize
+}
+
+// MarshalSSZTo appends the serialized DataColumnSidecarsByRootReq value to the provided byte slice.
+func (d *DataColumnSidecarsByRootReq) MarshalSSZTo(dst []byte) ([]byte, error) {
+	// A List without an enclosing container is marshaled exactly like a vector, no length offset required.
+	marshalledObj, err := d.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	return append(dst, marshalledObj...), nil
+}
+
+// MarshalSSZ serializes the DataColumnSidecarsByRootReq value to a byte slice.
+func (d *DataColumnSidecarsByRootReq) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, len(*d)*dataColumnIdSize)
+	for i, id := range *d {
+		by, err := id.MarshalSSZ()
+		if err != nil {
+			return nil, err
+		}
+		copy(buf[i*dataColumnIdSize:(i+1)*dataColumnIdSize], by)
+	}
+	return buf, nil
+}
+
+// UnmarshalSSZ unmarshals the provided bytes buffer into the
+// DataColumnSidecarsByRootReq value.
+func (d *DataColumnSidecarsByRootReq) UnmarshalSSZ(buf []byte) error {
+	bufLen := len(buf)
+	maxLength := int(params.BeaconConfig().MaxRequestDataColumnSidecars) * dataColumnIdSize
+	if bufLen > maxLength {
+		return errors.Errorf("expected buffer with length of up to %d but received length %d", maxLength, bufLen)
+	}
+	if bufLen%dataColumnIdSize != 0 {
+		return errors.Wrapf(ssz.ErrIncorrectByteSize, "size=%d", bufLen)
+	}
+	count := bufLen / dataColumnIdSize
+	*d = make([]*eth.DataColumnIdentifier, count)
+	for i := 0; i < count; i++ {
+		id := &eth.DataColumnIdentifier{}
+		err := id.UnmarshalSSZ(buf[i*dataColumnIdSize : (i+1)*dataColumnIdSize])
+		if err != nil {
+			return err
+		}
+		(*d)[i] = id
+	}
+	return nil
+}
+
+var _ sort.Interface = DataColumnSidecarsByRootReq{}
+
+// Less reports whether the element with index i must sort before the element with index j.
+// DataColumnIdentifier will be sorted in lexicographic order by root, with column index as tiebreaker for a given root.
+func (s DataColumnSidecarsByRootReq) Less(i, j int) bool {
+	rootCmp := bytes.Compare(s[i].BlockRoot, s[j].BlockRoot)
+	if rootCmp != 0 {
+		return rootCmp < 0
+	}
+	return s[i].ColumnIndex < s[j].ColumnIndex
+}
+
+// Swap swaps the elements with indexes i and j.
+func (s DataColumnSidecarsByRootReq) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+// Len is the number of elements in the collection.
+func (s DataColumnSidecarsByRootReq) Len() int {
+	return len(s)
+}
+
+// LightClientBootstrapReq is the block root of the trusted checkpoint a LightClientBootstrap is requested for.
+type LightClientBootstrapReq [rootLength]byte
+
+// SizeSSZ returns the size of the serialized representation.
+func (*LightClientBootstrapReq) SizeSSZ() int {
+	return rootLength
+}
+
+// MarshalSSZTo appends the serialized LightClientBootstrapReq value to the provided byte slice.
+func (r *LightClientBootstrapReq) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return append(dst, r[:]...), nil
+}
+
+// MarshalSSZ serializes the LightClientBootstrapReq value to a byte slice.
+func (r *LightClientBootstrapReq) MarshalSSZ() ([]byte, error) {
+	return r.MarshalSSZTo(make([]byte, 0, rootLength))
+}
+
+// UnmarshalSSZ unmarshals the provided bytes buffer into the LightClientBootstrapReq value.
+func (r *LightClientBootstrapReq) UnmarshalSSZ(buf []byte) error {
+	if len(buf) != rootLength {
+		return errors.Wrapf(ssz.ErrIncorrectByteSize, "size=%d", len(buf))
+	}
+	copy(r[:], buf)
+	return nil
+}
+
+// lightClientUpdatesByRangeReqSize is the size of the two uint64 fields of LightClientUpdatesByRangeReq.
+const lightClientUpdatesByRangeReqSize = 16
+
+// LightClientUpdatesByRangeReq specifies the sync committee periods requested in a LightClientUpdatesByRange RPC request.
+type LightClientUpdatesByRangeReq struct {
+	StartPeriod uint64
+	Count       uint64
+}
+
+// SizeSSZ returns the size of the serialized representation.
+func (*LightClientUpdatesByRangeReq) SizeSSZ() int {
+	return lightClientUpdatesByRangeReqSize
+}
+
+// MarshalSSZTo appends the serialized LightClientUpdatesByRangeReq value to the provided byte slice.
+func (r *LightClientUpdatesByRangeReq) MarshalSSZTo(dst []byte) ([]byte, error) {
+	dst = ssz.MarshalUint64(dst, r.StartPeriod)
+	return ssz.MarshalUint64(dst, r.Count), nil
+}
+
+// MarshalSSZ serializes the LightClientUpdatesByRangeReq value to a byte slice.
+func (r *LightClientUpdatesByRangeReq) MarshalSSZ() ([]byte, error) {
+	return r.MarshalSSZTo(make([]byte, 0, lightClientUpdatesByRangeReqSize))
+}
+
+// UnmarshalSSZ unmarshals the provided bytes buffer into the LightClientUpdatesByRangeReq value.
+func (r *LightClientUpdatesByRangeReq) UnmarshalSSZ(buf []byte) error {
+	if len(buf) != lightClientUpdatesByRangeReqSize {
+		return errors.Wrapf(ssz.ErrIncorrectByteSize, "size=%d", len(buf))
+	}
+	r.StartPeriod = ssz.UnmarshallUint64(buf[0:8])
+	r.Count = ssz.UnmarshallUint64(buf[8:16])
+	return nil
+}
+
 func init() {
 	sizer := &eth.BlobIdentifier{}
 	blobIdSize = sizer.SizeSSZ()
+	dataColumnIdSize = (&eth.DataColumnIdentifier{}).SizeSSZ()
 }
@@ -80,6 +80,53 @@ func TestBlobSidecarsByRootReq_MarshalSSZ(t *testing.T) {
 	}
 }
 
+func TestDataColumnSidecarsByRootReq_MarshalSSZ(t *testing.T) {
+	ids := make([]*eth.DataColumnIdentifier, 10)
+	for i := range ids {
+		ids[i] = &eth.DataColumnIdentifier{
+			BlockRoot:   bytesutil.PadTo([]byte{byte(i)}, 32),
+			ColumnIndex: uint64(i),
+		}
+	}
+	r := DataColumnSidecarsByRootReq(ids)
+	by, err := r.MarshalSSZ()
+	require.NoError(t, err)
+
+	got := &DataColumnSidecarsByRootReq{}
+	require.NoError(t, got.UnmarshalSSZ(by))
+	for i, gid := range *got {
+		require.DeepEqual(t, ids[i], gid)
+	}
+
+	require.ErrorIs(t, got.UnmarshalSSZ(append(by, byte(0))), ssz.ErrIncorrectByteSize)
+	tooLong := make([]byte, (int(params.BeaconConfig().MaxRequestDataColumnSidecars)+1)*dataColumnIdSize)
+	require.ErrorContains(t, "expected buffer with length of up to", got.UnmarshalSSZ(tooLong))
+}
+
+func TestLightClientBootstrapReq_MarshalSSZ(t *testing.T) {
+	r := LightClientBootstrapReq(bytesutil.ToBytes32([]byte{'a', 'b'}))
+	by, err := r.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, 32, len(by))
+
+	got := &LightClientBootstrapReq{}
+	require.NoError(t, got.UnmarshalSSZ(by))
+	require.Equal(t, r, *got)
+	require.ErrorIs(t, got.UnmarshalSSZ(by[1:]), ssz.ErrIncorrectByteSize)
+}
+
+func TestLightClientUpdatesByRangeReq_MarshalSSZ(t *testing.T) {
+	r := &LightClientUpdatesByRangeReq{StartPeriod: 42, Count: 7}
+	by, err := r.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, r.SizeSSZ(), len(by))
+
+	got := &LightClientUpdatesByRangeReq{}
+	require.NoError(t, got.UnmarshalSSZ(by))
+	require.DeepEqual(t, r, got)
+	require.ErrorIs(t, got.UnmarshalSSZ(append(by, 0)), ssz.ErrIncorrectByteSize)
+}
+
 func TestBeaconBlockByRootsReq_Limit(t *testing.T) {
 	fixedRoots := make([][32]byte, 0)
 	for i := uint64(0); i < params.BeaconConfig().MaxRequestBlocks+100; i++ {
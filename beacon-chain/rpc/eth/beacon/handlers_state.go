@@ -8,6 +8,8 @@ import (
 	"strconv"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ssz "github.com/prysmaticlabs/fastssz"
+	"github.com/prysmaticlabs/prysm/v5/api"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/altair"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/helpers"
@@ -20,6 +22,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 	ethpbalpha "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 )
 
@@ -333,3 +336,146 @@ func (s *Server) stateForSyncCommittee(ctx context.Context, w http.ResponseWrite
 	}
 	return st, true
 }
+
+// pendingQueueState is the state from which one of the Electra pending queues is read, along with the metadata
+// returned alongside the queue.
+type pendingQueueState struct {
+	st          state.BeaconState
+	optimistic  bool
+	finalized   bool
+	versionName string
+}
+
+// GetPendingDeposits returns the deposits waiting to be processed in the pending deposits queue of the state.
+func (s *Server) GetPendingDeposits(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetPendingDeposits")
+	defer span.End()
+
+	q, ok := s.pendingQueueStateForRequest(ctx, w, r)
+	if !ok {
+		return
+	}
+	deposits, err := q.st.PendingDeposits()
+	if err != nil {
+		httputil.HandleError(w, "Could not get pending deposits: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set(api.VersionHeader, q.versionName)
+	if httputil.RespondWithSsz(r) {
+		writeSszList(w, deposits, "pending_deposits.ssz")
+		return
+	}
+	httputil.WriteJson(w, &structs.GetPendingDepositsResponse{
+		Version:             q.versionName,
+		ExecutionOptimistic: q.optimistic,
+		Finalized:           q.finalized,
+		Data:                structs.PendingDepositsFromConsensus(deposits),
+	})
+}
+
+// GetPendingPartialWithdrawals returns the partial withdrawals waiting to be processed in the pending partial
+// withdrawals queue of the state.
+func (s *Server) GetPendingPartialWithdrawals(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetPendingPartialWithdrawals")
+	defer span.End()
+
+	q, ok := s.pendingQueueStateForRequest(ctx, w, r)
+	if !ok {
+		return
+	}
+	withdrawals, err := q.st.PendingPartialWithdrawals()
+	if err != nil {
+		httputil.HandleError(w, "Could not get pending partial withdrawals: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set(api.VersionHeader, q.versionName)
+	if httputil.RespondWithSsz(r) {
+		writeSszList(w, withdrawals, "pending_partial_withdrawals.ssz")
+		return
+	}
+	httputil.WriteJson(w, &structs.GetPendingPartialWithdrawalsResponse{
+		Version:             q.versionName,
+		ExecutionOptimistic: q.optimistic,
+		Finalized:           q.finalized,
+		Data:                structs.PendingPartialWithdrawalsFromConsensus(withdrawals),
+	})
+}
+
+// GetPendingConsolidations returns the consolidations waiting to be processed in the pending consolidations queue
+// of the state.
+func (s *Server) GetPendingConsolidations(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetPendingConsolidations")
+	defer span.End()
+
+	q, ok := s.pendingQueueStateForRequest(ctx, w, r)
+	if !ok {
+		return
+	}
+	consolidations, err := q.st.PendingConsolidations()
+	if err != nil {
+		httputil.HandleError(w, "Could not get pending consolidations: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set(api.VersionHeader, q.versionName)
+	if httputil.RespondWithSsz(r) {
+		writeSszList(w, consolidations, "pending_consolidations.ssz")
+		return
+	}
+	httputil.WriteJson(w, &structs.GetPendingConsolidationsResponse{
+		Version:             q.versionName,
+		ExecutionOptimistic: q.optimistic,
+		Finalized:           q.finalized,
+		Data:                structs.PendingConsolidationsFromConsensus(consolidations),
+	})
+}
+
+// pendingQueueStateForRequest fetches the post-Electra state identified by the state_id URL param. An error response
+// is written and false is returned if the state cannot be used.
+func (s *Server) pendingQueueStateForRequest(ctx context.Context, w http.ResponseWriter, r *http.Request) (*pendingQueueState, bool) {
+	stateId := r.PathValue("state_id")
+	if stateId == "" {
+		httputil.HandleError(w, "state_id is required in URL params", http.StatusBadRequest)
+		return nil, false
+	}
+	st, err := s.Stater.State(ctx, []byte(stateId))
+	if err != nil {
+		shared.WriteStateFetchError(w, err)
+		return nil, false
+	}
+	if st.Version() < version.Electra {
+		httputil.HandleError(w, "State is prior to the Electra fork and has no pending queues", http.StatusBadRequest)
+		return nil, false
+	}
+
+	isOptimistic, err := helpers.IsOptimistic(ctx, []byte(stateId), s.OptimisticModeFetcher, s.Stater, s.ChainInfoFetcher, s.BeaconDB)
+	if err != nil {
+		httputil.HandleError(w, "Could not check optimistic status: "+err.Error(), http.StatusInternalServerError)
+		return nil, false
+	}
+	blockRoot, err := st.LatestBlockHeader().HashTreeRoot()
+	if err != nil {
+		httputil.HandleError(w, "Could not calculate root of latest block header: "+err.Error(), http.StatusInternalServerError)
+		return nil, false
+	}
+	return &pendingQueueState{
+		st:          st,
+		optimistic:  isOptimistic,
+		finalized:   s.FinalizationFetcher.IsFinalized(ctx, blockRoot),
+		versionName: version.String(st.Version()),
+	}, true
+}
+
+// writeSszList writes the concatenated SSZ encoding of the items, which is the encoding of an SSZ list of
+// fixed-size items.
+func writeSszList[T ssz.Marshaler](w http.ResponseWriter, items []T, fileName string) {
+	var sszData []byte
+	for _, item := range items {
+		var err error
+		sszData, err = item.MarshalSSZTo(sszData)
+		if err != nil {
+			httputil.HandleError(w, "Could not marshal SSZ: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+	httputil.WriteSsz(w, sszData, fileName)
+}
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	chainMock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
 	dbTest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
@@ -647,3 +648,185 @@ func TestGetSyncCommittees_Future(t *testing.T) {
 		}
 	}
 }
+
+func TestGetPendingDeposits(t *testing.T) {
+	st, err := util.NewBeaconStateElectra()
+	require.NoError(t, err)
+	deposits := []*ethpbalpha.PendingDeposit{
+		{
+			PublicKey:             bytesutil.PadTo([]byte("pubkey1"), 48),
+			WithdrawalCredentials: bytesutil.PadTo([]byte("creds1"), 32),
+			Amount:                32000000000,
+			Signature:             bytesutil.PadTo([]byte("sig1"), 96),
+			Slot:                  5,
+		},
+		{
+			PublicKey:             bytesutil.PadTo([]byte("pubkey2"), 48),
+			WithdrawalCredentials: bytesutil.PadTo([]byte("creds2"), 32),
+			Amount:                1000000000,
+			Signature:             bytesutil.PadTo([]byte("sig2"), 96),
+			Slot:                  6,
+		},
+	}
+	require.NoError(t, st.SetPendingDeposits(deposits))
+
+	chainService := &chainMock.ChainService{Optimistic: true}
+	s := &Server{
+		Stater:                &testutil.MockStater{BeaconState: st},
+		OptimisticModeFetcher: chainService,
+		FinalizationFetcher:   chainService,
+	}
+
+	t.Run("json", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/states/{state_id}/pending_deposits", nil)
+		request.SetPathValue("state_id", "head")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetPendingDeposits(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, "electra", writer.Header().Get(api.VersionHeader))
+		resp := &structs.GetPendingDepositsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "electra", resp.Version)
+		assert.Equal(t, true, resp.ExecutionOptimistic)
+		require.Equal(t, 2, len(resp.Data))
+		assert.DeepEqual(t, structs.PendingDepositsFromConsensus(deposits), resp.Data)
+	})
+	t.Run("ssz", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/states/{state_id}/pending_deposits", nil)
+		request.SetPathValue("state_id", "head")
+		request.Header.Set("Accept", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetPendingDeposits(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, "electra", writer.Header().Get(api.VersionHeader))
+		var want []byte
+		for _, d := range deposits {
+			want, err = d.MarshalSSZTo(want)
+			require.NoError(t, err)
+		}
+		assert.DeepEqual(t, want, writer.Body.Bytes())
+	})
+	t.Run("pre-electra state", func(t *testing.T) {
+		denebSt, err := util.NewBeaconStateDeneb()
+		require.NoError(t, err)
+		s := &Server{Stater: &testutil.MockStater{BeaconState: denebSt}}
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/states/{state_id}/pending_deposits", nil)
+		request.SetPathValue("state_id", "head")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetPendingDeposits(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "prior to the Electra fork", e.Message)
+	})
+	t.Run("no state_id", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/states/{state_id}/pending_deposits", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetPendingDeposits(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
+
+func TestGetPendingPartialWithdrawals(t *testing.T) {
+	st, err := util.NewBeaconStateElectra()
+	require.NoError(t, err)
+	withdrawals := []*ethpbalpha.PendingPartialWithdrawal{
+		{Index: 1, Amount: 100, WithdrawableEpoch: 10},
+		{Index: 2, Amount: 200, WithdrawableEpoch: 20},
+	}
+	for _, w := range withdrawals {
+		require.NoError(t, st.AppendPendingPartialWithdrawal(w))
+	}
+
+	chainService := &chainMock.ChainService{}
+	s := &Server{
+		Stater:                &testutil.MockStater{BeaconState: st},
+		OptimisticModeFetcher: chainService,
+		FinalizationFetcher:   chainService,
+	}
+
+	t.Run("json", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/states/{state_id}/pending_partial_withdrawals", nil)
+		request.SetPathValue("state_id", "head")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetPendingPartialWithdrawals(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetPendingPartialWithdrawalsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "electra", resp.Version)
+		assert.DeepEqual(t, structs.PendingPartialWithdrawalsFromConsensus(withdrawals), resp.Data)
+	})
+	t.Run("ssz", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/states/{state_id}/pending_partial_withdrawals", nil)
+		request.SetPathValue("state_id", "head")
+		request.Header.Set("Accept", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetPendingPartialWithdrawals(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		var want []byte
+		for _, w := range withdrawals {
+			want, err = w.MarshalSSZTo(want)
+			require.NoError(t, err)
+		}
+		assert.DeepEqual(t, want, writer.Body.Bytes())
+	})
+}
+
+func TestGetPendingConsolidations(t *testing.T) {
+	st, err := util.NewBeaconStateElectra()
+	require.NoError(t, err)
+	consolidations := []*ethpbalpha.PendingConsolidation{
+		{SourceIndex: 1, TargetIndex: 2},
+		{SourceIndex: 3, TargetIndex: 4},
+	}
+	require.NoError(t, st.SetPendingConsolidations(consolidations))
+
+	chainService := &chainMock.ChainService{}
+	s := &Server{
+		Stater:                &testutil.MockStater{BeaconState: st},
+		OptimisticModeFetcher: chainService,
+		FinalizationFetcher:   chainService,
+	}
+
+	t.Run("json", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/states/{state_id}/pending_consolidations", nil)
+		request.SetPathValue("state_id", "head")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetPendingConsolidations(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetPendingConsolidationsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "electra", resp.Version)
+		assert.DeepEqual(t, structs.PendingConsolidationsFromConsensus(consolidations), resp.Data)
+	})
+	t.Run("ssz", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/states/{state_id}/pending_consolidations", nil)
+		request.SetPathValue("state_id", "head")
+		request.Header.Set("Accept", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetPendingConsolidations(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		var want []byte
+		for _, c := range consolidations {
+			want, err = c.MarshalSSZTo(want)
+			require.NoError(t, err)
+		}
+		assert.DeepEqual(t, want, writer.Body.Bytes())
+	})
+}
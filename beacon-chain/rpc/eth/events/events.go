@@ -67,6 +67,12 @@ const (
 	LightClientFinalityUpdateTopic = "light_client_finality_update"
 	// LightClientOptimisticUpdateTopic represents a new light client optimistic update event topic.
 	LightClientOptimisticUpdateTopic = "light_client_optimistic_update"
+	// SingleAttestationTopic represents a new submitted single attestation event topic.
+	SingleAttestationTopic = "single_attestation"
+	// BlockGossipTopic represents a new block received over gossip, before it is imported.
+	BlockGossipTopic = "block_gossip"
+	// DataColumnSidecarTopic represents a new data column sidecar event topic.
+	DataColumnSidecarTopic = "data_column_sidecar"
 )
 
 var (
@@ -98,6 +104,9 @@ var opsFeedEventTopics = map[feed.EventType]string{
 	operation.BlobSidecarReceived:               BlobSidecarTopic,
 	operation.AttesterSlashingReceived:          AttesterSlashingTopic,
 	operation.ProposerSlashingReceived:          ProposerSlashingTopic,
+	operation.SingleAttReceived:                 SingleAttestationTopic,
+	operation.BlockGossipReceived:               BlockGossipTopic,
+	operation.DataColumnSidecarReceived:         DataColumnSidecarTopic,
 }
 
 var stateFeedEventTopics = map[feed.EventType]string{
@@ -427,6 +436,12 @@ func topicForEvent(event *feed.Event) string {
 		return AttesterSlashingTopic
 	case *operation.ProposerSlashingReceivedData:
 		return ProposerSlashingTopic
+	case *operation.SingleAttReceivedData:
+		return SingleAttestationTopic
+	case *operation.BlockGossipReceivedData:
+		return BlockGossipTopic
+	case *operation.DataColumnSidecarReceivedData:
+		return DataColumnSidecarTopic
 	case *ethpb.EventHead:
 		return HeadTopic
 	case *ethpb.EventFinalizedCheckpoint:
@@ -523,6 +538,34 @@ func (s *Server) lazyReaderForEvent(ctx context.Context, event *feed.Event, topi
 		return func() io.Reader {
 			return jsonMarshalReader(eventName, structs.ProposerSlashingFromConsensus(v.ProposerSlashing))
 		}, nil
+	case *operation.SingleAttReceivedData:
+		return func() io.Reader {
+			return jsonMarshalReader(eventName, structs.SingleAttFromConsensus(v.Attestation))
+		}, nil
+	case *operation.BlockGossipReceivedData:
+		blockRoot, err := v.SignedBlock.Block().HashTreeRoot()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compute block root for BlockGossipReceivedData operation feed event")
+		}
+		return func() io.Reader {
+			return jsonMarshalReader(eventName, &structs.BlockGossipEvent{
+				Slot:  fmt.Sprintf("%d", v.SignedBlock.Block().Slot()),
+				Block: hexutil.Encode(blockRoot[:]),
+			})
+		}, nil
+	case *operation.DataColumnSidecarReceivedData:
+		return func() io.Reader {
+			commitments := make([]string, len(v.DataColumn.KzgCommitments))
+			for i, c := range v.DataColumn.KzgCommitments {
+				commitments[i] = hexutil.Encode(c)
+			}
+			return jsonMarshalReader(eventName, &structs.DataColumnSidecarEvent{
+				BlockRoot:      hexutil.Encode(v.DataColumn.BlockRootSlice()),
+				Index:          fmt.Sprintf("%d", v.DataColumn.ColumnIndex),
+				Slot:           fmt.Sprintf("%d", v.DataColumn.Slot()),
+				KzgCommitments: commitments,
+			})
+		}, nil
 	case *ethpb.EventFinalizedCheckpoint:
 		return func() io.Reader {
 			return jsonMarshalReader(eventName, structs.FinalizedCheckpointEventFromV1(v))
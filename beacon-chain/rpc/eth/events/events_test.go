@@ -387,6 +387,76 @@ func TestStreamEvents_OperationsEvents(t *testing.T) {
 
 		requireAllEventsReceived(t, stn, opn, events, topics, s, w, testSync.logs)
 	})
+	t.Run("gossip", func(t *testing.T) {
+		testSync := newStreamTestSync(t)
+		defer testSync.cleanup()
+
+		stn := mockChain.NewEventFeedWrapper()
+		opn := mockChain.NewEventFeedWrapper()
+		s := &Server{
+			StateNotifier:     &mockChain.SimpleNotifier{Feed: stn},
+			OperationNotifier: &mockChain.SimpleNotifier{Feed: opn},
+			EventWriteTimeout: testEventWriteTimeout,
+		}
+
+		topics, err := newTopicRequest([]string{
+			SingleAttestationTopic,
+			BlockGossipTopic,
+			DataColumnSidecarTopic,
+		})
+		require.NoError(t, err)
+		request := topics.testHttpRequest(testSync.ctx, t)
+		w := NewStreamingResponseWriterRecorder(testSync.ctx)
+
+		b, err := blocks.NewSignedBeaconBlock(util.HydrateSignedBeaconBlock(&eth.SignedBeaconBlock{}))
+		require.NoError(t, err)
+		dc, err := blocks.NewRODataColumn(&eth.DataColumnSidecar{
+			ColumnIndex:    1,
+			KzgCommitments: [][]byte{make([]byte, 48)},
+			SignedBlockHeader: &eth.SignedBeaconBlockHeader{
+				Header: &eth.BeaconBlockHeader{
+					ParentRoot: make([]byte, fieldparams.RootLength),
+					StateRoot:  make([]byte, fieldparams.RootLength),
+					BodyRoot:   make([]byte, fieldparams.RootLength),
+				},
+				Signature: make([]byte, fieldparams.BLSSignatureLength),
+			},
+		})
+		require.NoError(t, err)
+		vdc := blocks.NewVerifiedRODataColumn(dc)
+		events := []*feed.Event{
+			&feed.Event{
+				Type: operation.SingleAttReceived,
+				Data: &operation.SingleAttReceivedData{
+					Attestation: &eth.SingleAttestation{
+						CommitteeId:   1,
+						AttesterIndex: 2,
+						Data:          util.HydrateAttestationData(&eth.AttestationData{}),
+						Signature:     make([]byte, fieldparams.BLSSignatureLength),
+					},
+				},
+			},
+			&feed.Event{
+				Type: operation.BlockGossipReceived,
+				Data: &operation.BlockGossipReceivedData{
+					SignedBlock: b,
+				},
+			},
+			&feed.Event{
+				Type: operation.DataColumnSidecarReceived,
+				Data: &operation.DataColumnSidecarReceivedData{
+					DataColumn: &vdc,
+				},
+			},
+		}
+
+		go func() {
+			s.StreamEvents(w, request)
+			testSync.markDone()
+		}()
+
+		requireAllEventsReceived(t, stn, opn, events, topics, s, w, testSync.logs)
+	})
 	t.Run("payload attributes", func(t *testing.T) {
 		type testCase struct {
 			name                      string
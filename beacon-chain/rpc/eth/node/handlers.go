@@ -106,6 +106,34 @@ func (*Server) GetVersion(w http.ResponseWriter, r *http.Request) {
 	httputil.WriteJson(w, resp)
 }
 
+// GetVersionV2 returns the client versions of the beacon node and of its execution client, as defined by
+// the engine API. The execution client is omitted when its version can't be retrieved.
+func (s *Server) GetVersionV2(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "node.GetVersionV2")
+	defer span.End()
+
+	resp := &structs.GetVersionV2Response{
+		Data: &structs.VersionV2{
+			BeaconNode: clientVersionToJson(version.Client()),
+		},
+	}
+	if s.ExecutionChainInfoFetcher != nil {
+		if cv, err := s.ExecutionChainInfoFetcher.ExecutionClientVersion(ctx); err == nil {
+			resp.Data.ExecutionClient = clientVersionToJson(cv)
+		}
+	}
+	httputil.WriteJson(w, resp)
+}
+
+func clientVersionToJson(cv *version.ClientVersion) *structs.ClientVersionV1 {
+	return &structs.ClientVersionV1{
+		Code:    cv.Code,
+		Name:    cv.Name,
+		Version: cv.Version,
+		Commit:  cv.Commit,
+	}
+}
+
 // GetHealth returns node health status in http status codes. Useful for load balancers.
 func (s *Server) GetHealth(w http.ResponseWriter, r *http.Request) {
 	ctx, span := trace.StartSpan(r.Context(), "node.GetHealth")
@@ -89,6 +89,41 @@ func TestGetVersion(t *testing.T) {
 	assert.StringContains(t, arch, resp.Data.Version)
 }
 
+func TestGetVersionV2(t *testing.T) {
+	t.Run("with execution client", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v2/node/version", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s := &Server{
+			ExecutionChainInfoFetcher: &testutil.MockExecutionChainInfoFetcher{
+				Version: &version.ClientVersion{Code: "GE", Name: "Geth", Version: "v1.14.11", Commit: "0xf8d0a1b2"},
+			},
+		}
+		s.GetVersionV2(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetVersionV2Response{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.NotNil(t, resp.Data)
+		assert.Equal(t, version.ClientCode, resp.Data.BeaconNode.Code)
+		assert.Equal(t, version.SemanticVersion(), resp.Data.BeaconNode.Version)
+		assert.DeepEqual(t, &structs.ClientVersionV1{Code: "GE", Name: "Geth", Version: "v1.14.11", Commit: "0xf8d0a1b2"}, resp.Data.ExecutionClient)
+	})
+	t.Run("execution client version unknown", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v2/node/version", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s := &Server{ExecutionChainInfoFetcher: &testutil.MockExecutionChainInfoFetcher{}}
+		s.GetVersionV2(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetVersionV2Response{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, version.ClientName, resp.Data.BeaconNode.Name)
+		assert.Equal(t, true, resp.Data.ExecutionClient == nil)
+	})
+}
+
 func TestGetHealth(t *testing.T) {
 	checker := &syncmock.Sync{}
 	optimisticFetcher := &mock.ChainService{Optimistic: false}
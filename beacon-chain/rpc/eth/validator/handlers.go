@@ -15,6 +15,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
+	ssz "github.com/prysmaticlabs/fastssz"
 	"github.com/prysmaticlabs/prysm/v5/api"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/builder"
@@ -312,6 +313,11 @@ func (s *Server) SubmitAggregateAndProofsV2(w http.ResponseWriter, r *http.Reque
 	ctx, span := trace.StartSpan(r.Context(), "validator.SubmitAggregateAndProofsV2")
 	defer span.End()
 
+	if httputil.IsRequestSsz(r) {
+		s.submitAggregateAndProofsSSZ(ctx, w, r)
+		return
+	}
+
 	var reqData []json.RawMessage
 	if err := json.NewDecoder(r.Body).Decode(&reqData); err != nil {
 		if errors.Is(err, io.EOF) {
@@ -382,6 +388,75 @@ func (s *Server) SubmitAggregateAndProofsV2(w http.ResponseWriter, r *http.Reque
 	}
 }
 
+// submitAggregateAndProofsSSZ submits a list of SSZ-encoded signed aggregates, of the type of the version header.
+func (s *Server) submitAggregateAndProofsSSZ(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		httputil.HandleError(w, "Could not read request body: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	versionHeader := r.Header.Get(api.VersionHeader)
+	if versionHeader == "" {
+		httputil.HandleError(w, api.VersionHeader+" header is required", http.StatusBadRequest)
+		return
+	}
+	v, err := version.FromString(versionHeader)
+	if err != nil {
+		httputil.HandleError(w, "Invalid version: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	aggregates, err := unmarshalSignedAggregatesSSZ(body, v)
+	if err != nil {
+		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if len(aggregates) == 0 {
+		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	}
+
+	broadcastFailed := false
+	for _, agg := range aggregates {
+		rpcError := s.CoreService.SubmitSignedAggregateSelectionProof(ctx, agg)
+		if rpcError != nil {
+			var aggregateBroadcastFailedError *core.AggregateBroadcastFailedError
+			if errors.As(rpcError.Err, &aggregateBroadcastFailedError) {
+				broadcastFailed = true
+			} else {
+				httputil.HandleError(w, rpcError.Err.Error(), core.ErrorReasonToHTTP(rpcError.Reason))
+				return
+			}
+		}
+	}
+	if broadcastFailed {
+		httputil.HandleError(w, "Could not broadcast one or more signed aggregated attestations", http.StatusInternalServerError)
+	}
+}
+
+// unmarshalSignedAggregatesSSZ decodes an SSZ list of signed aggregates, which are Electra aggregates from Electra on.
+func unmarshalSignedAggregatesSSZ(body []byte, v int) ([]ethpbalpha.SignedAggregateAttAndProof, error) {
+	// The number of aggregates is only bounded by the size of the body.
+	n, err := ssz.DecodeDynamicLength(body, len(body))
+	if err != nil {
+		return nil, err
+	}
+	aggregates := make([]ethpbalpha.SignedAggregateAttAndProof, n)
+	err = ssz.UnmarshalDynamic(body, n, func(i int, b []byte) error {
+		if v >= version.Electra {
+			agg := &ethpbalpha.SignedAggregateAttestationAndProofElectra{}
+			aggregates[i] = agg
+			return agg.UnmarshalSSZ(b)
+		}
+		agg := &ethpbalpha.SignedAggregateAttestationAndProof{}
+		aggregates[i] = agg
+		return agg.UnmarshalSSZ(b)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return aggregates, nil
+}
+
 // SubmitSyncCommitteeSubscription subscribe to a number of sync committee subnets.
 //
 // Subscribing to sync committee subnets is an action performed by VC to enable
@@ -14,6 +14,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
+	ssz "github.com/prysmaticlabs/fastssz"
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/v5/api"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
@@ -836,9 +837,81 @@ func TestSubmitAggregateAndProofs(t *testing.T) {
 			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
 			assert.Equal(t, http.StatusBadRequest, e.Code)
 		})
+		t.Run("ssz", func(t *testing.T) {
+			broadcaster := &p2pmock.MockBroadcaster{}
+			s.CoreService.Broadcaster = broadcaster
+
+			var aggs []*structs.SignedAggregateAttestationAndProofElectra
+			require.NoError(t, json.Unmarshal([]byte(multipleAggregatesElectra), &aggs))
+			first, err := aggs[0].ToConsensus()
+			require.NoError(t, err)
+			second, err := aggs[1].ToConsensus()
+			require.NoError(t, err)
+			// The committee bits of the JSON aggregates are too short to be SSZ-encoded.
+			for _, agg := range []*ethpbalpha.SignedAggregateAttestationAndProofElectra{first, second} {
+				committeeBits := primitives.NewAttestationCommitteeBits()
+				committeeBits.SetBitAt(0, true)
+				agg.Message.Aggregate.CommitteeBits = committeeBits
+			}
+			body := marshalSSZList(t, first, second)
+			request := httptest.NewRequest(http.MethodPost, "http://example.com", bytes.NewReader(body))
+			request.Header.Set("Content-Type", api.OctetStreamMediaType)
+			request.Header.Set(api.VersionHeader, version.String(version.Electra))
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.SubmitAggregateAndProofsV2(writer, request)
+			assert.Equal(t, http.StatusOK, writer.Code)
+			assert.Equal(t, 2, len(broadcaster.BroadcastMessages))
+		})
+		t.Run("ssz-pre-electra", func(t *testing.T) {
+			broadcaster := &p2pmock.MockBroadcaster{}
+			s.CoreService.Broadcaster = broadcaster
+
+			var aggs []*structs.SignedAggregateAttestationAndProof
+			require.NoError(t, json.Unmarshal([]byte(singleAggregate), &aggs))
+			consensusAgg, err := aggs[0].ToConsensus()
+			require.NoError(t, err)
+			body := marshalSSZList(t, consensusAgg)
+			request := httptest.NewRequest(http.MethodPost, "http://example.com", bytes.NewReader(body))
+			request.Header.Set("Content-Type", api.OctetStreamMediaType)
+			request.Header.Set(api.VersionHeader, version.String(version.Phase0))
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.SubmitAggregateAndProofsV2(writer, request)
+			assert.Equal(t, http.StatusOK, writer.Code)
+			assert.Equal(t, 1, len(broadcaster.BroadcastMessages))
+		})
+		t.Run("ssz-invalid", func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodPost, "http://example.com", bytes.NewReader([]byte{4, 0, 0, 0, 1, 2, 3}))
+			request.Header.Set("Content-Type", api.OctetStreamMediaType)
+			request.Header.Set(api.VersionHeader, version.String(version.Electra))
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.SubmitAggregateAndProofsV2(writer, request)
+			assert.Equal(t, http.StatusBadRequest, writer.Code)
+			e := &httputil.DefaultJsonError{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+			assert.StringContains(t, "Could not decode request body", e.Message)
+		})
 	})
 }
 
+// marshalSSZList encodes a list of variable-size items: the offsets of the items, followed by the items.
+func marshalSSZList(t *testing.T, items ...interface{ MarshalSSZ() ([]byte, error) }) []byte {
+	offsets := make([]byte, 0, 4*len(items))
+	var data []byte
+	for _, item := range items {
+		enc, err := item.MarshalSSZ()
+		require.NoError(t, err)
+		offsets = ssz.WriteOffset(offsets, 4*len(items)+len(data))
+		data = append(data, enc...)
+	}
+	return append(offsets, data...)
+}
+
 func TestSubmitSyncCommitteeSubscription(t *testing.T) {
 	genesis := util.NewBeaconBlock()
 	deposits, _, err := util.DeterministicDepositsAndKeys(64)
@@ -1,6 +1,7 @@
 package validator
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"strings"
@@ -22,10 +23,12 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	enginev1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
@@ -45,6 +48,21 @@ const (
 	defaultBuilderBoostFactor = primitives.Gwei(100)
 )
 
+// defaultGraffiti returns the graffiti identifying the execution and consensus clients recommended by the
+// engine API. It is used when the validator client does not set any graffiti.
+func (vs *Server) defaultGraffiti(ctx context.Context) []byte {
+	var el *version.ClientVersion
+	if vs.Eth1InfoFetcher != nil {
+		cv, err := vs.Eth1InfoFetcher.ExecutionClientVersion(ctx)
+		if err != nil {
+			log.WithError(err).Debug("Could not get execution client version for the default graffiti")
+		} else {
+			el = cv
+		}
+	}
+	return bytesutil.PadTo([]byte(version.ClientGraffiti(el, nil, fieldparams.RootLength)), fieldparams.RootLength)
+}
+
 // GetBeaconBlock is called by a proposer during its assigned slot to request a block to sign
 // by passing in the slot and the signed randao reveal of the slot.
 func (vs *Server) GetBeaconBlock(ctx context.Context, req *ethpb.BlockRequest) (*ethpb.GenericBeaconBlock, error) {
@@ -82,7 +100,11 @@ func (vs *Server) GetBeaconBlock(ctx context.Context, req *ethpb.BlockRequest) (
 	}
 	// Set slot, graffiti, randao reveal, and parent root.
 	sBlk.SetSlot(req.Slot)
-	sBlk.SetGraffiti(req.Graffiti)
+	graffiti := req.Graffiti
+	if len(bytes.Trim(graffiti, "\x00")) == 0 {
+		graffiti = vs.defaultGraffiti(ctx)
+	}
+	sBlk.SetGraffiti(graffiti)
 	sBlk.SetRandaoReveal(req.RandaoReveal)
 	sBlk.SetParentRoot(parentRoot[:])
 
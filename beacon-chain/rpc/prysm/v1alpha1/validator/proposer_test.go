@@ -47,6 +47,7 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/attestation"
 	attaggregation "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/attestation/aggregation/attestations"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/testing/util"
@@ -117,6 +118,22 @@ func TestServer_GetBeaconBlock_Phase0(t *testing.T) {
 	assert.DeepEqual(t, attSlashings, phase0Blk.Phase0.Body.AttesterSlashings)
 }
 
+func TestServer_DefaultGraffiti(t *testing.T) {
+	ctx := context.Background()
+	cl := version.Client()
+
+	vs := &Server{Eth1InfoFetcher: &mockExecution.Chain{
+		ClientVersion: &version.ClientVersion{Code: "GE", Name: "Geth", Version: "v1.14.11", Commit: "0xf8d0a1b2"},
+	}}
+	want := bytesutil.ToBytes32([]byte("GEf8d0" + cl.Code + cl.ShortCommit(4)))
+	assert.DeepEqual(t, want[:], vs.defaultGraffiti(ctx))
+
+	// The execution client version is not known.
+	vs = &Server{Eth1InfoFetcher: &mockExecution.Chain{}}
+	want = bytesutil.ToBytes32([]byte(cl.Code + cl.ShortCommit(4)))
+	assert.DeepEqual(t, want[:], vs.defaultGraffiti(ctx))
+}
+
 func TestServer_GetBeaconBlock_Altair(t *testing.T) {
 	db := dbutil.SetupDB(t)
 	ctx := context.Background()
@@ -1,13 +1,18 @@
 package testutil
 
 import (
+	"context"
 	"math/big"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 )
 
 // MockExecutionChainInfoFetcher is a fake implementation of the powchain.ChainInfoFetcher
 type MockExecutionChainInfoFetcher struct {
 	CurrEndpoint string
 	CurrError    error
+	Version      *version.ClientVersion
 }
 
 func (*MockExecutionChainInfoFetcher) GenesisExecutionChainInfo() (uint64, *big.Int) {
@@ -25,3 +30,10 @@ func (m *MockExecutionChainInfoFetcher) ExecutionClientEndpoint() string {
 func (m *MockExecutionChainInfoFetcher) ExecutionClientConnectionErr() error {
 	return m.CurrError
 }
+
+func (m *MockExecutionChainInfoFetcher) ExecutionClientVersion(_ context.Context) (*version.ClientVersion, error) {
+	if m.Version == nil {
+		return nil, errors.New("no execution client version")
+	}
+	return m.Version, nil
+}
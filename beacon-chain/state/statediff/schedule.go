@@ -0,0 +1,75 @@
+package statediff
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+)
+
+// maxExponent bounds the exponents of a schedule so that intervals fit in a slot.
+const maxExponent = 40
+
+// DefaultExponents stores a full snapshot every 2^21 slots, and diffs down to every 2^5 slots.
+var DefaultExponents = []uint64{21, 18, 16, 13, 11, 9, 5}
+
+// Schedule describes the layers of a state diff hierarchy. Each layer is defined by an exponent, and stores a state
+// every 2^exponent slots. The first layer holds full snapshots, while every state of a lower layer is stored as a
+// diff against the state of the preceding layer at or before its slot. Reconstructing a state therefore applies at
+// most one diff per layer to a snapshot.
+type Schedule struct {
+	exponents []uint64
+}
+
+// NewSchedule creates a schedule from a list of strictly decreasing exponents, one per layer.
+func NewSchedule(exponents []uint64) (*Schedule, error) {
+	if len(exponents) == 0 {
+		return nil, errors.New("state diff schedule needs at least one exponent")
+	}
+	for i, e := range exponents {
+		if e > maxExponent {
+			return nil, fmt.Errorf("state diff exponent %d is greater than %d", e, maxExponent)
+		}
+		if i > 0 && e >= exponents[i-1] {
+			return nil, errors.New("state diff exponents must be strictly decreasing")
+		}
+	}
+	return &Schedule{exponents: append([]uint64{}, exponents...)}, nil
+}
+
+// Exponents returns the exponents of the layers of the schedule.
+func (s *Schedule) Exponents() []uint64 {
+	return append([]uint64{}, s.exponents...)
+}
+
+// Interval returns the number of slots between two consecutive stored states.
+func (s *Schedule) Interval() primitives.Slot {
+	return s.span(len(s.exponents) - 1)
+}
+
+// Level returns the layer storing the state at the given slot, where layer 0 holds full snapshots. It returns false
+// when no state is stored at the slot.
+func (s *Schedule) Level(slot primitives.Slot) (int, bool) {
+	for i := range s.exponents {
+		if slot%s.span(i) == 0 {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// BaseSlot returns the slot of the state that the state at the given slot is diffed against.
+func (s *Schedule) BaseSlot(slot primitives.Slot) (primitives.Slot, error) {
+	level, ok := s.Level(slot)
+	if !ok {
+		return 0, fmt.Errorf("no state is stored at slot %d", slot)
+	}
+	if level == 0 {
+		return 0, fmt.Errorf("state at slot %d is a snapshot", slot)
+	}
+	return slot - slot%s.span(level-1), nil
+}
+
+func (s *Schedule) span(level int) primitives.Slot {
+	return primitives.Slot(1) << s.exponents[level]
+}
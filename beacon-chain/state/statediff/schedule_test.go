@@ -0,0 +1,57 @@
+package statediff
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestNewSchedule(t *testing.T) {
+	_, err := NewSchedule(nil)
+	require.ErrorContains(t, "at least one exponent", err)
+	_, err = NewSchedule([]uint64{5, 5})
+	require.ErrorContains(t, "strictly decreasing", err)
+	_, err = NewSchedule([]uint64{41, 5})
+	require.ErrorContains(t, "greater than 40", err)
+	s, err := NewSchedule(DefaultExponents)
+	require.NoError(t, err)
+	require.DeepEqual(t, DefaultExponents, s.Exponents())
+	require.Equal(t, primitives.Slot(32), s.Interval())
+}
+
+func TestSchedule_Levels(t *testing.T) {
+	s, err := NewSchedule([]uint64{8, 6, 4})
+	require.NoError(t, err)
+
+	tests := []struct {
+		slot   primitives.Slot
+		level  int
+		stored bool
+		base   primitives.Slot
+	}{
+		{slot: 0, level: 0, stored: true},
+		{slot: 512, level: 0, stored: true},
+		{slot: 576, level: 1, stored: true, base: 512},
+		{slot: 592, level: 2, stored: true, base: 576},
+		{slot: 528, level: 2, stored: true, base: 512},
+		{slot: 530, stored: false},
+	}
+	for _, tt := range tests {
+		level, ok := s.Level(tt.slot)
+		require.Equal(t, tt.stored, ok)
+		if !ok {
+			_, err := s.BaseSlot(tt.slot)
+			require.ErrorContains(t, "no state is stored", err)
+			continue
+		}
+		require.Equal(t, tt.level, level)
+		base, err := s.BaseSlot(tt.slot)
+		if level == 0 {
+			require.ErrorContains(t, "is a snapshot", err)
+			continue
+		}
+		require.NoError(t, err)
+		require.Equal(t, tt.base, base)
+	}
+}
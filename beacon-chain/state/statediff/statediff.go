@@ -0,0 +1,392 @@
+// Package statediff encodes beacon states as compact binary diffs against other states, for hierarchical storage
+// of finalized states.
+package statediff
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	ssz "github.com/prysmaticlabs/fastssz"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	statenative "github.com/prysmaticlabs/prysm/v5/beacon-chain/state/state-native"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// The fields of the state which are not part of the state diff are either stored as is, or xor-ed with the same
+// fields of a base state of the same fork, which leaves mostly zeroes that compress well.
+const (
+	fieldsRaw byte = iota
+	fieldsXor
+)
+
+// validatorSize is the size of an ssz encoded validator.
+const validatorSize = 121
+
+// ErrInvalidDiff is returned when a state diff cannot be decoded or applied.
+var ErrInvalidDiff = errors.New("invalid state diff")
+
+// diffedFields are the fields of the state proto which are encoded separately from the rest of the state.
+var diffedFields = []protoreflect.Name{"validators", "balances", "inactivity_scores"}
+
+// Diff encodes the target state as a diff against the base state. The validators, balances and inactivity scores are
+// encoded as the changes from the base state, while the rest of the state is xor-ed with the base state when both
+// states are of the same fork. A nil base state encodes a full snapshot of the target state.
+func Diff(base, target state.ReadOnlyBeaconState) ([]byte, error) {
+	if target == nil || target.IsNil() {
+		return nil, errors.New("nil target state")
+	}
+	hasBase := base != nil && !base.IsNil()
+
+	fields, err := encodeFields(target)
+	if err != nil {
+		return nil, err
+	}
+	buf := binary.AppendUvarint(nil, uint64(target.Version()))
+	if hasBase && base.Version() == target.Version() {
+		baseFields, err := encodeFields(base)
+		if err != nil {
+			return nil, err
+		}
+		xor(fields, baseFields)
+		buf = append(buf, fieldsXor)
+	} else {
+		buf = append(buf, fieldsRaw)
+	}
+	buf = binary.AppendUvarint(buf, uint64(len(fields)))
+	buf = append(buf, fields...)
+
+	var baseValidators []*ethpb.Validator
+	var baseBalances, baseScores []uint64
+	if hasBase {
+		baseValidators = base.Validators()
+		baseBalances = base.Balances()
+		baseScores, err = inactivityScores(base)
+		if err != nil {
+			return nil, err
+		}
+	}
+	buf, err = appendValidatorsDiff(buf, baseValidators, target.Validators())
+	if err != nil {
+		return nil, err
+	}
+	buf = appendUint64sDiff(buf, baseBalances, target.Balances())
+	scores, err := inactivityScores(target)
+	if err != nil {
+		return nil, err
+	}
+	buf = appendUint64sDiff(buf, baseScores, scores)
+	return snappy.Encode(nil, buf), nil
+}
+
+// Apply reconstructs a state from the base state and a diff created by Diff with the same base state. A nil base
+// state is used for snapshots.
+func Apply(base state.ReadOnlyBeaconState, diff []byte) (state.BeaconState, error) {
+	enc, err := snappy.Decode(nil, diff)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decompress state diff")
+	}
+	hasBase := base != nil && !base.IsNil()
+	d := &decoder{buf: enc}
+
+	v := int(d.uvarint())
+	kind := d.byte()
+	fields := d.bytes(d.uvarint())
+	if d.err != nil {
+		return nil, d.err
+	}
+	switch kind {
+	case fieldsRaw:
+	case fieldsXor:
+		if !hasBase || base.Version() != v {
+			return nil, errors.Wrap(ErrInvalidDiff, "diff needs a base state of the same fork")
+		}
+		baseFields, err := encodeFields(base)
+		if err != nil {
+			return nil, err
+		}
+		fields = append([]byte{}, fields...)
+		xor(fields, baseFields)
+	default:
+		return nil, errors.Wrapf(ErrInvalidDiff, "unknown encoding %d", kind)
+	}
+	st, err := decodeFields(v, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	var baseValidators []*ethpb.Validator
+	var baseBalances, baseScores []uint64
+	if hasBase {
+		baseValidators = base.Validators()
+		baseBalances = base.Balances()
+		baseScores, err = inactivityScores(base)
+		if err != nil {
+			return nil, err
+		}
+	}
+	validators, err := d.validators(baseValidators)
+	if err != nil {
+		return nil, err
+	}
+	balances := d.uint64s(baseBalances)
+	scores := d.uint64s(baseScores)
+	if d.err != nil {
+		return nil, d.err
+	}
+	if len(d.buf) != 0 {
+		return nil, errors.Wrap(ErrInvalidDiff, "trailing bytes")
+	}
+
+	if err := st.SetValidators(validators); err != nil {
+		return nil, err
+	}
+	if err := st.SetBalances(balances); err != nil {
+		return nil, err
+	}
+	if v >= version.Altair {
+		if err := st.SetInactivityScores(scores); err != nil {
+			return nil, err
+		}
+	}
+	return st, nil
+}
+
+// encodeFields returns the ssz encoding of the state without the diffed fields.
+func encodeFields(st state.ReadOnlyBeaconState) ([]byte, error) {
+	pb, ok := st.ToProto().(proto.Message)
+	if !ok {
+		return nil, errors.New("state is not a proto message")
+	}
+	m := pb.ProtoReflect()
+	for _, name := range diffedFields {
+		if fd := m.Descriptor().Fields().ByName(name); fd != nil {
+			m.Clear(fd)
+		}
+	}
+	marshaler, ok := pb.(ssz.Marshaler)
+	if !ok {
+		return nil, errors.New("state is not ssz marshalable")
+	}
+	return marshaler.MarshalSSZ()
+}
+
+// decodeFields initializes a state of the given fork from the ssz encoding of its fields, without the diffed fields.
+func decodeFields(v int, enc []byte) (state.BeaconState, error) {
+	switch v {
+	case version.Phase0:
+		pb := &ethpb.BeaconState{}
+		if err := pb.UnmarshalSSZ(enc); err != nil {
+			return nil, err
+		}
+		return statenative.InitializeFromProtoUnsafePhase0(pb)
+	case version.Altair:
+		pb := &ethpb.BeaconStateAltair{}
+		if err := pb.UnmarshalSSZ(enc); err != nil {
+			return nil, err
+		}
+		return statenative.InitializeFromProtoUnsafeAltair(pb)
+	case version.Bellatrix:
+		pb := &ethpb.BeaconStateBellatrix{}
+		if err := pb.UnmarshalSSZ(enc); err != nil {
+			return nil, err
+		}
+		return statenative.InitializeFromProtoUnsafeBellatrix(pb)
+	case version.Capella:
+		pb := &ethpb.BeaconStateCapella{}
+		if err := pb.UnmarshalSSZ(enc); err != nil {
+			return nil, err
+		}
+		return statenative.InitializeFromProtoUnsafeCapella(pb)
+	case version.Deneb:
+		pb := &ethpb.BeaconStateDeneb{}
+		if err := pb.UnmarshalSSZ(enc); err != nil {
+			return nil, err
+		}
+		return statenative.InitializeFromProtoUnsafeDeneb(pb)
+	case version.Electra:
+		pb := &ethpb.BeaconStateElectra{}
+		if err := pb.UnmarshalSSZ(enc); err != nil {
+			return nil, err
+		}
+		return statenative.InitializeFromProtoUnsafeElectra(pb)
+	case version.Fulu:
+		pb := &ethpb.BeaconStateFulu{}
+		if err := pb.UnmarshalSSZ(enc); err != nil {
+			return nil, err
+		}
+		return statenative.InitializeFromProtoUnsafeFulu(pb)
+	default:
+		return nil, errors.Wrapf(ErrInvalidDiff, "unknown state version %d", v)
+	}
+}
+
+func inactivityScores(st state.ReadOnlyBeaconState) ([]uint64, error) {
+	if st.Version() < version.Altair {
+		return nil, nil
+	}
+	return st.InactivityScores()
+}
+
+// xor xors the bytes of b into a, over the length of the shortest of them.
+func xor(a, b []byte) {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		a[i] ^= b[i]
+	}
+}
+
+// appendValidatorsDiff encodes the number of target validators followed by the ssz encoding and index of every
+// validator which differs from the base validator at the same index.
+func appendValidatorsDiff(buf []byte, base, target []*ethpb.Validator) ([]byte, error) {
+	changed := make([]int, 0)
+	for i, val := range target {
+		if i >= len(base) || !validatorsEqual(base[i], val) {
+			changed = append(changed, i)
+		}
+	}
+	buf = binary.AppendUvarint(buf, uint64(len(target)))
+	buf = binary.AppendUvarint(buf, uint64(len(changed)))
+	for _, i := range changed {
+		buf = binary.AppendUvarint(buf, uint64(i))
+		var err error
+		buf, err = target[i].MarshalSSZTo(buf)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return buf, nil
+}
+
+// appendUint64sDiff encodes the number of target values followed by the difference of every target value with the
+// base value at the same index, or zero for indices past the base values.
+func appendUint64sDiff(buf []byte, base, target []uint64) []byte {
+	buf = binary.AppendUvarint(buf, uint64(len(target)))
+	for i, v := range target {
+		var b uint64
+		if i < len(base) {
+			b = base[i]
+		}
+		buf = binary.AppendVarint(buf, int64(v-b))
+	}
+	return buf
+}
+
+func validatorsEqual(a, b *ethpb.Validator) bool {
+	return a.EffectiveBalance == b.EffectiveBalance &&
+		a.Slashed == b.Slashed &&
+		a.ActivationEligibilityEpoch == b.ActivationEligibilityEpoch &&
+		a.ActivationEpoch == b.ActivationEpoch &&
+		a.ExitEpoch == b.ExitEpoch &&
+		a.WithdrawableEpoch == b.WithdrawableEpoch &&
+		bytes.Equal(a.PublicKey, b.PublicKey) &&
+		bytes.Equal(a.WithdrawalCredentials, b.WithdrawalCredentials)
+}
+
+// decoder reads the values of a state diff, recording the first error.
+type decoder struct {
+	buf []byte
+	err error
+}
+
+func (d *decoder) fail(msg string) {
+	if d.err == nil {
+		d.err = errors.Wrap(ErrInvalidDiff, msg)
+	}
+	d.buf = nil
+}
+
+func (d *decoder) byte() byte {
+	if len(d.buf) < 1 {
+		d.fail("unexpected end of diff")
+		return 0
+	}
+	b := d.buf[0]
+	d.buf = d.buf[1:]
+	return b
+}
+
+func (d *decoder) uvarint() uint64 {
+	v, n := binary.Uvarint(d.buf)
+	if n <= 0 {
+		d.fail("invalid unsigned varint")
+		return 0
+	}
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *decoder) varint() int64 {
+	v, n := binary.Varint(d.buf)
+	if n <= 0 {
+		d.fail("invalid varint")
+		return 0
+	}
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *decoder) bytes(n uint64) []byte {
+	if uint64(len(d.buf)) < n {
+		d.fail("unexpected end of diff")
+		return nil
+	}
+	b := d.buf[:n]
+	d.buf = d.buf[n:]
+	return b
+}
+
+func (d *decoder) validators(base []*ethpb.Validator) ([]*ethpb.Validator, error) {
+	n := d.uvarint()
+	changed := d.uvarint()
+	if d.err != nil {
+		return nil, d.err
+	}
+	// Every validator past the base validators is encoded in the diff.
+	if n > uint64(len(base))+uint64(len(d.buf)) {
+		return nil, errors.Wrap(ErrInvalidDiff, "too many validators")
+	}
+	vals := make([]*ethpb.Validator, n)
+	copy(vals, base)
+	for i := uint64(0); i < changed; i++ {
+		idx := d.uvarint()
+		enc := d.bytes(validatorSize)
+		if d.err != nil {
+			return nil, d.err
+		}
+		if idx >= n {
+			return nil, errors.Wrapf(ErrInvalidDiff, "validator index %d out of range", idx)
+		}
+		val := &ethpb.Validator{}
+		if err := val.UnmarshalSSZ(enc); err != nil {
+			return nil, err
+		}
+		vals[idx] = val
+	}
+	for i, val := range vals {
+		if val == nil {
+			return nil, errors.Wrapf(ErrInvalidDiff, "missing validator %d", i)
+		}
+	}
+	return vals, nil
+}
+
+func (d *decoder) uint64s(base []uint64) []uint64 {
+	n := d.uvarint()
+	if n > uint64(len(d.buf)) {
+		d.fail("too many values")
+		return nil
+	}
+	vals := make([]uint64, n)
+	for i := range vals {
+		var b uint64
+		if i < len(base) {
+			b = base[i]
+		}
+		vals[i] = b + uint64(d.varint())
+	}
+	return vals
+}
@@ -0,0 +1,89 @@
+package statediff
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func requireSameState(t *testing.T, want, got state.BeaconState) {
+	wantRoot, err := want.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	gotRoot, err := got.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, wantRoot, gotRoot)
+}
+
+func TestDiffApply(t *testing.T) {
+	base, _ := util.DeterministicGenesisStateElectra(t, 64)
+	target := base.Copy()
+	require.NoError(t, target.SetSlot(100))
+	require.NoError(t, target.UpdateRandaoMixesAtIndex(3, [32]byte{'a'}))
+	require.NoError(t, target.UpdateBalancesAtIndex(5, 123))
+	require.NoError(t, target.UpdateBalancesAtIndex(6, base.Balances()[6]+7))
+	scores, err := target.InactivityScores()
+	require.NoError(t, err)
+	scores[7] = 9
+	require.NoError(t, target.SetInactivityScores(scores))
+	val, err := target.ValidatorAtIndex(8)
+	require.NoError(t, err)
+	val.ExitEpoch = 10
+	require.NoError(t, target.UpdateValidatorAtIndex(8, val))
+	require.NoError(t, target.AppendValidator(&ethpb.Validator{
+		PublicKey:             make([]byte, 48),
+		WithdrawalCredentials: make([]byte, 32),
+		EffectiveBalance:      32,
+	}))
+	require.NoError(t, target.AppendBalance(32))
+	require.NoError(t, target.AppendInactivityScore(0))
+
+	t.Run("diff", func(t *testing.T) {
+		diff, err := Diff(base, target)
+		require.NoError(t, err)
+		snapshot, err := Diff(nil, target)
+		require.NoError(t, err)
+		require.Equal(t, true, len(diff) < len(snapshot))
+
+		st, err := Apply(base, diff)
+		require.NoError(t, err)
+		requireSameState(t, target, st)
+	})
+	t.Run("snapshot", func(t *testing.T) {
+		snapshot, err := Diff(nil, target)
+		require.NoError(t, err)
+		st, err := Apply(nil, snapshot)
+		require.NoError(t, err)
+		requireSameState(t, target, st)
+	})
+	t.Run("missing base", func(t *testing.T) {
+		diff, err := Diff(base, target)
+		require.NoError(t, err)
+		_, err = Apply(nil, diff)
+		require.ErrorIs(t, err, ErrInvalidDiff)
+	})
+	t.Run("corrupted", func(t *testing.T) {
+		diff, err := Diff(base, target)
+		require.NoError(t, err)
+		_, err = Apply(base, diff[:len(diff)/2])
+		require.NotNil(t, err)
+	})
+}
+
+func TestDiffApply_AcrossForks(t *testing.T) {
+	base, _ := util.DeterministicGenesisState(t, 16)
+	target, _ := util.DeterministicGenesisStateAltair(t, 32)
+	scores, err := target.InactivityScores()
+	require.NoError(t, err)
+	scores[1] = 2
+	require.NoError(t, target.SetInactivityScores(scores))
+
+	diff, err := Diff(base, target)
+	require.NoError(t, err)
+	st, err := Apply(base, diff)
+	require.NoError(t, err)
+	requireSameState(t, target, st)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/time"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
@@ -217,6 +218,18 @@ func (s *State) loadStateByRoot(ctx context.Context, blockRoot [32]byte) (state.
 	}
 	targetSlot := summary.Slot
 
+	// Finalized states are replayed from the closest state stored as a state diff, when there is one.
+	if s.stateDiffs && s.beaconDB.IsFinalizedBlock(ctx, blockRoot) {
+		startState, err := s.beaconDB.StateFromDiffs(ctx, targetSlot)
+		switch {
+		case errors.Is(err, db.ErrNotFoundState):
+		case err != nil:
+			return nil, errors.Wrap(err, "could not reconstruct state from state diffs")
+		default:
+			return s.replayFromState(ctx, startState, targetSlot, bytesutil.ToBytes32(summary.Root))
+		}
+	}
+
 	// Since the requested state is not in caches or DB, start replaying using the last
 	// available ancestor state which is retrieved using input block's root.
 	startState, err := s.latestAncestor(ctx, blockRoot)
@@ -227,11 +240,16 @@ func (s *State) loadStateByRoot(ctx context.Context, blockRoot [32]byte) (state.
 		return nil, errUnknownBoundaryState
 	}
 
+	return s.replayFromState(ctx, startState, targetSlot, bytesutil.ToBytes32(summary.Root))
+}
+
+// replayFromState replays the blocks from the start state up to the block with the given root at the target slot.
+func (s *State) replayFromState(ctx context.Context, startState state.BeaconState, targetSlot primitives.Slot, targetRoot [32]byte) (state.BeaconState, error) {
 	if startState.Slot() == targetSlot {
 		return startState, nil
 	}
 
-	blks, err := s.loadBlocks(ctx, startState.Slot()+1, targetSlot, bytesutil.ToBytes32(summary.Root))
+	blks, err := s.loadBlocks(ctx, startState.Slot()+1, targetSlot, targetRoot)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not load blocks for hot state using root")
 	}
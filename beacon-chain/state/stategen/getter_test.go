@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
 	testDB "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
@@ -367,6 +368,41 @@ func TestLoadeStateByRoot_FromDBBoundaryCase(t *testing.T) {
 	assert.Equal(t, primitives.Slot(10), loadedState.Slot(), "Did not correctly load state")
 }
 
+func TestLoadeStateByRoot_FromStateDiffs(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t, kv.WithStateDiffExponents([]uint64{3}))
+	service := New(beaconDB, doublylinkedtree.New())
+	require.Equal(t, primitives.Slot(8), service.slotsPerArchivedPoint)
+
+	gBlk := util.NewBeaconBlock()
+	util.SaveBlock(t, ctx, beaconDB, gBlk)
+	gBlkRoot, err := gBlk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, gBlkRoot))
+	beaconState, _ := util.DeterministicGenesisState(t, 32)
+	require.NoError(t, beaconState.SetSlot(8))
+	require.NoError(t, beaconDB.SaveStateDiff(ctx, beaconState))
+
+	blk := util.NewBeaconBlock()
+	blk.Block.Slot = 11
+	blk.Block.ProposerIndex = 8
+	blk.Block.ParentRoot = gBlkRoot[:]
+	util.SaveBlock(t, ctx, beaconDB, blk)
+	blkRoot, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveStateSummary(ctx, &ethpb.StateSummary{Slot: 10, Root: blkRoot[:]}))
+
+	// The state is not replayed from the state diffs until the block is finalized.
+	_, err = service.loadStateByRoot(ctx, blkRoot)
+	require.ErrorContains(t, "unknown boundary state", err)
+
+	require.NoError(t, beaconDB.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Root: blkRoot[:]}))
+	loadedState, err := service.loadStateByRoot(ctx, blkRoot)
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Slot(10), loadedState.Slot(), "Did not correctly load state")
+	assert.DeepEqual(t, beaconState.Validators(), loadedState.Validators())
+}
+
 func TestLastAncestorState_CanGetUsingDB(t *testing.T) {
 	ctx := context.Background()
 	beaconDB := testDB.SetupDB(t)
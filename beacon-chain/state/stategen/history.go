@@ -114,7 +114,11 @@ func (c *CanonicalHistory) chainForSlot(ctx context.Context, target primitives.S
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "unable to retrieve canonical block for slot, root=%#x", r)
 	}
-	s, descendants, err := c.ancestorChain(ctx, b)
+	diffState, err := c.stateFromDiffs(ctx, target)
+	if err != nil {
+		return nil, nil, err
+	}
+	s, descendants, err := c.ancestorChain(ctx, b, diffState)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "failed to query for ancestor and descendant blocks")
 	}
@@ -122,6 +126,23 @@ func (c *CanonicalHistory) chainForSlot(ctx context.Context, target primitives.S
 	return s, descendants, nil
 }
 
+// stateFromDiffs returns the most recent finalized state at or before the target slot which the database can reconstruct
+// from state diffs, or nil when the database does not store finalized states as state diffs.
+func (c *CanonicalHistory) stateFromDiffs(ctx context.Context, target primitives.Slot) (state.BeaconState, error) {
+	sd, ok := c.h.(stateDiffReader)
+	if !ok {
+		return nil, nil
+	}
+	st, err := sd.StateFromDiffs(ctx, target)
+	if errors.Is(err, db.ErrNotFoundState) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not reconstruct state from state diffs below slot=%d", target)
+	}
+	return st, nil
+}
+
 func (c *CanonicalHistory) getState(ctx context.Context, blockRoot [32]byte) (state.BeaconState, error) {
 	if c.cache != nil {
 		st, err := c.cache.ByBlockRoot(blockRoot)
@@ -138,9 +159,11 @@ func (c *CanonicalHistory) getState(ctx context.Context, blockRoot [32]byte) (st
 // ancestorChain works backwards through the chain lineage, accumulating blocks and checking for a saved state.
 // If it finds a saved state that the tail block was descended from, it returns this state and
 // all blocks in the lineage, including the tail block. Blocks are returned in ascending order.
+// The search also stops at the first block at or before the slot of the optional floor state, which must be a
+// canonical state, e.g. one reconstructed from state diffs.
 // Note that this function assumes that the tail is a canonical block, and therefore assumes that
 // all ancestors are also canonical.
-func (c *CanonicalHistory) ancestorChain(ctx context.Context, tail interfaces.ReadOnlySignedBeaconBlock) (state.BeaconState, []interfaces.ReadOnlySignedBeaconBlock, error) {
+func (c *CanonicalHistory) ancestorChain(ctx context.Context, tail interfaces.ReadOnlySignedBeaconBlock, floor state.BeaconState) (state.BeaconState, []interfaces.ReadOnlySignedBeaconBlock, error) {
 	ctx, span := trace.StartSpan(ctx, "canonicalChainer.ancestorChain")
 	defer span.End()
 	chain := make([]interfaces.ReadOnlySignedBeaconBlock, 0)
@@ -150,6 +173,11 @@ func (c *CanonicalHistory) ancestorChain(ctx context.Context, tail interfaces.Re
 			return nil, nil, errors.Wrap(err, msg)
 		}
 		b := tail.Block()
+		// The floor state was built from this block and every canonical block before it.
+		if floor != nil && b.Slot() <= floor.Slot() {
+			reverseChain(chain)
+			return floor, chain, nil
+		}
 		// compute hash_tree_root of current block and try to look up the corresponding state
 		root, err := b.HashTreeRoot()
 		if err != nil {
@@ -285,7 +285,7 @@ func TestAncestorChainCache(t *testing.T) {
 	require.Equal(t, 1, len(hist.states))
 
 	endBlock := hist.blocks[hist.slotMap[end]]
-	st, bs, err := ch.ancestorChain(ctx, endBlock)
+	st, bs, err := ch.ancestorChain(ctx, endBlock, nil)
 	require.NoError(t, err)
 	require.Equal(t, 3, len(bs))
 	expectedHTR, err := hist.states[hist.slotMap[0]].HashTreeRoot(ctx)
@@ -300,7 +300,7 @@ func TestAncestorChainCache(t *testing.T) {
 			hist.slotMap[end]: hist.hiddenStates[hist.slotMap[end]],
 		},
 	}
-	st, bs, err = ch.ancestorChain(ctx, endBlock)
+	st, bs, err = ch.ancestorChain(ctx, endBlock, nil)
 	require.NoError(t, err)
 	require.Equal(t, 0, len(bs))
 	expectedHTR, err = hist.hiddenStates[hist.slotMap[end]].HashTreeRoot(ctx)
@@ -315,7 +315,7 @@ func TestAncestorChainCache(t *testing.T) {
 			hist.slotMap[begin]: hist.hiddenStates[hist.slotMap[begin]],
 		},
 	}
-	st, bs, err = ch.ancestorChain(ctx, endBlock)
+	st, bs, err = ch.ancestorChain(ctx, endBlock, nil)
 	require.NoError(t, err)
 	require.Equal(t, 2, len(bs))
 	expectedHTR, err = hist.hiddenStates[hist.slotMap[begin]].HashTreeRoot(ctx)
@@ -333,7 +333,7 @@ func TestAncestorChainCache(t *testing.T) {
 			hist.slotMap[begin]: hist.hiddenStates[hist.slotMap[begin]],
 		},
 	}
-	st, bs, err = ch.ancestorChain(ctx, endBlock)
+	st, bs, err = ch.ancestorChain(ctx, endBlock, nil)
 	require.NoError(t, err)
 	require.Equal(t, 0, len(bs))
 	expectedHTR, err = hist.states[hist.slotMap[end]].HashTreeRoot(ctx)
@@ -355,7 +355,7 @@ func TestAncestorChainOK(t *testing.T) {
 	ch := &CanonicalHistory{h: hist, cc: hist, cs: hist}
 
 	endBlock := hist.blocks[hist.slotMap[end]]
-	st, bs, err := ch.ancestorChain(ctx, endBlock)
+	st, bs, err := ch.ancestorChain(ctx, endBlock, nil)
 	require.NoError(t, err)
 
 	// middle is the most recent slot where savedState == true
@@ -368,7 +368,7 @@ func TestAncestorChainOK(t *testing.T) {
 	require.Equal(t, expectedHTR, actualHTR)
 
 	middleBlock := hist.blocks[hist.slotMap[middle]]
-	st, bs, err = ch.ancestorChain(ctx, middleBlock)
+	st, bs, err = ch.ancestorChain(ctx, middleBlock, nil)
 	require.NoError(t, err)
 	actualHTR, err = st.HashTreeRoot(ctx)
 	require.NoError(t, err)
@@ -445,6 +445,37 @@ func TestChainForSlot(t *testing.T) {
 	}
 }
 
+func TestChainForSlot_StateDiffs(t *testing.T) {
+	ctx := context.Background()
+	var zero, one, two, three primitives.Slot = 50, 51, 150, 151
+	specs := []mockHistorySpec{
+		{slot: zero, canonicalBlock: true, savedState: true},
+		{slot: one, canonicalBlock: true},
+		{slot: two},
+		{slot: three, canonicalBlock: true},
+	}
+	hist := newMockHistory(t, specs, three+10)
+	diffState := hist.states[hist.slotMap[zero]].Copy()
+	require.NoError(t, diffState.SetSlot(100))
+	dh := &mockDiffHistory{mockHistory: hist, diffState: diffState}
+	ch := &CanonicalHistory{h: dh, cc: hist, cs: hist}
+
+	// The state reconstructed from the state diffs is used as a starting point when it is below the target slot.
+	st, blocks, err := ch.chainForSlot(ctx, three)
+	require.NoError(t, err)
+	require.Equal(t, primitives.Slot(100), st.Slot())
+	require.Equal(t, 2, len(blocks))
+	require.Equal(t, two, blocks[0].Block().Slot())
+	require.Equal(t, three, blocks[1].Block().Slot())
+
+	// Otherwise the state is looked up by block root.
+	st, blocks, err = ch.chainForSlot(ctx, one)
+	require.NoError(t, err)
+	require.Equal(t, zero, st.Slot())
+	require.Equal(t, 1, len(blocks))
+	require.Equal(t, one, blocks[0].Block().Slot())
+}
+
 func TestAncestorChainOrdering(t *testing.T) {
 	ctx := context.Background()
 	var zero, one, two, three, four, five primitives.Slot = 50, 51, 150, 151, 152, 200
@@ -462,7 +493,7 @@ func TestAncestorChainOrdering(t *testing.T) {
 	endBlock := hist.blocks[endRoot]
 
 	ch := &CanonicalHistory{h: hist, cc: hist, cs: hist}
-	st, bs, err := ch.ancestorChain(ctx, endBlock)
+	st, bs, err := ch.ancestorChain(ctx, endBlock, nil)
 	require.NoError(t, err)
 	expectedRoot, err := hist.states[hist.slotMap[one]].HashTreeRoot(ctx)
 	require.NoError(t, err)
@@ -485,7 +516,7 @@ func TestAncestorChainOrdering(t *testing.T) {
 	endBlock = hist.blocks[endRoot]
 
 	ch = &CanonicalHistory{h: hist, cc: hist, cs: hist}
-	st, bs, err = ch.ancestorChain(ctx, endBlock)
+	st, bs, err = ch.ancestorChain(ctx, endBlock, nil)
 	require.NoError(t, err)
 	expectedRoot, err = hist.states[endRoot].HashTreeRoot(ctx)
 	require.NoError(t, err)
@@ -502,7 +533,7 @@ func TestAncestorChainOrdering(t *testing.T) {
 	ch = &CanonicalHistory{h: hist, cc: hist, cs: hist}
 	endRoot = hist.slotMap[specs[len(specs)-1].slot]
 	endBlock = hist.blocks[endRoot]
-	st, bs, err = ch.ancestorChain(ctx, endBlock)
+	st, bs, err = ch.ancestorChain(ctx, endBlock, nil)
 	require.NoError(t, err)
 	expectedRoot, err = hist.states[hist.slotMap[one]].HashTreeRoot(ctx)
 	require.NoError(t, err)
@@ -5,7 +5,9 @@ import (
 	"encoding/hex"
 	"fmt"
 
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	"github.com/sirupsen/logrus"
@@ -73,7 +75,7 @@ func (s *State) MigrateToCold(ctx context.Context, fRoot [32]byte) error {
 				aRoot = roots[0]
 				// There's no need to generate the state if the state already exists in the DB.
 				// We can skip saving the state.
-				if !s.beaconDB.HasState(ctx, aRoot) {
+				if s.stateDiffs || !s.beaconDB.HasState(ctx, aRoot) {
 					aState, err = s.StateByRoot(ctx, aRoot)
 					if err != nil {
 						return err
@@ -81,6 +83,13 @@ func (s *State) MigrateToCold(ctx context.Context, fRoot [32]byte) error {
 				}
 			}
 
+			if s.stateDiffs {
+				if err := s.saveStateDiff(ctx, slot, aState); err != nil {
+					return errors.Wrapf(err, "could not save state diff at slot %d", slot)
+				}
+				continue
+			}
+
 			if s.beaconDB.HasState(ctx, aRoot) {
 				// If you are migrating a state and its already part of the hot state cache saved to the db,
 				// you can just remove it from the hot state cache as it becomes redundant.
@@ -120,3 +129,20 @@ func (s *State) MigrateToCold(ctx context.Context, fRoot [32]byte) error {
 
 	return nil
 }
+
+// saveStateDiff saves the finalized state at the given slot of the state diff hierarchy. The state of the last block
+// before that slot is advanced through the remaining empty slots.
+func (s *State) saveStateDiff(ctx context.Context, slot primitives.Slot, st state.BeaconState) error {
+	if st.Slot() < slot {
+		var err error
+		st, err = ReplayProcessSlots(ctx, st.Copy(), slot)
+		if err != nil {
+			return err
+		}
+	}
+	if err := s.beaconDB.SaveStateDiff(ctx, st); err != nil {
+		return err
+	}
+	log.WithField("slot", slot).Debug("Saved state diff in DB")
+	return nil
+}
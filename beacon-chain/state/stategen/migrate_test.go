@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
 	testDB "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
 	consensusblocks "github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
@@ -69,6 +70,37 @@ func TestMigrateToCold_HappyPath(t *testing.T) {
 	require.LogsContain(t, hook, "Saved state in DB")
 }
 
+func TestMigrateToCold_StateDiffs(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t, kv.WithStateDiffExponents([]uint64{4, 3}))
+
+	service := New(beaconDB, doublylinkedtree.New())
+	beaconState, _ := util.DeterministicGenesisState(t, 32)
+	require.NoError(t, beaconState.SetSlot(8))
+	b := util.NewBeaconBlock()
+	b.Block.Slot = 8
+	aRoot, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	util.SaveBlock(t, ctx, service.beaconDB, b)
+	require.NoError(t, service.epochBoundaryStateCache.put(aRoot, beaconState))
+	fBlock := util.NewBeaconBlock()
+	fBlock.Block.Slot = 9
+	fRoot, err := fBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+	util.SaveBlock(t, ctx, service.beaconDB, fBlock)
+	require.NoError(t, service.MigrateToCold(ctx, fRoot))
+
+	// Archived states are only stored as state diffs.
+	assert.Equal(t, false, service.beaconDB.HasState(ctx, aRoot), "Saved full state")
+	gotState, err := service.beaconDB.StateFromDiffs(ctx, 9)
+	require.NoError(t, err)
+	wanted, err := beaconState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	got, err := gotState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, wanted, got, "Did not save state diff")
+}
+
 func TestMigrateToCold_RegeneratePath(t *testing.T) {
 	hook := logTest.NewGlobal()
 	ctx := context.Background()
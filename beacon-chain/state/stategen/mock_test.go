@@ -162,6 +162,19 @@ func (m *mockHistory) CurrentSlot() primitives.Slot {
 	return m.current
 }
 
+// mockDiffHistory reconstructs a single state from state diffs.
+type mockDiffHistory struct {
+	*mockHistory
+	diffState state.BeaconState
+}
+
+func (m *mockDiffHistory) StateFromDiffs(_ context.Context, slot primitives.Slot) (state.BeaconState, error) {
+	if m.diffState.Slot() > slot {
+		return nil, db.ErrNotFoundState
+	}
+	return m.diffState.Copy(), nil
+}
+
 func (h *mockHistory) addBlock(root [32]byte, b interfaces.ReadOnlySignedBeaconBlock, canon bool) {
 	h.blocks[root] = b
 	h.slotMap[b.Block().Slot()] = root
@@ -32,6 +32,11 @@ type HistoryAccessor interface {
 	StateOrError(ctx context.Context, blockRoot [32]byte) (state.BeaconState, error)
 }
 
+// stateDiffReader is implemented by databases which can reconstruct finalized states from state diffs.
+type stateDiffReader interface {
+	StateFromDiffs(ctx context.Context, slot primitives.Slot) (state.BeaconState, error)
+}
+
 // CanonicalChecker determines whether the given block root is canonical.
 // In practice this should be satisfied by a type that uses the fork choice store.
 type CanonicalChecker interface {
@@ -56,6 +56,7 @@ type State struct {
 	avb                     coverage.AvailableBlocker
 	migrationLock           *sync.Mutex
 	fc                      forkchoice.ForkChoicer
+	stateDiffs              bool
 }
 
 // This tracks the config in the event of long non-finality,
@@ -102,6 +103,11 @@ func New(beaconDB db.NoHeadAccessDatabase, fc forkchoice.ForkChoicer, opts ...Op
 		migrationLock: new(sync.Mutex),
 		fc:            fc,
 	}
+	// When the db stores finalized states as state diffs, the states of the diff hierarchy replace the archived points.
+	if interval := beaconDB.StateDiffInterval(); interval > 0 {
+		s.slotsPerArchivedPoint = interval
+		s.stateDiffs = true
+	}
 	for _, o := range opts {
 		o(s)
 	}
@@ -0,0 +1,108 @@
+package backfill
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/das"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+var (
+	// ErrNoHistoryToImport is returned when the db holds the complete block history, because it was synced from genesis.
+	ErrNoHistoryToImport  = errors.New("db was synced from genesis, there is no missing history to import")
+	errBlobsNotImportable = errors.New("blocks within the blob retention period with blob commitments cannot be imported without their blobs")
+)
+
+// HistoryImporter imports finalized blocks that were obtained outside of the p2p network, like from era files,
+// into the gap between genesis and the checkpoint sync origin. Blocks go through the same signature and parent
+// root checks as blocks downloaded by the backfill service, and the db is updated the same way.
+type HistoryImporter struct {
+	store    *Store
+	verifier *verifier
+	current  primitives.Slot
+}
+
+// NewHistoryImporter initializes a HistoryImporter for the given db, using the validator public keys of the
+// checkpoint sync origin state to verify block signatures.
+func NewHistoryImporter(ctx context.Context, db BeaconDB) (*HistoryImporter, error) {
+	su, err := NewUpdater(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	if su.isGenesisSync() {
+		return nil, ErrNoHistoryToImport
+	}
+	cps, err := su.originState(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve checkpoint sync origin state")
+	}
+	keys, err := cps.PublicKeys()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to retrieve public keys for all validators in the origin state")
+	}
+	v, err := newBackfillVerifier(cps.GenesisValidatorsRoot(), keys)
+	if err != nil {
+		return nil, err
+	}
+	return &HistoryImporter{store: su, verifier: v, current: slots.CurrentSlot(cps.GenesisTime())}, nil
+}
+
+// LowSlot returns the slot of the lowest block in the db. Blocks below this slot are missing until
+// imported, unless it is 1, in which case the history is complete.
+func (hi *HistoryImporter) LowSlot() primitives.Slot {
+	return primitives.Slot(hi.store.status().LowSlot)
+}
+
+// Import verifies and saves the given finalized blocks, which must be sorted by slot without gaps in the chain.
+// Blocks at or above LowSlot, as well as the genesis block, are skipped. The highest remaining block must be
+// the parent of the block at LowSlot. It returns the number of imported blocks.
+func (hi *HistoryImporter) Import(ctx context.Context, blks []interfaces.ReadOnlySignedBeaconBlock) (int, error) {
+	low := hi.LowSlot()
+	missing := make([]interfaces.ReadOnlySignedBeaconBlock, 0, len(blks))
+	for _, b := range blks {
+		if b.Block().Slot() == 0 || b.Block().Slot() >= low {
+			continue
+		}
+		missing = append(missing, b)
+	}
+	if len(missing) == 0 {
+		return 0, nil
+	}
+	vb, err := hi.verifier.verify(missing)
+	if err != nil {
+		return 0, err
+	}
+	retentionStart, err := sync.BlobRPCMinValidSlot(hi.current)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not compute minimum blob retention slot")
+	}
+	bs, err := vb.blobIdents(retentionStart)
+	if err != nil {
+		return 0, err
+	}
+	if len(bs) > 0 {
+		return 0, errors.Wrapf(errBlobsNotImportable, "block root=%#x", bs[0].blockRoot)
+	}
+	if _, err := hi.store.fillBack(ctx, hi.current, vb, blobsCheckedStore{}); err != nil {
+		return 0, err
+	}
+	return len(vb), nil
+}
+
+// blobsCheckedStore is a das.AvailabilityStore for blocks that were already checked to have no blobs to be retained.
+type blobsCheckedStore struct{}
+
+var _ das.AvailabilityStore = blobsCheckedStore{}
+
+func (blobsCheckedStore) IsDataAvailable(context.Context, primitives.Slot, blocks.ROBlock) error {
+	return nil
+}
+
+func (blobsCheckedStore) Persist(primitives.Slot, ...blocks.ROBlob) error {
+	return nil
+}
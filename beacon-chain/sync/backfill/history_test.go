@@ -0,0 +1,99 @@
+package backfill
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/proto/dbval"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func testHistoryImporter(t *testing.T, nBlocks uint64, nBlobs int) (*HistoryImporter, *mockBackfillDB, []interfaces.ReadOnlySignedBeaconBlock) {
+	vr := make([]byte, 32)
+	copy(vr, "yooooo")
+	blks, _, _, _ := testBlocksWithKeys(t, nBlocks, nBlobs, vr)
+	st, _ := util.DeterministicGenesisState(t, nBlocks)
+	require.NoError(t, st.SetGenesisValidatorsRoot(vr))
+	originRoot := [32]byte{'o'}
+	highest := blks[len(blks)-1]
+	pr := highest.Block().ParentRoot()
+	mdb := &mockBackfillDB{
+		status: &dbval.BackfillStatus{
+			LowSlot:       uint64(highest.Block().Slot()),
+			LowRoot:       highest.RootSlice(),
+			LowParentRoot: pr[:],
+			OriginSlot:    uint64(highest.Block().Slot()),
+			OriginRoot:    originRoot[:],
+		},
+		states: map[[32]byte]state.BeaconState{originRoot: st},
+	}
+	hi, err := NewHistoryImporter(context.Background(), mdb)
+	require.NoError(t, err)
+	notrob := make([]interfaces.ReadOnlySignedBeaconBlock, len(blks))
+	for i := range blks {
+		notrob[i] = blks[i].ReadOnlySignedBeaconBlock
+	}
+	return hi, mdb, notrob
+}
+
+func TestHistoryImporter_Import(t *testing.T) {
+	ctx := context.Background()
+	hi, mdb, blks := testHistoryImporter(t, 5, 0)
+	require.Equal(t, primitives.Slot(4), hi.LowSlot())
+
+	n, err := hi.Import(ctx, blks)
+	require.NoError(t, err)
+	// The genesis block and the block at the origin are skipped.
+	require.Equal(t, 3, n)
+	require.Equal(t, primitives.Slot(1), hi.LowSlot())
+	require.Equal(t, uint64(1), mdb.status.LowSlot)
+	for _, b := range blks[1:4] {
+		root, err := b.Block().HashTreeRoot()
+		require.NoError(t, err)
+		_, ok := mdb.blocks[root]
+		require.Equal(t, true, ok)
+	}
+
+	n, err = hi.Import(ctx, blks)
+	require.NoError(t, err)
+	require.Equal(t, 0, n)
+}
+
+func TestHistoryImporter_ImportErrors(t *testing.T) {
+	ctx := context.Background()
+	hi, _, blks := testHistoryImporter(t, 5, 0)
+	_, err := hi.Import(ctx, blks[:3])
+	require.ErrorIs(t, err, errBatchDisconnected)
+	_, err = hi.Import(ctx, []interfaces.ReadOnlySignedBeaconBlock{blks[1], blks[3]})
+	require.ErrorIs(t, err, errInvalidBatchChain)
+	require.Equal(t, primitives.Slot(4), hi.LowSlot())
+
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.DenebForkEpoch = 0
+	params.OverrideBeaconConfig(cfg)
+	hi, _, blks = testHistoryImporter(t, 5, 1)
+	// Put the blocks within the blob retention period.
+	hi.current = 0
+	_, err = hi.Import(ctx, blks)
+	require.ErrorIs(t, err, errBlobsNotImportable)
+}
+
+func TestNewHistoryImporter_GenesisSync(t *testing.T) {
+	mdb := &mockBackfillDB{
+		backfillStatus: func(context.Context) (*dbval.BackfillStatus, error) {
+			return nil, db.ErrNotFound
+		},
+		originCheckpointBlockRoot: func(context.Context) ([32]byte, error) {
+			return [32]byte{}, db.ErrNotFoundOriginBlockRoot
+		},
+	}
+	_, err := NewHistoryImporter(context.Background(), mdb)
+	require.ErrorIs(t, err, ErrNoHistoryToImport)
+}
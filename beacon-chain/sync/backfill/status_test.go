@@ -143,6 +143,22 @@ func TestStatusUpdater_FillBack(t *testing.T) {
 	require.Equal(t, true, s.AvailableBlock(95))
 }
 
+func TestStatusUpdater_Reload(t *testing.T) {
+	ctx := context.Background()
+	mdb := &mockBackfillDB{status: &dbval.BackfillStatus{LowSlot: 100}}
+	s := &Store{genesisSync: true, store: mdb}
+	require.Equal(t, true, s.AvailableBlock(50))
+	require.NoError(t, s.Reload(ctx))
+	require.Equal(t, false, s.AvailableBlock(50))
+	require.Equal(t, true, s.AvailableBlock(100))
+
+	mdb.backfillStatus = func(context.Context) (*dbval.BackfillStatus, error) {
+		return nil, errEmptyMockDBMethod
+	}
+	require.ErrorIs(t, s.Reload(ctx), errEmptyMockDBMethod)
+	require.Equal(t, true, s.AvailableBlock(100))
+}
+
 func goodBlockRoot(root [32]byte) func(ctx context.Context) ([32]byte, error) {
 	return func(ctx context.Context) ([32]byte, error) {
 		return root, nil
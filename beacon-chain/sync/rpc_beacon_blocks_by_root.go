@@ -7,6 +7,8 @@ import (
 	libp2pcore "github.com/libp2p/go-libp2p/core"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/peerdas"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/types"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/sync/verify"
@@ -66,6 +68,22 @@ func (s *Service) sendBeaconBlocksRequest(ctx context.Context, requests *types.B
 			return err
 		}
 	}
+	for _, blk := range blks {
+		if blk.Version() < version.Fulu {
+			continue
+		}
+		blkRoot, err := blk.Block().HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		request, err := s.pendingDataColumnsRequestForBlock(ctx, blkRoot, blk)
+		if err != nil {
+			return err
+		}
+		if err := s.sendAndSaveDataColumnSidecars(ctx, request, id, blk); err != nil {
+			return err
+		}
+	}
 	return err
 }
 
@@ -171,8 +189,8 @@ func (s *Service) sendAndSaveBlobSidecars(ctx context.Context, request types.Blo
 }
 
 func (s *Service) pendingBlobsRequestForBlock(root [32]byte, b interfaces.ReadOnlySignedBeaconBlock) (types.BlobSidecarsByRootReq, error) {
-	if b.Version() < version.Deneb {
-		return nil, nil // Block before deneb has no blob.
+	if b.Version() < version.Deneb || b.Version() >= version.Fulu {
+		return nil, nil // Block before deneb has no blob, and from fulu on its data is served as data columns.
 	}
 	cc, err := b.Block().Body().BlobKzgCommitments()
 	if err != nil {
@@ -209,3 +227,93 @@ func requestsForMissingIndices(storedIndices []bool, commitments int, root [32]b
 	}
 	return ids
 }
+
+// sendAndSaveDataColumnSidecars sends the data column request and saves received sidecars.
+func (s *Service) sendAndSaveDataColumnSidecars(ctx context.Context, request types.DataColumnSidecarsByRootReq, peerID peer.ID, block interfaces.ReadOnlySignedBeaconBlock) error {
+	if len(request) == 0 {
+		return nil
+	}
+
+	sidecars, err := SendDataColumnSidecarsByRootRequest(ctx, s.cfg.clock, s.cfg.p2p, peerID, s.ctxMap, &request)
+	if err != nil {
+		return err
+	}
+
+	roBlock, err := blocks.NewROBlock(block)
+	if err != nil {
+		return err
+	}
+	// A peer may not custody all the requested columns, so a partial response is not an error here.
+	// Any column still missing is caught by the data availability check when the block is processed.
+	bv := verification.NewDataColumnBatchVerifier(s.newDataColumnVerifier, verification.PendingQueueDataColumnSidecarRequirements)
+	vcs, err := bv.VerifiedRODataColumns(ctx, roBlock, sidecars)
+	if err != nil {
+		return err
+	}
+	for i := range vcs {
+		if err := s.cfg.dataColumnStorage.Save(vcs[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Service) pendingDataColumnsRequestForBlock(ctx context.Context, root [32]byte, b interfaces.ReadOnlySignedBeaconBlock) (types.DataColumnSidecarsByRootReq, error) {
+	if b.Version() < version.Fulu {
+		return nil, nil
+	}
+	cc, err := b.Block().Body().BlobKzgCommitments()
+	if err != nil {
+		return nil, err
+	}
+	if len(cc) == 0 {
+		return nil, nil
+	}
+	return s.constructPendingDataColumnsRequest(ctx, root)
+}
+
+// constructPendingDataColumnsRequest creates a request for the custody columns of the given root, skipping the
+// columns that are already present in the data column storage.
+func (s *Service) constructPendingDataColumnsRequest(ctx context.Context, root [32]byte) (types.DataColumnSidecarsByRootReq, error) {
+	custody, err := peerdas.CustodyColumnsForNode(s.cfg.p2p.NodeID(), s.cfg.p2p.CustodyGroupCount())
+	if err != nil {
+		return nil, errors.Wrap(err, "custody columns for node")
+	}
+	stored, err := s.storedDataColumns(ctx, root)
+	if err != nil {
+		return nil, err
+	}
+	return requestsForMissingColumns(stored, custody, root), nil
+}
+
+// storedDataColumns returns a function reporting whether a column is stored for the given root. It relies on the
+// data column storage summary when available, and falls back to listing the root directory otherwise.
+func (s *Service) storedDataColumns(ctx context.Context, root [32]byte) (func(uint64) bool, error) {
+	sumz, err := s.cfg.dataColumnStorage.WaitForSummarizer(ctx)
+	if err == nil {
+		return sumz.Summary(root).HasIndex, nil
+	}
+	if !errors.Is(err, filesystem.ErrDataColumnStorageSummarizerUnavailable) {
+		return nil, err
+	}
+	indices, err := s.cfg.dataColumnStorage.Indices(root)
+	if err != nil {
+		return nil, err
+	}
+	return func(idx uint64) bool {
+		return idx < uint64(len(indices)) && indices[idx]
+	}, nil
+}
+
+// requestsForMissingColumns constructs a slice of DataColumnIdentifiers for the custody columns
+// that are missing from local storage, sorted by column index.
+func requestsForMissingColumns(stored func(uint64) bool, custody map[uint64]bool, root [32]byte) types.DataColumnSidecarsByRootReq {
+	var ids types.DataColumnSidecarsByRootReq
+	for idx := uint64(0); idx < params.BeaconConfig().NumberOfColumns; idx++ {
+		if !custody[idx] || stored(idx) {
+			continue
+		}
+		ids = append(ids, &eth.DataColumnIdentifier{BlockRoot: root[:], ColumnIndex: idx})
+	}
+	return ids
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/libp2p/go-libp2p/core/protocol"
 	gcache "github.com/patrickmn/go-cache"
 	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/peerdas"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
 	db "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
@@ -477,3 +478,48 @@ func TestFilterUnknownIndices(t *testing.T) {
 	require.Equal(t, expected[1].Index, actual[1].Index)
 	require.DeepEqual(t, actual[1].BlockRoot, expected[1].BlockRoot)
 }
+
+func TestConstructPendingDataColumnsRequest(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	dcs := filesystem.NewEphemeralDataColumnStorage(t)
+	s := &Service{cfg: &config{p2p: p1, dataColumnStorage: dcs}}
+	ctx := context.Background()
+
+	custody, err := peerdas.CustodyColumnsForNode(p1.NodeID(), p1.CustodyGroupCount())
+	require.NoError(t, err)
+
+	_, columns := util.GenerateTestFuluBlockWithDataColumns(t, [32]byte{}, 1, 1)
+	root := columns[0].BlockRoot()
+
+	// Nothing stored, every custody column is requested.
+	actual, err := s.constructPendingDataColumnsRequest(ctx, root)
+	require.NoError(t, err)
+	require.Equal(t, len(custody), len(actual))
+	for _, id := range actual {
+		require.Equal(t, true, custody[id.ColumnIndex])
+		require.DeepEqual(t, root[:], id.BlockRoot)
+	}
+
+	// Stored custody columns are skipped.
+	verified := verification.FakeVerifyDataColumnSliceForTest(t, columns)
+	stored := actual[0].ColumnIndex
+	require.NoError(t, dcs.Save(verified[stored]))
+	actual, err = s.constructPendingDataColumnsRequest(ctx, root)
+	require.NoError(t, err)
+	require.Equal(t, len(custody)-1, len(actual))
+	for _, id := range actual {
+		require.NotEqual(t, stored, id.ColumnIndex)
+	}
+}
+
+func TestRequestsForMissingColumns(t *testing.T) {
+	root := [32]byte{1}
+	custody := map[uint64]bool{1: true, 4: true, 9: true}
+	stored := func(idx uint64) bool { return idx == 4 }
+
+	actual := requestsForMissingColumns(stored, custody, root)
+	require.Equal(t, 2, len(actual))
+	require.Equal(t, uint64(1), actual[0].ColumnIndex)
+	require.Equal(t, uint64(9), actual[1].ColumnIndex)
+	require.DeepEqual(t, root[:], actual[0].BlockRoot)
+}
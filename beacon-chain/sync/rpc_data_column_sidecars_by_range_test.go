@@ -0,0 +1,126 @@
+package sync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	p2pTypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+func TestDataColumnSidecarsByRangeRPCHandler(t *testing.T) {
+	s, slot := setupDataColumnRPCTest(t)
+	first, firstDcs := util.GenerateTestFuluBlockWithDataColumns(t, [32]byte{}, slot, 1)
+	saveDataColumns(t, s, first, firstDcs, 0, 1, 2)
+	second, secondDcs := util.GenerateTestFuluBlockWithDataColumns(t, first.Root(), slot+1, 1)
+	saveDataColumns(t, s, second, secondDcs, 1, 2)
+
+	req := &ethpb.DataColumnSidecarsByRangeRequest{
+		StartSlot: slot,
+		Count:     2,
+		Columns:   []uint64{0, 2},
+	}
+	rht := &rpcHandlerTest{
+		t:       t,
+		topic:   protocol.ID(p2p.RPCDataColumnSidecarsByRangeTopicV1 + s.cfg.p2p.Encoding().ProtocolSuffix()),
+		timeout: 10 * time.Second,
+		s:       s,
+	}
+	expected := []blocks.RODataColumn{firstDcs[0], firstDcs[2], secondDcs[2]}
+	rht.testHandler(dataColumnStreamReader(t, expected), s.dataColumnSidecarsByRangeRPCHandler, req)
+}
+
+func TestValidateDataColumnsByRange(t *testing.T) {
+	s, slot := setupDataColumnRPCTest(t)
+	current := s.cfg.clock.CurrentSlot()
+	minStart, err := DataColumnRPCMinValidSlot(current)
+	require.NoError(t, err)
+	require.Equal(t, slot-1, minStart)
+
+	cases := []struct {
+		name   string
+		req    *ethpb.DataColumnSidecarsByRangeRequest
+		start  primitives.Slot
+		end    primitives.Slot
+		errIs  error
+		noSize bool
+	}{
+		{
+			name:  "zero count",
+			req:   &ethpb.DataColumnSidecarsByRangeRequest{StartSlot: slot, Count: 0, Columns: []uint64{0}},
+			errIs: p2pTypes.ErrInvalidRequest,
+		},
+		{
+			name:  "no columns",
+			req:   &ethpb.DataColumnSidecarsByRangeRequest{StartSlot: slot, Count: 1},
+			errIs: p2pTypes.ErrInvalidRequest,
+		},
+		{
+			name:  "column out of bounds",
+			req:   &ethpb.DataColumnSidecarsByRangeRequest{StartSlot: slot, Count: 1, Columns: []uint64{params.BeaconConfig().NumberOfColumns}},
+			errIs: p2pTypes.ErrInvalidRequest,
+		},
+		{
+			name:  "start before retention is clamped",
+			req:   &ethpb.DataColumnSidecarsByRangeRequest{StartSlot: 0, Count: 1, Columns: []uint64{0}},
+			start: minStart,
+			end:   minStart,
+		},
+		{
+			name:  "valid",
+			req:   &ethpb.DataColumnSidecarsByRangeRequest{StartSlot: slot, Count: 4, Columns: []uint64{0, 1}},
+			start: slot,
+			end:   slot + 3,
+		},
+		{
+			name:   "future start is a noop",
+			req:    &ethpb.DataColumnSidecarsByRangeRequest{StartSlot: current + 1, Count: 4, Columns: []uint64{0}},
+			start:  current,
+			end:    current,
+			noSize: true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rp, err := validateDataColumnsByRange(c.req, current)
+			if c.errIs != nil {
+				require.ErrorIs(t, err, c.errIs)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, c.start, rp.start)
+			require.Equal(t, c.end, rp.end)
+			if c.noSize {
+				require.Equal(t, uint64(0), rp.size)
+			}
+		})
+	}
+}
+
+func TestDataColumnRPCMinValidSlot(t *testing.T) {
+	s, _ := setupDataColumnRPCTest(t)
+	fuluStart, err := slots.EpochStart(params.BeaconConfig().FuluForkEpoch)
+	require.NoError(t, err)
+
+	// Within MIN_EPOCHS_FOR_DATA_COLUMN_SIDECARS_REQUESTS of fulu, the fork epoch is the lower bound.
+	minStart, err := DataColumnRPCMinValidSlot(s.cfg.clock.CurrentSlot())
+	require.NoError(t, err)
+	require.Equal(t, fuluStart, minStart)
+
+	// Past the retention period the lower bound follows the current epoch.
+	current, err := slots.EpochStart(params.BeaconConfig().FuluForkEpoch + params.BeaconConfig().MinEpochsForDataColumnSidecarsRequest + 5)
+	require.NoError(t, err)
+	minStart, err = DataColumnRPCMinValidSlot(current)
+	require.NoError(t, err)
+	expected, err := slots.EpochStart(params.BeaconConfig().FuluForkEpoch + 5)
+	require.NoError(t, err)
+	require.Equal(t, expected, minStart)
+}
@@ -0,0 +1,128 @@
+package sync
+
+import (
+	"context"
+	"io"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
+	db "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	p2ptest "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
+	p2pTypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	leakybucket "github.com/prysmaticlabs/prysm/v5/container/leaky-bucket"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// setupDataColumnRPCTest returns a service able to serve data column rpc requests, with the fulu fork scheduled
+// far enough in the past that the first fulu slot is within the retention period.
+func setupDataColumnRPCTest(t *testing.T) (*Service, primitives.Slot) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	repositionFutureEpochs(cfg)
+	if cfg.ElectraForkEpoch == math.MaxUint64 {
+		cfg.ElectraForkEpoch = cfg.DenebForkEpoch + 100
+	}
+	if cfg.FuluForkEpoch == math.MaxUint64 {
+		cfg.FuluForkEpoch = cfg.ElectraForkEpoch + 100
+	}
+	cfg.InitializeForkSchedule()
+	params.OverrideBeaconConfig(cfg)
+
+	fuluStart, err := slots.EpochStart(cfg.FuluForkEpoch)
+	require.NoError(t, err)
+	current, err := slots.EpochStart(cfg.FuluForkEpoch + 10)
+	require.NoError(t, err)
+	genesis := time.Now().Add(-1 * time.Second * time.Duration(uint64(current)*cfg.SecondsPerSlot))
+	clock := startup.NewClock(genesis, [32]byte{})
+	chain := &mock.ChainService{Genesis: genesis, FinalizedCheckPoint: &ethpb.Checkpoint{}}
+
+	client := p2ptest.NewTestP2P(t)
+	s := &Service{
+		cfg: &config{
+			p2p:               client,
+			chain:             chain,
+			clock:             clock,
+			beaconDB:          db.SetupDB(t),
+			dataColumnStorage: filesystem.NewEphemeralDataColumnStorage(t),
+		},
+		rateLimiter: newRateLimiter(client),
+	}
+	limit := int64(params.BeaconConfig().MaxRequestDataColumnSidecars)
+	s.setRateCollector(p2p.RPCDataColumnSidecarsByRootTopicV1, leakybucket.NewCollector(0.000001, limit, time.Second, false))
+	s.setRateCollector(p2p.RPCDataColumnSidecarsByRangeTopicV1, leakybucket.NewCollector(0.000001, limit, time.Second, false))
+	return s, fuluStart + 1
+}
+
+// saveDataColumns persists the block and the data columns with the given indices.
+func saveDataColumns(t *testing.T, s *Service, blk blocks.ROBlock, dcs []blocks.RODataColumn, indices ...uint64) {
+	require.NoError(t, s.cfg.beaconDB.SaveBlock(context.Background(), blk))
+	verified := verification.FakeVerifyDataColumnSliceForTest(t, dcs)
+	for _, i := range indices {
+		require.NoError(t, s.cfg.dataColumnStorage.Save(verified[i]))
+	}
+}
+
+// dataColumnStreamReader reads all data column chunks from the stream and compares them to the expected columns.
+func dataColumnStreamReader(t *testing.T, expected []blocks.RODataColumn) func(network.Stream) {
+	return func(stream network.Stream) {
+		ctxMap, err := ContextByteVersionsForValRoot([32]byte{})
+		require.NoError(t, err)
+		encoding := p2ptest.NewTestP2P(t).Encoding()
+		vf := func(blocks.RODataColumn) error { return nil }
+		for i := range expected {
+			dc, err := readChunkedDataColumnSidecar(stream, encoding, ctxMap, vf)
+			require.NoError(t, err)
+			require.Equal(t, expected[i].BlockRoot(), dc.BlockRoot())
+			require.Equal(t, expected[i].ColumnIndex, dc.ColumnIndex)
+		}
+		_, err = readChunkedDataColumnSidecar(stream, encoding, ctxMap, vf)
+		require.ErrorIs(t, err, io.EOF)
+	}
+}
+
+func TestDataColumnSidecarsByRootRPCHandler(t *testing.T) {
+	s, slot := setupDataColumnRPCTest(t)
+	blk, dcs := util.GenerateTestFuluBlockWithDataColumns(t, [32]byte{}, slot, 1)
+	saveDataColumns(t, s, blk, dcs, 0, 1, 2)
+	root := blk.Root()
+
+	req := p2pTypes.DataColumnSidecarsByRootReq{
+		{BlockRoot: root[:], ColumnIndex: 2},
+		{BlockRoot: root[:], ColumnIndex: 0},
+		// Not custodied, should be skipped.
+		{BlockRoot: root[:], ColumnIndex: 5},
+	}
+	rht := &rpcHandlerTest{
+		t:       t,
+		topic:   protocol.ID(p2p.RPCDataColumnSidecarsByRootTopicV1 + s.cfg.p2p.Encoding().ProtocolSuffix()),
+		timeout: 10 * time.Second,
+		s:       s,
+	}
+	rht.testHandler(dataColumnStreamReader(t, []blocks.RODataColumn{dcs[0], dcs[2]}), s.dataColumnSidecarByRootRPCHandler, &req)
+}
+
+func TestValidateDataColumnByRootRequest(t *testing.T) {
+	root := make([]byte, 32)
+	require.NoError(t, validateDataColumnByRootRequest(p2pTypes.DataColumnSidecarsByRootReq{{BlockRoot: root, ColumnIndex: 1}}))
+
+	numberOfColumns := params.BeaconConfig().NumberOfColumns
+	err := validateDataColumnByRootRequest(p2pTypes.DataColumnSidecarsByRootReq{{BlockRoot: root, ColumnIndex: numberOfColumns}})
+	require.ErrorIs(t, err, p2pTypes.ErrInvalidRequest)
+
+	tooMany := make(p2pTypes.DataColumnSidecarsByRootReq, params.BeaconConfig().MaxRequestDataColumnSidecars+1)
+	require.ErrorIs(t, validateDataColumnByRootRequest(tooMany), p2pTypes.ErrMaxDataColumnReqExceeded)
+}
@@ -0,0 +1,195 @@
+package sync
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	lightClient "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/light-client"
+	db "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	p2ptest "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/network/forks"
+	pb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+func newLightClientTestService(t *testing.T, p1 *p2ptest.TestP2P) *Service {
+	return &Service{
+		cfg: &config{
+			beaconDB: db.SetupDB(t),
+			p2p:      p1,
+			chain:    &mock.ChainService{ValidatorsRoot: [32]byte{'A'}},
+			lcStore:  lightClient.NewLightClientStore(),
+		},
+		rateLimiter: newRateLimiter(p1),
+	}
+}
+
+func testLightClientUpdate(t *testing.T) interfaces.LightClientUpdate {
+	l := util.NewTestLightClient(t).SetupTestAltair()
+	update, err := lightClient.NewLightClientUpdateFromBeaconState(l.Ctx, l.State.Slot(), l.State, l.Block, l.AttestedState, l.AttestedBlock, l.FinalizedBlock)
+	require.NoError(t, err)
+	return update
+}
+
+func expectLightClientChunkContext(t *testing.T, stream network.Stream, r *Service, update interfaces.LightClientOptimisticUpdate) {
+	expectSuccess(t, stream)
+	ctxBytes, err := readContextFromStream(stream)
+	require.NoError(t, err)
+	valRoot := r.cfg.chain.GenesisValidatorsRoot()
+	want, err := forks.ForkDigestFromEpoch(slots.ToEpoch(update.AttestedHeader().Beacon().Slot), valRoot[:])
+	require.NoError(t, err)
+	assert.DeepEqual(t, want[:], ctxBytes)
+}
+
+func TestLightClientOptimisticUpdateRPCHandler(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	r := newLightClientTestService(t, p1)
+
+	l := util.NewTestLightClient(t).SetupTestAltair()
+	update, err := lightClient.NewLightClientOptimisticUpdateFromBeaconState(l.Ctx, l.State.Slot(), l.State, l.Block, l.AttestedState, l.AttestedBlock)
+	require.NoError(t, err)
+	r.cfg.lcStore.SetLastOptimisticUpdate(update)
+
+	pcl := protocol.ID(p2p.RPCLightClientOptimisticUpdateTopicV1 + p1.Encoding().ProtocolSuffix())
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		expectLightClientChunkContext(t, stream, r, update)
+		out := &pb.LightClientOptimisticUpdateAltair{}
+		require.NoError(t, r.cfg.p2p.Encoding().DecodeWithMaxLength(stream, out))
+		assert.DeepSSZEqual(t, update.Proto(), out)
+	})
+	stream, err := p1.BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+
+	require.NoError(t, r.lightClientOptimisticUpdateRPCHandler(context.Background(), new(interface{}), stream))
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+func TestLightClientFinalityUpdateRPCHandler_Unavailable(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	r := newLightClientTestService(t, p1)
+
+	pcl := protocol.ID(p2p.RPCLightClientFinalityUpdateTopicV1 + p1.Encoding().ProtocolSuffix())
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		expectFailure(t, responseCodeResourceUnavailable, types.ErrResourceUnavailable.Error(), stream)
+	})
+	stream, err := p1.BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+
+	err = r.lightClientFinalityUpdateRPCHandler(context.Background(), new(interface{}), stream)
+	require.ErrorIs(t, err, types.ErrResourceUnavailable)
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+func TestLightClientBootstrapRPCHandler_NotFound(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	r := newLightClientTestService(t, p1)
+
+	pcl := protocol.ID(p2p.RPCLightClientBootstrapTopicV1 + p1.Encoding().ProtocolSuffix())
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		expectFailure(t, responseCodeResourceUnavailable, types.ErrResourceUnavailable.Error(), stream)
+	})
+	stream, err := p1.BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+
+	req := types.LightClientBootstrapReq([32]byte{'a'})
+	err = r.lightClientBootstrapRPCHandler(context.Background(), &req, stream)
+	require.ErrorIs(t, err, types.ErrResourceUnavailable)
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+func TestLightClientUpdatesByRangeRPCHandler(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	r := newLightClientTestService(t, p1)
+
+	update := testLightClientUpdate(t)
+	// Periods 1 and 2 are stored, but period 3 is missing, so period 4 must not be served.
+	for _, period := range []uint64{1, 2, 4} {
+		require.NoError(t, r.cfg.beaconDB.SaveLightClientUpdate(context.Background(), period, update))
+	}
+
+	pcl := protocol.ID(p2p.RPCLightClientUpdatesByRangeTopicV1 + p1.Encoding().ProtocolSuffix())
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		for i := 0; i < 2; i++ {
+			expectSuccess(t, stream)
+			_, err := readContextFromStream(stream)
+			require.NoError(t, err)
+			out := &pb.LightClientUpdateAltair{}
+			require.NoError(t, r.cfg.p2p.Encoding().DecodeWithMaxLength(stream, out))
+			assert.DeepSSZEqual(t, update.Proto(), out)
+		}
+		_, _, err := ReadStatusCode(stream, r.cfg.p2p.Encoding())
+		require.ErrorContains(t, "EOF", err)
+	})
+	stream, err := p1.BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+
+	req := &types.LightClientUpdatesByRangeReq{StartPeriod: 1, Count: 4}
+	require.NoError(t, r.lightClientUpdatesByRangeRPCHandler(context.Background(), req, stream))
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+func TestLightClientUpdatesByRangeRPCHandler_InvalidCount(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	r := newLightClientTestService(t, p1)
+
+	pcl := protocol.ID(p2p.RPCLightClientUpdatesByRangeTopicV1 + p1.Encoding().ProtocolSuffix())
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		code, _, err := ReadStatusCode(stream, r.cfg.p2p.Encoding())
+		require.NoError(t, err)
+		assert.Equal(t, responseCodeInvalidRequest, code)
+	})
+	stream, err := p1.BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+
+	req := &types.LightClientUpdatesByRangeReq{StartPeriod: params.BeaconConfig().MaxRequestLightClientUpdates, Count: 0}
+	err = r.lightClientUpdatesByRangeRPCHandler(context.Background(), req, stream)
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
@@ -691,3 +691,28 @@ func TestSeqBlobValid(t *testing.T) {
 		})
 	}
 }
+
+func TestDataColumnValidatorFromRootReq(t *testing.T) {
+	_, dcsA := util.GenerateTestFuluBlockWithDataColumns(t, [32]byte{}, 1, 1)
+	_, dcsB := util.GenerateTestFuluBlockWithDataColumns(t, [32]byte{'b'}, 2, 1)
+	rootA := dcsA[0].BlockRoot()
+	req := p2pTypes.DataColumnSidecarsByRootReq{{BlockRoot: rootA[:], ColumnIndex: 0}}
+	vf := dataColumnValidatorFromRootReq(&req)
+	require.NoError(t, vf(dcsA[0]))
+	require.ErrorIs(t, vf(dcsA[1]), errUnrequestedDataColumn)
+	require.ErrorIs(t, vf(dcsB[0]), errUnrequestedDataColumn)
+}
+
+func TestDataColumnValidatorFromRangeReq(t *testing.T) {
+	req := &ethpb.DataColumnSidecarsByRangeRequest{StartSlot: 10, Count: 2, Columns: []uint64{1}}
+	vf := dataColumnValidatorFromRangeReq(req)
+
+	_, dcs := util.GenerateTestFuluBlockWithDataColumns(t, [32]byte{}, 11, 1)
+	require.NoError(t, vf(dcs[1]))
+	require.ErrorIs(t, vf(dcs[0]), errUnrequestedDataColumn)
+
+	_, before := util.GenerateTestFuluBlockWithDataColumns(t, [32]byte{}, 9, 1)
+	require.ErrorIs(t, vf(before[1]), errDataColumnResponseOutOfBounds)
+	_, after := util.GenerateTestFuluBlockWithDataColumns(t, [32]byte{}, 12, 1)
+	require.ErrorIs(t, vf(after[1]), errDataColumnResponseOutOfBounds)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/altair"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/peerdas"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
 	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
@@ -77,6 +78,25 @@ func (s *Service) activeSyncSubnetIndices(currentSlot primitives.Slot) []uint64
 	return slice.SetUint64(subs)
 }
 
+// dataColumnSubnetIndices returns the data column subnets this node samples, as derived from its node ID
+// and custody group count.
+func (s *Service) dataColumnSubnetIndices(_ primitives.Slot) []uint64 {
+	samplingSize := peerdas.CustodyGroupSamplingSize(s.cfg.p2p.CustodyGroupCount())
+	columns, err := peerdas.CustodyColumnsForNode(s.cfg.p2p.NodeID(), samplingSize)
+	if err != nil {
+		log.WithError(err).Error("Could not compute data column subnets to subscribe to")
+		return []uint64{}
+	}
+	sampled := peerdas.DataColumnSubnets(columns)
+	subnets := make([]uint64, 0, len(sampled))
+	for subnet := uint64(0); subnet < params.BeaconConfig().DataColumnSidecarSubnetCount; subnet++ {
+		if sampled[subnet] {
+			subnets = append(subnets, subnet)
+		}
+	}
+	return subnets
+}
+
 // Register PubSub subscribers
 func (s *Service) registerSubscribers(epoch primitives.Epoch, digest [4]byte) {
 	s.subscribe(
@@ -135,6 +155,22 @@ func (s *Service) registerSubscribers(epoch primitives.Epoch, digest [4]byte) {
 		)
 	}
 
+	// Light client gossip topics, served since Altair when the node computes light client data.
+	if params.BeaconConfig().AltairForkEpoch <= epoch && features.Get().EnableLightClient {
+		s.subscribe(
+			p2p.LightClientFinalityUpdateTopicFormat,
+			s.validateLightClientFinalityUpdate,
+			s.lightClientFinalityUpdateSubscriber,
+			digest,
+		)
+		s.subscribe(
+			p2p.LightClientOptimisticUpdateTopicFormat,
+			s.validateLightClientOptimisticUpdate,
+			s.lightClientOptimisticUpdateSubscriber,
+			digest,
+		)
+	}
+
 	// New gossip topic in Capella
 	if params.BeaconConfig().CapellaForkEpoch <= epoch {
 		s.subscribe(
@@ -159,8 +195,8 @@ func (s *Service) registerSubscribers(epoch primitives.Epoch, digest [4]byte) {
 		)
 	}
 
-	// Modified gossip topic in Electra
-	if params.BeaconConfig().ElectraForkEpoch <= epoch {
+	// Modified gossip topic in Electra, replaced by data columns in Fulu
+	if params.BeaconConfig().ElectraForkEpoch <= epoch && epoch < params.BeaconConfig().FuluForkEpoch {
 		s.subscribeWithParameters(
 			p2p.BlobSubnetTopicFormat,
 			s.validateBlob,
@@ -172,6 +208,18 @@ func (s *Service) registerSubscribers(epoch primitives.Epoch, digest [4]byte) {
 			func(currentSlot primitives.Slot) []uint64 { return []uint64{} },
 		)
 	}
+
+	// New gossip topic in Fulu
+	if params.BeaconConfig().FuluForkEpoch <= epoch {
+		s.subscribeWithParameters(
+			p2p.DataColumnSubnetTopicFormat,
+			s.validateDataColumn,
+			s.dataColumnSubscriber,
+			digest,
+			s.dataColumnSubnetIndices,
+			func(currentSlot primitives.Slot) []uint64 { return []uint64{} },
+		)
+	}
 }
 
 // subscribe to a given topic with a given validator and subscription handler.
@@ -0,0 +1,27 @@
+package sync
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	lightclient "github.com/prysmaticlabs/prysm/v5/consensus-types/light-client"
+	"google.golang.org/protobuf/proto"
+)
+
+// lightClientFinalityUpdateSubscriber handles finality updates that passed gossip validation. Such updates
+// match the one computed locally, which is already served over req/resp, so there is nothing left to do.
+func (s *Service) lightClientFinalityUpdateSubscriber(_ context.Context, msg proto.Message) error {
+	if _, err := lightclient.NewWrappedFinalityUpdate(msg); err != nil {
+		return errors.Wrapf(err, "incorrect type of message received, got %T", msg)
+	}
+	return nil
+}
+
+// lightClientOptimisticUpdateSubscriber handles optimistic updates that passed gossip validation. Such updates
+// match the one computed locally, which is already served over req/resp, so there is nothing left to do.
+func (s *Service) lightClientOptimisticUpdateSubscriber(_ context.Context, msg proto.Message) error {
+	if _, err := lightclient.NewWrappedOptimisticUpdate(msg); err != nil {
+		return errors.Wrapf(err, "incorrect type of message received, got %T", msg)
+	}
+	return nil
+}
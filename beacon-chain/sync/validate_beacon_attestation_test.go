@@ -11,6 +11,8 @@ import (
 	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
 	"github.com/prysmaticlabs/go-bitfield"
 	mockChain "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/operation"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
 	dbtest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
@@ -465,6 +467,126 @@ func TestService_validateCommitteeIndexBeaconAttestationElectra(t *testing.T) {
 	}
 }
 
+func TestService_validateCommitteeIndexBeaconAttestationElectra_NotifiesSingleAttReceived(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig()
+	fvs := map[[fieldparams.VersionLength]byte]primitives.Epoch{}
+	fvs[bytesutil.ToBytes4(cfg.GenesisForkVersion)] = 1
+	fvs[bytesutil.ToBytes4(cfg.AltairForkVersion)] = 2
+	fvs[bytesutil.ToBytes4(cfg.BellatrixForkVersion)] = 3
+	fvs[bytesutil.ToBytes4(cfg.CapellaForkVersion)] = 4
+	fvs[bytesutil.ToBytes4(cfg.DenebForkVersion)] = 5
+	fvs[bytesutil.ToBytes4(cfg.FuluForkVersion)] = 6
+	fvs[bytesutil.ToBytes4(cfg.ElectraForkVersion)] = 0
+	cfg.ForkVersionSchedule = fvs
+	params.OverrideBeaconConfig(cfg)
+
+	p := p2ptest.NewTestP2P(t)
+	db := dbtest.SetupDB(t)
+	chain := &mockChain.ChainService{
+		// 1 slot ago.
+		Genesis:          time.Now().Add(time.Duration(-1*int64(params.BeaconConfig().SecondsPerSlot)) * time.Second),
+		ValidatorsRoot:   [32]byte{'A'},
+		ValidAttestation: true,
+		DB:               db,
+		Optimistic:       true,
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := &Service{
+		ctx: ctx,
+		cfg: &config{
+			initialSync:         &mockSync.Sync{IsSyncing: false},
+			p2p:                 p,
+			beaconDB:            db,
+			chain:               chain,
+			clock:               startup.NewClock(chain.Genesis, chain.ValidatorsRoot),
+			attestationNotifier: (&mockChain.ChainService{}).OperationNotifier(),
+		},
+		blkRootToPendingAtts:             make(map[[32]byte][]ethpb.SignedAggregateAttAndProof),
+		seenUnAggregatedAttestationCache: lruwrpr.New(10),
+		signatureChan:                    make(chan *signatureVerifier, verifierLimit),
+	}
+	s.initCaches()
+	go s.verifierRoutine()
+
+	digest, err := s.currentForkDigest()
+	require.NoError(t, err)
+
+	blk := util.NewBeaconBlock()
+	blk.Block.Slot = 1
+	util.SaveBlock(t, ctx, db, blk)
+
+	validBlockRoot, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	chain.FinalizedCheckPoint = &ethpb.Checkpoint{
+		Root:  validBlockRoot[:],
+		Epoch: 0,
+	}
+
+	savedState, keys := util.DeterministicGenesisState(t, 64)
+	require.NoError(t, savedState.SetSlot(1))
+	require.NoError(t, db.SaveState(context.Background(), savedState, validBlockRoot))
+	chain.State = savedState
+	committee, err := helpers.BeaconCommitteeFromState(ctx, savedState, 1, 0)
+	require.NoError(t, err)
+
+	att := &ethpb.SingleAttestation{
+		Data: &ethpb.AttestationData{
+			BeaconBlockRoot: validBlockRoot[:],
+			CommitteeIndex:  0,
+			Slot:            1,
+			Target: &ethpb.Checkpoint{
+				Epoch: 0,
+				Root:  validBlockRoot[:],
+			},
+			Source: &ethpb.Checkpoint{Root: make([]byte, fieldparams.RootLength)},
+		},
+		AttesterIndex: committee[0],
+	}
+	domain, err := signing.Domain(savedState.Fork(), att.Data.Target.Epoch, params.BeaconConfig().DomainBeaconAttester, savedState.GenesisValidatorsRoot())
+	require.NoError(t, err)
+	attRoot, err := signing.ComputeSigningRoot(att.Data, domain)
+	require.NoError(t, err)
+	att.Signature = keys[committee[0]].Sign(attRoot[:]).Marshal()
+	buf := new(bytes.Buffer)
+	_, err = p.Encoding().EncodeGossip(buf, att)
+	require.NoError(t, err)
+	topic := fmt.Sprintf("/eth2/%x/beacon_attestation_1", digest)
+	m := &pubsub.Message{
+		Message: &pubsubpb.Message{
+			Data:  buf.Bytes(),
+			Topic: &topic,
+		},
+	}
+
+	opChannel := make(chan *feed.Event, 2)
+	opSub := s.cfg.attestationNotifier.OperationFeed().Subscribe(opChannel)
+	defer opSub.Unsubscribe()
+
+	res, err := s.validateCommitteeIndexBeaconAttestation(ctx, "", m)
+	require.NoError(t, err)
+	require.Equal(t, pubsub.ValidationAccept, res)
+
+	var received bool
+	for !received {
+		select {
+		case event := <-opChannel:
+			if event.Type != operation.SingleAttReceived {
+				continue
+			}
+			data, ok := event.Data.(*operation.SingleAttReceivedData)
+			require.Equal(t, true, ok, "Entity is not of type *operation.SingleAttReceivedData")
+			require.Equal(t, committee[0], data.Attestation.AttesterIndex)
+			received = true
+		case <-opSub.Err():
+			t.Fatal("Subscription to operation notifier failed")
+		case <-time.After(10 * time.Second):
+			t.Fatal("Timeout waiting for single attestation notification")
+		}
+	}
+}
+
 func TestService_setSeenCommitteeIndicesSlot(t *testing.T) {
 	s := NewService(context.Background(), WithP2P(p2ptest.NewTestP2P(t)))
 	s.initCaches()
@@ -12,6 +12,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed"
 	blockfeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/block"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/operation"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
@@ -208,6 +209,14 @@ func (s *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 	}
 	msg.ValidatorData = blkPb // Used in downstream subscriber
 
+	// Notify the event stream of the block having passed gossip validation, before it is imported.
+	s.cfg.operationNotifier.OperationFeed().Send(&feed.Event{
+		Type: operation.BlockGossipReceived,
+		Data: &operation.BlockGossipReceivedData{
+			SignedBlock: blk,
+		},
+	})
+
 	// Log the arrival time of the accepted block
 	graffiti := blk.Block().Body().Graffiti()
 	startTime, err := slots.ToTime(genesisTime, blk.Block().Slot())
@@ -0,0 +1,266 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/pkg/errors"
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	p2ptest "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	mockSync "github.com/prysmaticlabs/prysm/v5/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
+	lruwrpr "github.com/prysmaticlabs/prysm/v5/cache/lru"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestValidateDataColumn_FromSelf(t *testing.T) {
+	ctx := context.Background()
+	p := p2ptest.NewTestP2P(t)
+	s := &Service{cfg: &config{p2p: p}}
+	result, err := s.validateDataColumn(ctx, s.cfg.p2p.PeerID(), nil)
+	require.NoError(t, err)
+	require.Equal(t, result, pubsub.ValidationAccept)
+}
+
+func TestValidateDataColumn_InitSync(t *testing.T) {
+	ctx := context.Background()
+	p := p2ptest.NewTestP2P(t)
+	s := &Service{cfg: &config{p2p: p, initialSync: &mockSync.Sync{IsSyncing: true}}}
+	result, err := s.validateDataColumn(ctx, "", nil)
+	require.NoError(t, err)
+	require.Equal(t, result, pubsub.ValidationIgnore)
+}
+
+func TestValidateDataColumn_InvalidTopic(t *testing.T) {
+	ctx := context.Background()
+	p := p2ptest.NewTestP2P(t)
+	s := &Service{cfg: &config{p2p: p, initialSync: &mockSync.Sync{}}}
+	result, err := s.validateDataColumn(ctx, "", &pubsub.Message{
+		Message: &pb.Message{},
+	})
+	require.ErrorIs(t, errInvalidTopic, err)
+	require.Equal(t, result, pubsub.ValidationReject)
+}
+
+func TestValidateDataColumn_InvalidMessageType(t *testing.T) {
+	ctx := context.Background()
+	p := p2ptest.NewTestP2P(t)
+	chainService := &mock.ChainService{Genesis: time.Unix(time.Now().Unix()-int64(params.BeaconConfig().SecondsPerSlot), 0)}
+	s := &Service{cfg: &config{p2p: p, initialSync: &mockSync.Sync{}, clock: startup.NewClock(chainService.Genesis, chainService.ValidatorsRoot)}}
+	s.newDataColumnVerifier = testNewDataColumnVerifier()
+
+	msg := util.NewBeaconBlock()
+	buf := new(bytes.Buffer)
+	_, err := p.Encoding().EncodeGossip(buf, msg)
+	require.NoError(t, err)
+
+	topic := p2p.GossipTypeMapping[reflect.TypeOf(msg)]
+	digest, err := s.currentForkDigest()
+	require.NoError(t, err)
+	topic = s.addDigestToTopic(topic, digest)
+	result, err := s.validateDataColumn(ctx, "", &pubsub.Message{
+		Message: &pb.Message{
+			Data:  buf.Bytes(),
+			Topic: &topic,
+		}})
+	require.ErrorIs(t, errWrongMessage, err)
+	require.Equal(t, result, pubsub.ValidationReject)
+}
+
+func TestValidateDataColumn_ErrorPathsWithMock(t *testing.T) {
+	tests := []struct {
+		name     string
+		error    error
+		verifier verification.NewDataColumnVerifier
+		result   pubsub.ValidationResult
+	}{
+		{
+			error: errors.New("valid fields"),
+			verifier: func(b blocks.RODataColumn, reqs []verification.Requirement) verification.DataColumnVerifier {
+				return &verification.MockDataColumnVerifier{ErrValidFields: errors.New("valid fields")}
+			},
+			result: pubsub.ValidationReject,
+		},
+		{
+			error: errors.New("correct subnet"),
+			verifier: func(b blocks.RODataColumn, reqs []verification.Requirement) verification.DataColumnVerifier {
+				return &verification.MockDataColumnVerifier{ErrCorrectSubnet: errors.New("correct subnet")}
+			},
+			result: pubsub.ValidationReject,
+		},
+		{
+			error: errors.New("slot too early"),
+			verifier: func(b blocks.RODataColumn, reqs []verification.Requirement) verification.DataColumnVerifier {
+				return &verification.MockDataColumnVerifier{ErrSlotTooEarly: errors.New("slot too early")}
+			},
+			result: pubsub.ValidationIgnore,
+		},
+		{
+			error: errors.New("slot above finalized"),
+			verifier: func(b blocks.RODataColumn, reqs []verification.Requirement) verification.DataColumnVerifier {
+				return &verification.MockDataColumnVerifier{ErrSlotAboveFinalized: errors.New("slot above finalized")}
+			},
+			result: pubsub.ValidationIgnore,
+		},
+		{
+			error: errors.New("sidecar parent seen"),
+			verifier: func(b blocks.RODataColumn, reqs []verification.Requirement) verification.DataColumnVerifier {
+				return &verification.MockDataColumnVerifier{ErrSidecarParentSeen: errors.New("sidecar parent seen")}
+			},
+			result: pubsub.ValidationIgnore,
+		},
+		{
+			error: errors.New("valid proposer signature"),
+			verifier: func(b blocks.RODataColumn, reqs []verification.Requirement) verification.DataColumnVerifier {
+				return &verification.MockDataColumnVerifier{ErrValidProposerSignature: errors.New("valid proposer signature")}
+			},
+			result: pubsub.ValidationReject,
+		},
+		{
+			error: errors.New("sidecar parent valid"),
+			verifier: func(b blocks.RODataColumn, reqs []verification.Requirement) verification.DataColumnVerifier {
+				return &verification.MockDataColumnVerifier{ErrSidecarParentValid: errors.New("sidecar parent valid")}
+			},
+			result: pubsub.ValidationReject,
+		},
+		{
+			error: errors.New("sidecar parent slot lower"),
+			verifier: func(b blocks.RODataColumn, reqs []verification.Requirement) verification.DataColumnVerifier {
+				return &verification.MockDataColumnVerifier{ErrSidecarParentSlotLower: errors.New("sidecar parent slot lower")}
+			},
+			result: pubsub.ValidationReject,
+		},
+		{
+			error: errors.New("descends from finalized"),
+			verifier: func(b blocks.RODataColumn, reqs []verification.Requirement) verification.DataColumnVerifier {
+				return &verification.MockDataColumnVerifier{ErrSidecarDescendsFromFinalized: errors.New("descends from finalized")}
+			},
+			result: pubsub.ValidationReject,
+		},
+		{
+			error: errors.New("inclusion proven"),
+			verifier: func(b blocks.RODataColumn, reqs []verification.Requirement) verification.DataColumnVerifier {
+				return &verification.MockDataColumnVerifier{ErrSidecarInclusionProven: errors.New("inclusion proven")}
+			},
+			result: pubsub.ValidationReject,
+		},
+		{
+			error: errors.New("kzg proof verified"),
+			verifier: func(b blocks.RODataColumn, reqs []verification.Requirement) verification.DataColumnVerifier {
+				return &verification.MockDataColumnVerifier{ErrSidecarKzgProofVerified: errors.New("kzg proof verified")}
+			},
+			result: pubsub.ValidationReject,
+		},
+		{
+			error: errors.New("sidecar proposer expected"),
+			verifier: func(b blocks.RODataColumn, reqs []verification.Requirement) verification.DataColumnVerifier {
+				return &verification.MockDataColumnVerifier{ErrSidecarProposerExpected: errors.New("sidecar proposer expected")}
+			},
+			result: pubsub.ValidationReject,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.error.Error(), func(t *testing.T) {
+			ctx := context.Background()
+			p := p2ptest.NewTestP2P(t)
+			chainService := &mock.ChainService{Genesis: time.Unix(time.Now().Unix()-int64(params.BeaconConfig().SecondsPerSlot), 0)}
+			s := &Service{
+				seenDataColumnCache: lruwrpr.New(10),
+				seenPendingBlocks:   make(map[[32]byte]bool),
+				cfg:                 &config{chain: chainService, p2p: p, initialSync: &mockSync.Sync{}, clock: startup.NewClock(chainService.Genesis, chainService.ValidatorsRoot)}}
+			s.newDataColumnVerifier = tt.verifier
+
+			msg, _ := testDataColumnMessage(t, s, p, chainService.CurrentSlot()+1)
+			result, err := s.validateDataColumn(ctx, "", msg)
+			require.ErrorContains(t, tt.error.Error(), err)
+			require.Equal(t, result, tt.result)
+		})
+	}
+}
+
+func TestValidateDataColumn_AlreadySeenInCache(t *testing.T) {
+	ctx := context.Background()
+	p := p2ptest.NewTestP2P(t)
+	chainService := &mock.ChainService{Genesis: time.Unix(time.Now().Unix()-int64(params.BeaconConfig().SecondsPerSlot), 0)}
+	s := &Service{
+		seenDataColumnCache: lruwrpr.New(10),
+		cfg:                 &config{chain: chainService, p2p: p, initialSync: &mockSync.Sync{}, clock: startup.NewClock(chainService.Genesis, chainService.ValidatorsRoot)}}
+	s.newDataColumnVerifier = testNewDataColumnVerifier()
+
+	msg, dc := testDataColumnMessage(t, s, p, chainService.CurrentSlot())
+	s.setSeenDataColumnIndex(dc.Slot(), dc.ProposerIndex(), dc.ColumnIndex)
+
+	result, err := s.validateDataColumn(ctx, "", msg)
+	require.NoError(t, err)
+	require.Equal(t, result, pubsub.ValidationIgnore)
+}
+
+func TestValidateDataColumn_Valid(t *testing.T) {
+	ctx := context.Background()
+	p := p2ptest.NewTestP2P(t)
+	chainService := &mock.ChainService{Genesis: time.Unix(time.Now().Unix()-int64(params.BeaconConfig().SecondsPerSlot), 0)}
+	s := &Service{
+		seenDataColumnCache: lruwrpr.New(10),
+		cfg:                 &config{chain: chainService, p2p: p, initialSync: &mockSync.Sync{}, clock: startup.NewClock(chainService.Genesis, chainService.ValidatorsRoot)}}
+	_, dcs := util.GenerateTestFuluBlockWithDataColumns(t, [32]byte{}, chainService.CurrentSlot(), 1)
+	verified := verification.FakeVerifyDataColumnSliceForTest(t, dcs)
+	s.newDataColumnVerifier = func(b blocks.RODataColumn, reqs []verification.Requirement) verification.DataColumnVerifier {
+		return &verification.MockDataColumnVerifier{CbVerifiedRODataColumn: func() (blocks.VerifiedRODataColumn, error) {
+			return verified[0], nil
+		}}
+	}
+
+	msg, _ := testDataColumnMessage(t, s, p, chainService.CurrentSlot())
+	result, err := s.validateDataColumn(ctx, "", msg)
+	require.NoError(t, err)
+	require.Equal(t, result, pubsub.ValidationAccept)
+	require.NotNil(t, msg.ValidatorData)
+}
+
+func TestDataColumnSubnetFromTopic(t *testing.T) {
+	subnet, err := dataColumnSubnetFromTopic("/eth2/abcdef01/data_column_sidecar_7/ssz_snappy")
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), subnet)
+
+	_, err = dataColumnSubnetFromTopic("/eth2/abcdef01/data_column_sidecar_x/ssz_snappy")
+	require.ErrorIs(t, err, errInvalidTopic)
+
+	_, err = dataColumnSubnetFromTopic("/eth2/abcdef01/blob_sidecar_7/ssz_snappy")
+	require.ErrorContains(t, "wrong topic name", err)
+}
+
+// testDataColumnMessage builds a gossip message carrying the first data column of a test fulu block at the given slot.
+func testDataColumnMessage(t *testing.T, s *Service, p *p2ptest.TestP2P, slot primitives.Slot) (*pubsub.Message, blocks.RODataColumn) {
+	_, dcs := util.GenerateTestFuluBlockWithDataColumns(t, [32]byte{}, slot, 1)
+	msg := dcs[0].DataColumnSidecar
+	buf := new(bytes.Buffer)
+	_, err := p.Encoding().EncodeGossip(buf, msg)
+	require.NoError(t, err)
+
+	topic := p2p.GossipTypeMapping[reflect.TypeOf(msg)]
+	digest, err := s.currentForkDigest()
+	require.NoError(t, err)
+	topic = s.addDigestAndIndexToTopic(topic, digest, dcs[0].ColumnIndex)
+	return &pubsub.Message{
+		Message: &pb.Message{
+			Data:  buf.Bytes(),
+			Topic: &topic,
+		}}, dcs[0]
+}
+
+func testNewDataColumnVerifier() verification.NewDataColumnVerifier {
+	return func(b blocks.RODataColumn, reqs []verification.Requirement) verification.DataColumnVerifier {
+		return &verification.MockDataColumnVerifier{}
+	}
+}
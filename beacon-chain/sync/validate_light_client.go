@@ -0,0 +1,195 @@
+package sync
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	lightclient "github.com/prysmaticlabs/prysm/v5/consensus-types/light-client"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"google.golang.org/protobuf/proto"
+)
+
+// validateLightClientFinalityUpdate validates a light client finality update received over gossip.
+// spec: https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/light-client/p2p-interface.md#light_client_finality_update
+func (s *Service) validateLightClientFinalityUpdate(ctx context.Context, pid peer.ID, msg *pubsub.Message) (pubsub.ValidationResult, error) {
+	// Validation runs on publish (not just subscriptions), so we should approve any message from
+	// ourselves.
+	if pid == s.cfg.p2p.PeerID() {
+		return pubsub.ValidationAccept, nil
+	}
+
+	// We cannot compute the update locally while syncing.
+	if s.cfg.initialSync.Syncing() {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	_, span := trace.StartSpan(ctx, "sync.validateLightClientFinalityUpdate")
+	defer span.End()
+
+	m, err := s.decodePubsubMessage(msg)
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		return pubsub.ValidationReject, err
+	}
+	pm, ok := m.(proto.Message)
+	if !ok {
+		return pubsub.ValidationReject, errWrongMessage
+	}
+	update, err := lightclient.NewWrappedFinalityUpdate(pm)
+	if err != nil {
+		return pubsub.ValidationReject, err
+	}
+
+	// [IGNORE] The finalized_header.beacon.slot is greater than that of all previously forwarded finality_updates.
+	finalizedSlot := update.FinalizedHeader().Beacon().Slot
+	if s.lcForwarded.forwardedFinalized(finalizedSlot) {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	// [IGNORE] The finality_update is received after the block at signature_slot was given enough time
+	// to propagate through the network.
+	if !s.lightClientUpdatePropagated(update.SignatureSlot()) {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	// [IGNORE] The received finality_update matches the locally computed one exactly.
+	var local interfaces.LightClientFinalityUpdate
+	if s.cfg.lcStore != nil {
+		local = s.cfg.lcStore.LastFinalityUpdate()
+	}
+	if local == nil || !proto.Equal(local.Proto(), update.Proto()) {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	if !s.lcForwarded.setFinalizedSlot(finalizedSlot) {
+		return pubsub.ValidationIgnore, nil
+	}
+	msg.ValidatorData = update.Proto() // Used in downstream subscriber
+	return pubsub.ValidationAccept, nil
+}
+
+// validateLightClientOptimisticUpdate validates a light client optimistic update received over gossip.
+// spec: https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/light-client/p2p-interface.md#light_client_optimistic_update
+func (s *Service) validateLightClientOptimisticUpdate(ctx context.Context, pid peer.ID, msg *pubsub.Message) (pubsub.ValidationResult, error) {
+	// Validation runs on publish (not just subscriptions), so we should approve any message from
+	// ourselves.
+	if pid == s.cfg.p2p.PeerID() {
+		return pubsub.ValidationAccept, nil
+	}
+
+	// We cannot compute the update locally while syncing.
+	if s.cfg.initialSync.Syncing() {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	_, span := trace.StartSpan(ctx, "sync.validateLightClientOptimisticUpdate")
+	defer span.End()
+
+	m, err := s.decodePubsubMessage(msg)
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		return pubsub.ValidationReject, err
+	}
+	pm, ok := m.(proto.Message)
+	if !ok {
+		return pubsub.ValidationReject, errWrongMessage
+	}
+	update, err := lightclient.NewWrappedOptimisticUpdate(pm)
+	if err != nil {
+		return pubsub.ValidationReject, err
+	}
+
+	// [IGNORE] The attested_header.beacon.slot is greater than that of all previously forwarded optimistic_updates.
+	attestedSlot := update.AttestedHeader().Beacon().Slot
+	if s.lcForwarded.forwardedAttested(attestedSlot) {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	// [IGNORE] The optimistic_update is received after the block at signature_slot was given enough time
+	// to propagate through the network.
+	if !s.lightClientUpdatePropagated(update.SignatureSlot()) {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	// [IGNORE] The received optimistic_update matches the locally computed one exactly.
+	var local interfaces.LightClientOptimisticUpdate
+	if s.cfg.lcStore != nil {
+		local = s.cfg.lcStore.LastOptimisticUpdate()
+	}
+	if local == nil || !proto.Equal(local.Proto(), update.Proto()) {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	if !s.lcForwarded.setAttestedSlot(attestedSlot) {
+		return pubsub.ValidationIgnore, nil
+	}
+	msg.ValidatorData = update.Proto() // Used in downstream subscriber
+	return pubsub.ValidationAccept, nil
+}
+
+// lightClientUpdatePropagated returns true once a third of the signature slot, less the maximum gossip
+// clock disparity, has elapsed. Updates received earlier are ignored.
+func (s *Service) lightClientUpdatePropagated(signatureSlot primitives.Slot) bool {
+	slotStart, err := slots.ToTime(uint64(s.cfg.clock.GenesisTime().Unix()), signatureSlot)
+	if err != nil {
+		return false
+	}
+	cfg := params.BeaconConfig()
+	due := slotStart.
+		Add(time.Duration(cfg.SecondsPerSlot/cfg.IntervalsPerSlot) * time.Second).
+		Add(-cfg.MaximumGossipClockDisparityDuration())
+	return !prysmTime.Now().Before(due)
+}
+
+// lightClientForwardedSlots tracks the highest finalized and attested header slots of the light client
+// updates forwarded over gossip, as only strictly newer updates may be forwarded.
+type lightClientForwardedSlots struct {
+	sync.Mutex
+	finalized primitives.Slot
+	attested  primitives.Slot
+}
+
+// forwardedFinalized returns true if a finality update with a finalized header slot of at least slot was forwarded.
+func (f *lightClientForwardedSlots) forwardedFinalized(slot primitives.Slot) bool {
+	f.Lock()
+	defer f.Unlock()
+	return f.finalized >= slot
+}
+
+// setFinalizedSlot records a forwarded finality update, returning false if a newer one was recorded first.
+func (f *lightClientForwardedSlots) setFinalizedSlot(slot primitives.Slot) bool {
+	f.Lock()
+	defer f.Unlock()
+	if f.finalized >= slot {
+		return false
+	}
+	f.finalized = slot
+	return true
+}
+
+// forwardedAttested returns true if an optimistic update with an attested header slot of at least slot was forwarded.
+func (f *lightClientForwardedSlots) forwardedAttested(slot primitives.Slot) bool {
+	f.Lock()
+	defer f.Unlock()
+	return f.attested >= slot
+}
+
+// setAttestedSlot records a forwarded optimistic update, returning false if a newer one was recorded first.
+func (f *lightClientForwardedSlots) setAttestedSlot(slot primitives.Slot) bool {
+	f.Lock()
+	defer f.Unlock()
+	if f.attested >= slot {
+		return false
+	}
+	f.attested = slot
+	return true
+}
@@ -0,0 +1,117 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/snappy"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	lightClient "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/light-client"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	p2ptest "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	mockSync "github.com/prysmaticlabs/prysm/v5/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/network/forks"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+func TestValidateLightClientOptimisticUpdate(t *testing.T) {
+	l := util.NewTestLightClient(t).SetupTestAltair()
+	update, err := lightClient.NewLightClientOptimisticUpdateFromBeaconState(l.Ctx, l.State.Slot(), l.State, l.Block, l.AttestedState, l.AttestedBlock)
+	require.NoError(t, err)
+
+	p := p2ptest.NewTestP2P(t)
+	valRoot := [32]byte{'A'}
+	// Start the clock well after the signature slot, so the update had enough time to propagate.
+	genesis := time.Now().Add(-time.Duration(uint64(update.SignatureSlot())+1) * time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	s := &Service{
+		cfg: &config{
+			p2p:         p,
+			initialSync: &mockSync.Sync{IsSyncing: false},
+			chain:       &mock.ChainService{Genesis: genesis, ValidatorsRoot: valRoot},
+			clock:       startup.NewClock(genesis, valRoot),
+			lcStore:     lightClient.NewLightClientStore(),
+		},
+	}
+
+	digest, err := forks.ForkDigestFromEpoch(slots.ToEpoch(update.AttestedHeader().Beacon().Slot), valRoot[:])
+	require.NoError(t, err)
+	topic := fmt.Sprintf(p2p.LightClientOptimisticUpdateTopicFormat, digest) + p.Encoding().ProtocolSuffix()
+	enc, err := update.MarshalSSZ()
+	require.NoError(t, err)
+	newMsg := func() *pubsub.Message {
+		return &pubsub.Message{Message: &pubsubpb.Message{Data: snappy.Encode(nil, enc), Topic: &topic}}
+	}
+
+	// The update was not computed locally.
+	res, err := s.validateLightClientOptimisticUpdate(context.Background(), "foobar", newMsg())
+	require.NoError(t, err)
+	assert.Equal(t, pubsub.ValidationIgnore, res)
+
+	s.cfg.lcStore.SetLastOptimisticUpdate(update)
+	msg := newMsg()
+	res, err = s.validateLightClientOptimisticUpdate(context.Background(), "foobar", msg)
+	require.NoError(t, err)
+	assert.Equal(t, pubsub.ValidationAccept, res)
+	assert.NotNil(t, msg.ValidatorData)
+
+	// The update was already forwarded.
+	res, err = s.validateLightClientOptimisticUpdate(context.Background(), "foobar", newMsg())
+	require.NoError(t, err)
+	assert.Equal(t, pubsub.ValidationIgnore, res)
+}
+
+func TestValidateLightClientOptimisticUpdate_TooEarly(t *testing.T) {
+	l := util.NewTestLightClient(t).SetupTestAltair()
+	update, err := lightClient.NewLightClientOptimisticUpdateFromBeaconState(l.Ctx, l.State.Slot(), l.State, l.Block, l.AttestedState, l.AttestedBlock)
+	require.NoError(t, err)
+
+	p := p2ptest.NewTestP2P(t)
+	valRoot := [32]byte{'A'}
+	// The signature slot starts now, so a third of the slot has not elapsed yet.
+	genesis := time.Now().Add(-time.Duration(uint64(update.SignatureSlot())) * time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	s := &Service{
+		cfg: &config{
+			p2p:         p,
+			initialSync: &mockSync.Sync{IsSyncing: false},
+			chain:       &mock.ChainService{Genesis: genesis, ValidatorsRoot: valRoot},
+			clock:       startup.NewClock(genesis, valRoot),
+			lcStore:     lightClient.NewLightClientStore(),
+		},
+	}
+	s.cfg.lcStore.SetLastOptimisticUpdate(update)
+
+	digest, err := forks.ForkDigestFromEpoch(slots.ToEpoch(update.AttestedHeader().Beacon().Slot), valRoot[:])
+	require.NoError(t, err)
+	topic := fmt.Sprintf(p2p.LightClientOptimisticUpdateTopicFormat, digest) + p.Encoding().ProtocolSuffix()
+	enc, err := update.MarshalSSZ()
+	require.NoError(t, err)
+	msg := &pubsub.Message{Message: &pubsubpb.Message{Data: snappy.Encode(nil, enc), Topic: &topic}}
+
+	res, err := s.validateLightClientOptimisticUpdate(context.Background(), "foobar", msg)
+	require.NoError(t, err)
+	assert.Equal(t, pubsub.ValidationIgnore, res)
+}
+
+func TestLightClientForwardedSlots(t *testing.T) {
+	f := &lightClientForwardedSlots{}
+	assert.Equal(t, false, f.forwardedFinalized(1))
+	assert.Equal(t, true, f.setFinalizedSlot(1))
+	assert.Equal(t, true, f.forwardedFinalized(1))
+	assert.Equal(t, false, f.setFinalizedSlot(1))
+	assert.Equal(t, false, f.forwardedFinalized(2))
+
+	assert.Equal(t, false, f.forwardedAttested(5))
+	assert.Equal(t, true, f.setAttestedSlot(5))
+	assert.Equal(t, true, f.forwardedAttested(4))
+	assert.Equal(t, false, f.setAttestedSlot(4))
+	assert.Equal(t, true, f.setAttestedSlot(6))
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/kzg"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/peerdas"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 )
@@ -92,3 +93,64 @@ func (batch *BlobBatchVerifier) verifyOneBlob(sc blocks.ROBlob) (blocks.Verified
 
 	return bv.VerifiedROBlob()
 }
+
+// NewDataColumnBatchVerifier initializes a data column batch verifier. Like NewBlobBatchVerifier, it requires the caller
+// to specify the verification Requirements along with a NewDataColumnVerifier callback.
+func NewDataColumnBatchVerifier(newVerifier NewDataColumnVerifier, reqs []Requirement) *DataColumnBatchVerifier {
+	return &DataColumnBatchVerifier{
+		verifyKzg:   peerdas.VerifyDataColumnsSidecarKZGProofs,
+		newVerifier: newVerifier,
+		reqs:        reqs,
+	}
+}
+
+// DataColumnBatchVerifier is the data column counterpart of BlobBatchVerifier.
+type DataColumnBatchVerifier struct {
+	verifyKzg   rodataColumnCommitmentVerifier
+	newVerifier NewDataColumnVerifier
+	reqs        []Requirement
+}
+
+// VerifiedRODataColumns satisfies the das.DataColumnBatchVerifier interface, used by das.AvailabilityStore.
+func (batch *DataColumnBatchVerifier) VerifiedRODataColumns(ctx context.Context, blk blocks.ROBlock, scs []blocks.RODataColumn) ([]blocks.VerifiedRODataColumn, error) {
+	if len(scs) == 0 {
+		return nil, nil
+	}
+	blkSig := blk.Signature()
+	for i := range scs {
+		colSig := bytesutil.ToBytes96(scs[i].SignedBlockHeader.Signature)
+		if blkSig != colSig {
+			return nil, ErrBatchSignatureMismatch
+		}
+		if blk.Root() != scs[i].BlockRoot() {
+			return nil, ErrBatchBlockRootMismatch
+		}
+	}
+	// The structural checks must pass before the kzg batch verification, which assumes consistent lengths.
+	vs := make([]DataColumnVerifier, len(scs))
+	for i := range scs {
+		vs[i] = batch.newVerifier(scs[i], batch.reqs)
+		if err := vs[i].ValidFields(); err != nil {
+			return nil, err
+		}
+	}
+	// Verify the cell proofs of all the columns at once.
+	if err := batch.verifyKzg(scs); err != nil {
+		return nil, err
+	}
+	vcs := make([]blocks.VerifiedRODataColumn, len(scs))
+	for i := range vs {
+		// Both requirements are satisfied by the checks above, done for the whole batch at once.
+		vs[i].SatisfyRequirement(RequireSidecarKzgProofVerified)
+		vs[i].SatisfyRequirement(RequireValidProposerSignature)
+		if err := vs[i].SidecarInclusionProven(); err != nil {
+			return nil, err
+		}
+		vc, err := vs[i].VerifiedRODataColumn()
+		if err != nil {
+			return nil, err
+		}
+		vcs[i] = vc
+	}
+	return vcs, nil
+}
@@ -26,6 +26,8 @@ const (
 	RequireSidecarInclusionProven
 	RequireSidecarKzgProofVerified
 	RequireSidecarProposerExpected
+	RequireValidFields
+	RequireCorrectSubnet
 )
 
 var allBlobSidecarRequirements = []Requirement{
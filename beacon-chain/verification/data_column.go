@@ -0,0 +1,323 @@
+package verification
+
+import (
+	"context"
+	goError "errors"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/peerdas"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/types"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/runtime/logging"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+var allDataColumnSidecarRequirements = []Requirement{
+	RequireValidFields,
+	RequireCorrectSubnet,
+	RequireNotFromFutureSlot,
+	RequireSlotAboveFinalized,
+	RequireValidProposerSignature,
+	RequireSidecarParentSeen,
+	RequireSidecarParentValid,
+	RequireSidecarParentSlotLower,
+	RequireSidecarDescendsFromFinalized,
+	RequireSidecarInclusionProven,
+	RequireSidecarKzgProofVerified,
+	RequireSidecarProposerExpected,
+}
+
+// GossipDataColumnSidecarRequirements defines the set of requirements that DataColumnSidecars received on gossip
+// must satisfy in order to upgrade an RODataColumn to a VerifiedRODataColumn.
+var GossipDataColumnSidecarRequirements = requirementList(allDataColumnSidecarRequirements).excluding()
+
+// InitsyncDataColumnSidecarRequirements is the list of verification requirements to be used by the init-sync service
+// and by the req/resp handlers. Like their blob counterparts, data columns obtained this way are only verified
+// as part of the IsDataAvailable check, once the block has been verified, and they are keyed by the block root.
+var InitsyncDataColumnSidecarRequirements = requirementList(GossipDataColumnSidecarRequirements).excluding(
+	RequireCorrectSubnet,
+	RequireNotFromFutureSlot,
+	RequireSlotAboveFinalized,
+	RequireSidecarParentSeen,
+	RequireSidecarParentValid,
+	RequireSidecarParentSlotLower,
+	RequireSidecarDescendsFromFinalized,
+	RequireSidecarProposerExpected,
+)
+
+// PendingQueueDataColumnSidecarRequirements is the same as InitsyncDataColumnSidecarRequirements, used by the pending blocks queue.
+var PendingQueueDataColumnSidecarRequirements = requirementList(InitsyncDataColumnSidecarRequirements).excluding()
+
+var (
+	ErrDataColumnInvalid = errors.New("data column failed verification")
+	// ErrDataColumnFieldsInvalid means RequireValidFields failed.
+	ErrDataColumnFieldsInvalid = errors.New("data column sidecar fields are invalid")
+	// ErrDataColumnIncorrectSubnet means RequireCorrectSubnet failed.
+	ErrDataColumnIncorrectSubnet = errors.New("data column sidecar received on the wrong subnet")
+)
+
+type RODataColumnVerifier struct {
+	*sharedResources
+	results                    *results
+	dataColumn                 blocks.RODataColumn
+	parent                     state.BeaconState
+	verifyDataColumnCommitment rodataColumnCommitmentVerifier
+}
+
+type rodataColumnCommitmentVerifier func([]blocks.RODataColumn) error
+
+var _ DataColumnVerifier = &RODataColumnVerifier{}
+
+// VerifiedRODataColumn "upgrades" the wrapped RODataColumn to a VerifiedRODataColumn.
+// If any of the verifications ran against the data column failed, or some required verifications
+// were not run, an error will be returned.
+func (dv *RODataColumnVerifier) VerifiedRODataColumn() (blocks.VerifiedRODataColumn, error) {
+	if dv.results.allSatisfied() {
+		return blocks.NewVerifiedRODataColumn(dv.dataColumn), nil
+	}
+	return blocks.VerifiedRODataColumn{}, dv.results.errors(ErrDataColumnInvalid)
+}
+
+// SatisfyRequirement allows the caller to assert that a requirement has been satisfied.
+// See ROBlobVerifier.SatisfyRequirement for the situations this is intended for.
+func (dv *RODataColumnVerifier) SatisfyRequirement(req Requirement) {
+	dv.recordResult(req, nil)
+}
+
+func (dv *RODataColumnVerifier) recordResult(req Requirement, err *error) {
+	if err == nil || *err == nil {
+		dv.results.record(req, nil)
+		return
+	}
+	dv.results.record(req, *err)
+}
+
+// ValidFields represents the spec verification:
+// [REJECT] The sidecar is valid as verified by verify_data_column_sidecar(sidecar).
+func (dv *RODataColumnVerifier) ValidFields() (err error) {
+	defer dv.recordResult(RequireValidFields, &err)
+	if err := peerdas.VerifyDataColumnSidecar(dv.dataColumn); err != nil {
+		log.WithError(err).WithFields(logging.DataColumnFields(dv.dataColumn)).Debug("Invalid data column sidecar fields")
+		return dataColumnErrBuilder(errors.Wrap(ErrDataColumnFieldsInvalid, err.Error()))
+	}
+	return nil
+}
+
+// CorrectSubnet represents the spec verification:
+// [REJECT] The sidecar is for the correct subnet -- i.e. compute_subnet_for_data_column_sidecar(sidecar.index) == subnet_id.
+func (dv *RODataColumnVerifier) CorrectSubnet(subnet uint64) (err error) {
+	defer dv.recordResult(RequireCorrectSubnet, &err)
+	if expected := peerdas.ComputeSubnetForDataColumnSidecar(dv.dataColumn.ColumnIndex); expected != subnet {
+		log.WithFields(logging.DataColumnFields(dv.dataColumn)).
+			WithField("expectedSubnet", expected).WithField("subnet", subnet).
+			Debug("Data column sidecar received on the wrong subnet")
+		return dataColumnErrBuilder(ErrDataColumnIncorrectSubnet)
+	}
+	return nil
+}
+
+// NotFromFutureSlot represents the spec verification:
+// [IGNORE] The sidecar is not from a future slot (with a MAXIMUM_GOSSIP_CLOCK_DISPARITY allowance)
+// -- i.e. validate that block_header.slot <= current_slot
+func (dv *RODataColumnVerifier) NotFromFutureSlot() (err error) {
+	defer dv.recordResult(RequireNotFromFutureSlot, &err)
+	if dv.clock.CurrentSlot() == dv.dataColumn.Slot() {
+		return nil
+	}
+	// earliestStart represents the time the slot starts, lowered by MAXIMUM_GOSSIP_CLOCK_DISPARITY.
+	earliestStart := dv.clock.SlotStart(dv.dataColumn.Slot()).Add(-1 * params.BeaconConfig().MaximumGossipClockDisparityDuration())
+	if dv.clock.Now().Before(earliestStart) {
+		log.WithFields(logging.DataColumnFields(dv.dataColumn)).Debug("Sidecar slot is too far in the future")
+		return dataColumnErrBuilder(ErrFromFutureSlot)
+	}
+	return nil
+}
+
+// SlotAboveFinalized represents the spec verification:
+// [IGNORE] The sidecar is from a slot greater than the latest finalized slot
+// -- i.e. validate that block_header.slot > compute_start_slot_at_epoch(state.finalized_checkpoint.epoch)
+func (dv *RODataColumnVerifier) SlotAboveFinalized() (err error) {
+	defer dv.recordResult(RequireSlotAboveFinalized, &err)
+	fcp := dv.fc.FinalizedCheckpoint()
+	fSlot, err := slots.EpochStart(fcp.Epoch)
+	if err != nil {
+		return errors.Wrapf(dataColumnErrBuilder(ErrSlotNotAfterFinalized), "error computing epoch start slot for finalized checkpoint (%d) %s", fcp.Epoch, err.Error())
+	}
+	if dv.dataColumn.Slot() <= fSlot {
+		log.WithFields(logging.DataColumnFields(dv.dataColumn)).Debug("Sidecar slot is not after finalized checkpoint")
+		return dataColumnErrBuilder(ErrSlotNotAfterFinalized)
+	}
+	return nil
+}
+
+// ValidProposerSignature represents the spec verification:
+// [REJECT] The proposer signature of sidecar.signed_block_header, is valid with respect to the block_header.proposer_index pubkey.
+func (dv *RODataColumnVerifier) ValidProposerSignature(ctx context.Context) (err error) {
+	defer dv.recordResult(RequireValidProposerSignature, &err)
+	sd := columnToSignatureData(dv.dataColumn)
+	// First check if there is a cached verification that can be reused.
+	seen, err := dv.sc.SignatureVerified(sd)
+	if seen {
+		columnVerificationProposerSignatureCache.WithLabelValues("hit-valid").Inc()
+		if err != nil {
+			log.WithFields(logging.DataColumnFields(dv.dataColumn)).WithError(err).Debug("Reusing failed proposer signature validation from cache")
+			columnVerificationProposerSignatureCache.WithLabelValues("hit-invalid").Inc()
+			return dataColumnErrBuilder(ErrInvalidProposerSignature)
+		}
+		return nil
+	}
+	columnVerificationProposerSignatureCache.WithLabelValues("miss").Inc()
+
+	// Retrieve the parent state to fallback to full verification.
+	parent, err := dv.parentState(ctx)
+	if err != nil {
+		log.WithFields(logging.DataColumnFields(dv.dataColumn)).WithError(err).Debug("Could not replay parent state for data column signature verification")
+		return dataColumnErrBuilder(ErrInvalidProposerSignature)
+	}
+	// Full verification, which will subsequently be cached for anything sharing the signature cache.
+	if err = dv.sc.VerifySignature(sd, parent); err != nil {
+		log.WithFields(logging.DataColumnFields(dv.dataColumn)).WithError(err).Debug("Signature verification failed")
+		return dataColumnErrBuilder(ErrInvalidProposerSignature)
+	}
+	return nil
+}
+
+// SidecarParentSeen represents the spec verification:
+// [IGNORE] The sidecar's block's parent (defined by block_header.parent_root) has been seen
+// (via both gossip and non-gossip sources) (a client MAY queue sidecars for processing once the parent block is retrieved).
+func (dv *RODataColumnVerifier) SidecarParentSeen(parentSeen func([32]byte) bool) (err error) {
+	defer dv.recordResult(RequireSidecarParentSeen, &err)
+	if parentSeen != nil && parentSeen(dv.dataColumn.ParentRoot()) {
+		return nil
+	}
+	if dv.fc.HasNode(dv.dataColumn.ParentRoot()) {
+		return nil
+	}
+	log.WithFields(logging.DataColumnFields(dv.dataColumn)).Debug("Parent root has not been seen")
+	return dataColumnErrBuilder(ErrSidecarParentNotSeen)
+}
+
+// SidecarParentValid represents the spec verification:
+// [REJECT] The sidecar's block's parent (defined by block_header.parent_root) passes validation.
+func (dv *RODataColumnVerifier) SidecarParentValid(badParent func([32]byte) bool) (err error) {
+	defer dv.recordResult(RequireSidecarParentValid, &err)
+	if badParent != nil && badParent(dv.dataColumn.ParentRoot()) {
+		log.WithFields(logging.DataColumnFields(dv.dataColumn)).Debug("Parent root is invalid")
+		return dataColumnErrBuilder(ErrSidecarParentInvalid)
+	}
+	return nil
+}
+
+// SidecarParentSlotLower represents the spec verification:
+// [REJECT] The sidecar is from a higher slot than the sidecar's block's parent (defined by block_header.parent_root).
+func (dv *RODataColumnVerifier) SidecarParentSlotLower() (err error) {
+	defer dv.recordResult(RequireSidecarParentSlotLower, &err)
+	parentSlot, err := dv.fc.Slot(dv.dataColumn.ParentRoot())
+	if err != nil {
+		return errors.Wrap(dataColumnErrBuilder(ErrSlotNotAfterParent), "parent root not in forkchoice")
+	}
+	if parentSlot >= dv.dataColumn.Slot() {
+		return dataColumnErrBuilder(ErrSlotNotAfterParent)
+	}
+	return nil
+}
+
+// SidecarDescendsFromFinalized represents the spec verification:
+// [REJECT] The current finalized_checkpoint is an ancestor of the sidecar's block
+// -- i.e. get_checkpoint_block(store, block_header.parent_root, store.finalized_checkpoint.epoch) == store.finalized_checkpoint.root.
+func (dv *RODataColumnVerifier) SidecarDescendsFromFinalized() (err error) {
+	defer dv.recordResult(RequireSidecarDescendsFromFinalized, &err)
+	if !dv.fc.HasNode(dv.dataColumn.ParentRoot()) {
+		log.WithFields(logging.DataColumnFields(dv.dataColumn)).Debug("Parent root not in forkchoice")
+		return dataColumnErrBuilder(ErrSidecarNotFinalizedDescendent)
+	}
+	return nil
+}
+
+// SidecarInclusionProven represents the spec verification:
+// [REJECT] The sidecar's kzg_commitments field inclusion proof is valid as verified by
+// verify_data_column_sidecar_inclusion_proof(sidecar).
+func (dv *RODataColumnVerifier) SidecarInclusionProven() (err error) {
+	defer dv.recordResult(RequireSidecarInclusionProven, &err)
+	if err = blocks.VerifyKZGCommitmentsInclusionProof(dv.dataColumn); err != nil {
+		log.WithError(err).WithFields(logging.DataColumnFields(dv.dataColumn)).Debug("Sidecar inclusion proof verification failed")
+		return dataColumnErrBuilder(ErrSidecarInclusionProofInvalid)
+	}
+	return nil
+}
+
+// SidecarKzgProofVerified represents the spec verification:
+// [REJECT] The sidecar's column data is valid as verified by verify_data_column_sidecar_kzg_proofs(sidecar).
+func (dv *RODataColumnVerifier) SidecarKzgProofVerified() (err error) {
+	defer dv.recordResult(RequireSidecarKzgProofVerified, &err)
+	if err = dv.verifyDataColumnCommitment([]blocks.RODataColumn{dv.dataColumn}); err != nil {
+		log.WithError(err).WithFields(logging.DataColumnFields(dv.dataColumn)).Debug("KZG commitment proof verification failed")
+		return dataColumnErrBuilder(ErrSidecarKzgProofInvalid)
+	}
+	return nil
+}
+
+// SidecarProposerExpected represents the spec verification:
+// [REJECT] The sidecar is proposed by the expected proposer_index for the block's slot
+// in the context of the current shuffling (defined by block_header.parent_root/block_header.slot).
+func (dv *RODataColumnVerifier) SidecarProposerExpected(ctx context.Context) (err error) {
+	defer dv.recordResult(RequireSidecarProposerExpected, &err)
+	e := slots.ToEpoch(dv.dataColumn.Slot())
+	if e > 0 {
+		e = e - 1
+	}
+	r, err := dv.fc.TargetRootForEpoch(dv.dataColumn.ParentRoot(), e)
+	if err != nil {
+		return dataColumnErrBuilder(ErrSidecarUnexpectedProposer)
+	}
+	c := &forkchoicetypes.Checkpoint{Root: r, Epoch: e}
+	idx, cached := dv.pc.Proposer(c, dv.dataColumn.Slot())
+	if !cached {
+		pst, err := dv.parentState(ctx)
+		if err != nil {
+			log.WithError(err).WithFields(logging.DataColumnFields(dv.dataColumn)).Debug("State replay to parent_root failed")
+			return dataColumnErrBuilder(ErrSidecarUnexpectedProposer)
+		}
+		idx, err = dv.pc.ComputeProposer(ctx, dv.dataColumn.ParentRoot(), dv.dataColumn.Slot(), pst)
+		if err != nil {
+			log.WithError(err).WithFields(logging.DataColumnFields(dv.dataColumn)).Debug("Error computing proposer index from parent state")
+			return dataColumnErrBuilder(ErrSidecarUnexpectedProposer)
+		}
+	}
+	if idx != dv.dataColumn.ProposerIndex() {
+		log.WithError(dataColumnErrBuilder(ErrSidecarUnexpectedProposer)).
+			WithFields(logging.DataColumnFields(dv.dataColumn)).WithField("expectedProposer", idx).
+			Debug("Unexpected data column proposer")
+		return dataColumnErrBuilder(ErrSidecarUnexpectedProposer)
+	}
+	return nil
+}
+
+func (dv *RODataColumnVerifier) parentState(ctx context.Context) (state.BeaconState, error) {
+	if dv.parent != nil {
+		return dv.parent, nil
+	}
+	st, err := dv.sr.StateByRoot(ctx, dv.dataColumn.ParentRoot())
+	if err != nil {
+		return nil, err
+	}
+	dv.parent = st
+	return dv.parent, nil
+}
+
+func columnToSignatureData(dc blocks.RODataColumn) SignatureData {
+	return SignatureData{
+		Root:      dc.BlockRoot(),
+		Parent:    dc.ParentRoot(),
+		Signature: bytesutil.ToBytes96(dc.SignedBlockHeader.Signature),
+		Proposer:  dc.ProposerIndex(),
+		Slot:      dc.Slot(),
+	}
+}
+
+func dataColumnErrBuilder(baseErr error) error {
+	return goError.Join(ErrDataColumnInvalid, baseErr)
+}
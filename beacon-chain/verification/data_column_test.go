@@ -0,0 +1,173 @@
+package verification
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/peerdas"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestDataColumnValidFields(t *testing.T) {
+	ini := &Initializer{}
+	_, columns := util.GenerateTestFuluBlockWithDataColumns(t, [32]byte{}, 1, 2)
+	v := ini.NewDataColumnVerifier(columns[0], GossipDataColumnSidecarRequirements)
+	require.NoError(t, v.ValidFields())
+	require.Equal(t, true, v.results.executed(RequireValidFields))
+	require.NoError(t, v.results.result(RequireValidFields))
+
+	dc := columns[1]
+	dc.ColumnIndex = params.BeaconConfig().NumberOfColumns
+	v = ini.NewDataColumnVerifier(dc, GossipDataColumnSidecarRequirements)
+	err := v.ValidFields()
+	require.ErrorIs(t, err, ErrDataColumnFieldsInvalid)
+	require.ErrorIs(t, err, ErrDataColumnInvalid)
+	require.NotNil(t, v.results.result(RequireValidFields))
+}
+
+func TestDataColumnCorrectSubnet(t *testing.T) {
+	ini := &Initializer{}
+	_, columns := util.GenerateTestFuluBlockWithDataColumns(t, [32]byte{}, 1, 1)
+	dc := columns[3]
+	subnet := peerdas.ComputeSubnetForDataColumnSidecar(dc.ColumnIndex)
+
+	v := ini.NewDataColumnVerifier(dc, GossipDataColumnSidecarRequirements)
+	require.NoError(t, v.CorrectSubnet(subnet))
+	require.NoError(t, v.results.result(RequireCorrectSubnet))
+
+	v = ini.NewDataColumnVerifier(dc, GossipDataColumnSidecarRequirements)
+	require.ErrorIs(t, v.CorrectSubnet(subnet+1), ErrDataColumnIncorrectSubnet)
+	require.NotNil(t, v.results.result(RequireCorrectSubnet))
+}
+
+func TestDataColumnSidecarParentValid(t *testing.T) {
+	_, columns := util.GenerateTestFuluBlockWithDataColumns(t, [32]byte{'p'}, 1, 1)
+	dc := columns[0]
+	ini := Initializer{shared: &sharedResources{}}
+	v := ini.NewDataColumnVerifier(dc, GossipDataColumnSidecarRequirements)
+	require.NoError(t, v.SidecarParentValid(badParentCb(t, dc.ParentRoot(), false)))
+
+	v = ini.NewDataColumnVerifier(dc, GossipDataColumnSidecarRequirements)
+	require.ErrorIs(t, v.SidecarParentValid(badParentCb(t, dc.ParentRoot(), true)), ErrSidecarParentInvalid)
+	require.NotNil(t, v.results.result(RequireSidecarParentValid))
+}
+
+func TestDataColumnSidecarInclusionProven(t *testing.T) {
+	_, columns := util.GenerateTestFuluBlockWithDataColumns(t, [32]byte{}, 1, 2)
+	dc := columns[0]
+
+	ini := Initializer{}
+	v := ini.NewDataColumnVerifier(dc, GossipDataColumnSidecarRequirements)
+	require.NoError(t, v.SidecarInclusionProven())
+	require.NoError(t, v.results.result(RequireSidecarInclusionProven))
+
+	// Invert bits of the first byte of the body root to mess up the proof
+	dc.SignedBlockHeader.Header.BodyRoot[0] ^= 255
+	v = ini.NewDataColumnVerifier(dc, GossipDataColumnSidecarRequirements)
+	require.ErrorIs(t, v.SidecarInclusionProven(), ErrSidecarInclusionProofInvalid)
+	require.NotNil(t, v.results.result(RequireSidecarInclusionProven))
+}
+
+func TestDataColumnSidecarKzgProofVerified(t *testing.T) {
+	_, columns := util.GenerateTestFuluBlockWithDataColumns(t, [32]byte{}, 1, 1)
+	dc := columns[0]
+	passes := func(dcs []blocks.RODataColumn) error {
+		require.Equal(t, dc.ColumnIndex, dcs[0].ColumnIndex)
+		return nil
+	}
+	v := &RODataColumnVerifier{verifyDataColumnCommitment: passes, results: newResults(), dataColumn: dc}
+	require.NoError(t, v.SidecarKzgProofVerified())
+	require.NoError(t, v.results.result(RequireSidecarKzgProofVerified))
+
+	fails := func(_ []blocks.RODataColumn) error {
+		return errors.New("bad column")
+	}
+	v = &RODataColumnVerifier{verifyDataColumnCommitment: fails, results: newResults(), dataColumn: dc}
+	require.ErrorIs(t, v.SidecarKzgProofVerified(), ErrSidecarKzgProofInvalid)
+	require.NotNil(t, v.results.result(RequireSidecarKzgProofVerified))
+}
+
+func TestDataColumnSidecarProposerExpected(t *testing.T) {
+	ctx := context.Background()
+	_, columns := util.GenerateTestFuluBlockWithDataColumns(t, [32]byte{}, 1, 1)
+	dc := columns[0]
+	t.Run("cached, matches", func(t *testing.T) {
+		ini := Initializer{shared: &sharedResources{pc: &mockProposerCache{ProposerCB: pcReturnsIdx(dc.ProposerIndex())}, fc: &mockForkchoicer{TargetRootForEpochCB: fcReturnsTargetRoot([32]byte{})}}}
+		v := ini.NewDataColumnVerifier(dc, GossipDataColumnSidecarRequirements)
+		require.NoError(t, v.SidecarProposerExpected(ctx))
+		require.NoError(t, v.results.result(RequireSidecarProposerExpected))
+	})
+	t.Run("cached, does not match", func(t *testing.T) {
+		ini := Initializer{shared: &sharedResources{pc: &mockProposerCache{ProposerCB: pcReturnsIdx(dc.ProposerIndex() + 1)}, fc: &mockForkchoicer{TargetRootForEpochCB: fcReturnsTargetRoot([32]byte{})}}}
+		v := ini.NewDataColumnVerifier(dc, GossipDataColumnSidecarRequirements)
+		require.ErrorIs(t, v.SidecarProposerExpected(ctx), ErrSidecarUnexpectedProposer)
+		require.NotNil(t, v.results.result(RequireSidecarProposerExpected))
+	})
+	t.Run("not cached, state lookup failure", func(t *testing.T) {
+		ini := Initializer{shared: &sharedResources{sr: sbrNotFound(t, dc.ParentRoot()), pc: &mockProposerCache{ProposerCB: pcReturnsNotFound()}, fc: &mockForkchoicer{TargetRootForEpochCB: fcReturnsTargetRoot([32]byte{})}}}
+		v := ini.NewDataColumnVerifier(dc, GossipDataColumnSidecarRequirements)
+		require.ErrorIs(t, v.SidecarProposerExpected(ctx), ErrSidecarUnexpectedProposer)
+	})
+}
+
+func TestDataColumnRequirementSatisfaction(t *testing.T) {
+	_, columns := util.GenerateTestFuluBlockWithDataColumns(t, [32]byte{}, 1, 1)
+	ini := Initializer{}
+	v := ini.NewDataColumnVerifier(columns[0], GossipDataColumnSidecarRequirements)
+
+	_, err := v.VerifiedRODataColumn()
+	require.ErrorIs(t, err, ErrDataColumnInvalid)
+	var me VerificationMultiError
+	require.Equal(t, true, errors.As(err, &me))
+	for _, f := range me.Failures() {
+		require.ErrorIs(t, f, ErrMissingVerification)
+	}
+
+	for _, r := range GossipDataColumnSidecarRequirements {
+		v.SatisfyRequirement(r)
+	}
+	_, err = v.VerifiedRODataColumn()
+	require.NoError(t, err)
+}
+
+func TestDataColumnBatchVerifier(t *testing.T) {
+	ctx := context.Background()
+	blk, columns := util.GenerateTestFuluBlockWithDataColumns(t, [32]byte{}, 1, 2)
+	ini := &Initializer{}
+	newVerifier := func(dc blocks.RODataColumn, reqs []Requirement) DataColumnVerifier {
+		return ini.NewDataColumnVerifier(dc, reqs)
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		bv := NewDataColumnBatchVerifier(newVerifier, InitsyncDataColumnSidecarRequirements)
+		bv.verifyKzg = func([]blocks.RODataColumn) error { return nil }
+		vcs, err := bv.VerifiedRODataColumns(ctx, blk, columns[:3])
+		require.NoError(t, err)
+		require.Equal(t, 3, len(vcs))
+	})
+	t.Run("kzg failure", func(t *testing.T) {
+		bv := NewDataColumnBatchVerifier(newVerifier, InitsyncDataColumnSidecarRequirements)
+		bv.verifyKzg = func([]blocks.RODataColumn) error { return errors.New("bad kzg") }
+		_, err := bv.VerifiedRODataColumns(ctx, blk, columns[:3])
+		require.ErrorContains(t, "bad kzg", err)
+	})
+	t.Run("signature mismatch", func(t *testing.T) {
+		bv := NewDataColumnBatchVerifier(newVerifier, InitsyncDataColumnSidecarRequirements)
+		bv.verifyKzg = func([]blocks.RODataColumn) error { return nil }
+		_, other := util.GenerateTestFuluBlockWithDataColumns(t, [32]byte{}, 2, 2)
+		other[0].SignedBlockHeader.Signature = []byte{'x'}
+		_, err := bv.VerifiedRODataColumns(ctx, blk, other[:1])
+		require.ErrorIs(t, err, ErrBatchSignatureMismatch)
+	})
+	t.Run("root mismatch", func(t *testing.T) {
+		bv := NewDataColumnBatchVerifier(newVerifier, InitsyncDataColumnSidecarRequirements)
+		bv.verifyKzg = func([]blocks.RODataColumn) error { return nil }
+		_, other := util.GenerateTestFuluBlockWithDataColumns(t, [32]byte{}, 2, 2)
+		_, err := bv.VerifiedRODataColumns(ctx, blk, other[:1])
+		require.ErrorIs(t, err, ErrBatchBlockRootMismatch)
+	})
+}
@@ -22,6 +22,12 @@ func BlobSidecarSliceNoop(b []blocks.ROBlob) ([]blocks.VerifiedROBlob, error) {
 	return vbs, nil
 }
 
+// DataColumnSidecarNoop is a FAKE verification function that simply launders a RODataColumn->VerifiedRODataColumn.
+// It should only be used for data columns read back from storage, which only accepts verified columns.
+func DataColumnSidecarNoop(dc blocks.RODataColumn) (blocks.VerifiedRODataColumn, error) {
+	return blocks.NewVerifiedRODataColumn(dc), nil
+}
+
 // FakeVerifyForTest can be used by tests that need a VerifiedROBlob but don't want to do all the
 // expensive set up to perform full validation.
 func FakeVerifyForTest(t *testing.T, b blocks.ROBlob) blocks.VerifiedROBlob {
@@ -42,3 +48,15 @@ func FakeVerifySliceForTest(t *testing.T, b []blocks.ROBlob) []blocks.VerifiedRO
 	}
 	return vbs
 }
+
+// FakeVerifyDataColumnSliceForTest can be used by tests that need a []VerifiedRODataColumn but don't want to do all the
+// expensive set up to perform full validation.
+func FakeVerifyDataColumnSliceForTest(t *testing.T, dcs []blocks.RODataColumn) []blocks.VerifiedRODataColumn {
+	// log so that t is truly required
+	t.Log("producing fake []VerifiedRODataColumn for a test")
+	vcs := make([]blocks.VerifiedRODataColumn, len(dcs))
+	for i := range dcs {
+		vcs[i] = blocks.NewVerifiedRODataColumn(dcs[i])
+	}
+	return vcs
+}
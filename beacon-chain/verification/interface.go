@@ -26,6 +26,28 @@ type BlobVerifier interface {
 	SatisfyRequirement(Requirement)
 }
 
+// DataColumnVerifier defines the methods implemented by the RODataColumnVerifier.
+// Like BlobVerifier, it mainly exists to make mocking straightforward.
+type DataColumnVerifier interface {
+	VerifiedRODataColumn() (blocks.VerifiedRODataColumn, error)
+	ValidFields() (err error)
+	CorrectSubnet(subnet uint64) (err error)
+	NotFromFutureSlot() (err error)
+	SlotAboveFinalized() (err error)
+	ValidProposerSignature(ctx context.Context) (err error)
+	SidecarParentSeen(parentSeen func([32]byte) bool) (err error)
+	SidecarParentValid(badParent func([32]byte) bool) (err error)
+	SidecarParentSlotLower() (err error)
+	SidecarDescendsFromFinalized() (err error)
+	SidecarInclusionProven() (err error)
+	SidecarKzgProofVerified() (err error)
+	SidecarProposerExpected(ctx context.Context) (err error)
+	SatisfyRequirement(Requirement)
+}
+
+// NewDataColumnVerifier is a function signature that can be used to mock Initializer.NewDataColumnVerifier.
+type NewDataColumnVerifier func(dc blocks.RODataColumn, reqs []Requirement) DataColumnVerifier
+
 // NewBlobVerifier is a function signature that can be used by code that needs to be
 // able to mock Initializer.NewBlobVerifier without complex setup.
 type NewBlobVerifier func(b blocks.ROBlob, reqs []Requirement) BlobVerifier
@@ -61,3 +61,10 @@ func TestAllBlobRequirementsHaveStrings(t *testing.T) {
 		require.NotEqual(t, unknownRequirementName, allBlobSidecarRequirements[i].String())
 	}
 }
+
+func TestAllDataColumnRequirementsHaveStrings(t *testing.T) {
+	for i := range allDataColumnSidecarRequirements {
+		require.NotEqual(t, unknownRequirementName, allDataColumnSidecarRequirements[i].String())
+	}
+	require.DeepEqual(t, allDataColumnSidecarRequirements, GossipDataColumnSidecarRequirements)
+}
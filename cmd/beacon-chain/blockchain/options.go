@@ -5,6 +5,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v5/cmd"
 	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/urfave/cli/v2"
 )
 
@@ -19,6 +20,7 @@ func FlagOptions(c *cli.Context) ([]blockchain.Option, error) {
 	opts := []blockchain.Option{
 		blockchain.WithMaxGoroutines(maxRoutines),
 		blockchain.WithWeakSubjectivityCheckpoint(wsCheckpt),
+		blockchain.WithForkchoiceSnapshotInterval(primitives.Epoch(c.Uint64(flags.ForkchoiceSnapshotInterval.Name))),
 	}
 	return opts, nil
 }
@@ -31,6 +31,40 @@ func FlagOptions(c *cli.Context) ([]execution.Option, error) {
 	if len(jwtSecret) > 0 {
 		opts = append(opts, execution.WithHttpEndpointAndJWTSecret(endpoint, jwtSecret))
 	}
+	engineOpts, err := additionalEngineOptions(c, jwtSecret)
+	if err != nil {
+		return nil, err
+	}
+	return append(opts, engineOpts...), nil
+}
+
+// additionalEngineOptions returns the options for the additional execution endpoints and the engine mode.
+// Endpoints without their own JWT secret use the secret of the primary endpoint.
+func additionalEngineOptions(c *cli.Context, jwtSecret []byte) ([]execution.Option, error) {
+	endpoints := c.StringSlice(flags.AdditionalExecutionEndpoints.Name)
+	if len(endpoints) == 0 {
+		return nil, nil
+	}
+	secretFiles := c.StringSlice(flags.AdditionalExecutionJWTSecrets.Name)
+	if len(secretFiles) > len(endpoints) {
+		return nil, fmt.Errorf("%d values of %s were provided for %d additional execution endpoints",
+			len(secretFiles), flags.AdditionalExecutionJWTSecrets.Name, len(endpoints))
+	}
+	mode, err := execution.ParseEngineMode(c.String(flags.ExecutionEngineMode.Name))
+	if err != nil {
+		return nil, err
+	}
+	opts := []execution.Option{execution.WithEngineMode(mode)}
+	for i, endpoint := range endpoints {
+		secret := jwtSecret
+		if i < len(secretFiles) && secretFiles[i] != "" {
+			secret, err = readJWTSecretFile(secretFiles[i])
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not read JWT secret file of additional execution endpoint %d", i)
+			}
+		}
+		opts = append(opts, execution.WithAdditionalEngineEndpoint(endpoint, secret))
+	}
 	return opts, nil
 }
 
@@ -47,6 +81,10 @@ func parseJWTSecretFromFile(c *cli.Context) ([]byte, error) {
 	if jwtSecretFile == "" {
 		return nil, nil
 	}
+	return readJWTSecretFile(jwtSecretFile)
+}
+
+func readJWTSecretFile(jwtSecretFile string) ([]byte, error) {
 	enc, err := file.ReadFileAsBytes(jwtSecretFile)
 	if err != nil {
 		return nil, err
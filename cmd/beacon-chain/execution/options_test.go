@@ -99,3 +99,33 @@ func TestPowchainPreregistration_EmptyWeb3Provider(t *testing.T) {
 	_, err := parseExecutionChainEndpoint(ctx)
 	assert.ErrorContains(t, "you need to specify", err)
 }
+
+func Test_additionalEngineOptions(t *testing.T) {
+	secretPath := filepath.Join(t.TempDir(), "secret")
+	secret := bytesutil.ToBytes32([]byte("bar"))
+	require.NoError(t, file.WriteFile(secretPath, []byte(fmt.Sprintf("%#x", secret))))
+	newContext := func(endpoints, secrets []string, mode string) *cli.Context {
+		app := cli.App{}
+		set := flag.NewFlagSet("test", 0)
+		set.Var(cli.NewStringSlice(endpoints...), flags.AdditionalExecutionEndpoints.Name, "")
+		set.Var(cli.NewStringSlice(secrets...), flags.AdditionalExecutionJWTSecrets.Name, "")
+		set.String(flags.ExecutionEngineMode.Name, mode, "")
+		return cli.NewContext(&app, set, nil)
+	}
+
+	opts, err := additionalEngineOptions(newContext(nil, nil, "bad"), nil)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(opts))
+
+	opts, err = additionalEngineOptions(newContext([]string{"http://a:8551", "http://b:8551"}, []string{secretPath}, "comparison"), []byte("primary"))
+	require.NoError(t, err)
+	// The engine mode and one option per endpoint.
+	require.Equal(t, 3, len(opts))
+
+	_, err = additionalEngineOptions(newContext([]string{"http://a:8551"}, []string{secretPath, secretPath}, "failover"), nil)
+	require.ErrorContains(t, "2 values of additional-jwt-secret", err)
+	_, err = additionalEngineOptions(newContext([]string{"http://a:8551"}, nil, "roundrobin"), nil)
+	require.ErrorContains(t, "unknown execution engine mode", err)
+	_, err = additionalEngineOptions(newContext([]string{"http://a:8551"}, []string{"/tmp/askdjkajsd"}, "failover"), nil)
+	require.ErrorContains(t, "no such file", err)
+}
@@ -4,6 +4,7 @@ package flags
 
 import (
 	"strings"
+	"time"
 
 	"github.com/prysmaticlabs/prysm/v5/cmd"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
@@ -28,6 +29,23 @@ var (
 		Usage: "A MEV builder relay string http endpoint, this will be used to interact MEV builder network using API defined in: https://ethereum.github.io/builder-specs/#/Builder",
 		Value: "",
 	}
+	// AdditionalMevRelayEndpoints provides HTTP access endpoints to MEV builder networks used along with the one of MevRelayEndpoint.
+	AdditionalMevRelayEndpoints = &cli.StringSliceFlag{
+		Name: "additional-http-mev-relay",
+		Usage: "An additional MEV builder relay http endpoint, used along with --http-mev-relay. " +
+			"Multiple relays can be set by repeating the flag or with a comma separated list, the highest valid bid among all the relays is then used.",
+	}
+	// MevRelayGetHeaderTimeout is the deadline for MEV relays to return their bids.
+	MevRelayGetHeaderTimeout = &cli.DurationFlag{
+		Name:  "http-mev-relay-get-header-timeout",
+		Usage: "Deadline for the MEV relays to return their bids, bids received later are discarded.",
+		Value: 950 * time.Millisecond,
+	}
+	// MevRelayDisableSSZ makes the beacon node exchange bids and blinded blocks with the MEV relays in JSON only.
+	MevRelayDisableSSZ = &cli.BoolFlag{
+		Name:  "http-mev-relay-disable-ssz",
+		Usage: "Only use JSON encoding to request bids from and submit blinded blocks to the MEV relays, instead of trying SSZ first.",
+	}
 	MaxBuilderConsecutiveMissedSlots = &cli.IntFlag{
 		Name:  "max-builder-consecutive-missed-slots",
 		Usage: "Number of consecutive skip slot to fallback from using relay/builder to local execution engine for block construction",
@@ -86,6 +104,28 @@ var (
 			"This is not required if using an IPC connection.",
 		Value: "",
 	}
+	// AdditionalExecutionEndpoints provides execution client endpoints used along with the one of ExecutionEngineEndpoint.
+	AdditionalExecutionEndpoints = &cli.StringSliceFlag{
+		Name: "additional-execution-endpoint",
+		Usage: "An additional execution client http endpoint, used as defined by --execution-engine-mode. " +
+			"Multiple endpoints can be set by repeating the flag or with a comma separated list.",
+	}
+	// AdditionalExecutionJWTSecrets provides the paths to the JWT secrets of the additional execution endpoints.
+	AdditionalExecutionJWTSecrets = &cli.StringSliceFlag{
+		Name: "additional-jwt-secret",
+		Usage: "Path to the JWT secret of the additional execution endpoint at the same position. " +
+			"Endpoints without their own secret use the one of --jwt-secret.",
+	}
+	// ExecutionEngineMode defines how engine API requests are dispatched to the execution endpoints.
+	ExecutionEngineMode = &cli.StringFlag{
+		Name: "execution-engine-mode",
+		Usage: "How execution client requests are dispatched when additional execution endpoints are set. " +
+			"'failover' sends them to the primary endpoint and switches to the next endpoint on errors or timeouts. " +
+			"'comparison' also compares the payload statuses of all the endpoints, and reports when some of them " +
+			"consider a payload valid and others invalid. In both modes payloads and forkchoice updates are " +
+			"forwarded to all the endpoints so that they stay in sync.",
+		Value: "failover",
+	}
 	// JwtId is the id field of the JWT claims. The consensus layer client MAY use this to communicate a unique identifier for the individual consensus layer client
 	JwtId = &cli.StringFlag{
 		Name:  "jwt-id",
@@ -214,6 +254,11 @@ var (
 		Name:  "subscribe-all-subnets",
 		Usage: "Subscribe to all possible attestation and sync subnets.",
 	}
+	// SubscribeAllDataSubnets defines a flag to specify whether to subscribe to all data column subnets and custody every column.
+	SubscribeAllDataSubnets = &cli.BoolFlag{
+		Name:  "subscribe-all-data-subnets",
+		Usage: "Subscribe to all data column subnets and custody all data columns. This requires significantly more bandwidth and storage.",
+	}
 	// HistoricalSlasherNode is a set of beacon node flags required for performing historical detection with a slasher.
 	HistoricalSlasherNode = &cli.BoolFlag{
 		Name:  "historical-slasher-node",
@@ -296,4 +341,43 @@ var (
 		Usage: "Directory for the slasher database",
 		Value: cmd.DefaultDataDir(),
 	}
+	// BeaconDBPruning enables the background deletion of historical blocks and states from the beacon db.
+	BeaconDBPruning = &cli.BoolFlag{
+		Name: "beacon-db-pruning",
+		Usage: "Enables deleting finalized blocks and states older than --pruner-retention-epochs from the beacon db. " +
+			"Pruned blocks are no longer served to peers or over the API.",
+	}
+	// PrunerRetentionEpochs defines the number of epochs of blocks and states kept by the beacon db pruner.
+	PrunerRetentionEpochs = &cli.Uint64Flag{
+		Name: "pruner-retention-epochs",
+		Usage: "Number of epochs of blocks and states kept when --beacon-db-pruning is enabled. " +
+			"Cannot be smaller than the spec MIN_EPOCHS_FOR_BLOCK_REQUESTS.",
+	}
+	// EnableStateDiff stores finalized states as hierarchical state diffs.
+	EnableStateDiff = &cli.BoolFlag{
+		Name: "enable-state-diff",
+		Usage: "Stores finalized states as compact diffs against periodic snapshots instead of full states at archived points. " +
+			"Can only be enabled on a new database, or with --clear-db, and cannot be disabled afterwards.",
+	}
+	// StateDiffExponents defines the layers of the state diff hierarchy.
+	StateDiffExponents = &cli.IntSliceFlag{
+		Name: "state-diff-exponents",
+		Usage: "Strictly decreasing exponents of the state diff hierarchy, used with --enable-state-diff. " +
+			"A layer stores a state every 2^exponent slots, the first layer as full snapshots and the others as diffs.",
+		Value: cli.NewIntSlice(21, 18, 16, 13, 11, 9, 5),
+	}
+	// ForkchoiceSnapshotInterval defines how often the fork choice store is saved to the db.
+	ForkchoiceSnapshotInterval = &cli.Uint64Flag{
+		Name: "forkchoice-snapshot-interval",
+		Usage: "Number of epochs between snapshots of the fork choice store saved to the db. When set, a snapshot is also " +
+			"saved on shutdown and restored on startup instead of rebuilding fork choice from the finalized checkpoint. " +
+			"Disabled when 0.",
+	}
+	// ForkchoiceTraceFile defines a file where the fork choice operations are recorded.
+	ForkchoiceTraceFile = &cli.StringFlag{
+		Name: "forkchoice-trace-file",
+		Usage: "Records the blocks, attestations and slot ticks applied to fork choice to the given file, so that they can be " +
+			"replayed offline with tools/forkchoice-sim. The file is overwritten at startup. Recording has a memory and disk " +
+			"overhead and should only be enabled to investigate fork choice behavior.",
+	}
 )
@@ -45,6 +45,9 @@ var appFlags = []cli.Flag{
 	flags.ExecutionEngineEndpoint,
 	flags.ExecutionEngineHeaders,
 	flags.ExecutionJWTSecretFlag,
+	flags.AdditionalExecutionEndpoints,
+	flags.AdditionalExecutionJWTSecrets,
+	flags.ExecutionEngineMode,
 	flags.RPCHost,
 	flags.RPCPort,
 	flags.CertFlag,
@@ -64,6 +67,7 @@ var appFlags = []cli.Flag{
 	flags.SlotsPerArchivedPoint,
 	flags.DisableDebugRPCEndpoints,
 	flags.SubscribeToAllSubnets,
+	flags.SubscribeAllDataSubnets,
 	flags.HistoricalSlasherNode,
 	flags.ChainID,
 	flags.NetworkID,
@@ -76,6 +80,9 @@ var appFlags = []cli.Flag{
 	flags.TerminalBlockHashOverride,
 	flags.TerminalBlockHashActivationEpochOverride,
 	flags.MevRelayEndpoint,
+	flags.AdditionalMevRelayEndpoints,
+	flags.MevRelayGetHeaderTimeout,
+	flags.MevRelayDisableSSZ,
 	flags.MaxBuilderEpochMissedSlots,
 	flags.MaxBuilderConsecutiveMissedSlots,
 	flags.EngineEndpointTimeoutSeconds,
@@ -140,8 +147,15 @@ var appFlags = []cli.Flag{
 	genesis.StatePath,
 	genesis.BeaconAPIURL,
 	flags.SlasherDirFlag,
+	flags.BeaconDBPruning,
+	flags.PrunerRetentionEpochs,
+	flags.EnableStateDiff,
+	flags.StateDiffExponents,
+	flags.ForkchoiceSnapshotInterval,
+	flags.ForkchoiceTraceFile,
 	flags.JwtId,
 	storage.BlobStoragePathFlag,
+	storage.DataColumnStoragePathFlag,
 	storage.BlobRetentionEpochFlag,
 	bflags.EnableExperimentalBackfill,
 	bflags.BackfillBatchSize,
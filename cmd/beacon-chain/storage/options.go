@@ -18,6 +18,11 @@ var (
 		Name:  "blob-path",
 		Usage: "Location for blob storage. Default location will be a 'blobs' directory next to the beacon db.",
 	}
+	// DataColumnStoragePathFlag defines the location of data column sidecar storage.
+	DataColumnStoragePathFlag = &cli.PathFlag{
+		Name:  "data-column-path",
+		Usage: "Location for data column storage. Default location will be a 'data-columns' directory next to the beacon db.",
+	}
 	BlobRetentionEpochFlag = &cli.Uint64Flag{
 		Name:    "blob-retention-epochs",
 		Usage:   "Override the default blob retention period (measured in epochs). The node will exit with an error at startup if the value is less than the default of 4096 epochs.",
@@ -35,9 +40,12 @@ func BeaconNodeOptions(c *cli.Context) ([]node.Option, error) {
 	if err != nil {
 		return nil, err
 	}
-	opts := []node.Option{node.WithBlobStorageOptions(
-		filesystem.WithBlobRetentionEpochs(e), filesystem.WithBasePath(blobStoragePath(c)),
-	)}
+	opts := []node.Option{
+		node.WithBlobStorageOptions(
+			filesystem.WithBlobRetentionEpochs(e), filesystem.WithBasePath(blobStoragePath(c)),
+		),
+		node.WithDataColumnStorageOptions(filesystem.WithDataColumnBasePath(dataColumnStoragePath(c))),
+	}
 	return opts, nil
 }
 
@@ -50,6 +58,15 @@ func blobStoragePath(c *cli.Context) string {
 	return blobsPath
 }
 
+func dataColumnStoragePath(c *cli.Context) string {
+	columnsPath := c.Path(DataColumnStoragePathFlag.Name)
+	if columnsPath == "" {
+		// append a "data-columns" subdir to the end of the data dir path
+		columnsPath = path.Join(c.String(cmd.DataDirFlag.Name), "data-columns")
+	}
+	return columnsPath
+}
+
 var errInvalidBlobRetentionEpochs = errors.New("value is smaller than spec minimum")
 
 // blobRetentionEpoch returns the spec default MIN_EPOCHS_FOR_BLOB_SIDECARS_REQUEST
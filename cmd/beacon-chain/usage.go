@@ -109,6 +109,9 @@ var appHelpFlagGroups = []flagGroup{
 			flags.ExecutionEngineEndpoint,
 			flags.ExecutionEngineHeaders,
 			flags.ExecutionJWTSecretFlag,
+			flags.AdditionalExecutionEndpoints,
+			flags.AdditionalExecutionJWTSecrets,
+			flags.ExecutionEngineMode,
 			flags.SetGCPercent,
 			flags.SlotsPerArchivedPoint,
 			flags.BlockBatchLimit,
@@ -117,6 +120,7 @@ var appHelpFlagGroups = []flagGroup{
 			flags.BlobBatchLimitBurstFactor,
 			flags.DisableDebugRPCEndpoints,
 			flags.SubscribeToAllSubnets,
+			flags.SubscribeAllDataSubnets,
 			flags.HistoricalSlasherNode,
 			flags.ChainID,
 			flags.NetworkID,
@@ -125,10 +129,19 @@ var appHelpFlagGroups = []flagGroup{
 			flags.MinPeersPerSubnet,
 			flags.MaxConcurrentDials,
 			flags.MevRelayEndpoint,
+			flags.AdditionalMevRelayEndpoints,
+			flags.MevRelayGetHeaderTimeout,
+			flags.MevRelayDisableSSZ,
 			flags.MaxBuilderEpochMissedSlots,
 			flags.MaxBuilderConsecutiveMissedSlots,
 			flags.EngineEndpointTimeoutSeconds,
 			flags.SlasherDirFlag,
+			flags.BeaconDBPruning,
+			flags.PrunerRetentionEpochs,
+			flags.EnableStateDiff,
+			flags.StateDiffExponents,
+			flags.ForkchoiceSnapshotInterval,
+			flags.ForkchoiceTraceFile,
 			flags.LocalBlockValueBoost,
 			flags.MinBuilderBid,
 			flags.MinBuilderDiff,
@@ -139,6 +152,7 @@ var appHelpFlagGroups = []flagGroup{
 			genesis.StatePath,
 			genesis.BeaconAPIURL,
 			storage.BlobStoragePathFlag,
+			storage.DataColumnStoragePathFlag,
 			storage.BlobRetentionEpochFlag,
 			backfill.EnableExperimentalBackfill,
 			backfill.BackfillWorkerCount,
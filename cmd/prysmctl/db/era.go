@@ -0,0 +1,370 @@
+package db
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/sync/backfill"
+	"github.com/prysmaticlabs/prysm/v5/cmd"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/era"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var exportEraFlags = struct {
+	Path     string
+	Network  string
+	Dir      string
+	StartEra uint64
+	EndEra   uint64
+}{}
+
+var importEraFlags = struct {
+	Path    string
+	Network string
+	Dir     string
+}{}
+
+var exportEraCmd = &cli.Command{
+	Name:  "export-era",
+	Usage: "export the finalized blocks and the state at the end of each era of the beacon db to era files",
+	Action: func(cliCtx *cli.Context) error {
+		if err := exportEraAction(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not export era files")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		cmd.ChainConfigFileFlag,
+		&cli.StringFlag{
+			Name:        "path",
+			Usage:       "path to directory containing beaconchain.db",
+			Destination: &exportEraFlags.Path,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "network",
+			Usage:       "name of the network of the db (mainnet, sepolia, holesky)",
+			Destination: &exportEraFlags.Network,
+			Value:       params.MainnetName,
+		},
+		&cli.StringFlag{
+			Name:        "era-dir",
+			Usage:       "directory to write era files to",
+			Destination: &exportEraFlags.Dir,
+			Required:    true,
+		},
+		&cli.Uint64Flag{
+			Name:        "start-era",
+			Usage:       "first era to export",
+			Destination: &exportEraFlags.StartEra,
+		},
+		&cli.Uint64Flag{
+			Name:        "end-era",
+			Usage:       "last era to export, the last finalized era by default",
+			Destination: &exportEraFlags.EndEra,
+		},
+	},
+}
+
+var importEraCmd = &cli.Command{
+	Name: "import-era",
+	Usage: "import the blocks of era files into a checkpoint synced beacon db, filling the history before the " +
+		"checkpoint sync origin",
+	Action: func(cliCtx *cli.Context) error {
+		if err := importEraAction(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not import era files")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		cmd.ChainConfigFileFlag,
+		&cli.StringFlag{
+			Name:        "path",
+			Usage:       "path to directory containing beaconchain.db",
+			Destination: &importEraFlags.Path,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "network",
+			Usage:       "name of the network of the db (mainnet, sepolia, holesky)",
+			Destination: &importEraFlags.Network,
+			Value:       params.MainnetName,
+		},
+		&cli.StringFlag{
+			Name:        "era-dir",
+			Usage:       "directory containing the era files to import",
+			Destination: &importEraFlags.Dir,
+			Required:    true,
+		},
+	},
+}
+
+func setEraNetworkConfig(cliCtx *cli.Context, network string) error {
+	cfg, err := params.ByName(network)
+	if err != nil {
+		return errors.Wrapf(err, "unknown network %s", network)
+	}
+	if err := params.SetActive(cfg); err != nil {
+		return err
+	}
+	if cliCtx.IsSet(cmd.ChainConfigFileFlag.Name) {
+		return params.LoadChainConfigFile(cliCtx.String(cmd.ChainConfigFileFlag.Name), nil)
+	}
+	return nil
+}
+
+func exportEraAction(cliCtx *cli.Context) error {
+	flags := exportEraFlags
+	if err := setEraNetworkConfig(cliCtx, flags.Network); err != nil {
+		return err
+	}
+	ctx := cliCtx.Context
+	db, err := kv.NewKVStore(ctx, flags.Path)
+	if err != nil {
+		return errors.Wrapf(err, "could not open db at %s", flags.Path)
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			log.WithError(err).Error("Could not close db")
+		}
+	}()
+
+	genesis, err := db.GenesisState(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve genesis state")
+	}
+	if genesis == nil || genesis.IsNil() {
+		return errors.New("db has no genesis state")
+	}
+	cp, err := db.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve finalized checkpoint")
+	}
+	finalized, err := slots.EpochStart(cp.Epoch)
+	if err != nil {
+		return err
+	}
+	last := uint64(finalized / params.BeaconConfig().SlotsPerHistoricalRoot)
+	end := flags.EndEra
+	if !cliCtx.IsSet("end-era") {
+		end = last
+	}
+	if end > last {
+		return errors.Errorf("era %d is not finalized, the last finalized era is %d", end, last)
+	}
+	if flags.StartEra > end {
+		return errors.Errorf("start era %d is after end era %d", flags.StartEra, end)
+	}
+	if err := os.MkdirAll(flags.Dir, params.BeaconIoConfig().ReadWriteExecutePermissions); err != nil {
+		return err
+	}
+
+	ch := stategen.NewCanonicalHistory(db, finalizedChecker{db: db}, genesisSlotter{genesisTime: genesis.GenesisTime()})
+	for n := flags.StartEra; n <= end; n++ {
+		e, err := eraFromDB(ctx, db, ch, n)
+		if err != nil {
+			return errors.Wrapf(err, "could not build era %d", n)
+		}
+		name, err := e.Filename(params.BeaconConfig().ConfigName)
+		if err != nil {
+			return err
+		}
+		path := filepath.Join(flags.Dir, name)
+		if _, err := os.Stat(path); err == nil {
+			log.WithField("path", path).Info("Era file already exists, skipping")
+			continue
+		}
+		if err := writeEraFile(path, e); err != nil {
+			return errors.Wrapf(err, "could not write era %d", n)
+		}
+		log.WithFields(log.Fields{"era": n, "blocks": len(e.Blocks), "path": path}).Info("Exported era")
+	}
+	return nil
+}
+
+// eraFromDB reads the finalized blocks of the given era from the db and replays the state at its end.
+func eraFromDB(ctx context.Context, db *kv.Store, ch *stategen.CanonicalHistory, number uint64) (*era.Era, error) {
+	if number == 0 {
+		st, err := db.GenesisState(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &era.Era{State: st}, nil
+	}
+	end := primitives.Slot(number) * params.BeaconConfig().SlotsPerHistoricalRoot
+	st, err := ch.ReplayerForSlot(end-1).ReplayToSlot(ctx, end)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not replay state at slot %d", end)
+	}
+	blks, roots, err := db.Blocks(ctx, filters.NewFilter().SetStartSlot(era.StartSlot(number)).SetEndSlot(end-1))
+	if err != nil {
+		return nil, err
+	}
+	e := &era.Era{State: st, Blocks: make([]interfaces.ReadOnlySignedBeaconBlock, 0, len(blks))}
+	for i, b := range blks {
+		if b.Block().Slot() == 0 || !db.IsFinalizedBlock(ctx, roots[i]) {
+			continue
+		}
+		if b.IsBlinded() {
+			return nil, errors.Errorf("block at slot %d is blinded, era files can only be exported from a db "+
+				"written with --save-full-execution-payloads", b.Block().Slot())
+		}
+		e.Blocks = append(e.Blocks, b)
+	}
+	sort.Slice(e.Blocks, func(i, j int) bool {
+		return e.Blocks[i].Block().Slot() < e.Blocks[j].Block().Slot()
+	})
+	if err := e.VerifyBlockRoots(); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// writeEraFile writes the era to a temporary file first, so that an interrupted export never leaves
+// a truncated era file behind.
+func writeEraFile(path string, e *era.Era) error {
+	tmp := path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, params.BeaconIoConfig().ReadWritePermissions) // #nosec G304
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	err = era.Write(w, e)
+	if err == nil {
+		err = w.Flush()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		if rerr := os.Remove(tmp); rerr != nil {
+			log.WithError(rerr).WithField("path", tmp).Error("Could not remove temporary era file")
+		}
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func importEraAction(cliCtx *cli.Context) error {
+	flags := importEraFlags
+	if err := setEraNetworkConfig(cliCtx, flags.Network); err != nil {
+		return err
+	}
+	numbers, paths, err := eraFiles(flags.Dir)
+	if err != nil {
+		return err
+	}
+	ctx := cliCtx.Context
+	db, err := kv.NewKVStore(ctx, flags.Path)
+	if err != nil {
+		return errors.Wrapf(err, "could not open db at %s", flags.Path)
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			log.WithError(err).Error("Could not close db")
+		}
+	}()
+	hi, err := backfill.NewHistoryImporter(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	// Blocks are imported backwards from the checkpoint sync origin, so the eras are imported from the last one.
+	for i := len(numbers) - 1; i >= 0; i-- {
+		if hi.LowSlot() <= 1 {
+			break
+		}
+		if numbers[i] == 0 || era.StartSlot(numbers[i]) >= hi.LowSlot() {
+			continue
+		}
+		e, err := readEraFile(paths[i])
+		if err != nil {
+			return errors.Wrapf(err, "could not read era file %s", paths[i])
+		}
+		if err := e.VerifyBlockRoots(); err != nil {
+			return errors.Wrapf(err, "could not verify era file %s", paths[i])
+		}
+		n, err := hi.Import(ctx, e.Blocks)
+		if err != nil {
+			return errors.Wrapf(err, "could not import era file %s", paths[i])
+		}
+		log.WithFields(log.Fields{"era": numbers[i], "blocks": n, "lowSlot": hi.LowSlot()}).Info("Imported era")
+	}
+	if hi.LowSlot() > 1 {
+		log.WithField("lowSlot", hi.LowSlot()).Warn("Era files do not cover the complete history, backfill will download the remaining blocks")
+	}
+	return nil
+}
+
+// eraFiles returns the era numbers and paths of the era files of dir, sorted by era number.
+func eraFiles(dir string) ([]uint64, []string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	byNumber := make(map[uint64]string)
+	numbers := make([]uint64, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		n, err := era.NumberFromFilename(entry.Name())
+		if err != nil {
+			continue
+		}
+		if prev, ok := byNumber[n]; ok {
+			return nil, nil, errors.Errorf("era files %s and %s have the same era number", prev, entry.Name())
+		}
+		byNumber[n] = entry.Name()
+		numbers = append(numbers, n)
+	}
+	sort.Slice(numbers, func(i, j int) bool { return numbers[i] < numbers[j] })
+	paths := make([]string, len(numbers))
+	for i, n := range numbers {
+		paths[i] = filepath.Join(dir, byNumber[n])
+	}
+	return numbers, paths, nil
+}
+
+func readEraFile(path string) (*era.Era, error) {
+	f, err := os.Open(path) // #nosec G304
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).WithField("path", path).Error("Could not close era file")
+		}
+	}()
+	return era.Read(bufio.NewReader(f))
+}
+
+// finalizedChecker considers the finalized blocks of the db as canonical.
+type finalizedChecker struct {
+	db *kv.Store
+}
+
+func (c finalizedChecker) IsCanonical(ctx context.Context, root [32]byte) (bool, error) {
+	return c.db.IsFinalizedBlock(ctx, root), nil
+}
+
+// genesisSlotter computes the current slot from the genesis time, without a running clock.
+type genesisSlotter struct {
+	genesisTime uint64
+}
+
+func (s genesisSlotter) CurrentSlot() primitives.Slot {
+	return slots.CurrentSlot(s.genesisTime)
+}
@@ -249,32 +249,12 @@ func generateGenesis(ctx context.Context) (state.BeaconState, error) {
 		)
 	}
 
-	gen := &core.Genesis{}
+	var gen *core.Genesis
 	if f.GethGenesisJsonIn != "" {
-		gbytes, err := os.ReadFile(f.GethGenesisJsonIn) // #nosec G304
+		gen, err = readGethGenesisJson(f.GethGenesisJsonIn, f.GenesisTime)
 		if err != nil {
-			return nil, errors.Wrapf(err, "failed to read %s", f.GethGenesisJsonIn)
-		}
-		if err := json.Unmarshal(gbytes, gen); err != nil {
 			return nil, err
 		}
-		// set timestamps for genesis and shanghai fork
-		gen.Timestamp = f.GenesisTime
-		gen.Config.ShanghaiTime = interop.GethShanghaiTime(f.GenesisTime, params.BeaconConfig())
-		gen.Config.CancunTime = interop.GethCancunTime(f.GenesisTime, params.BeaconConfig())
-		gen.Config.PragueTime = interop.GethPragueTime(f.GenesisTime, params.BeaconConfig())
-
-		fields := logrus.Fields{}
-		if gen.Config.ShanghaiTime != nil {
-			fields["shanghai"] = fmt.Sprintf("%d", *gen.Config.ShanghaiTime)
-		}
-		if gen.Config.CancunTime != nil {
-			fields["cancun"] = fmt.Sprintf("%d", *gen.Config.CancunTime)
-		}
-		if gen.Config.PragueTime != nil {
-			fields["prague"] = fmt.Sprintf("%d", *gen.Config.PragueTime)
-		}
-		log.WithFields(fields).Info("Setting fork geth times")
 		if v > version.Altair {
 			// set ttd to zero so EL goes post-merge immediately
 			gen.Config.TerminalTotalDifficulty = big.NewInt(0)
@@ -339,6 +319,40 @@ func generateGenesis(ctx context.Context) (state.BeaconState, error) {
 	return genesisState, err
 }
 
+// readGethGenesisJson reads a json representation of Geth's core.Genesis, setting the genesis and fork timestamps
+// from the genesis time and the fork epochs of the chain config.
+func readGethGenesisJson(path string, genesisTime uint64) (*core.Genesis, error) {
+	gbytes, err := os.ReadFile(path) // #nosec G304
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read %s", path)
+	}
+	gen := &core.Genesis{}
+	if err := json.Unmarshal(gbytes, gen); err != nil {
+		return nil, err
+	}
+	if gen.Config == nil {
+		return nil, errors.Errorf("no chain config in %s", path)
+	}
+	// set timestamps for genesis and shanghai fork
+	gen.Timestamp = genesisTime
+	gen.Config.ShanghaiTime = interop.GethShanghaiTime(genesisTime, params.BeaconConfig())
+	gen.Config.CancunTime = interop.GethCancunTime(genesisTime, params.BeaconConfig())
+	gen.Config.PragueTime = interop.GethPragueTime(genesisTime, params.BeaconConfig())
+
+	fields := logrus.Fields{}
+	if gen.Config.ShanghaiTime != nil {
+		fields["shanghai"] = fmt.Sprintf("%d", *gen.Config.ShanghaiTime)
+	}
+	if gen.Config.CancunTime != nil {
+		fields["cancun"] = fmt.Sprintf("%d", *gen.Config.CancunTime)
+	}
+	if gen.Config.PragueTime != nil {
+		fields["prague"] = fmt.Sprintf("%d", *gen.Config.PragueTime)
+	}
+	log.WithFields(fields).Info("Setting fork geth times")
+	return gen, nil
+}
+
 func depositEntriesFromJSON(enc []byte) ([][]byte, []*ethpb.Deposit_Data, error) {
 	var depositJSON []*depositDataJSON
 	if err := json.Unmarshal(enc, &depositJSON); err != nil {
@@ -0,0 +1,152 @@
+package testnet
+
+import (
+	"encoding/hex"
+	"math/big"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/runtime/interop"
+	"github.com/prysmaticlabs/prysm/v5/testing/mockengine"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	mockELFlags = struct {
+		Host              string
+		Port              int
+		JwtSecretFile     string
+		ChainConfigFile   string
+		GenesisTime       uint64
+		GethGenesisJsonIn string
+		BlobsPerBlock     int
+		ExecutionRequests bool
+	}{}
+	mockELCmd = &cli.Command{
+		Name:  "mock-el",
+		Usage: "Serve the engine API with a deterministic mock execution engine, to run a local beacon chain without an execution client",
+		Description: "The execution chain starts at the genesis block of `generate-genesis` for the same chain config, genesis time and " +
+			"geth genesis json. Payloads are built deterministically and transactions are never executed.",
+		Action: func(cliCtx *cli.Context) error {
+			if err := cliActionMockEL(cliCtx); err != nil {
+				log.WithError(err).Fatal("Could not run mock execution engine")
+			}
+			return nil
+		},
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "host",
+				Destination: &mockELFlags.Host,
+				Usage:       "Host to serve the engine API on",
+				Value:       "127.0.0.1",
+			},
+			&cli.IntFlag{
+				Name:        "port",
+				Destination: &mockELFlags.Port,
+				Usage:       "Port to serve the engine API on",
+				Value:       8551,
+			},
+			&cli.StringFlag{
+				Name:        "jwt-secret",
+				Destination: &mockELFlags.JwtSecretFile,
+				Usage:       "Path to a file containing the hex encoded JWT secret shared with the beacon node. If unset, requests are not authenticated",
+			},
+			&cli.StringFlag{
+				Name:        "chain-config-file",
+				Destination: &mockELFlags.ChainConfigFile,
+				Usage:       "The path to a YAML file with chain config values",
+			},
+			&cli.Uint64Flag{
+				Name:        "genesis-time",
+				Destination: &mockELFlags.GenesisTime,
+				Usage:       "Unix timestamp seconds of the genesis state, including any genesis time delay",
+				Required:    true,
+			},
+			&cli.StringFlag{
+				Name:        "geth-genesis-json-in",
+				Destination: &mockELFlags.GethGenesisJsonIn,
+				Usage:       "Path to the \"genesis.json\" file the genesis state was generated with, if any",
+			},
+			&cli.IntFlag{
+				Name:        "blobs-per-block",
+				Destination: &mockELFlags.BlobsPerBlock,
+				Usage:       "Number of blobs in the payloads built after Cancun, up to the maximum number of blobs per block",
+			},
+			&cli.BoolFlag{
+				Name:        "execution-requests",
+				Destination: &mockELFlags.ExecutionRequests,
+				Usage:       "Add a deposit, a withdrawal and a consolidation request, for unknown validators, to the payloads built after Prague",
+			},
+		},
+	}
+)
+
+func cliActionMockEL(cliCtx *cli.Context) error {
+	f := &mockELFlags
+	if f.ChainConfigFile != "" {
+		if err := params.LoadChainConfigFile(f.ChainConfigFile, nil); err != nil {
+			return errors.Wrap(err, "could not load chain config")
+		}
+	}
+	gen, err := mockELGenesis(f.GenesisTime, f.GethGenesisJsonIn)
+	if err != nil {
+		return err
+	}
+	opts := []mockengine.Option{
+		mockengine.WithHost(f.Host),
+		mockengine.WithPort(f.Port),
+		mockengine.WithGenesis(gen),
+		mockengine.WithBlobsPerBlock(f.BlobsPerBlock),
+	}
+	if f.ExecutionRequests {
+		opts = append(opts, mockengine.WithExecutionRequests())
+	}
+	if f.JwtSecretFile != "" {
+		secret, err := readJwtSecret(f.JwtSecretFile)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, mockengine.WithJwtSecret(secret))
+	} else {
+		log.Warn("No JWT secret was provided, requests are not authenticated")
+	}
+	srv, err := mockengine.New(opts...)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := signal.NotifyContext(cliCtx.Context, os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+	return srv.Start(ctx)
+}
+
+// mockELGenesis returns the execution genesis of the beacon chain genesis state created by generate-genesis. The
+// chain ID of the default genesis is the deposit chain ID of the chain config, which the beacon node checks.
+func mockELGenesis(genesisTime uint64, gethGenesisJsonIn string) (*core.Genesis, error) {
+	if gethGenesisJsonIn != "" {
+		return readGethGenesisJson(gethGenesisJsonIn, genesisTime)
+	}
+	gen := interop.GethTestnetGenesis(genesisTime, params.BeaconConfig())
+	gen.Config.ChainID = new(big.Int).SetUint64(params.BeaconConfig().DepositChainID)
+	return gen, nil
+}
+
+func readJwtSecret(path string) ([]byte, error) {
+	enc, err := file.ReadFileAsBytes(path)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(string(enc)), "0x"))
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not decode JWT secret in %s", path)
+	}
+	if len(secret) < 32 {
+		return nil, errors.New("JWT secret should be a hex string of at least 32 bytes")
+	}
+	return secret, nil
+}
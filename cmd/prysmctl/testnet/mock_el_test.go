@@ -0,0 +1,38 @@
+package testnet
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/runtime/interop"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func Test_mockELGenesis(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.DepositChainID = 32382
+	params.OverrideBeaconConfig(cfg)
+
+	gen, err := mockELGenesis(1700000000, "")
+	require.NoError(t, err)
+	// The genesis block is the one of the genesis state generated by generate-genesis.
+	require.Equal(t, interop.GethTestnetGenesis(1700000000, cfg).ToBlock().Hash(), gen.ToBlock().Hash())
+	require.Equal(t, uint64(32382), gen.Config.ChainID.Uint64())
+}
+
+func Test_readJwtSecret(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "jwt.hex")
+	require.NoError(t, os.WriteFile(path, []byte("0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f\n"), 0600))
+	secret, err := readJwtSecret(path)
+	require.NoError(t, err)
+	require.Equal(t, 32, len(secret))
+	require.Equal(t, byte(0x1f), secret[31])
+
+	require.NoError(t, os.WriteFile(path, []byte("0x0001"), 0600))
+	_, err = readJwtSecret(path)
+	require.ErrorContains(t, "at least 32 bytes", err)
+}
@@ -123,6 +123,52 @@ var Commands = []*cli.Command{
 					return nil
 				},
 			},
+			{
+				Name:  "withdrawal-request",
+				Usage: "Create the transaction payload of an execution layer triggered partial withdrawal or full exit (EIP-7002), validated against the head state of the beacon node.",
+				Flags: []cli.Flag{
+					BeaconHostFlag,
+					ValidatorFlag,
+					AmountFlag,
+					SourceAddressFlag,
+					FeeFlag,
+					ExecutionEndpointFlag,
+					RequestOutputFlag,
+					cmd.ConfigFileFlag,
+				},
+				Before: func(cliCtx *cli.Context) error {
+					return cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags)
+				},
+				Action: func(cliCtx *cli.Context) error {
+					if err := withdrawalRequest(cliCtx); err != nil {
+						log.WithError(err).Fatal("Could not create withdrawal request")
+					}
+					return nil
+				},
+			},
+			{
+				Name:  "consolidation-request",
+				Usage: "Create the transaction payload of an execution layer triggered consolidation (EIP-7251), validated against the head state of the beacon node.",
+				Flags: []cli.Flag{
+					BeaconHostFlag,
+					SourceValidatorFlag,
+					TargetValidatorFlag,
+					SourceAddressFlag,
+					FeeFlag,
+					ExecutionEndpointFlag,
+					RequestOutputFlag,
+					cmd.ConfigFileFlag,
+				},
+				Before: func(cliCtx *cli.Context) error {
+					return cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags)
+				},
+				Action: func(cliCtx *cli.Context) error {
+					if err := consolidationRequest(cliCtx); err != nil {
+						log.WithError(err).Fatal("Could not create consolidation request")
+					}
+					return nil
+				},
+			},
 			{
 				Name:    "proposer-settings",
 				Aliases: []string{"ps"},
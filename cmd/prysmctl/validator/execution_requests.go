@@ -0,0 +1,458 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"path/filepath"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/client/beacon"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/electra"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/ssz/detect"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// Addresses of the EIP-7002 and EIP-7251 system contracts receiving execution layer requests.
+var (
+	withdrawalRequestContract    = common.HexToAddress("0x00000961Ef480Eb55e80D19ad83579A64c007002")
+	consolidationRequestContract = common.HexToAddress("0x0000BBdDc7CE488642fb579F8B00f3a590007251")
+)
+
+var (
+	ValidatorFlag = &cli.StringFlag{
+		Name:  "validator",
+		Usage: "public key or index of the validator to withdraw from or exit",
+	}
+
+	AmountFlag = &cli.Uint64Flag{
+		Name:  "amount",
+		Usage: "amount in Gwei to withdraw, 0 requests a full exit of the validator",
+		Value: 0,
+	}
+
+	SourceValidatorFlag = &cli.StringFlag{
+		Name:  "source-validator",
+		Usage: "public key or index of the validator to consolidate from",
+	}
+
+	TargetValidatorFlag = &cli.StringFlag{
+		Name:  "target-validator",
+		Usage: "public key or index of the validator to consolidate into, use the source validator to switch it to compounding withdrawal credentials",
+	}
+
+	SourceAddressFlag = &cli.StringFlag{
+		Name:  "source-address",
+		Usage: "execution address sending the request, defaults to the address in the withdrawal credentials of the validator",
+	}
+
+	FeeFlag = &cli.StringFlag{
+		Name:  "fee",
+		Usage: "fee in wei attached to the request, required unless --execution-endpoint is set. Any excess is not refunded",
+	}
+
+	ExecutionEndpointFlag = &cli.StringFlag{
+		Name:  "execution-endpoint",
+		Usage: "execution client JSON-RPC endpoint to read the current request fee from, used when --fee is not set",
+	}
+
+	RequestOutputFlag = &cli.StringFlag{
+		Name:  "output-path",
+		Usage: "path to write the transaction payload JSON to, the payload is printed when not set",
+	}
+)
+
+// executionRequestTx is an unsigned transaction sending an execution layer request to its system contract.
+type executionRequestTx struct {
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Value string `json:"value"`
+	Data  string `json:"data"`
+}
+
+func withdrawalRequest(c *cli.Context) error {
+	ctx, span := trace.StartSpan(c.Context, "validator.withdrawalRequest")
+	defer span.End()
+	if !c.IsSet(ValidatorFlag.Name) {
+		return fmt.Errorf("no --%s flag value was provided", ValidatorFlag.Name)
+	}
+	st, err := headState(ctx, c.String(BeaconHostFlag.Name))
+	if err != nil {
+		return err
+	}
+	idx, err := validatorIndex(st, c.String(ValidatorFlag.Name))
+	if err != nil {
+		return err
+	}
+	source, err := sourceAddress(c, st, idx)
+	if err != nil {
+		return err
+	}
+	amount := c.Uint64(AmountFlag.Name)
+	exitEpoch, err := validateWithdrawalRequest(st, idx, source, amount)
+	if err != nil {
+		return errors.Wrap(err, "withdrawal request would be ignored")
+	}
+	fields := log.Fields{
+		"validatorIndex":    idx,
+		"exitQueueEpoch":    exitEpoch,
+		"withdrawableEpoch": exitEpoch + params.BeaconConfig().MinValidatorWithdrawabilityDelay,
+	}
+	if amount == params.BeaconConfig().FullExitRequestAmount {
+		log.WithFields(fields).Info("Validator can be exited")
+	} else {
+		log.WithFields(fields).Info("Partial withdrawal can be processed")
+	}
+
+	v, err := st.ValidatorAtIndexReadOnly(idx)
+	if err != nil {
+		return err
+	}
+	pubkey := v.PublicKey()
+	data := make([]byte, 0, fieldparams.BLSPubkeyLength+8)
+	data = append(data, pubkey[:]...)
+	data = binary.BigEndian.AppendUint64(data, amount)
+	return writeExecutionRequestTx(c, source, withdrawalRequestContract, data)
+}
+
+func consolidationRequest(c *cli.Context) error {
+	ctx, span := trace.StartSpan(c.Context, "validator.consolidationRequest")
+	defer span.End()
+	if !c.IsSet(SourceValidatorFlag.Name) {
+		return fmt.Errorf("no --%s flag value was provided", SourceValidatorFlag.Name)
+	}
+	if !c.IsSet(TargetValidatorFlag.Name) {
+		return fmt.Errorf("no --%s flag value was provided", TargetValidatorFlag.Name)
+	}
+	st, err := headState(ctx, c.String(BeaconHostFlag.Name))
+	if err != nil {
+		return err
+	}
+	sourceIdx, err := validatorIndex(st, c.String(SourceValidatorFlag.Name))
+	if err != nil {
+		return err
+	}
+	targetIdx, err := validatorIndex(st, c.String(TargetValidatorFlag.Name))
+	if err != nil {
+		return err
+	}
+	source, err := sourceAddress(c, st, sourceIdx)
+	if err != nil {
+		return err
+	}
+	if sourceIdx == targetIdx {
+		if err := validateSwitchToCompoundingRequest(st, sourceIdx, source); err != nil {
+			return errors.Wrap(err, "switch to compounding request would be ignored")
+		}
+		log.WithField("validatorIndex", sourceIdx).Info("Validator can be switched to compounding withdrawal credentials")
+	} else {
+		exitEpoch, err := validateConsolidationRequest(ctx, st, sourceIdx, targetIdx, source)
+		if err != nil {
+			return errors.Wrap(err, "consolidation request would be ignored")
+		}
+		log.WithFields(log.Fields{
+			"sourceIndex":       sourceIdx,
+			"targetIndex":       targetIdx,
+			"exitEpoch":         exitEpoch,
+			"withdrawableEpoch": exitEpoch + params.BeaconConfig().MinValidatorWithdrawabilityDelay,
+		}).Info("Consolidation can be processed")
+	}
+
+	sourceV, err := st.ValidatorAtIndexReadOnly(sourceIdx)
+	if err != nil {
+		return err
+	}
+	targetV, err := st.ValidatorAtIndexReadOnly(targetIdx)
+	if err != nil {
+		return err
+	}
+	sourcePubkey, targetPubkey := sourceV.PublicKey(), targetV.PublicKey()
+	data := make([]byte, 0, 2*fieldparams.BLSPubkeyLength)
+	data = append(data, sourcePubkey[:]...)
+	data = append(data, targetPubkey[:]...)
+	return writeExecutionRequestTx(c, source, consolidationRequestContract, data)
+}
+
+// headState downloads the head state of the beacon node, which must be at or after the Electra fork.
+func headState(ctx context.Context, host string) (state.BeaconState, error) {
+	client, err := beacon.NewClient(host)
+	if err != nil {
+		return nil, err
+	}
+	log.Info("Downloading head state from beacon node...")
+	b, err := client.GetState(ctx, beacon.IdHead)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve head state")
+	}
+	vu, err := detect.FromState(b)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not detect version of head state")
+	}
+	st, err := vu.UnmarshalBeaconState(b)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal head state")
+	}
+	if st.Version() < version.Electra {
+		return nil, errors.New("execution layer requests are only available after the Electra/Prague hard fork")
+	}
+	return st, nil
+}
+
+// validatorIndex resolves a validator given by public key or index.
+func validatorIndex(st state.ReadOnlyBeaconState, id string) (primitives.ValidatorIndex, error) {
+	if b, err := hexutil.Decode(id); err == nil {
+		if len(b) != fieldparams.BLSPubkeyLength {
+			return 0, fmt.Errorf("public key %s has length %d, expected %d", id, len(b), fieldparams.BLSPubkeyLength)
+		}
+		idx, ok := st.ValidatorIndexByPubkey([fieldparams.BLSPubkeyLength]byte(b))
+		if !ok {
+			return 0, fmt.Errorf("validator with public key %s not found", id)
+		}
+		return idx, nil
+	}
+	i, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s is neither a validator public key nor an index", id)
+	}
+	if i >= uint64(st.NumValidators()) {
+		return 0, fmt.Errorf("validator with index %d not found", i)
+	}
+	return primitives.ValidatorIndex(i), nil
+}
+
+// sourceAddress returns the address set with the source address flag, or the address in the
+// withdrawal credentials of the validator.
+func sourceAddress(c *cli.Context, st state.ReadOnlyBeaconState, idx primitives.ValidatorIndex) (common.Address, error) {
+	if c.IsSet(SourceAddressFlag.Name) {
+		a := c.String(SourceAddressFlag.Name)
+		if !common.IsHexAddress(a) {
+			return common.Address{}, fmt.Errorf("%s is not a valid execution address", a)
+		}
+		return common.HexToAddress(a), nil
+	}
+	v, err := st.ValidatorAtIndexReadOnly(idx)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if !v.HasExecutionWithdrawalCredentials() {
+		return common.Address{}, fmt.Errorf("validator %d does not have execution withdrawal credentials", idx)
+	}
+	return common.BytesToAddress(v.GetWithdrawalCredentials()[12:]), nil
+}
+
+// validateWithdrawalRequest checks that a withdrawal request is not ignored by process_withdrawal_request
+// and returns the exit queue epoch it is processed in.
+func validateWithdrawalRequest(st state.BeaconState, idx primitives.ValidatorIndex, source common.Address, amount uint64) (primitives.Epoch, error) {
+	cfg := params.BeaconConfig()
+	isFullExitRequest := amount == cfg.FullExitRequestAmount
+	if n, err := st.NumPendingPartialWithdrawals(); err != nil {
+		return 0, err
+	} else if n >= cfg.PendingPartialWithdrawalsLimit && !isFullExitRequest {
+		return 0, errors.New("pending partial withdrawals queue is full")
+	}
+	v, err := st.ValidatorAtIndexReadOnly(idx)
+	if err != nil {
+		return 0, err
+	}
+	if err := validateRequestSource(v, idx, source); err != nil {
+		return 0, err
+	}
+	currentEpoch := slots.ToEpoch(st.Slot())
+	if err := validateActiveAndNotExiting(v, idx, currentEpoch); err != nil {
+		return 0, err
+	}
+	if currentEpoch < v.ActivationEpoch().AddEpoch(cfg.ShardCommitteePeriod) {
+		return 0, fmt.Errorf("validator %d has not been active for %d epochs", idx, cfg.ShardCommitteePeriod)
+	}
+	pendingBalanceToWithdraw, err := st.PendingBalanceToWithdraw(idx)
+	if err != nil {
+		return 0, err
+	}
+
+	// The churn is consumed on a copy, the downloaded state stays untouched.
+	st = st.Copy()
+	if isFullExitRequest {
+		if pendingBalanceToWithdraw != 0 {
+			return 0, fmt.Errorf("validator %d has %d Gwei of pending partial withdrawals", idx, pendingBalanceToWithdraw)
+		}
+		return st.ExitEpochAndUpdateChurn(primitives.Gwei(v.EffectiveBalance()))
+	}
+	if !v.HasCompoundingWithdrawalCredentials() {
+		return 0, fmt.Errorf("validator %d does not have compounding withdrawal credentials required for partial withdrawals", idx)
+	}
+	if v.EffectiveBalance() < cfg.MinActivationBalance {
+		return 0, fmt.Errorf("validator %d has an effective balance below %d Gwei", idx, cfg.MinActivationBalance)
+	}
+	balance, err := st.BalanceAtIndex(idx)
+	if err != nil {
+		return 0, err
+	}
+	if balance <= cfg.MinActivationBalance+pendingBalanceToWithdraw {
+		return 0, fmt.Errorf("validator %d has no balance in excess of %d Gwei and pending partial withdrawals", idx, cfg.MinActivationBalance)
+	}
+	toWithdraw := min(balance-cfg.MinActivationBalance-pendingBalanceToWithdraw, amount)
+	if toWithdraw < amount {
+		log.WithField("amount", toWithdraw).Warn("Requested amount exceeds the excess balance of the validator, only the excess balance is withdrawn")
+	}
+	return st.ExitEpochAndUpdateChurn(primitives.Gwei(toWithdraw))
+}
+
+// validateSwitchToCompoundingRequest checks that a consolidation request with equal source and target
+// switches the validator to compounding withdrawal credentials.
+func validateSwitchToCompoundingRequest(st state.ReadOnlyBeaconState, idx primitives.ValidatorIndex, source common.Address) error {
+	v, err := st.ValidatorAtIndexReadOnly(idx)
+	if err != nil {
+		return err
+	}
+	if err := validateRequestSource(v, idx, source); err != nil {
+		return err
+	}
+	if !v.HasETH1WithdrawalCredentials() {
+		return fmt.Errorf("validator %d does not have 0x01 withdrawal credentials", idx)
+	}
+	return validateActiveAndNotExiting(v, idx, slots.ToEpoch(st.Slot()))
+}
+
+// validateConsolidationRequest checks that a consolidation request is not ignored by process_consolidation_request
+// and returns the exit epoch of the source validator.
+func validateConsolidationRequest(ctx context.Context, st state.BeaconState, sourceIdx, targetIdx primitives.ValidatorIndex, source common.Address) (primitives.Epoch, error) {
+	cfg := params.BeaconConfig()
+	if n, err := st.NumPendingConsolidations(); err != nil {
+		return 0, err
+	} else if n >= cfg.PendingConsolidationsLimit {
+		return 0, errors.New("pending consolidations queue is full")
+	}
+	activeBalance, err := helpers.TotalActiveBalance(st)
+	if err != nil {
+		return 0, err
+	}
+	if helpers.ConsolidationChurnLimit(primitives.Gwei(activeBalance)) <= primitives.Gwei(cfg.MinActivationBalance) {
+		return 0, errors.New("consolidation churn limit is too low to process consolidations")
+	}
+	sourceV, err := st.ValidatorAtIndexReadOnly(sourceIdx)
+	if err != nil {
+		return 0, err
+	}
+	targetV, err := st.ValidatorAtIndexReadOnly(targetIdx)
+	if err != nil {
+		return 0, err
+	}
+	if err := validateRequestSource(sourceV, sourceIdx, source); err != nil {
+		return 0, err
+	}
+	if !targetV.HasCompoundingWithdrawalCredentials() {
+		return 0, fmt.Errorf("target validator %d does not have compounding withdrawal credentials", targetIdx)
+	}
+	currentEpoch := slots.ToEpoch(st.Slot())
+	if err := validateActiveAndNotExiting(sourceV, sourceIdx, currentEpoch); err != nil {
+		return 0, err
+	}
+	if err := validateActiveAndNotExiting(targetV, targetIdx, currentEpoch); err != nil {
+		return 0, err
+	}
+	if currentEpoch < sourceV.ActivationEpoch().AddEpoch(cfg.ShardCommitteePeriod) {
+		return 0, fmt.Errorf("validator %d has not been active for %d epochs", sourceIdx, cfg.ShardCommitteePeriod)
+	}
+	pendingBalanceToWithdraw, err := st.PendingBalanceToWithdraw(sourceIdx)
+	if err != nil {
+		return 0, err
+	}
+	if pendingBalanceToWithdraw > 0 {
+		return 0, fmt.Errorf("validator %d has %d Gwei of pending partial withdrawals", sourceIdx, pendingBalanceToWithdraw)
+	}
+	// The churn is consumed on a copy, the downloaded state stays untouched.
+	return electra.ComputeConsolidationEpochAndUpdateChurn(ctx, st.Copy(), primitives.Gwei(sourceV.EffectiveBalance()))
+}
+
+func validateRequestSource(v state.ReadOnlyValidator, idx primitives.ValidatorIndex, source common.Address) error {
+	if !v.HasExecutionWithdrawalCredentials() {
+		return fmt.Errorf("validator %d does not have execution withdrawal credentials", idx)
+	}
+	if !bytes.Equal(v.GetWithdrawalCredentials()[12:], source.Bytes()) {
+		return fmt.Errorf("source address %s does not match the withdrawal credentials of validator %d", source.Hex(), idx)
+	}
+	return nil
+}
+
+func validateActiveAndNotExiting(v state.ReadOnlyValidator, idx primitives.ValidatorIndex, currentEpoch primitives.Epoch) error {
+	if !helpers.IsActiveValidatorUsingTrie(v, currentEpoch) {
+		return fmt.Errorf("validator %d is not active", idx)
+	}
+	if v.ExitEpoch() != params.BeaconConfig().FarFutureEpoch {
+		return fmt.Errorf("validator %d has already initiated an exit", idx)
+	}
+	return nil
+}
+
+// requestFee returns the fee set with the fee flag, or reads the current fee of the system contract
+// from the execution client.
+func requestFee(c *cli.Context, contract common.Address) (*big.Int, error) {
+	if c.IsSet(FeeFlag.Name) {
+		fee, ok := new(big.Int).SetString(c.String(FeeFlag.Name), 10)
+		if !ok || fee.Sign() < 0 {
+			return nil, fmt.Errorf("%s is not a valid fee", c.String(FeeFlag.Name))
+		}
+		return fee, nil
+	}
+	if !c.IsSet(ExecutionEndpointFlag.Name) {
+		return nil, fmt.Errorf("no --%s or --%s flag value was provided", FeeFlag.Name, ExecutionEndpointFlag.Name)
+	}
+	endpoint := c.String(ExecutionEndpointFlag.Name)
+	client, err := ethclient.DialContext(c.Context, endpoint)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not dial execution client at %s", endpoint)
+	}
+	defer client.Close()
+	// The system contract returns its current fee when called without input data.
+	b, err := client.CallContract(c.Context, ethereum.CallMsg{To: &contract}, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read the current fee of the system contract")
+	}
+	fee := new(big.Int).SetBytes(b)
+	log.WithField("fee", fee).Info("Read the current request fee from the system contract, it can increase before the transaction is included")
+	return fee, nil
+}
+
+func writeExecutionRequestTx(c *cli.Context, from, to common.Address, data []byte) error {
+	fee, err := requestFee(c, to)
+	if err != nil {
+		return err
+	}
+	b, err := json.MarshalIndent(&executionRequestTx{
+		From:  from.Hex(),
+		To:    to.Hex(),
+		Value: hexutil.EncodeBig(fee),
+		Data:  hexutil.Encode(data),
+	}, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "could not marshal transaction payload")
+	}
+	if !c.IsSet(RequestOutputFlag.Name) {
+		fmt.Println(string(b))
+		return nil
+	}
+	p := filepath.Clean(c.String(RequestOutputFlag.Name))
+	if err := file.WriteFile(p, b); err != nil {
+		return errors.Wrap(err, "could not write transaction payload")
+	}
+	log.Infof("Successfully wrote transaction payload to %s", p)
+	return nil
+}
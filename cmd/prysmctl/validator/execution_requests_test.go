@@ -0,0 +1,249 @@
+package validator
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"github.com/urfave/cli/v2"
+)
+
+var requestSourceAddress = common.HexToAddress("0x0000000000000000000000000000000000001234")
+
+// executionRequestsState returns an Electra state old enough for exits in which validator 0 has
+// 0x01 credentials, validators 1 and 2 have 0x02 credentials and the others have BLS credentials.
+func executionRequestsState(t *testing.T) state.BeaconState {
+	cfg := params.BeaconConfig()
+	st, _ := util.DeterministicGenesisStateElectra(t, 64)
+	require.NoError(t, st.SetSlot(primitives.Slot(uint64(cfg.ShardCommitteePeriod+1)*uint64(cfg.SlotsPerEpoch))))
+	require.NoError(t, st.SetFork(&ethpb.Fork{
+		PreviousVersion: cfg.DenebForkVersion,
+		CurrentVersion:  cfg.ElectraForkVersion,
+	}))
+	for idx, prefix := range []byte{cfg.ETH1AddressWithdrawalPrefixByte, cfg.CompoundingWithdrawalPrefixByte, cfg.CompoundingWithdrawalPrefixByte} {
+		v, err := st.ValidatorAtIndex(primitives.ValidatorIndex(idx))
+		require.NoError(t, err)
+		v.WithdrawalCredentials = append([]byte{prefix}, make([]byte, 11)...)
+		v.WithdrawalCredentials = append(v.WithdrawalCredentials, requestSourceAddress.Bytes()...)
+		require.NoError(t, st.UpdateValidatorAtIndex(primitives.ValidatorIndex(idx), v))
+	}
+	require.NoError(t, st.UpdateBalancesAtIndex(1, cfg.MinActivationBalance+1_000_000_000))
+	return st
+}
+
+func TestValidateWithdrawalRequest(t *testing.T) {
+	cfg := params.BeaconConfig()
+	tests := []struct {
+		name    string
+		mutate  func(t *testing.T, st state.BeaconState)
+		idx     primitives.ValidatorIndex
+		source  common.Address
+		amount  uint64
+		wantErr string
+	}{
+		{
+			name:   "full exit",
+			idx:    0,
+			source: requestSourceAddress,
+			amount: cfg.FullExitRequestAmount,
+		},
+		{
+			name:   "partial withdrawal",
+			idx:    1,
+			source: requestSourceAddress,
+			amount: 500_000_000,
+		},
+		{
+			name:    "partial withdrawal without compounding credentials",
+			idx:     0,
+			source:  requestSourceAddress,
+			amount:  500_000_000,
+			wantErr: "validator 0 does not have compounding withdrawal credentials",
+		},
+		{
+			name:    "partial withdrawal without excess balance",
+			idx:     2,
+			source:  requestSourceAddress,
+			amount:  500_000_000,
+			wantErr: "validator 2 has no balance in excess",
+		},
+		{
+			name:    "BLS credentials",
+			idx:     3,
+			source:  requestSourceAddress,
+			amount:  cfg.FullExitRequestAmount,
+			wantErr: "validator 3 does not have execution withdrawal credentials",
+		},
+		{
+			name:    "wrong source address",
+			idx:     0,
+			source:  common.HexToAddress("0x01"),
+			amount:  cfg.FullExitRequestAmount,
+			wantErr: "does not match the withdrawal credentials of validator 0",
+		},
+		{
+			name: "already exiting",
+			mutate: func(t *testing.T, st state.BeaconState) {
+				v, err := st.ValidatorAtIndex(0)
+				require.NoError(t, err)
+				v.ExitEpoch = 1000
+				require.NoError(t, st.UpdateValidatorAtIndex(0, v))
+			},
+			idx:     0,
+			source:  requestSourceAddress,
+			amount:  cfg.FullExitRequestAmount,
+			wantErr: "validator 0 has already initiated an exit",
+		},
+		{
+			name: "full exit with pending partial withdrawals",
+			mutate: func(t *testing.T, st state.BeaconState) {
+				require.NoError(t, st.AppendPendingPartialWithdrawal(&ethpb.PendingPartialWithdrawal{Index: 1, Amount: 1}))
+			},
+			idx:     1,
+			source:  requestSourceAddress,
+			amount:  cfg.FullExitRequestAmount,
+			wantErr: "validator 1 has 1 Gwei of pending partial withdrawals",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := executionRequestsState(t)
+			if tt.mutate != nil {
+				tt.mutate(t, st)
+			}
+			epoch, err := validateWithdrawalRequest(st, tt.idx, tt.source, tt.amount)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, true, epoch > primitives.Epoch(cfg.ShardCommitteePeriod))
+			n, err := st.NumPendingPartialWithdrawals()
+			require.NoError(t, err)
+			assert.Equal(t, uint64(0), n, "state must not be modified")
+		})
+	}
+}
+
+func TestValidateConsolidationRequest(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	// Lower the activation and exit churn so that the small test state has consolidation churn left.
+	cfg.MaxPerEpochActivationExitChurnLimit = 64_000_000_000
+	params.OverrideBeaconConfig(cfg)
+
+	st := executionRequestsState(t)
+	_, err := validateConsolidationRequest(context.Background(), st, 0, 1, requestSourceAddress)
+	require.NoError(t, err)
+	_, err = validateConsolidationRequest(context.Background(), st, 1, 3, requestSourceAddress)
+	require.ErrorContains(t, "target validator 3 does not have compounding withdrawal credentials", err)
+	_, err = validateConsolidationRequest(context.Background(), st, 3, 1, requestSourceAddress)
+	require.ErrorContains(t, "validator 3 does not have execution withdrawal credentials", err)
+
+	require.NoError(t, validateSwitchToCompoundingRequest(st, 0, requestSourceAddress))
+	require.ErrorContains(t, "validator 1 does not have 0x01 withdrawal credentials", validateSwitchToCompoundingRequest(st, 1, requestSourceAddress))
+}
+
+func TestValidateConsolidationRequest_NoChurn(t *testing.T) {
+	st := executionRequestsState(t)
+	_, err := validateConsolidationRequest(context.Background(), st, 0, 1, requestSourceAddress)
+	require.ErrorContains(t, "consolidation churn limit is too low", err)
+}
+
+func TestWithdrawalRequest(t *testing.T) {
+	st := executionRequestsState(t)
+	b, err := st.MarshalSSZ()
+	require.NoError(t, err)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/eth/v2/debug/beacon/states/head", r.URL.Path)
+		w.Header().Set("Content-Type", "application/octet-stream")
+		_, err := w.Write(b)
+		require.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	output := filepath.Join(t.TempDir(), "request.json")
+	set := flag.NewFlagSet("test", 0)
+	set.String(BeaconHostFlag.Name, srv.URL, "")
+	set.String(ValidatorFlag.Name, "1", "")
+	set.Uint64(AmountFlag.Name, 500_000_000, "")
+	set.String(FeeFlag.Name, "2", "")
+	set.String(RequestOutputFlag.Name, output, "")
+	require.NoError(t, set.Set(ValidatorFlag.Name, "1"))
+	require.NoError(t, set.Set(FeeFlag.Name, "2"))
+	require.NoError(t, set.Set(RequestOutputFlag.Name, output))
+	cliCtx := cli.NewContext(&cli.App{}, set, nil)
+	require.NoError(t, withdrawalRequest(cliCtx))
+
+	content, err := os.ReadFile(output)
+	require.NoError(t, err)
+	tx := &executionRequestTx{}
+	require.NoError(t, json.Unmarshal(content, tx))
+	v, err := st.ValidatorAtIndexReadOnly(1)
+	require.NoError(t, err)
+	pubkey := v.PublicKey()
+	assert.Equal(t, requestSourceAddress.Hex(), tx.From)
+	assert.Equal(t, withdrawalRequestContract.Hex(), tx.To)
+	assert.Equal(t, "0x2", tx.Value)
+	assert.Equal(t, hexutil.Encode(append(pubkey[:], 0, 0, 0, 0, 0x1d, 0xcd, 0x65, 0x00)), tx.Data)
+}
+
+func TestRequestFee(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := struct {
+			ID     json.RawMessage   `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.Equal(t, "eth_call", req.Method)
+		call := struct {
+			To common.Address `json:"to"`
+		}{}
+		require.NoError(t, json.Unmarshal(req.Params[0], &call))
+		require.Equal(t, withdrawalRequestContract, call.To)
+		w.Header().Set("Content-Type", "application/json")
+		_, err := fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":"0x%064x"}`, req.ID, 3)
+		require.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	t.Run("fee flag", func(t *testing.T) {
+		set := flag.NewFlagSet("test", 0)
+		set.String(FeeFlag.Name, "", "")
+		set.String(ExecutionEndpointFlag.Name, srv.URL, "")
+		require.NoError(t, set.Set(FeeFlag.Name, "2"))
+		require.NoError(t, set.Set(ExecutionEndpointFlag.Name, srv.URL))
+		fee, err := requestFee(cli.NewContext(&cli.App{}, set, nil), withdrawalRequestContract)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), fee.Int64())
+	})
+	t.Run("execution endpoint", func(t *testing.T) {
+		set := flag.NewFlagSet("test", 0)
+		set.String(ExecutionEndpointFlag.Name, srv.URL, "")
+		require.NoError(t, set.Set(ExecutionEndpointFlag.Name, srv.URL))
+		fee, err := requestFee(cli.NewContext(&cli.App{}, set, nil), withdrawalRequestContract)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), fee.Int64())
+	})
+	t.Run("no fee source", func(t *testing.T) {
+		set := flag.NewFlagSet("test", 0)
+		_, err := requestFee(cli.NewContext(&cli.App{}, set, nil), withdrawalRequestContract)
+		require.ErrorContains(t, "no --fee or --execution-endpoint flag value was provided", err)
+	})
+}
@@ -115,6 +115,43 @@ var Commands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name: "generate-deposits",
+			Description: "derives validating keys from a mnemonic starting at a given index, writes them as EIP-2335 " +
+				"keystore.json files and writes their deposit data, with execution address or compounding withdrawal " +
+				"credentials, into a deposit_data.json file. Deposit signatures are verified against the selected network",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.MnemonicFileFlag,
+				flags.MnemonicLanguageFlag,
+				flags.Mnemonic25thWordFileFlag,
+				flags.MnemonicStartIndexFlag,
+				flags.NumAccountsFlag,
+				flags.DepositsDirFlag,
+				flags.KeystoresPasswordFileFlag,
+				flags.WithdrawalAddressFlag,
+				flags.CompoundingWithdrawalsFlag,
+				flags.DepositAmountsGweiFlag,
+				features.Mainnet,
+				features.SepoliaTestnet,
+				features.HoleskyTestnet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				if err := tos.VerifyTosAcceptedOrPrompt(cliCtx); err != nil {
+					return err
+				}
+				return features.ConfigureValidator(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := accountsGenerateDeposits(cliCtx); err != nil {
+					log.WithError(err).Fatal("Could not generate deposits")
+				}
+				return nil
+			},
+		},
 		{
 			Name:        "import",
 			Description: `imports Ethereum validator accounts stored in EIP-2335 keystore.json files from an external directory`,
@@ -0,0 +1,122 @@
+package accounts
+
+import (
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/io/prompt"
+	"github.com/prysmaticlabs/prysm/v5/validator/accounts"
+	"github.com/prysmaticlabs/prysm/v5/validator/accounts/userprompt"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	depositsDirPromptText = "Enter the directory where keystores and deposit data will be written to"
+	// #nosec G101 -- Not sensitive data
+	mnemonicPromptText = "Enter the seed phrase to generate validating keys from"
+)
+
+func accountsGenerateDeposits(c *cli.Context) error {
+	mnemonic, err := inputMnemonic(c)
+	if err != nil {
+		return errors.Wrap(err, "could not get mnemonic phrase")
+	}
+	amounts, err := depositAmounts(c)
+	if err != nil {
+		return err
+	}
+	opts := []accounts.Option{
+		accounts.WithMnemonic(mnemonic),
+		accounts.WithStartIndex(c.Int(flags.MnemonicStartIndexFlag.Name)),
+		accounts.WithNumAccounts(c.Int(flags.NumAccountsFlag.Name)),
+		accounts.WithCompoundingWithdrawals(c.Bool(flags.CompoundingWithdrawalsFlag.Name)),
+		accounts.WithDepositAmounts(amounts),
+	}
+	if c.IsSet(flags.MnemonicLanguageFlag.Name) {
+		opts = append(opts, accounts.WithMnemonicLanguage(c.String(flags.MnemonicLanguageFlag.Name)))
+	}
+	if c.IsSet(flags.Mnemonic25thWordFileFlag.Name) {
+		mnemonicPassphrase, err := prompt.InputPassword(
+			c,
+			flags.Mnemonic25thWordFileFlag,
+			"", "", false,
+			func(input string) error {
+				if strings.TrimSpace(input) == "" {
+					return errors.New("input cannot be empty")
+				}
+				return nil
+			},
+		)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, accounts.WithMnemonic25thWord(mnemonicPassphrase))
+	}
+
+	withdrawalAddress := c.String(flags.WithdrawalAddressFlag.Name)
+	if !common.IsHexAddress(withdrawalAddress) {
+		return errors.Errorf("--%s must be a valid execution address, got %q", flags.WithdrawalAddressFlag.Name, withdrawalAddress)
+	}
+	opts = append(opts, accounts.WithWithdrawalAddress(common.HexToAddress(withdrawalAddress)))
+
+	depositsDir, err := userprompt.InputDirectory(c, depositsDirPromptText, flags.DepositsDirFlag)
+	if err != nil {
+		return errors.Wrap(err, "could not parse deposits directory")
+	}
+	keystoresPassword, err := prompt.InputPassword(
+		c,
+		flags.KeystoresPasswordFileFlag,
+		"Enter a new password for your generated keystores",
+		"Confirm new password",
+		true,
+		prompt.ValidatePasswordInput,
+	)
+	if err != nil {
+		return errors.Wrap(err, "could not determine password for generated keystores")
+	}
+	opts = append(opts, accounts.WithDepositsDir(depositsDir))
+	opts = append(opts, accounts.WithKeystoresPassword(keystoresPassword))
+
+	acc, err := accounts.NewCLIManager(opts...)
+	if err != nil {
+		return err
+	}
+	return acc.GenerateDeposits(c.Context)
+}
+
+func inputMnemonic(c *cli.Context) (string, error) {
+	if c.IsSet(flags.MnemonicFileFlag.Name) {
+		mnemonicFilePath, err := file.ExpandPath(c.String(flags.MnemonicFileFlag.Name))
+		if err != nil {
+			return "", errors.Wrap(err, "could not determine absolute path of mnemonic file")
+		}
+		data, err := os.ReadFile(mnemonicFilePath) // #nosec G304 -- ReadFile is safe
+		if err != nil {
+			return "", err
+		}
+		mnemonic := strings.TrimSpace(string(data))
+		if err := accounts.ValidateMnemonic(mnemonic); err != nil {
+			return "", errors.Wrap(err, "mnemonic phrase did not pass validation")
+		}
+		return mnemonic, nil
+	}
+	return prompt.ValidatePrompt(os.Stdin, mnemonicPromptText, accounts.ValidateMnemonic)
+}
+
+func depositAmounts(c *cli.Context) ([]uint64, error) {
+	values := c.StringSlice(flags.DepositAmountsGweiFlag.Name)
+	amounts := make([]uint64, 0, len(values))
+	for _, v := range values {
+		amount, err := strconv.ParseUint(strings.TrimSpace(v), 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse deposit amount %q", v)
+		}
+		amounts = append(amounts, amount)
+	}
+	return amounts, nil
+}
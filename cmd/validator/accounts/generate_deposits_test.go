@@ -0,0 +1,77 @@
+package accounts
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/accounts"
+	constant "github.com/prysmaticlabs/prysm/v5/validator/testing"
+	"github.com/urfave/cli/v2"
+)
+
+func setupGenerateDepositsCtx(t *testing.T, depositsDir, withdrawalAddress string, amounts string) *cli.Context {
+	dir := t.TempDir()
+	mnemonicFile := filepath.Join(dir, "mnemonic.txt")
+	require.NoError(t, os.WriteFile(mnemonicFile, []byte(constant.TestMnemonic+"\n"), params.BeaconIoConfig().ReadWritePermissions))
+	passwordFile := filepath.Join(dir, "password.txt")
+	require.NoError(t, os.WriteFile(passwordFile, []byte("Passw0rdz4938%%"), params.BeaconIoConfig().ReadWritePermissions))
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(flags.MnemonicFileFlag.Name, mnemonicFile, "")
+	set.Int(flags.MnemonicStartIndexFlag.Name, 5, "")
+	set.Int(flags.NumAccountsFlag.Name, 2, "")
+	set.String(flags.DepositsDirFlag.Name, depositsDir, "")
+	set.String(flags.KeystoresPasswordFileFlag.Name, passwordFile, "")
+	set.String(flags.WithdrawalAddressFlag.Name, withdrawalAddress, "")
+	set.Bool(flags.CompoundingWithdrawalsFlag.Name, true, "")
+	set.Var(cli.NewStringSlice(), flags.DepositAmountsGweiFlag.Name, "")
+	require.NoError(t, set.Set(flags.MnemonicFileFlag.Name, mnemonicFile))
+	require.NoError(t, set.Set(flags.MnemonicStartIndexFlag.Name, "5"))
+	require.NoError(t, set.Set(flags.NumAccountsFlag.Name, "2"))
+	require.NoError(t, set.Set(flags.DepositsDirFlag.Name, depositsDir))
+	require.NoError(t, set.Set(flags.KeystoresPasswordFileFlag.Name, passwordFile))
+	require.NoError(t, set.Set(flags.WithdrawalAddressFlag.Name, withdrawalAddress))
+	require.NoError(t, set.Set(flags.CompoundingWithdrawalsFlag.Name, "true"))
+	require.NoError(t, set.Set(flags.DepositAmountsGweiFlag.Name, amounts))
+	return cli.NewContext(&app, set, nil)
+}
+
+func TestGenerateDeposits_Noninteractive(t *testing.T) {
+	depositsDir := filepath.Join(t.TempDir(), "validator_keys")
+	amount := params.BeaconConfig().MaxEffectiveBalanceElectra
+	cliCtx := setupGenerateDepositsCtx(t, depositsDir, "0x8ba1f109551bD432803012645Ac136ddd64DBA72", strconv.FormatUint(amount, 10))
+	require.NoError(t, accountsGenerateDeposits(cliCtx))
+
+	keystores, err := filepath.Glob(filepath.Join(depositsDir, accounts.KeystoreFilePrefix+"m_12381_3600_*_0_0-*.json"))
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(keystores))
+	depositData, err := filepath.Glob(filepath.Join(depositsDir, accounts.DepositDataFilePrefix+"*.json"))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(depositData))
+	encoded, err := os.ReadFile(depositData[0])
+	require.NoError(t, err)
+	var deposits []*accounts.DepositDataJSON
+	require.NoError(t, json.Unmarshal(encoded, &deposits))
+	require.Equal(t, 2, len(deposits))
+	for _, d := range deposits {
+		assert.Equal(t, amount, d.Amount)
+		assert.Equal(t, "020000000000000000000000"+"8ba1f109551bd432803012645ac136ddd64dba72", d.WithdrawalCredentials)
+	}
+}
+
+func TestGenerateDeposits_InvalidFlags(t *testing.T) {
+	cliCtx := setupGenerateDepositsCtx(t, t.TempDir(), "0x1234", "32000000000")
+	assert.ErrorContains(t, "must be a valid execution address", accountsGenerateDeposits(cliCtx))
+
+	cliCtx = setupGenerateDepositsCtx(t, t.TempDir(), "0x8ba1f109551bD432803012645Ac136ddd64DBA72", "32 ETH")
+	assert.ErrorContains(t, "could not parse deposit amount", accountsGenerateDeposits(cliCtx))
+}
@@ -2,6 +2,7 @@ package db
 
 import (
 	"github.com/prysmaticlabs/prysm/v5/cmd"
+	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
 	"github.com/prysmaticlabs/prysm/v5/runtime/tos"
 	validatordb "github.com/prysmaticlabs/prysm/v5/validator/db"
 	"github.com/sirupsen/logrus"
@@ -82,6 +83,46 @@ var Commands = &cli.Command{
 				},
 			},
 		},
+		{
+			Name:     "verify",
+			Category: "db",
+			Usage:    "Verifies the integrity of the slashing protection of the validator database",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				flags.SlashingProtectionJSONFileFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				dataDir := cliCtx.String(cmd.DataDirFlag.Name)
+				interchangeFilePath := cliCtx.String(flags.SlashingProtectionJSONFileFlag.Name)
+
+				if err := validatordb.VerifyDatabase(cliCtx.Context, dataDir, interchangeFilePath, false); err != nil {
+					log.WithError(err).Fatal("Could not verify database")
+				}
+
+				return nil
+			},
+		},
+		{
+			Name:     "repair",
+			Category: "db",
+			Usage:    "Raises the slashing protection watermarks of the validator database to protect against the anomalies found by verify",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				flags.SlashingProtectionJSONFileFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				dataDir := cliCtx.String(cmd.DataDirFlag.Name)
+				interchangeFilePath := cliCtx.String(flags.SlashingProtectionJSONFileFlag.Name)
+
+				if err := validatordb.VerifyDatabase(cliCtx.Context, dataDir, interchangeFilePath, true); err != nil {
+					log.WithError(err).Fatal("Could not repair database")
+				}
+
+				return nil
+			},
+		},
 		{
 			Name:     "convert-complete-to-minimal",
 			Category: "db",
@@ -42,6 +42,13 @@ var (
 		Usage: "Beacon node REST API provider endpoint.",
 		Value: "http://127.0.0.1:3500",
 	}
+	// ParallelBeaconNodesFlag sends duties to all beacon nodes of the REST API provider at once.
+	ParallelBeaconNodesFlag = &cli.BoolFlag{
+		Name: "parallel-beacon-nodes",
+		Usage: `Keeps connections to every beacon node listed in --beacon-rest-api-provider at once. Attestation data
+		is taken from the fastest node agreeing with a majority of nodes, and signed attestations, aggregates,
+		sync committee messages and blocks are broadcast to all nodes. Requires --enable-beacon-rest-api.`,
+	}
 	// CertFlag defines a flag for the node's TLS certificate.
 	CertFlag = &cli.StringFlag{
 		Name:  "tls-cert",
@@ -83,8 +90,9 @@ var (
 	}
 	// GraffitiFlag defines the graffiti value included in proposed blocks
 	GraffitiFlag = &cli.StringFlag{
-		Name:  "graffiti",
-		Usage: "String to include in proposed blocks.",
+		Name: "graffiti",
+		Usage: "String to include in proposed blocks. It may contain the {client_version}, {el_code}, {el_version}, {el_commit}, " +
+			"{cl_code}, {cl_version}, {cl_commit}, {index}, {slot} and {label} placeholders.",
 	}
 	// GRPCRetriesFlag defines the number of times to retry a failed gRPC request.
 	GRPCRetriesFlag = &cli.UintFlag{
@@ -250,6 +258,40 @@ var (
 		Usage: "Path to a directory where accounts will be backed up into a zip file.",
 		Value: DefaultValidatorDir(),
 	}
+	// MnemonicStartIndexFlag defines the index of the first key to derive from a mnemonic.
+	MnemonicStartIndexFlag = &cli.IntFlag{
+		Name:  "mnemonic-start-index",
+		Usage: "Index of the first validating key to derive from the mnemonic.",
+		Value: 0,
+	}
+	// DepositsDirFlag defines the path of the directory keystores and deposit data are generated into.
+	DepositsDirFlag = &cli.StringFlag{
+		Name:  "deposits-dir",
+		Usage: "Path to a directory where keystores and deposit data will be written.",
+		Value: filepath.Join(DefaultValidatorDir(), "validator_keys"),
+	}
+	// KeystoresPasswordFileFlag for encrypting the keystores generated from a mnemonic.
+	KeystoresPasswordFileFlag = &cli.StringFlag{
+		Name:  "keystores-password-file",
+		Usage: "Path to a plain-text, .txt file containing the desired password for the generated keystores.",
+	}
+	// WithdrawalAddressFlag defines the execution address of the withdrawal credentials of deposits.
+	WithdrawalAddressFlag = &cli.StringFlag{
+		Name:  "withdrawal-address",
+		Usage: "Execution address (0x-prefixed hex string) deposits will withdraw to.",
+	}
+	// CompoundingWithdrawalsFlag uses compounding withdrawal credentials for deposits.
+	CompoundingWithdrawalsFlag = &cli.BoolFlag{
+		Name: "compounding-withdrawals",
+		Usage: "Uses compounding (0x02) withdrawal credentials instead of execution address (0x01) withdrawal " +
+			"credentials, allowing deposits of up to 2048 ETH per validator.",
+	}
+	// DepositAmountsGweiFlag defines the amounts of deposits, either one for all keys or one per key.
+	DepositAmountsGweiFlag = &cli.StringSliceFlag{
+		Name: "deposit-amounts-gwei",
+		Usage: "Amounts of deposits in Gwei, either a single amount for all keys or a comma-separated list " +
+			"with one amount per key. Defaults to 32 ETH.",
+	}
 	// SlashingProtectionJSONFileFlag is used to enter the file path of the slashing protection JSON.
 	SlashingProtectionJSONFileFlag = &cli.StringFlag{
 		Name:  "slashing-protection-json-file",
@@ -309,6 +351,23 @@ var (
 		Aliases: []string{"remote-signer-keys-file"},
 	}
 
+	// Web3SignerKeyDiscoveryIntervalFlag defines how often to discover the keys held by web3signer.
+	// example:--validators-external-signer-key-discovery-interval=1m
+	Web3SignerKeyDiscoveryIntervalFlag = &cli.DurationFlag{
+		Name: "validators-external-signer-key-discovery-interval",
+		Usage: "Interval at which to discover the keys held by web3signer, adding and removing validator keys as they change on web3signer. " +
+			"Keys are fetched from --validators-external-signer-public-keys if it is a URL, or from the public keys API of web3signer otherwise. Disabled by default.",
+	}
+
+	// ThresholdSignerConfigFlag defines the path to the configuration of a threshold signer, signing with key shares
+	// of distributed validators together with the other validator clients of the cluster.
+	// example:--threshold-signer-config=./path/to/threshold.json
+	ThresholdSignerConfigFlag = &cli.StringFlag{
+		Name:  "threshold-signer-config",
+		Usage: "A file path to the configuration of a threshold signer, used to sign with key shares of distributed validators together with the other validator clients of the cluster.",
+		Value: "",
+	}
+
 	// KeymanagerKindFlag defines the kind of keymanager desired by a user during wallet creation.
 	KeymanagerKindFlag = &cli.StringFlag{
 		Name:  "keymanager-kind",
@@ -383,6 +442,13 @@ var (
 		Usage: "Sets the maximum size for one batch of validator registrations. Use a non-positive value to disable batching.",
 		Value: 0,
 	}
+	// DoppelGangerEpochsFlag sets the number of epochs a validating key must be seen offline before its duties are performed.
+	DoppelGangerEpochsFlag = &cli.Uint64Flag{
+		Name: "doppelganger-epochs",
+		Usage: "Sets the number of epochs a validating key must be seen offline by the doppelganger check " +
+			"before its duties are performed. Requires --enable-doppelganger.",
+		Value: params.BeaconConfig().DefaultDoppelGangerEpochs,
+	}
 	// EnableDistributed enables the usage of prysm validator client in a Distributed Validator Cluster.
 	EnableDistributed = &cli.BoolFlag{
 		Name:  "distributed",
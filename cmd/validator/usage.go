@@ -106,6 +106,7 @@ var appHelpFlagGroups = []flagGroup{
 			flags.HTTPServerCorsDomain,
 			flags.GRPCHeadersFlag,
 			flags.BeaconRESTApiProviderFlag,
+			flags.ParallelBeaconNodesFlag,
 		},
 	},
 	{
@@ -117,6 +118,7 @@ var appHelpFlagGroups = []flagGroup{
 			flags.EnableBuilderFlag,
 			flags.BuilderGasLimitFlag,
 			flags.ValidatorsRegistrationBatchSizeFlag,
+			flags.DoppelGangerEpochsFlag,
 			flags.GraffitiFlag,
 			flags.GraffitiFileFlag,
 		},
@@ -127,6 +129,8 @@ var appHelpFlagGroups = []flagGroup{
 			flags.Web3SignerURLFlag,
 			flags.Web3SignerPublicValidatorKeysFlag,
 			flags.Web3SignerKeyFileFlag,
+			flags.Web3SignerKeyDiscoveryIntervalFlag,
+			flags.ThresholdSignerConfigFlag,
 		},
 	},
 	{
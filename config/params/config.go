@@ -154,6 +154,7 @@ type BeaconChainConfig struct {
 
 	// Slashing protection constants.
 	SlashingProtectionPruningEpochs primitives.Epoch // SlashingProtectionPruningEpochs defines a period after which all prior epochs are pruned in the validator database.
+	DefaultDoppelGangerEpochs       uint64           // DefaultDoppelGangerEpochs defines the number of epochs a validating key has to be seen offline before the validator client performs its duties.
 
 	// Fork-related values.
 	GenesisForkVersion   []byte           `yaml:"GENESIS_FORK_VERSION" spec:"true"`   // GenesisForkVersion is used to track fork version between state transitions.
@@ -266,6 +267,7 @@ type BeaconChainConfig struct {
 	MinEpochsForDataColumnSidecarsRequest primitives.Epoch `yaml:"MIN_EPOCHS_FOR_DATA_COLUMN_SIDECARS_REQUESTS"` // MinEpochsForDataColumnSidecarsRequest is the minimum number of epochs the node will keep the data columns for.
 	MaxCellsInExtendedMatrix              uint64           `yaml:"MAX_CELLS_IN_EXTENDED_MATRIX" spec:"true"`     // MaxCellsInExtendedMatrix is the full data of one-dimensional erasure coding extended blobs (in row major format).
 	NumberOfColumns                       uint64           `yaml:"NUMBER_OF_COLUMNS" spec:"true"`                // NumberOfColumns in the extended data matrix.
+	NumberOfCustodyGroups                 uint64           `yaml:"NUMBER_OF_CUSTODY_GROUPS" spec:"true"`         // NumberOfCustodyGroups is the number of custody groups the columns are split into.
 
 	// Networking Specific Parameters
 	GossipMaxSize                   uint64          `yaml:"GOSSIP_MAX_SIZE" spec:"true"`                    // GossipMaxSize is the maximum allowed size of uncompressed gossip messages.
@@ -412,3 +414,8 @@ func PeerDASEnabled() bool {
 func WithinDAPeriod(block, current primitives.Epoch) bool {
 	return block+BeaconConfig().MinEpochsForBlobsSidecarsRequest >= current
 }
+
+// WithinDataColumnDAPeriod checks if the block epoch is within MIN_EPOCHS_FOR_DATA_COLUMN_SIDECARS_REQUESTS of the given current epoch.
+func WithinDataColumnDAPeriod(block, current primitives.Epoch) bool {
+	return block+BeaconConfig().MinEpochsForDataColumnSidecarsRequest >= current
+}
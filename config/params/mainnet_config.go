@@ -37,6 +37,7 @@ var mainnetNetworkConfig = &NetworkConfig{
 	ETH2Key:                    "eth2",
 	AttSubnetKey:               "attnets",
 	SyncCommsSubnetKey:         "syncnets",
+	CustodyGroupCountKey:       "cgc",
 	MinimumPeersInSubnetSearch: 20,
 	ContractDeploymentBlock:    11184524, // Note: contract was deployed in block 11052984 but no transactions were sent until 11184524.
 	BootstrapNodes: []string{
@@ -202,6 +203,7 @@ var mainnetBeaconConfig = &BeaconChainConfig{
 	WeakSubjectivityPeriod:          54000,
 	PruneSlasherStoragePeriod:       10,
 	SlashingProtectionPruningEpochs: 512,
+	DefaultDoppelGangerEpochs:       2,
 
 	// Weak subjectivity values.
 	SafetyDecay: 10,
@@ -302,6 +304,7 @@ var mainnetBeaconConfig = &BeaconChainConfig{
 
 	// PeerDAS
 	NumberOfColumns:                       128,
+	NumberOfCustodyGroups:                 128,
 	MaxCellsInExtendedMatrix:              768,
 	SamplesPerSlot:                        8,
 	CustodyRequirement:                    4,
@@ -125,6 +125,46 @@ func TestProposerSettingsLoader(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "builder boost factor from file",
+			args: args{
+				proposerSettingsFlagValues: &proposerSettingsFlag{
+					dir:        "./testdata/builder-boost-factor-settings.json",
+					url:        "",
+					defaultfee: "",
+				},
+			},
+			want: func() *proposer.Settings {
+				key1, err := hexutil.Decode("0xa057816155ad77931185101128655c0191bd0214c201ca48ed887f6c4c6adf334070efcd75140eada5ac83a92506dd7a")
+				require.NoError(t, err)
+				localOnly := validator.Uint64(0)
+				defaultFactor := validator.Uint64(90)
+				return &proposer.Settings{
+					ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*proposer.Option{
+						bytesutil.ToBytes48(key1): {
+							FeeRecipientConfig: &proposer.FeeRecipientConfig{
+								FeeRecipient: common.HexToAddress("0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3"),
+							},
+							BuilderConfig: &proposer.BuilderConfig{
+								Enabled:            true,
+								GasLimit:           validator.Uint64(30000000),
+								BuilderBoostFactor: &localOnly,
+							},
+						},
+					},
+					DefaultConfig: &proposer.Option{
+						FeeRecipientConfig: &proposer.FeeRecipientConfig{
+							FeeRecipient: common.HexToAddress("0x6e35733c5af9B61374A128e6F85f553aF09ff89A"),
+						},
+						BuilderConfig: &proposer.BuilderConfig{
+							Enabled:            true,
+							GasLimit:           validator.Uint64(40000000),
+							BuilderBoostFactor: &defaultFactor,
+						},
+					},
+				}
+			},
+		},
 		{
 			name: "db settings override file settings if file default config is missing",
 			args: args{
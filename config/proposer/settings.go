@@ -78,10 +78,13 @@ func verifyOption(key string, option *validatorpb.ProposerOptionPayload) error {
 
 // BuilderConfig is the struct representation of the JSON config file set in the validator through the CLI.
 // GasLimit is a number set to help the network decide on the maximum gas in each block.
+// BuilderBoostFactor is a percentage multiplier applied to the builder payload value when the beacon node
+// compares it with the local payload; 0 always selects the local payload and when unset the beacon node default is used.
 type BuilderConfig struct {
-	Enabled  bool             `json:"enabled" yaml:"enabled"`
-	GasLimit validator.Uint64 `json:"gas_limit,omitempty" yaml:"gas_limit,omitempty"`
-	Relays   []string         `json:"relays,omitempty" yaml:"relays,omitempty"`
+	Enabled            bool              `json:"enabled" yaml:"enabled"`
+	GasLimit           validator.Uint64  `json:"gas_limit,omitempty" yaml:"gas_limit,omitempty"`
+	Relays             []string          `json:"relays,omitempty" yaml:"relays,omitempty"`
+	BuilderBoostFactor *validator.Uint64 `json:"builder_boost_factor,omitempty" yaml:"builder_boost_factor,omitempty"`
 }
 
 // BuilderConfigFromConsensus converts protobuf to a builder config used in in-memory storage
@@ -93,6 +96,10 @@ func BuilderConfigFromConsensus(from *validatorpb.BuilderConfig) *BuilderConfig
 		Enabled:  from.Enabled,
 		GasLimit: from.GasLimit,
 	}
+	if from.BuilderBoostFactor != nil {
+		factor := *from.BuilderBoostFactor
+		c.BuilderBoostFactor = &factor
+	}
 	if from.Relays != nil {
 		relays := make([]string, len(from.Relays))
 		copy(relays, from.Relays)
@@ -224,6 +231,10 @@ func (bc *BuilderConfig) Clone() *BuilderConfig {
 	c := &BuilderConfig{}
 	c.Enabled = bc.Enabled
 	c.GasLimit = bc.GasLimit
+	if bc.BuilderBoostFactor != nil {
+		factor := *bc.BuilderBoostFactor
+		c.BuilderBoostFactor = &factor
+	}
 	var relays []string
 	if bc.Relays != nil {
 		relays = make([]string, len(bc.Relays))
@@ -255,5 +266,9 @@ func (bc *BuilderConfig) ToConsensus() *validatorpb.BuilderConfig {
 		c.Relays = relays
 	}
 	c.GasLimit = bc.GasLimit
+	if bc.BuilderBoostFactor != nil {
+		factor := *bc.BuilderBoostFactor
+		c.BuilderBoostFactor = &factor
+	}
 	return c
 }
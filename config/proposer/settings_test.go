@@ -16,6 +16,7 @@ func Test_Proposer_Setting_Cloning(t *testing.T) {
 	key1hex := "0xa057816155ad77931185101128655c0191bd0214c201ca48ed887f6c4c6adf334070efcd75140eada5ac83a92506dd7a"
 	key1, err := hexutil.Decode(key1hex)
 	require.NoError(t, err)
+	boostFactor := validator.Uint64(50)
 	settings := &Settings{
 		ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*Option{
 			bytesutil.ToBytes48(key1): {
@@ -23,9 +24,10 @@ func Test_Proposer_Setting_Cloning(t *testing.T) {
 					FeeRecipient: common.HexToAddress("0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3"),
 				},
 				BuilderConfig: &BuilderConfig{
-					Enabled:  true,
-					GasLimit: validator.Uint64(40000000),
-					Relays:   []string{"https://example-relay.com"},
+					Enabled:            true,
+					GasLimit:           validator.Uint64(40000000),
+					Relays:             []string{"https://example-relay.com"},
+					BuilderBoostFactor: &boostFactor,
 				},
 			},
 		},
@@ -66,6 +68,22 @@ func Test_Proposer_Setting_Cloning(t *testing.T) {
 		require.Equal(t, config.Enabled, clone.Enabled)
 		require.Equal(t, config.GasLimit, clone.GasLimit)
 	})
+	t.Run("Builder boost factor", func(t *testing.T) {
+		option, ok := settings.ProposeConfig[bytesutil.ToBytes48(key1)]
+		require.Equal(t, true, ok)
+		clone := option.BuilderConfig.Clone()
+		require.DeepEqual(t, option.BuilderConfig.BuilderBoostFactor, clone.BuilderBoostFactor)
+		*clone.BuilderBoostFactor = 100
+		require.Equal(t, validator.Uint64(50), *option.BuilderConfig.BuilderBoostFactor)
+
+		payload := option.BuilderConfig.ToConsensus()
+		require.Equal(t, validator.Uint64(50), payload.GetBuilderBoostFactor())
+		config := BuilderConfigFromConsensus(payload)
+		require.DeepEqual(t, option.BuilderConfig.BuilderBoostFactor, config.BuilderBoostFactor)
+
+		clone.BuilderBoostFactor = nil
+		require.Equal(t, true, clone.ToConsensus().BuilderBoostFactor == nil)
+	})
 	t.Run("To Payload and SettingFromConsensus", func(t *testing.T) {
 		payload := settings.ToConsensus()
 		option, ok := settings.ProposeConfig[bytesutil.ToBytes48(key1)]
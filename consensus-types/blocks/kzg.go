@@ -47,6 +47,59 @@ func VerifyKZGInclusionProof(blob ROBlob) error {
 	return nil
 }
 
+// VerifyKZGCommitmentsInclusionProof verifies the Merkle proof in a data column sidecar
+// of the inclusion of its KZG commitment list against the beacon block body root.
+func VerifyKZGCommitmentsInclusionProof(dc RODataColumn) error {
+	if dc.SignedBlockHeader == nil || dc.SignedBlockHeader.Header == nil {
+		return errNilBlockHeader
+	}
+	root := dc.SignedBlockHeader.Header.BodyRoot
+	if len(root) != field_params.RootLength {
+		return errInvalidBodyRoot
+	}
+	leaf, err := kzgCommitmentsRoot(dc.KzgCommitments)
+	if err != nil {
+		return err
+	}
+	verified := trie.VerifyMerkleProof(root, leaf[:], kzgPosition, dc.KzgCommitmentsInclusionProof)
+	if !verified {
+		return errInvalidInclusionProof
+	}
+	return nil
+}
+
+// MerkleProofKZGCommitments constructs a Merkle proof of inclusion of the KZG
+// commitment list into the Beacon Block with the given `body`.
+func MerkleProofKZGCommitments(body interfaces.ReadOnlyBeaconBlockBody) ([][]byte, error) {
+	if body.Version() < version.Deneb {
+		return nil, errUnsupportedBeaconBlockBody
+	}
+	membersRoots, err := topLevelRoots(body)
+	if err != nil {
+		return nil, err
+	}
+	sparse, err := trie.GenerateTrieFromItems(membersRoots, logBodyLength)
+	if err != nil {
+		return nil, err
+	}
+	proof, err := sparse.MerkleProof(kzgPosition)
+	if err != nil {
+		return nil, err
+	}
+	// sparse.MerkleProof always includes the length of the slice, which is
+	// not part of the body tree.
+	return proof[:len(proof)-1], nil
+}
+
+// kzgCommitmentsRoot computes the hash tree root of a KZG commitment list.
+func kzgCommitmentsRoot(commitments [][]byte) ([32]byte, error) {
+	sparse, err := trie.GenerateTrieFromItems(leavesFromCommitments(commitments), field_params.LogMaxBlobCommitments)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return sparse.HashTreeRoot()
+}
+
 // MerkleProofKZGCommitment constructs a Merkle proof of inclusion of the KZG
 // commitment of index `index` into the Beacon Block with the given `body`
 func MerkleProofKZGCommitment(body interfaces.ReadOnlyBeaconBlockBody, index int) ([][]byte, error) {
@@ -76,7 +76,7 @@ func Test_MerkleProofKZGCommitment(t *testing.T) {
 	require.NoError(t, err)
 
 	// Test the logic of topProof in MerkleProofKZGCommitment.
-	commitmentsRoot, err := getBlobKzgCommitmentsRoot(kzgs)
+	commitmentsRoot, err := kzgCommitmentsRoot(kzgs)
 	require.NoError(t, err)
 	bodyMembersRoots, err := topLevelRoots(body)
 	require.NoError(t, err, "Failed to get top level roots")
@@ -138,18 +138,6 @@ func ceilLog2(x uint32) (uint32, error) {
 	return y, nil
 }
 
-func getBlobKzgCommitmentsRoot(commitments [][]byte) ([32]byte, error) {
-	commitmentsLeaves := leavesFromCommitments(commitments)
-	commitmentsSparse, err := trie.GenerateTrieFromItems(
-		commitmentsLeaves,
-		fieldparams.LogMaxBlobCommitments,
-	)
-	if err != nil {
-		return [32]byte{}, err
-	}
-	return commitmentsSparse.HashTreeRoot()
-}
-
 func Benchmark_MerkleProofKZGCommitment(b *testing.B) {
 	kzgs := make([][]byte, 3)
 	kzgs[0] = make([]byte, 48)
@@ -259,3 +247,65 @@ func Test_VerifyKZGInclusionProof(t *testing.T) {
 	proof[2] = make([]byte, 32)
 	require.ErrorIs(t, errInvalidInclusionProof, VerifyKZGInclusionProof(blob))
 }
+
+func Test_VerifyKZGCommitmentsInclusionProof(t *testing.T) {
+	kzgs := make([][]byte, 2)
+	for i := range kzgs {
+		kzgs[i] = make([]byte, 48)
+		_, err := rand.Read(kzgs[i])
+		require.NoError(t, err)
+	}
+	pbBody := &ethpb.BeaconBlockBodyDeneb{
+		SyncAggregate: &ethpb.SyncAggregate{
+			SyncCommitteeBits:      make([]byte, fieldparams.SyncAggregateSyncCommitteeBytesLength),
+			SyncCommitteeSignature: make([]byte, fieldparams.BLSSignatureLength),
+		},
+		ExecutionPayload: &enginev1.ExecutionPayloadDeneb{
+			ParentHash:    make([]byte, fieldparams.RootLength),
+			FeeRecipient:  make([]byte, 20),
+			StateRoot:     make([]byte, fieldparams.RootLength),
+			ReceiptsRoot:  make([]byte, fieldparams.RootLength),
+			LogsBloom:     make([]byte, 256),
+			PrevRandao:    make([]byte, fieldparams.RootLength),
+			BaseFeePerGas: make([]byte, fieldparams.RootLength),
+			BlockHash:     make([]byte, fieldparams.RootLength),
+			Transactions:  make([][]byte, 0),
+			ExtraData:     make([]byte, 0),
+		},
+		Eth1Data: &ethpb.Eth1Data{
+			DepositRoot: make([]byte, fieldparams.RootLength),
+			BlockHash:   make([]byte, fieldparams.RootLength),
+		},
+		BlobKzgCommitments: kzgs,
+	}
+
+	body, err := NewBeaconBlockBody(pbBody)
+	require.NoError(t, err)
+	root, err := body.HashTreeRoot()
+	require.NoError(t, err)
+	proof, err := MerkleProofKZGCommitments(body)
+	require.NoError(t, err)
+	require.Equal(t, logBodyLength, len(proof))
+
+	sidecar := &ethpb.DataColumnSidecar{
+		KzgCommitments:               kzgs,
+		KzgCommitmentsInclusionProof: proof,
+		SignedBlockHeader: &ethpb.SignedBeaconBlockHeader{
+			Header: &ethpb.BeaconBlockHeader{
+				BodyRoot:   root[:],
+				ParentRoot: make([]byte, 32),
+				StateRoot:  make([]byte, 32),
+			},
+			Signature: make([]byte, fieldparams.BLSSignatureLength),
+		},
+	}
+	dc, err := NewRODataColumn(sidecar)
+	require.NoError(t, err)
+	require.NoError(t, VerifyKZGCommitmentsInclusionProof(dc))
+
+	sidecar.KzgCommitments = kzgs[:1]
+	require.ErrorIs(t, errInvalidInclusionProof, VerifyKZGCommitmentsInclusionProof(dc))
+	sidecar.KzgCommitments = kzgs
+	proof[1] = make([]byte, 32)
+	require.ErrorIs(t, errInvalidInclusionProof, VerifyKZGCommitmentsInclusionProof(dc))
+}
@@ -0,0 +1,79 @@
+package blocks
+
+import (
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestRODataColumnNilChecks(t *testing.T) {
+	cases := []struct {
+		name string
+		dc   *ethpb.DataColumnSidecar
+		err  error
+	}{
+		{
+			name: "nil sidecar",
+			err:  errNilDataColumn,
+		},
+		{
+			name: "nil signed block header",
+			dc:   &ethpb.DataColumnSidecar{},
+			err:  errNilBlockHeader,
+		},
+		{
+			name: "nil inner header",
+			dc:   &ethpb.DataColumnSidecar{SignedBlockHeader: &ethpb.SignedBeaconBlockHeader{}},
+			err:  errNilBlockHeader,
+		},
+		{
+			name: "missing signature",
+			dc: &ethpb.DataColumnSidecar{SignedBlockHeader: &ethpb.SignedBeaconBlockHeader{
+				Header: &ethpb.BeaconBlockHeader{},
+			}},
+			err: errMissingBlockSignature,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			_, err := NewRODataColumn(c.dc)
+			require.ErrorIs(t, err, c.err)
+			_, err = NewRODataColumnWithRoot(c.dc, [32]byte{'a'})
+			require.ErrorIs(t, err, c.err)
+		})
+	}
+}
+
+func TestRODataColumnAccessors(t *testing.T) {
+	header := &ethpb.BeaconBlockHeader{
+		Slot:          10,
+		ProposerIndex: 3,
+		ParentRoot:    bytesutil.PadTo([]byte("parent"), 32),
+		StateRoot:     make([]byte, 32),
+		BodyRoot:      bytesutil.PadTo([]byte("body"), 32),
+	}
+	dc, err := NewRODataColumn(&ethpb.DataColumnSidecar{
+		ColumnIndex: 7,
+		SignedBlockHeader: &ethpb.SignedBeaconBlockHeader{
+			Header:    header,
+			Signature: make([]byte, fieldparams.BLSSignatureLength),
+		},
+	})
+	require.NoError(t, err)
+	root, err := header.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, root, dc.BlockRoot())
+	require.DeepEqual(t, root[:], dc.BlockRootSlice())
+	require.Equal(t, header.Slot, dc.Slot())
+	require.Equal(t, header.ProposerIndex, dc.ProposerIndex())
+	require.Equal(t, bytesutil.ToBytes32(header.ParentRoot), dc.ParentRoot())
+	require.DeepEqual(t, header.ParentRoot, dc.ParentRootSlice())
+	require.Equal(t, bytesutil.ToBytes32(header.BodyRoot), dc.BodyRoot())
+
+	verified := NewVerifiedRODataColumn(dc)
+	require.Equal(t, uint64(7), verified.ColumnIndex)
+	require.Equal(t, 1, len(RODataColumnSlice{dc}.Protos()))
+}
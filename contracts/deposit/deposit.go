@@ -3,6 +3,7 @@
 package deposit
 
 import (
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
@@ -27,9 +28,18 @@ import (
 //
 // See: https://github.com/ethereum/consensus-specs/blob/master/specs/validator/0_beacon-chain-validator.md#submit-deposit
 func DepositInput(depositKey, withdrawalKey bls.SecretKey, amountInGwei uint64) (*ethpb.Deposit_Data, [32]byte, error) {
+	return DepositInputWithCredentials(depositKey, WithdrawalCredentialsHash(withdrawalKey), amountInGwei)
+}
+
+// DepositInputWithCredentials is the same as DepositInput, for any kind of withdrawal credentials
+// such as execution address (0x01) or compounding (0x02) withdrawal credentials.
+func DepositInputWithCredentials(depositKey bls.SecretKey, withdrawalCredentials []byte, amountInGwei uint64) (*ethpb.Deposit_Data, [32]byte, error) {
+	if len(withdrawalCredentials) != 32 {
+		return nil, [32]byte{}, errors.Errorf("withdrawal credentials must be 32 bytes, got %d", len(withdrawalCredentials))
+	}
 	depositMessage := &ethpb.DepositMessage{
 		PublicKey:             depositKey.PublicKey().Marshal(),
-		WithdrawalCredentials: WithdrawalCredentialsHash(withdrawalKey),
+		WithdrawalCredentials: withdrawalCredentials,
 		Amount:                amountInGwei,
 	}
 
@@ -79,6 +89,21 @@ func WithdrawalCredentialsHash(withdrawalKey bls.SecretKey) []byte {
 	return append([]byte{params.BeaconConfig().BLSWithdrawalPrefixByte}, h[1:]...)[:32]
 }
 
+// ExecutionAddressWithdrawalCredentials forms the withdrawal credentials of an execution address,
+// using the given prefix byte for execution address (0x01) or compounding (0x02) credentials.
+//
+// The specification is as follows:
+//
+//	withdrawal_credentials[:1] == prefix
+//	withdrawal_credentials[1:12] == b'\x00' * 11
+//	withdrawal_credentials[12:] == address
+func ExecutionAddressWithdrawalCredentials(prefix byte, address common.Address) []byte {
+	credentials := make([]byte, 32)
+	credentials[0] = prefix
+	copy(credentials[12:], address.Bytes())
+	return credentials
+}
+
 // VerifyDepositSignature verifies the correctness of Eth1 deposit BLS signature
 func VerifyDepositSignature(dd *ethpb.Deposit_Data, domain []byte) error {
 	ddCopy := dd.Copy()
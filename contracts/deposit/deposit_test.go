@@ -3,6 +3,7 @@ package deposit_test
 import (
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/contracts/deposit"
@@ -43,6 +44,33 @@ func TestDepositInput_GeneratesPb(t *testing.T) {
 	assert.Equal(t, true, sig.Verify(k1.PublicKey(), root[:]))
 }
 
+func TestDepositInputWithCredentials(t *testing.T) {
+	k, err := bls.RandKey()
+	require.NoError(t, err)
+	address := common.HexToAddress("0x8ba1f109551bD432803012645Ac136ddd64DBA72")
+	credentials := deposit.ExecutionAddressWithdrawalCredentials(params.BeaconConfig().CompoundingWithdrawalPrefixByte, address)
+	assert.Equal(t, 32, len(credentials))
+	assert.Equal(t, params.BeaconConfig().CompoundingWithdrawalPrefixByte, credentials[0])
+	assert.DeepEqual(t, make([]byte, 11), credentials[1:12])
+	assert.DeepEqual(t, address.Bytes(), credentials[12:])
+
+	amount := params.BeaconConfig().MaxEffectiveBalanceElectra
+	result, root, err := deposit.DepositInputWithCredentials(k, credentials, amount)
+	require.NoError(t, err)
+	assert.DeepEqual(t, credentials, result.WithdrawalCredentials)
+	assert.Equal(t, amount, result.Amount)
+	wantRoot, err := result.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, wantRoot, root)
+
+	domain, err := signing.ComputeDomain(params.BeaconConfig().DomainDeposit, nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, deposit.VerifyDepositSignature(result, domain))
+
+	_, _, err = deposit.DepositInputWithCredentials(k, credentials[1:], amount)
+	assert.ErrorContains(t, "withdrawal credentials must be 32 bytes", err)
+}
+
 func TestVerifyDepositSignature_ValidSig(t *testing.T) {
 	deposits, _, err := util.DeterministicDepositsAndKeys(1)
 	require.NoError(t, err)
@@ -80,3 +80,15 @@ func NewAggregateSignature() common.Signature {
 func RandKey() (common.SecretKey, error) {
 	return blst.RandKey()
 }
+
+// SplitSecretKey splits a secret key into total shares, any threshold of which can produce a signature
+// of the secret key with RecoverSignature. Shares are indexed from 1 to total.
+func SplitSecretKey(secretKey SecretKey, threshold, total uint64) (map[uint64]SecretKey, error) {
+	return blst.SplitSecretKey(secretKey, threshold, total)
+}
+
+// RecoverSignature combines threshold partial signatures, made by the secret key shares with the given
+// indices, into the signature of the shared secret key.
+func RecoverSignature(indices []uint64, partials []common.Signature) (common.Signature, error) {
+	return blst.RecoverSignature(indices, partials)
+}
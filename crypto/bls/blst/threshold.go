@@ -0,0 +1,129 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && !blst_disabled
+
+package blst
+
+import (
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls/common"
+	blst "github.com/supranational/blst/bindings/go"
+)
+
+// SplitSecretKey splits a secret key into total shares using Shamir's secret sharing, such that the
+// signatures of any threshold shares can be combined into a signature of the secret key with
+// RecoverSignature. Shares are identified by their index, starting at 1.
+func SplitSecretKey(secretKey common.SecretKey, threshold, total uint64) (map[uint64]common.SecretKey, error) {
+	sk, ok := secretKey.(*bls12SecretKey)
+	if !ok {
+		return nil, errors.New("unsupported secret key type")
+	}
+	if threshold == 0 || threshold > total {
+		return nil, errors.Errorf("threshold %d must be between 1 and the number of shares %d", threshold, total)
+	}
+
+	// The secret key is the constant term of a random polynomial of degree threshold-1.
+	coefficients := make([]*blst.Scalar, threshold)
+	coefficients[0] = sk.p
+	for i := uint64(1); i < threshold; i++ {
+		c, err := RandKey()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not generate polynomial coefficient")
+		}
+		coefficients[i] = c.(*bls12SecretKey).p
+	}
+
+	shares := make(map[uint64]common.SecretKey, total)
+	for index := uint64(1); index <= total; index++ {
+		x, err := scalarFromIndex(index)
+		if err != nil {
+			return nil, err
+		}
+		// Evaluate the polynomial at the share index with Horner's method.
+		share := *coefficients[threshold-1]
+		for i := int(threshold) - 2; i >= 0; i-- {
+			share.MulAssign(x)
+			share.AddAssign(coefficients[i])
+		}
+		if !share.Valid() {
+			return nil, common.ErrZeroKey
+		}
+		shares[index] = &bls12SecretKey{p: &share}
+	}
+	return shares, nil
+}
+
+// RecoverSignature combines the partial signatures made by the secret key shares with the given
+// indices into the signature of the shared secret key, using Lagrange interpolation at zero.
+// Exactly threshold partial signatures must be provided for the result to be valid.
+func RecoverSignature(indices []uint64, partials []common.Signature) (common.Signature, error) {
+	if len(indices) == 0 {
+		return nil, errors.New("no partial signatures provided")
+	}
+	if len(indices) != len(partials) {
+		return nil, errors.Errorf("got %d indices for %d partial signatures", len(indices), len(partials))
+	}
+
+	xs := make([]*blst.Scalar, len(indices))
+	seen := make(map[uint64]bool, len(indices))
+	for i, index := range indices {
+		if seen[index] {
+			return nil, errors.Errorf("duplicate share index %d", index)
+		}
+		seen[index] = true
+		x, err := scalarFromIndex(index)
+		if err != nil {
+			return nil, err
+		}
+		xs[i] = x
+	}
+
+	var combined *blst.P2
+	for i, partial := range partials {
+		sig, ok := partial.(*Signature)
+		if !ok {
+			return nil, errors.New("unsupported signature type")
+		}
+		// lambda_i = prod_{j != i} x_j / (x_j - x_i)
+		numerator, denominator := one(), one()
+		for j, x := range xs {
+			if i == j {
+				continue
+			}
+			numerator.MulAssign(x)
+			diff, _ := x.Sub(xs[i])
+			denominator.MulAssign(diff)
+		}
+		lambda, _ := numerator.Mul(denominator.Inverse())
+
+		var p blst.P2
+		p.FromAffine(sig.s)
+		p.MultAssign(lambda)
+		if combined == nil {
+			combined = &p
+		} else {
+			combined.AddAssign(&p)
+		}
+	}
+	return &Signature{s: combined.ToAffine()}, nil
+}
+
+// scalarFromIndex converts a share index to a scalar.
+func scalarFromIndex(index uint64) (*blst.Scalar, error) {
+	if index == 0 {
+		return nil, errors.New("share index must not be 0")
+	}
+	var b [32]byte
+	binary.BigEndian.PutUint64(b[24:], index)
+	x := new(blst.Scalar).Deserialize(b[:])
+	if x == nil {
+		return nil, errors.Errorf("could not convert share index %d to a scalar", index)
+	}
+	return x, nil
+}
+
+func one() *blst.Scalar {
+	var b [32]byte
+	b[31] = 1
+	return new(blst.Scalar).Deserialize(b[:])
+}
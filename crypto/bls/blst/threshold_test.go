@@ -0,0 +1,67 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && !blst_disabled
+
+package blst_test
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls/blst"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls/common"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestSplitSecretKey_RecoverSignature(t *testing.T) {
+	sk, err := blst.RandKey()
+	require.NoError(t, err)
+	msg := []byte("hello")
+	want := sk.Sign(msg).Marshal()
+
+	shares, err := blst.SplitSecretKey(sk, 3, 5)
+	require.NoError(t, err)
+	require.Equal(t, 5, len(shares))
+
+	for _, indices := range [][]uint64{{1, 2, 3}, {5, 1, 4}, {2, 3, 5}} {
+		partials := make([]common.Signature, len(indices))
+		for i, index := range indices {
+			partials[i] = shares[index].Sign(msg)
+		}
+		sig, err := blst.RecoverSignature(indices, partials)
+		require.NoError(t, err)
+		assert.DeepEqual(t, want, sig.Marshal())
+		assert.Equal(t, true, sig.Verify(sk.PublicKey(), msg))
+	}
+
+	// Fewer partial signatures than the threshold do not recover the signature.
+	sig, err := blst.RecoverSignature([]uint64{1, 2}, []common.Signature{shares[1].Sign(msg), shares[2].Sign(msg)})
+	require.NoError(t, err)
+	assert.Equal(t, false, sig.Verify(sk.PublicKey(), msg))
+}
+
+func TestSplitSecretKey_Errors(t *testing.T) {
+	sk, err := blst.RandKey()
+	require.NoError(t, err)
+	_, err = blst.SplitSecretKey(sk, 0, 3)
+	require.ErrorContains(t, "threshold 0 must be between 1 and the number of shares 3", err)
+	_, err = blst.SplitSecretKey(sk, 4, 3)
+	require.ErrorContains(t, "threshold 4 must be between 1 and the number of shares 3", err)
+
+	shares, err := blst.SplitSecretKey(sk, 1, 2)
+	require.NoError(t, err)
+	assert.DeepEqual(t, sk.Marshal(), shares[1].Marshal())
+}
+
+func TestRecoverSignature_Errors(t *testing.T) {
+	sk, err := blst.RandKey()
+	require.NoError(t, err)
+	sig := sk.Sign([]byte("hello"))
+
+	_, err = blst.RecoverSignature(nil, nil)
+	require.ErrorContains(t, "no partial signatures provided", err)
+	_, err = blst.RecoverSignature([]uint64{1, 2}, []common.Signature{sig})
+	require.ErrorContains(t, "got 2 indices for 1 partial signatures", err)
+	_, err = blst.RecoverSignature([]uint64{1, 1}, []common.Signature{sig, sig})
+	require.ErrorContains(t, "duplicate share index 1", err)
+	_, err = blst.RecoverSignature([]uint64{0}, []common.Signature{sig})
+	require.ErrorContains(t, "share index must not be 0", err)
+}
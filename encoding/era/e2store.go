@@ -0,0 +1,99 @@
+package era
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// headerLength is the length of the header of an e2store entry: 2 bytes of type,
+	// 4 bytes of little-endian data length and 2 reserved bytes.
+	headerLength = 8
+	// maxEntryLength bounds the memory allocated for the data of a single entry of a corrupted file.
+	maxEntryLength = 1 << 30
+)
+
+var errEntryTooLarge = errors.New("e2store entry is too large")
+
+// Entry is a type-length-value record of an e2store file.
+type Entry struct {
+	Type [2]byte
+	Data []byte
+}
+
+// Writer writes e2store entries to an underlying writer, keeping track of the offset of the next entry.
+type Writer struct {
+	w      io.Writer
+	offset int64
+}
+
+// NewWriter returns a Writer writing e2store entries to w.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w}
+}
+
+// Offset returns the number of bytes written so far, which is the offset of the next entry.
+func (w *Writer) Offset() int64 {
+	return w.offset
+}
+
+// WriteEntry writes an entry of the given type and data.
+func (w *Writer) WriteEntry(typ [2]byte, data []byte) error {
+	if len(data) > maxEntryLength {
+		return errors.Wrapf(errEntryTooLarge, "length %d", len(data))
+	}
+	header := make([]byte, headerLength)
+	copy(header, typ[:])
+	binary.LittleEndian.PutUint32(header[2:6], uint32(len(data)))
+	if _, err := w.w.Write(header); err != nil {
+		return err
+	}
+	if _, err := w.w.Write(data); err != nil {
+		return err
+	}
+	w.offset += int64(headerLength + len(data))
+	return nil
+}
+
+// Reader reads e2store entries from an underlying reader, keeping track of the offset of the next entry.
+type Reader struct {
+	r      io.Reader
+	offset int64
+}
+
+// NewReader returns a Reader reading e2store entries from r.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{r: r}
+}
+
+// Offset returns the number of bytes read so far, which is the offset of the next entry.
+func (r *Reader) Offset() int64 {
+	return r.offset
+}
+
+// ReadEntry reads the next entry, returning io.EOF when there are no more entries.
+func (r *Reader) ReadEntry() (*Entry, error) {
+	header := make([]byte, headerLength)
+	if _, err := io.ReadFull(r.r, header); err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, errors.Wrap(err, "truncated e2store entry header")
+		}
+		return nil, err
+	}
+	if header[6] != 0 || header[7] != 0 {
+		return nil, errors.Errorf("invalid reserved bytes %#x in e2store entry header", header[6:])
+	}
+	length := binary.LittleEndian.Uint32(header[2:6])
+	if length > maxEntryLength {
+		return nil, errors.Wrapf(errEntryTooLarge, "length %d", length)
+	}
+	e := &Entry{Data: make([]byte, length)}
+	copy(e.Type[:], header[:2])
+	if _, err := io.ReadFull(r.r, e.Data); err != nil {
+		return nil, errors.Wrap(err, "truncated e2store entry data")
+	}
+	r.offset += int64(headerLength) + int64(length)
+	return e, nil
+}
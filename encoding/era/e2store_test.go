@@ -0,0 +1,44 @@
+package era
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestWriterReader(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	require.NoError(t, w.WriteEntry(TypeVersion, nil))
+	assert.Equal(t, int64(8), w.Offset())
+	require.NoError(t, w.WriteEntry([2]byte{0x01, 0x02}, []byte("data")))
+	assert.Equal(t, int64(20), w.Offset())
+	assert.DeepEqual(t, []byte{0x65, 0x32, 0, 0, 0, 0, 0, 0, 0x01, 0x02, 4, 0, 0, 0, 0, 0, 'd', 'a', 't', 'a'}, buf.Bytes())
+
+	r := NewReader(&buf)
+	entry, err := r.ReadEntry()
+	require.NoError(t, err)
+	assert.Equal(t, TypeVersion, entry.Type)
+	assert.Equal(t, 0, len(entry.Data))
+	entry, err = r.ReadEntry()
+	require.NoError(t, err)
+	assert.Equal(t, [2]byte{0x01, 0x02}, entry.Type)
+	assert.DeepEqual(t, []byte("data"), entry.Data)
+	assert.Equal(t, int64(20), r.Offset())
+	_, err = r.ReadEntry()
+	require.ErrorIs(t, err, io.EOF)
+}
+
+func TestReader_Invalid(t *testing.T) {
+	_, err := NewReader(bytes.NewReader([]byte{0x65, 0x32, 0, 0, 0, 0, 0, 1})).ReadEntry()
+	assert.ErrorContains(t, "invalid reserved bytes", err)
+	_, err = NewReader(bytes.NewReader([]byte{0x65, 0x32, 0, 0})).ReadEntry()
+	assert.ErrorContains(t, "truncated e2store entry header", err)
+	_, err = NewReader(bytes.NewReader([]byte{0x65, 0x32, 4, 0, 0, 0, 0, 0, 1})).ReadEntry()
+	assert.ErrorContains(t, "truncated e2store entry data", err)
+	_, err = NewReader(bytes.NewReader([]byte{0x65, 0x32, 0xff, 0xff, 0xff, 0xff, 0, 0})).ReadEntry()
+	require.ErrorIs(t, err, errEntryTooLarge)
+}
@@ -0,0 +1,396 @@
+// Package era implements the era archive format, storing the finalized blocks of a period of
+// SLOTS_PER_HISTORICAL_ROOT slots and the state at the end of the period in an e2store file.
+//
+// An era file is made of the following entries:
+//
+//	Version | CompressedSignedBeaconBlock* | CompressedBeaconState | SlotIndex(blocks) | SlotIndex(state)
+//
+// The era number N holds the blocks of the slots [(N-1)*SLOTS_PER_HISTORICAL_ROOT, N*SLOTS_PER_HISTORICAL_ROOT)
+// and the state at slot N*SLOTS_PER_HISTORICAL_ROOT, before any block of that slot is applied. The era 0 holds
+// the genesis state only. The genesis block is never included, as it can be derived from the genesis state.
+//
+// See: https://github.com/status-im/nimbus-eth2/blob/stable/docs/e2store.md
+package era
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/encoding/ssz/detect"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+)
+
+// Types of the e2store entries of an era file.
+var (
+	TypeVersion                     = [2]byte{0x65, 0x32}
+	TypeCompressedSignedBeaconBlock = [2]byte{0x01, 0x00}
+	TypeCompressedBeaconState       = [2]byte{0x02, 0x00}
+	TypeSlotIndex                   = [2]byte{0x69, 0x32}
+)
+
+var (
+	// ErrInvalidEra is returned when an era file is malformed or inconsistent.
+	ErrInvalidEra = errors.New("invalid era file")
+	// ErrBlockRootMismatch is returned when a block of an era does not match the block roots of the era state.
+	ErrBlockRootMismatch = errors.New("block root does not match the block roots of the era state")
+
+	filenameRegex = regexp.MustCompile(`^[a-z0-9_-]+-(\d{5,})-[0-9a-f]{8}\.era$`)
+)
+
+// Era is the content of an era file.
+type Era struct {
+	// Blocks of the era, in increasing slot order, empty slots having no block.
+	Blocks []interfaces.ReadOnlySignedBeaconBlock
+	// State at the end of the era, at a slot multiple of SLOTS_PER_HISTORICAL_ROOT.
+	State state.BeaconState
+}
+
+// Number returns the era number, derived from the slot of the era state.
+func (e *Era) Number() uint64 {
+	return uint64(e.State.Slot() / params.BeaconConfig().SlotsPerHistoricalRoot)
+}
+
+// StartSlot returns the first slot of the blocks of the given era.
+func StartSlot(number uint64) primitives.Slot {
+	if number == 0 {
+		return 0
+	}
+	return primitives.Slot(number-1) * params.BeaconConfig().SlotsPerHistoricalRoot
+}
+
+// Filename returns the name of the era file, <config-name>-<era-number>-<short-historical-root>.era, where the
+// short historical root is the first 4 bytes of the historical root of the era, or of the genesis validators
+// root for the era 0.
+func (e *Era) Filename(configName string) (string, error) {
+	root, err := historicalRoot(e.State)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s-%05d-%x.era", configName, e.Number(), root[:4]), nil
+}
+
+// NumberFromFilename returns the era number of an era file name.
+func NumberFromFilename(name string) (uint64, error) {
+	m := filenameRegex.FindStringSubmatch(name)
+	if m == nil {
+		return 0, errors.Errorf("%s is not an era file name", name)
+	}
+	return strconv.ParseUint(m[1], 10, 64)
+}
+
+// historicalRoot returns the root of the block and state roots accumulated during the era, which is the last
+// historical summary, or historical root before Capella, of the era state.
+func historicalRoot(st state.ReadOnlyBeaconState) ([32]byte, error) {
+	if st.Slot() == 0 {
+		return bytesutil.ToBytes32(st.GenesisValidatorsRoot()), nil
+	}
+	if st.Version() >= version.Capella {
+		summaries, err := st.HistoricalSummaries()
+		if err != nil {
+			return [32]byte{}, err
+		}
+		if len(summaries) > 0 {
+			return summaries[len(summaries)-1].HashTreeRoot()
+		}
+	}
+	roots, err := st.HistoricalRoots()
+	if err != nil {
+		return [32]byte{}, err
+	}
+	if len(roots) == 0 {
+		return [32]byte{}, errors.Wrap(ErrInvalidEra, "era state has no historical root")
+	}
+	return bytesutil.ToBytes32(roots[len(roots)-1]), nil
+}
+
+// Write writes the era to w in the era file format.
+func Write(w io.Writer, e *Era) error {
+	if e.State == nil || e.State.IsNil() {
+		return errors.Wrap(ErrInvalidEra, "nil era state")
+	}
+	sphr := params.BeaconConfig().SlotsPerHistoricalRoot
+	if e.State.Slot()%sphr != 0 {
+		return errors.Wrapf(ErrInvalidEra, "state slot %d is not the end of an era", e.State.Slot())
+	}
+	number := e.Number()
+	start := StartSlot(number)
+	if number == 0 && len(e.Blocks) > 0 {
+		return errors.Wrap(ErrInvalidEra, "era 0 cannot have blocks")
+	}
+
+	ew := NewWriter(w)
+	if err := ew.WriteEntry(TypeVersion, nil); err != nil {
+		return err
+	}
+	offsets := make([]int64, sphr)
+	prev := primitives.Slot(0)
+	for i, b := range e.Blocks {
+		if err := blocks.BeaconBlockIsNil(b); err != nil {
+			return err
+		}
+		if b.IsBlinded() {
+			return errors.Wrapf(ErrInvalidEra, "block at slot %d is blinded", b.Block().Slot())
+		}
+		slot := b.Block().Slot()
+		if slot < start || slot >= start+sphr || (i > 0 && slot <= prev) || slot == 0 {
+			return errors.Wrapf(ErrInvalidEra, "unexpected block at slot %d in era %d", slot, number)
+		}
+		prev = slot
+		enc, err := b.MarshalSSZ()
+		if err != nil {
+			return errors.Wrapf(err, "could not marshal block at slot %d", slot)
+		}
+		offsets[slot-start] = ew.Offset()
+		if err := writeCompressed(ew, TypeCompressedSignedBeaconBlock, enc); err != nil {
+			return err
+		}
+	}
+	stateOffset := ew.Offset()
+	enc, err := e.State.MarshalSSZ()
+	if err != nil {
+		return errors.Wrap(err, "could not marshal era state")
+	}
+	if err := writeCompressed(ew, TypeCompressedBeaconState, enc); err != nil {
+		return err
+	}
+	if number > 0 {
+		if err := writeSlotIndex(ew, start, offsets); err != nil {
+			return err
+		}
+	}
+	return writeSlotIndex(ew, e.State.Slot(), []int64{stateOffset})
+}
+
+func writeCompressed(w *Writer, typ [2]byte, data []byte) error {
+	var buf bytes.Buffer
+	sw := snappy.NewBufferedWriter(&buf)
+	if _, err := sw.Write(data); err != nil {
+		return err
+	}
+	if err := sw.Close(); err != nil {
+		return err
+	}
+	return w.WriteEntry(typ, buf.Bytes())
+}
+
+// writeSlotIndex writes a slot index entry, whose data is the starting slot, the offset of the entry of each slot
+// relative to the start of the index entry, or 0 for empty slots, and the number of slots, as little-endian int64.
+func writeSlotIndex(w *Writer, start primitives.Slot, offsets []int64) error {
+	indexOffset := w.Offset()
+	data := make([]byte, 8*(len(offsets)+2))
+	binary.LittleEndian.PutUint64(data, uint64(start))
+	for i, o := range offsets {
+		if o == 0 {
+			continue
+		}
+		binary.LittleEndian.PutUint64(data[8*(i+1):], uint64(o-indexOffset))
+	}
+	binary.LittleEndian.PutUint64(data[len(data)-8:], uint64(len(offsets)))
+	return w.WriteEntry(TypeSlotIndex, data)
+}
+
+type slotIndex struct {
+	start   primitives.Slot
+	offsets []int64
+}
+
+func readSlotIndex(e *Entry, indexOffset int64) (*slotIndex, error) {
+	if len(e.Data) < 16 || len(e.Data)%8 != 0 {
+		return nil, errors.Wrapf(ErrInvalidEra, "slot index of invalid length %d", len(e.Data))
+	}
+	count := binary.LittleEndian.Uint64(e.Data[len(e.Data)-8:])
+	if count != uint64(len(e.Data)/8-2) {
+		return nil, errors.Wrapf(ErrInvalidEra, "slot index count %d does not match its length %d", count, len(e.Data))
+	}
+	idx := &slotIndex{
+		start:   primitives.Slot(binary.LittleEndian.Uint64(e.Data)),
+		offsets: make([]int64, count),
+	}
+	for i := range idx.offsets {
+		rel := int64(binary.LittleEndian.Uint64(e.Data[8*(i+1):]))
+		if rel != 0 {
+			idx.offsets[i] = indexOffset + rel
+		}
+	}
+	return idx, nil
+}
+
+// Read reads an era from r, checking that its slot indices match the blocks and the state it contains.
+func Read(r io.Reader) (*Era, error) {
+	er := NewReader(r)
+	first, err := er.ReadEntry()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read version entry")
+	}
+	if first.Type != TypeVersion || len(first.Data) != 0 {
+		return nil, errors.Wrap(ErrInvalidEra, "era file does not start with a version entry")
+	}
+
+	e := &Era{}
+	blockOffsets := make(map[int64]primitives.Slot)
+	var stateOffset int64
+	var indices []*slotIndex
+	for {
+		offset := er.Offset()
+		entry, err := er.ReadEntry()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch entry.Type {
+		case TypeCompressedSignedBeaconBlock:
+			if e.State != nil {
+				return nil, errors.Wrap(ErrInvalidEra, "block after the era state")
+			}
+			b, err := decodeBlock(entry.Data)
+			if err != nil {
+				return nil, err
+			}
+			blockOffsets[offset] = b.Block().Slot()
+			e.Blocks = append(e.Blocks, b)
+		case TypeCompressedBeaconState:
+			if e.State != nil {
+				return nil, errors.Wrap(ErrInvalidEra, "more than one era state")
+			}
+			e.State, err = decodeState(entry.Data)
+			if err != nil {
+				return nil, err
+			}
+			stateOffset = offset
+		case TypeSlotIndex:
+			idx, err := readSlotIndex(entry, offset)
+			if err != nil {
+				return nil, err
+			}
+			indices = append(indices, idx)
+		default:
+			// Other entry types are allowed and ignored.
+		}
+	}
+	if e.State == nil {
+		return nil, errors.Wrap(ErrInvalidEra, "no era state")
+	}
+	if err := e.checkIndices(indices, blockOffsets, stateOffset); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (e *Era) checkIndices(indices []*slotIndex, blockOffsets map[int64]primitives.Slot, stateOffset int64) error {
+	sphr := params.BeaconConfig().SlotsPerHistoricalRoot
+	if e.State.Slot()%sphr != 0 {
+		return errors.Wrapf(ErrInvalidEra, "state slot %d is not the end of an era", e.State.Slot())
+	}
+	number := e.Number()
+	want := 2
+	if number == 0 {
+		want = 1
+	}
+	if len(indices) != want {
+		return errors.Wrapf(ErrInvalidEra, "expected %d slot indices, got %d", want, len(indices))
+	}
+	stateIndex := indices[len(indices)-1]
+	if stateIndex.start != e.State.Slot() || len(stateIndex.offsets) != 1 || stateIndex.offsets[0] != stateOffset {
+		return errors.Wrap(ErrInvalidEra, "state slot index does not match the era state")
+	}
+	if number == 0 {
+		if len(e.Blocks) > 0 {
+			return errors.Wrap(ErrInvalidEra, "era 0 cannot have blocks")
+		}
+		return nil
+	}
+	blockIndex := indices[0]
+	start := StartSlot(number)
+	if blockIndex.start != start || uint64(len(blockIndex.offsets)) != uint64(sphr) {
+		return errors.Wrap(ErrInvalidEra, "block slot index does not match the era")
+	}
+	indexed := 0
+	for i, o := range blockIndex.offsets {
+		if o == 0 {
+			continue
+		}
+		slot, ok := blockOffsets[o]
+		if !ok || slot != start+primitives.Slot(i) {
+			return errors.Wrapf(ErrInvalidEra, "block slot index entry for slot %d does not point to its block", start+primitives.Slot(i))
+		}
+		indexed++
+	}
+	if indexed != len(e.Blocks) {
+		return errors.Wrapf(ErrInvalidEra, "block slot index has %d entries for %d blocks", indexed, len(e.Blocks))
+	}
+	return nil
+}
+
+// VerifyBlockRoots checks that the root of every block of the era is the block root of its slot in the era state,
+// and that the era has a block for every slot whose block root differs from the one of the previous slot.
+func (e *Era) VerifyBlockRoots() error {
+	sphr := params.BeaconConfig().SlotsPerHistoricalRoot
+	start := StartSlot(e.Number())
+	roots := e.State.BlockRoots()
+	if uint64(len(roots)) != uint64(sphr) {
+		return errors.Wrapf(ErrInvalidEra, "era state has %d block roots", len(roots))
+	}
+	bySlot := make(map[primitives.Slot][32]byte, len(e.Blocks))
+	for _, b := range e.Blocks {
+		root, err := b.Block().HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		slot := b.Block().Slot()
+		if !bytes.Equal(root[:], roots[slot%sphr]) {
+			return errors.Wrapf(ErrBlockRootMismatch, "slot %d, block root %#x, state block root %#x", slot, root, roots[slot%sphr])
+		}
+		bySlot[slot] = root
+	}
+	for slot := start + 1; slot < start+sphr && e.Number() > 0; slot++ {
+		if _, ok := bySlot[slot]; ok {
+			continue
+		}
+		if !bytes.Equal(roots[slot%sphr], roots[(slot-1)%sphr]) {
+			return errors.Wrapf(ErrInvalidEra, "missing block at slot %d", slot)
+		}
+	}
+	return nil
+}
+
+func decompress(data []byte) ([]byte, error) {
+	return io.ReadAll(snappy.NewReader(bytes.NewReader(data)))
+}
+
+func decodeBlock(data []byte) (interfaces.ReadOnlySignedBeaconBlock, error) {
+	enc, err := decompress(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decompress block")
+	}
+	vu, err := detect.FromBlock(enc)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not detect block version")
+	}
+	return vu.UnmarshalBeaconBlock(enc)
+}
+
+func decodeState(data []byte) (state.BeaconState, error) {
+	enc, err := decompress(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decompress state")
+	}
+	vu, err := detect.FromState(enc)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not detect state version")
+	}
+	return vu.UnmarshalBeaconState(enc)
+}
@@ -0,0 +1,162 @@
+package era
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+// testEra returns an era 1 with blocks at the given slots, whose state block roots match the blocks.
+func testEra(t *testing.T, blockSlots ...primitives.Slot) *Era {
+	sphr := params.BeaconConfig().SlotsPerHistoricalRoot
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(sphr))
+	require.NoError(t, st.AppendHistoricalRoots([32]byte{0xde, 0xad, 0xbe, 0xef, 0x01}))
+
+	e := &Era{State: st}
+	bySlot := make(map[primitives.Slot][32]byte)
+	for _, slot := range blockSlots {
+		b := util.NewBeaconBlock()
+		b.Block.Slot = slot
+		b.Block.ProposerIndex = primitives.ValidatorIndex(slot)
+		sb, err := blocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+		root, err := sb.Block().HashTreeRoot()
+		require.NoError(t, err)
+		bySlot[slot] = root
+		e.Blocks = append(e.Blocks, sb)
+	}
+	var last [32]byte
+	for slot := primitives.Slot(0); slot < sphr; slot++ {
+		if root, ok := bySlot[slot]; ok {
+			last = root
+		}
+		require.NoError(t, st.UpdateBlockRootAtIndex(uint64(slot), last))
+	}
+	return e
+}
+
+func blockRoots(t *testing.T, blks []interfaces.ReadOnlySignedBeaconBlock) [][32]byte {
+	roots := make([][32]byte, len(blks))
+	for i, b := range blks {
+		var err error
+		roots[i], err = b.Block().HashTreeRoot()
+		require.NoError(t, err)
+	}
+	return roots
+}
+
+func stateRoot(t *testing.T, st state.BeaconState) [32]byte {
+	root, err := st.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	return root
+}
+
+func TestWriteRead(t *testing.T) {
+	e := testEra(t, 1, 2, 5, params.BeaconConfig().SlotsPerHistoricalRoot-1)
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, e))
+
+	got, err := Read(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), got.Number())
+	assert.DeepEqual(t, blockRoots(t, e.Blocks), blockRoots(t, got.Blocks))
+	assert.Equal(t, stateRoot(t, e.State), stateRoot(t, got.State))
+	require.NoError(t, got.VerifyBlockRoots())
+
+	name, err := got.Filename("mainnet")
+	require.NoError(t, err)
+	assert.Equal(t, "mainnet-00001-deadbeef.era", name)
+	number, err := NumberFromFilename(name)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), number)
+}
+
+func TestWriteRead_Genesis(t *testing.T) {
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetGenesisValidatorsRoot(bytes.Repeat([]byte{0xab}, 32)))
+	e := &Era{State: st}
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, e))
+
+	got, err := Read(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), got.Number())
+	assert.Equal(t, 0, len(got.Blocks))
+	name, err := got.Filename("holesky")
+	require.NoError(t, err)
+	assert.Equal(t, "holesky-00000-abababab.era", name)
+
+	e.Blocks = testEra(t, 1).Blocks
+	require.ErrorIs(t, Write(&bytes.Buffer{}, e), ErrInvalidEra)
+}
+
+func TestWrite_InvalidBlocks(t *testing.T) {
+	sphr := params.BeaconConfig().SlotsPerHistoricalRoot
+	e := testEra(t, 2, 1)
+	assert.ErrorContains(t, "unexpected block at slot 1", Write(&bytes.Buffer{}, e))
+	e = testEra(t, sphr)
+	assert.ErrorContains(t, fmt.Sprintf("unexpected block at slot %d", sphr), Write(&bytes.Buffer{}, e))
+	e = testEra(t, 1)
+	require.NoError(t, e.State.SetSlot(sphr+1))
+	assert.ErrorContains(t, "is not the end of an era", Write(&bytes.Buffer{}, e))
+}
+
+func TestRead_Invalid(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, testEra(t, 1, 2)))
+	valid := buf.Bytes()
+
+	_, err := Read(bytes.NewReader(valid[:len(valid)-10]))
+	assert.ErrorContains(t, "truncated e2store entry", err)
+
+	_, err = Read(bytes.NewReader(valid[headerLength:]))
+	assert.ErrorContains(t, "does not start with a version entry", err)
+
+	// Only keep the version entry and the first block.
+	r := NewReader(bytes.NewReader(valid))
+	var short bytes.Buffer
+	w := NewWriter(&short)
+	for i := 0; i < 2; i++ {
+		entry, err := r.ReadEntry()
+		require.NoError(t, err)
+		require.NoError(t, w.WriteEntry(entry.Type, entry.Data))
+	}
+	_, err = Read(&short)
+	assert.ErrorContains(t, "no era state", err)
+}
+
+func TestVerifyBlockRoots(t *testing.T) {
+	e := testEra(t, 1, 3)
+	require.NoError(t, e.VerifyBlockRoots())
+
+	require.NoError(t, e.State.UpdateBlockRootAtIndex(3, [32]byte{'a'}))
+	require.ErrorIs(t, e.VerifyBlockRoots(), ErrBlockRootMismatch)
+
+	e = testEra(t, 1, 3)
+	e.Blocks = e.Blocks[:1]
+	assert.ErrorContains(t, "missing block at slot 3", e.VerifyBlockRoots())
+}
+
+func TestNumberFromFilename(t *testing.T) {
+	number, err := NumberFromFilename("mainnet-01234-0123abcd.era")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1234), number)
+
+	for _, name := range []string{"mainnet-1-0123abcd.era", "mainnet-01234-0123abcd.e2s", "01234-0123abcd.era"} {
+		_, err = NumberFromFilename(name)
+		assert.ErrorContains(t, "is not an era file name", err)
+	}
+}
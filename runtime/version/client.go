@@ -0,0 +1,82 @@
+package version
+
+import (
+	"encoding/hex"
+	"strings"
+)
+
+const (
+	// ClientCode is the two letters code identifying Prysm in the engine API.
+	ClientCode = "PM"
+	// ClientName is the name of the Prysm client.
+	ClientName = "Prysm"
+	// commitLength is the number of hex characters of the commit hash included in a client version.
+	commitLength = 8
+)
+
+// ClientVersion identifies the implementation and the build of a client,
+// as defined by the ClientVersionV1 structure of the engine API.
+type ClientVersion struct {
+	Code    string `json:"code"`
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Commit  string `json:"commit"`
+}
+
+// Client returns the client version of the current build.
+func Client() *ClientVersion {
+	commit := strings.ToLower(GitCommit())
+	if _, err := hex.DecodeString(commit); err != nil || len(commit) < commitLength {
+		// Builds made outside of a git checkout have no known commit.
+		commit = strings.Repeat("0", commitLength)
+	}
+	return &ClientVersion{
+		Code:    ClientCode,
+		Name:    ClientName,
+		Version: SemanticVersion(),
+		Commit:  "0x" + commit[:commitLength],
+	}
+}
+
+// ShortCommit returns the first hex characters of the commit hash of the client, without 0x prefix.
+func (c *ClientVersion) ShortCommit(length int) string {
+	if c == nil {
+		return ""
+	}
+	commit := strings.ToLower(strings.TrimPrefix(strings.TrimPrefix(c.Commit, "0x"), "0X"))
+	if len(commit) > length {
+		return commit[:length]
+	}
+	return commit
+}
+
+// ClientGraffiti returns the graffiti identifying the execution and consensus clients recommended by
+// the engine API, in its longest form fitting into maxLen bytes. From the longest to the shortest,
+// the forms are ELcode ELcommit[0:4] CLcode CLcommit[0:4] (e.g. GEabcdPMe4f6),
+// ELcode ELcommit[0:2] CLcode CLcommit[0:2], ELcode CLcode and finally CLcode alone.
+// The execution client forms are skipped when its version is unknown.
+func ClientGraffiti(el, cl *ClientVersion, maxLen int) string {
+	if cl == nil {
+		cl = Client()
+	}
+	var forms []string
+	if el != nil && el.Code != "" {
+		forms = append(forms,
+			el.Code+el.ShortCommit(4)+cl.Code+cl.ShortCommit(4),
+			el.Code+el.ShortCommit(2)+cl.Code+cl.ShortCommit(2),
+			el.Code+cl.Code,
+		)
+	} else {
+		forms = append(forms,
+			cl.Code+cl.ShortCommit(4),
+			cl.Code+cl.ShortCommit(2),
+		)
+	}
+	forms = append(forms, cl.Code)
+	for _, f := range forms {
+		if len(f) <= maxLen {
+			return f
+		}
+	}
+	return ""
+}
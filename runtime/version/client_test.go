@@ -0,0 +1,40 @@
+package version
+
+import "testing"
+
+func TestClient(t *testing.T) {
+	c := Client()
+	if c.Code != ClientCode || c.Name != ClientName {
+		t.Fatalf("unexpected client identity %s %s", c.Code, c.Name)
+	}
+	if len(c.Commit) != 2+commitLength {
+		t.Fatalf("unexpected commit length: %s", c.Commit)
+	}
+}
+
+func TestClientGraffiti(t *testing.T) {
+	el := &ClientVersion{Code: "GE", Name: "Geth", Version: "v1.14.11", Commit: "0xF8D0A1B2"}
+	cl := &ClientVersion{Code: "PM", Name: "Prysm", Version: "v5.2.0", Commit: "0xe4f61234"}
+
+	tests := []struct {
+		name   string
+		el     *ClientVersion
+		maxLen int
+		want   string
+	}{
+		{name: "full", el: el, maxLen: 32, want: "GEf8d0PMe4f6"},
+		{name: "short commits", el: el, maxLen: 11, want: "GEf8PMe4"},
+		{name: "codes only", el: el, maxLen: 7, want: "GEPM"},
+		{name: "consensus client only", el: el, maxLen: 3, want: "PM"},
+		{name: "no space", el: el, maxLen: 1, want: ""},
+		{name: "unknown execution client", maxLen: 32, want: "PMe4f6"},
+		{name: "unknown execution client, short commit", maxLen: 5, want: "PMe4"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ClientGraffiti(tt.el, cl, tt.maxLen); got != tt.want {
+				t.Errorf("ClientGraffiti() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,312 @@
+package mockengine
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/container/trie"
+	"github.com/prysmaticlabs/prysm/v5/contracts/deposit"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+)
+
+// maxPayloadBodies is the maximum number of payload bodies returned by a single request.
+const maxPayloadBodies = 1024
+
+// capabilities are the engine API methods served by the engine.
+var capabilities = []string{
+	"engine_newPayloadV1",
+	"engine_newPayloadV2",
+	"engine_newPayloadV3",
+	"engine_newPayloadV4",
+	"engine_forkchoiceUpdatedV1",
+	"engine_forkchoiceUpdatedV2",
+	"engine_forkchoiceUpdatedV3",
+	"engine_getPayloadV1",
+	"engine_getPayloadV2",
+	"engine_getPayloadV3",
+	"engine_getPayloadV4",
+	"engine_getPayloadBodiesByHashV1",
+	"engine_getPayloadBodiesByRangeV1",
+	"engine_getBlobsV1",
+	"engine_getClientVersionV1",
+}
+
+// engineAPI serves the engine_ namespace.
+type engineAPI struct {
+	chain *chain
+}
+
+func (*engineAPI) ExchangeCapabilities([]string) []string {
+	return capabilities
+}
+
+func (*engineAPI) GetClientVersionV1(engine.ClientVersionV1) []engine.ClientVersionV1 {
+	commit := version.GitCommit()
+	if len(commit) > 8 {
+		commit = commit[:8]
+	}
+	return []engine.ClientVersionV1{{Code: "MK", Name: "prysm-mock-el", Version: version.SemanticVersion(), Commit: commit}}
+}
+
+func (api *engineAPI) ForkchoiceUpdatedV1(state engine.ForkchoiceStateV1, attrs *engine.PayloadAttributes) (engine.ForkChoiceResponse, error) {
+	return api.chain.forkchoiceUpdated(state, attrs)
+}
+
+func (api *engineAPI) ForkchoiceUpdatedV2(state engine.ForkchoiceStateV1, attrs *engine.PayloadAttributes) (engine.ForkChoiceResponse, error) {
+	return api.chain.forkchoiceUpdated(state, attrs)
+}
+
+func (api *engineAPI) ForkchoiceUpdatedV3(state engine.ForkchoiceStateV1, attrs *engine.PayloadAttributes) (engine.ForkChoiceResponse, error) {
+	return api.chain.forkchoiceUpdated(state, attrs)
+}
+
+func (api *engineAPI) GetPayloadV1(id engine.PayloadID) (*engine.ExecutableData, error) {
+	env, err := api.getPayload(id)
+	if err != nil {
+		return nil, err
+	}
+	return env.ExecutionPayload, nil
+}
+
+func (api *engineAPI) GetPayloadV2(id engine.PayloadID) (*engine.ExecutionPayloadEnvelope, error) {
+	env, err := api.getPayload(id)
+	if err != nil {
+		return nil, err
+	}
+	env.BlobsBundle, env.Requests = nil, nil
+	return env, nil
+}
+
+func (api *engineAPI) GetPayloadV3(id engine.PayloadID) (*engine.ExecutionPayloadEnvelope, error) {
+	env, err := api.getPayload(id)
+	if err != nil {
+		return nil, err
+	}
+	env.Requests = nil
+	return env, nil
+}
+
+func (api *engineAPI) GetPayloadV4(id engine.PayloadID) (*engine.ExecutionPayloadEnvelope, error) {
+	return api.getPayload(id)
+}
+
+func (api *engineAPI) getPayload(id engine.PayloadID) (*engine.ExecutionPayloadEnvelope, error) {
+	p, err := api.chain.payload(id)
+	if err != nil {
+		return nil, err
+	}
+	env := engine.BlockToExecutableData(p.Block, big.NewInt(0), nil, nil)
+	// The requests are returned as built, with their type prefix.
+	env.BlobsBundle, env.Requests = p.blobs, p.requests
+	return env, nil
+}
+
+func (api *engineAPI) NewPayloadV1(data engine.ExecutableData) (engine.PayloadStatusV1, error) {
+	return api.chain.newPayload(data, nil, nil, nil), nil
+}
+
+func (api *engineAPI) NewPayloadV2(data engine.ExecutableData) (engine.PayloadStatusV1, error) {
+	return api.chain.newPayload(data, nil, nil, nil), nil
+}
+
+func (api *engineAPI) NewPayloadV3(data engine.ExecutableData, versionedHashes []common.Hash, beaconRoot *common.Hash) (engine.PayloadStatusV1, error) {
+	if versionedHashes == nil || beaconRoot == nil {
+		return engine.PayloadStatusV1{}, engine.InvalidParams.With(errors.New("missing versioned hashes or parent beacon block root"))
+	}
+	return api.chain.newPayload(data, versionedHashes, beaconRoot, nil), nil
+}
+
+func (api *engineAPI) NewPayloadV4(data engine.ExecutableData, versionedHashes []common.Hash, beaconRoot *common.Hash, requests []hexutil.Bytes) (engine.PayloadStatusV1, error) {
+	if versionedHashes == nil || beaconRoot == nil || requests == nil {
+		return engine.PayloadStatusV1{}, engine.InvalidParams.With(errors.New("missing versioned hashes, parent beacon block root or execution requests"))
+	}
+	flat := make([][]byte, len(requests))
+	for i := range requests {
+		flat[i] = requests[i]
+	}
+	return api.chain.newPayload(data, versionedHashes, beaconRoot, flat), nil
+}
+
+func (api *engineAPI) GetPayloadBodiesByHashV1(hashes []common.Hash) ([]*engine.ExecutionPayloadBody, error) {
+	if len(hashes) > maxPayloadBodies {
+		return nil, engine.TooLargeRequest
+	}
+	bodies := make([]*engine.ExecutionPayloadBody, len(hashes))
+	for i, h := range hashes {
+		bodies[i] = payloadBody(api.chain.blockByHash(h))
+	}
+	return bodies, nil
+}
+
+func (api *engineAPI) GetPayloadBodiesByRangeV1(start, count hexutil.Uint64) ([]*engine.ExecutionPayloadBody, error) {
+	if start == 0 || count == 0 {
+		return nil, engine.InvalidParams.With(errors.Errorf("invalid start %d or count %d", start, count))
+	}
+	if count > maxPayloadBodies {
+		return nil, engine.TooLargeRequest
+	}
+	bodies := make([]*engine.ExecutionPayloadBody, 0, count)
+	for n := uint64(start); n < uint64(start+count); n++ {
+		b := api.chain.blockByNumber(n)
+		if b == nil {
+			break
+		}
+		bodies = append(bodies, payloadBody(b))
+	}
+	return bodies, nil
+}
+
+func (api *engineAPI) GetBlobsV1(hashes []common.Hash) ([]*engine.BlobAndProofV1, error) {
+	if len(hashes) > maxPayloadBodies {
+		return nil, engine.TooLargeRequest
+	}
+	blobs := make([]*engine.BlobAndProofV1, len(hashes))
+	for i, h := range hashes {
+		blobs[i] = api.chain.blobAndProof(h)
+	}
+	return blobs, nil
+}
+
+func payloadBody(b *block) *engine.ExecutionPayloadBody {
+	if b == nil {
+		return nil
+	}
+	txs := make([]hexutil.Bytes, len(b.Transactions()))
+	for i, tx := range b.Transactions() {
+		enc, err := tx.MarshalBinary()
+		if err != nil {
+			return nil
+		}
+		txs[i] = enc
+	}
+	return &engine.ExecutionPayloadBody{TransactionData: txs, Withdrawals: b.Withdrawals()}
+}
+
+// ethAPI serves the eth_ namespace methods used by the beacon node: reading blocks and the state of the deposit
+// contract, which has no deposits.
+type ethAPI struct {
+	chain       *chain
+	depositABI  abi.ABI
+	depositRoot [32]byte
+}
+
+func newEthAPI(c *chain) (*ethAPI, error) {
+	depositABI, err := abi.JSON(strings.NewReader(deposit.DepositContractABI))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse deposit contract abi")
+	}
+	t, err := trie.NewTrie(params.BeaconConfig().DepositContractTreeDepth)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create deposit tree")
+	}
+	root, err := t.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get deposit tree root")
+	}
+	return &ethAPI{chain: c, depositABI: depositABI, depositRoot: root}, nil
+}
+
+func (api *ethAPI) ChainId() *hexutil.Big {
+	return (*hexutil.Big)(api.chain.chainConfig().ChainID)
+}
+
+func (api *ethAPI) BlockNumber() hexutil.Uint64 {
+	return hexutil.Uint64(api.chain.labeledBlock("latest").NumberU64())
+}
+
+func (api *ethAPI) GetBlockByNumber(number gethRPC.BlockNumber, fullTx bool) (map[string]interface{}, error) {
+	var b *block
+	switch number {
+	case gethRPC.LatestBlockNumber, gethRPC.PendingBlockNumber:
+		b = api.chain.labeledBlock("latest")
+	case gethRPC.SafeBlockNumber:
+		b = api.chain.labeledBlock("safe")
+	case gethRPC.FinalizedBlockNumber:
+		b = api.chain.labeledBlock("finalized")
+	case gethRPC.EarliestBlockNumber:
+		b = api.chain.blockByNumber(0)
+	default:
+		b = api.chain.blockByNumber(uint64(number.Int64()))
+	}
+	return rpcBlock(b, fullTx)
+}
+
+func (api *ethAPI) GetBlockByHash(hash common.Hash, fullTx bool) (map[string]interface{}, error) {
+	return rpcBlock(api.chain.blockByHash(hash), fullTx)
+}
+
+// GetLogs returns no logs, since no transaction is executed.
+func (*ethAPI) GetLogs(json.RawMessage) []*gethTypes.Log {
+	return []*gethTypes.Log{}
+}
+
+// callArgs are the fields of an eth_call request used by the engine.
+type callArgs struct {
+	Data  *hexutil.Bytes `json:"data"`
+	Input *hexutil.Bytes `json:"input"`
+}
+
+// Call answers the view functions of the deposit contract, as if no deposit was made.
+func (api *ethAPI) Call(args callArgs, _ *json.RawMessage) (hexutil.Bytes, error) {
+	input := args.Input
+	if input == nil {
+		input = args.Data
+	}
+	if input == nil || len(*input) < 4 {
+		return hexutil.Bytes{}, nil
+	}
+	method, err := api.depositABI.MethodById((*input)[:4])
+	if err != nil {
+		return hexutil.Bytes{}, nil
+	}
+	switch method.Name {
+	case "get_deposit_count":
+		return method.Outputs.Pack(make([]byte, 8))
+	case "get_deposit_root":
+		return method.Outputs.Pack(api.depositRoot)
+	default:
+		return hexutil.Bytes{}, nil
+	}
+}
+
+// rpcBlock returns the JSON representation of a block, with the fields of the header, the hash, the total
+// difficulty, the transactions, or their hashes, and the withdrawals.
+func rpcBlock(b *block, fullTx bool) (map[string]interface{}, error) {
+	if b == nil {
+		return nil, nil
+	}
+	enc, err := b.Header().MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	fields := make(map[string]interface{})
+	if err := json.Unmarshal(enc, &fields); err != nil {
+		return nil, err
+	}
+	fields["hash"] = b.Hash()
+	fields["totalDifficulty"] = (*hexutil.Big)(big.NewInt(0))
+	fields["size"] = hexutil.Uint64(b.Size())
+	fields["uncles"] = []common.Hash{}
+	if fullTx {
+		fields["transactions"] = b.Transactions()
+	} else {
+		hashes := make([]common.Hash, len(b.Transactions()))
+		for i, tx := range b.Transactions() {
+			hashes[i] = tx.Hash()
+		}
+		fields["transactions"] = hashes
+	}
+	if b.Withdrawals() != nil {
+		fields["withdrawals"] = b.Withdrawals()
+	}
+	return fields, nil
+}
@@ -0,0 +1,324 @@
+package mockengine
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip1559"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	gethParams "github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// invalidBlockHash is the payload status of a payload whose block hash does not match its content.
+const invalidBlockHash = "INVALID_BLOCK_HASH"
+
+// extraData is set in the payloads built by the engine.
+var extraData = []byte("prysm mock el")
+
+// block is an execution block known to the engine, along with the execution requests of its payload and, for the
+// payloads built by the engine, the blobs of its transactions.
+type block struct {
+	*gethTypes.Block
+	requests [][]byte
+	blobs    *engine.BlobsBundleV1
+}
+
+// chain is the block tree of the engine. Transactions are not executed: a payload is valid when its parent is known,
+// its block hash matches its content and it follows its parent.
+type chain struct {
+	cfg       *config
+	lock      sync.Mutex
+	blocks    map[common.Hash]*block
+	canonical map[uint64]common.Hash
+	head      *block
+	safe      common.Hash
+	finalized common.Hash
+	payloads  map[engine.PayloadID]*block
+	blobs     map[common.Hash]*engine.BlobAndProofV1 // blobs of the pending payloads, by versioned hash.
+}
+
+func newChain(cfg *config) *chain {
+	genesis := &block{Block: cfg.genesis.ToBlock()}
+	c := &chain{
+		cfg:       cfg,
+		blocks:    map[common.Hash]*block{genesis.Hash(): genesis},
+		canonical: make(map[uint64]common.Hash),
+		safe:      genesis.Hash(),
+		finalized: genesis.Hash(),
+		payloads:  make(map[engine.PayloadID]*block),
+		blobs:     make(map[common.Hash]*engine.BlobAndProofV1),
+	}
+	c.setHead(genesis)
+	return c
+}
+
+func (c *chain) chainConfig() *gethParams.ChainConfig {
+	return c.cfg.genesis.Config
+}
+
+// forkchoiceUpdated sets the head of the chain and, when payload attributes are given, builds a payload on top of
+// it. Building is deterministic: the same head and attributes always give the same payload and payload ID.
+func (c *chain) forkchoiceUpdated(state engine.ForkchoiceStateV1, attrs *engine.PayloadAttributes) (engine.ForkChoiceResponse, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	head, ok := c.blocks[state.HeadBlockHash]
+	if !ok {
+		return engine.STATUS_SYNCING, nil
+	}
+	for _, h := range []common.Hash{state.SafeBlockHash, state.FinalizedBlockHash} {
+		if _, ok := c.blocks[h]; !ok && h != (common.Hash{}) {
+			return engine.STATUS_INVALID, engine.InvalidForkChoiceState.With(errors.Errorf("unknown block %#x", h))
+		}
+	}
+	if head != c.head {
+		c.setHead(head)
+		log.WithFields(logrus.Fields{
+			"number": head.NumberU64(),
+			"hash":   head.Hash(),
+		}).Info("Updated head")
+	}
+	c.safe, c.finalized = state.SafeBlockHash, state.FinalizedBlockHash
+	hash := head.Hash()
+	resp := engine.ForkChoiceResponse{PayloadStatus: engine.PayloadStatusV1{Status: engine.VALID, LatestValidHash: &hash}}
+	if attrs == nil {
+		return resp, nil
+	}
+	if attrs.Timestamp <= head.Time() {
+		return engine.STATUS_INVALID, engine.InvalidPayloadAttributes.With(errors.New("timestamp is not after the head"))
+	}
+	id, err := payloadID(hash, attrs)
+	if err != nil {
+		return engine.STATUS_INVALID, engine.InvalidPayloadAttributes.With(err)
+	}
+	if _, ok := c.payloads[id]; !ok {
+		p, err := c.buildPayload(head, attrs)
+		if err != nil {
+			return engine.STATUS_INVALID, engine.InvalidPayloadAttributes.With(err)
+		}
+		c.payloads[id] = p
+		if p.blobs != nil {
+			for i := range p.blobs.Blobs {
+				c.blobs[kzgToVersionedHash(p.blobs.Commitments[i])] = &engine.BlobAndProofV1{Blob: p.blobs.Blobs[i], Proof: p.blobs.Proofs[i]}
+			}
+		}
+		log.WithFields(logrus.Fields{
+			"number":   p.NumberU64(),
+			"hash":     p.Hash(),
+			"blobs":    len(p.blobs.Blobs),
+			"requests": len(p.requests),
+		}).Debug("Built payload")
+	}
+	resp.PayloadID = &id
+	return resp, nil
+}
+
+// payload returns a payload built by the engine.
+func (c *chain) payload(id engine.PayloadID) (*block, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	p, ok := c.payloads[id]
+	if !ok {
+		return nil, engine.UnknownPayload
+	}
+	return p, nil
+}
+
+// newPayload adds a payload to the block tree.
+func (c *chain) newPayload(data engine.ExecutableData, versionedHashes []common.Hash, beaconRoot *common.Hash, requests [][]byte) engine.PayloadStatusV1 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if b, ok := c.blocks[data.BlockHash]; ok {
+		return validStatus(b.Hash())
+	}
+	parent, ok := c.blocks[data.ParentHash]
+	if !ok {
+		return engine.PayloadStatusV1{Status: engine.SYNCING}
+	}
+	b, err := payloadToBlock(data, versionedHashes, beaconRoot, requests)
+	if err != nil {
+		return invalidStatus(engine.INVALID, parent.Hash(), err)
+	}
+	if b.Hash() != data.BlockHash {
+		return invalidStatus(invalidBlockHash, parent.Hash(), errors.Errorf("block hash %#x does not match the payload, computed %#x", data.BlockHash, b.Hash()))
+	}
+	if b.NumberU64() != parent.NumberU64()+1 || b.Time() <= parent.Time() {
+		return invalidStatus(engine.INVALID, parent.Hash(), errors.New("block does not follow its parent"))
+	}
+	c.blocks[b.Hash()] = &block{Block: b, requests: requests}
+	return validStatus(b.Hash())
+}
+
+// setHead makes the given block the head, updating the canonical chain and dropping the payloads built on top of
+// older blocks. The lock must be held.
+func (c *chain) setHead(head *block) {
+	for n := head.NumberU64() + 1; ; n++ {
+		if _, ok := c.canonical[n]; !ok {
+			break
+		}
+		delete(c.canonical, n)
+	}
+	for b := head; b != nil && c.canonical[b.NumberU64()] != b.Hash(); b = c.blocks[b.ParentHash()] {
+		c.canonical[b.NumberU64()] = b.Hash()
+	}
+	c.head = head
+	for id, p := range c.payloads {
+		if p.NumberU64() > head.NumberU64() {
+			continue
+		}
+		delete(c.payloads, id)
+		for _, commitment := range p.blobs.Commitments {
+			delete(c.blobs, kzgToVersionedHash(commitment))
+		}
+	}
+}
+
+// blockByNumber returns the canonical block with the given number, or nil.
+func (c *chain) blockByNumber(number uint64) *block {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	h, ok := c.canonical[number]
+	if !ok {
+		return nil
+	}
+	return c.blocks[h]
+}
+
+// blockByHash returns the block with the given hash, or nil.
+func (c *chain) blockByHash(hash common.Hash) *block {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.blocks[hash]
+}
+
+// labeledBlock returns the head, safe or finalized block.
+func (c *chain) labeledBlock(label string) *block {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	switch label {
+	case "safe":
+		return c.blocks[c.safe]
+	case "finalized":
+		return c.blocks[c.finalized]
+	default:
+		return c.head
+	}
+}
+
+// blobAndProof returns the blob of a pending payload with the given versioned hash, or nil.
+func (c *chain) blobAndProof(versionedHash common.Hash) *engine.BlobAndProofV1 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.blobs[versionedHash]
+}
+
+// buildPayload builds a payload on top of the given parent, with the synthetic transactions and execution requests
+// the engine is configured with.
+func (c *chain) buildPayload(parent *block, attrs *engine.PayloadAttributes) (*block, error) {
+	cc := c.chainConfig()
+	header := &gethTypes.Header{
+		ParentHash: parent.Hash(),
+		Coinbase:   attrs.SuggestedFeeRecipient,
+		Root:       parent.Root(),
+		Difficulty: common.Big0,
+		Number:     new(big.Int).Add(parent.Number(), common.Big1),
+		GasLimit:   parent.GasLimit(),
+		Time:       attrs.Timestamp,
+		Extra:      extraData,
+		MixDigest:  attrs.Random,
+		BaseFee:    eip1559.CalcBaseFee(cc, parent.Header()),
+	}
+	body := &gethTypes.Body{}
+	if cc.IsShanghai(header.Number, header.Time) {
+		if attrs.Withdrawals == nil {
+			return nil, errors.New("missing withdrawals")
+		}
+		body.Withdrawals = attrs.Withdrawals
+	}
+	p := &block{blobs: &engine.BlobsBundleV1{}}
+	if cc.IsCancun(header.Number, header.Time) {
+		if attrs.BeaconRoot == nil {
+			return nil, errors.New("missing parent beacon block root")
+		}
+		header.ParentBeaconRoot = attrs.BeaconRoot
+		var parentExcess, parentUsed uint64
+		if parent.ExcessBlobGas() != nil && parent.BlobGasUsed() != nil {
+			parentExcess, parentUsed = *parent.ExcessBlobGas(), *parent.BlobGasUsed()
+		}
+		excess := eip4844.CalcExcessBlobGas(parentExcess, parentUsed)
+		header.ExcessBlobGas = &excess
+		txs, bundle, err := c.blobTransactions(header)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not build blob transactions")
+		}
+		used := uint64(len(bundle.Blobs)) * gethParams.BlobTxBlobGasPerBlob
+		header.BlobGasUsed = &used
+		body.Transactions, p.blobs = txs, bundle
+	}
+	if cc.IsPrague(header.Number, header.Time) {
+		requests := make([][]byte, 0)
+		if c.cfg.executionRequests {
+			var err error
+			requests, err = syntheticRequests(header)
+			if err != nil {
+				return nil, errors.Wrap(err, "could not build execution requests")
+			}
+		}
+		h := gethTypes.CalcRequestsHash(requests)
+		header.RequestsHash = &h
+		p.requests = requests
+	}
+	p.Block = gethTypes.NewBlock(header, body, nil, trie.NewStackTrie(nil))
+	return p, nil
+}
+
+// payloadToBlock returns the block of a payload. The requests are passed with their type prefix, as in the engine
+// API, so the requests hash is set here rather than by go-ethereum, which expects them without it.
+func payloadToBlock(data engine.ExecutableData, versionedHashes []common.Hash, beaconRoot *common.Hash, requests [][]byte) (*gethTypes.Block, error) {
+	b, err := engine.ExecutableDataToBlockNoHash(data, versionedHashes, beaconRoot, nil)
+	if err != nil || requests == nil {
+		return b, err
+	}
+	header := b.Header()
+	h := gethTypes.CalcRequestsHash(requests)
+	header.RequestsHash = &h
+	return gethTypes.NewBlockWithHeader(header).WithBody(*b.Body()), nil
+}
+
+// payloadID derives the ID of a payload from its parent and attributes.
+func payloadID(parent common.Hash, attrs *engine.PayloadAttributes) (engine.PayloadID, error) {
+	h := sha256.New()
+	h.Write(parent[:])
+	h.Write(binary.BigEndian.AppendUint64(nil, attrs.Timestamp))
+	h.Write(attrs.Random[:])
+	h.Write(attrs.SuggestedFeeRecipient[:])
+	if attrs.BeaconRoot != nil {
+		h.Write(attrs.BeaconRoot[:])
+	}
+	if attrs.Withdrawals != nil {
+		if err := rlp.Encode(h, attrs.Withdrawals); err != nil {
+			return engine.PayloadID{}, err
+		}
+	}
+	var id engine.PayloadID
+	copy(id[:], h.Sum(nil))
+	return id, nil
+}
+
+func validStatus(hash common.Hash) engine.PayloadStatusV1 {
+	return engine.PayloadStatusV1{Status: engine.VALID, LatestValidHash: &hash}
+}
+
+func invalidStatus(status string, latestValidHash common.Hash, err error) engine.PayloadStatusV1 {
+	msg := err.Error()
+	log.WithError(err).Warn("Received invalid payload")
+	return engine.PayloadStatusV1{Status: status, LatestValidHash: &latestValidHash, ValidationError: &msg}
+}
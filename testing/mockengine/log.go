@@ -0,0 +1,5 @@
+package mockengine
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "mock-el")
@@ -0,0 +1,77 @@
+package mockengine
+
+import (
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/pkg/errors"
+)
+
+type config struct {
+	host              string
+	port              int
+	secret            []byte
+	genesis           *core.Genesis
+	blobsPerBlock     int
+	executionRequests bool
+}
+
+// Option configures the mock execution engine.
+type Option func(s *Server) error
+
+// WithHost sets the host the engine API is served on.
+func WithHost(host string) Option {
+	return func(s *Server) error {
+		s.cfg.host = host
+		return nil
+	}
+}
+
+// WithPort sets the port the engine API is served on.
+func WithPort(port int) Option {
+	return func(s *Server) error {
+		s.cfg.port = port
+		return nil
+	}
+}
+
+// WithJwtSecret requires requests to be authenticated with a JWT token signed with the given secret.
+func WithJwtSecret(secret []byte) Option {
+	return func(s *Server) error {
+		if len(secret) == 0 {
+			return errors.New("empty jwt secret")
+		}
+		s.cfg.secret = secret
+		return nil
+	}
+}
+
+// WithGenesis sets the genesis of the execution chain, which must be the one of the beacon chain genesis state.
+func WithGenesis(genesis *core.Genesis) Option {
+	return func(s *Server) error {
+		if genesis == nil || genesis.Config == nil {
+			return errors.New("genesis without chain config")
+		}
+		s.cfg.genesis = genesis
+		return nil
+	}
+}
+
+// WithBlobsPerBlock adds blob transactions with the given number of blobs to the payloads built after Cancun,
+// up to the maximum number of blobs per block.
+func WithBlobsPerBlock(n int) Option {
+	return func(s *Server) error {
+		if n < 0 {
+			return errors.Errorf("invalid number of blobs per block %d", n)
+		}
+		s.cfg.blobsPerBlock = n
+		return nil
+	}
+}
+
+// WithExecutionRequests adds a deposit, a withdrawal and a consolidation request to the payloads built after
+// Prague. The requests are for unknown validators, so that they are processed and then ignored by the beacon chain.
+func WithExecutionRequests() Option {
+	return func(s *Server) error {
+		s.cfg.executionRequests = true
+		return nil
+	}
+}
@@ -0,0 +1,133 @@
+package mockengine
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	gethParams "github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	pb "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+)
+
+// blobTxRecipient is the recipient of the synthetic blob transactions.
+var blobTxRecipient = common.HexToAddress("0x00000000000000000000000000000000000b10b5")
+
+// blobTransactions returns the synthetic blob transactions of a payload, one per blob, along with their blobs.
+// The transactions are not signed, since they are never executed.
+func (c *chain) blobTransactions(header *gethTypes.Header) ([]*gethTypes.Transaction, *engine.BlobsBundleV1, error) {
+	n := c.cfg.blobsPerBlock
+	maxBlobs := params.BeaconConfig().DeprecatedMaxBlobsPerBlock
+	if c.chainConfig().IsPrague(header.Number, header.Time) {
+		maxBlobs = params.BeaconConfig().DeprecatedMaxBlobsPerBlockElectra
+	}
+	n = min(n, maxBlobs)
+	bundle := &engine.BlobsBundleV1{
+		Commitments: make([]hexutil.Bytes, 0, n),
+		Proofs:      make([]hexutil.Bytes, 0, n),
+		Blobs:       make([]hexutil.Bytes, 0, n),
+	}
+	txs := make([]*gethTypes.Transaction, 0, n)
+	for i := 0; i < n; i++ {
+		blob := syntheticBlob(header, i)
+		commitment, err := kzg4844.BlobToCommitment(blob)
+		if err != nil {
+			return nil, nil, err
+		}
+		proof, err := kzg4844.ComputeBlobProof(blob, commitment)
+		if err != nil {
+			return nil, nil, err
+		}
+		txs = append(txs, gethTypes.NewTx(&gethTypes.BlobTx{
+			ChainID:    uint256.MustFromBig(c.chainConfig().ChainID),
+			Nonce:      header.Number.Uint64()*uint64(maxBlobs) + uint64(i),
+			GasFeeCap:  uint256.MustFromBig(header.BaseFee),
+			Gas:        gethParams.TxGas,
+			To:         blobTxRecipient,
+			BlobFeeCap: uint256.MustFromBig(eip4844.CalcBlobFee(*header.ExcessBlobGas)),
+			BlobHashes: []common.Hash{kzgToVersionedHash(commitment[:])},
+		}))
+		bundle.Commitments = append(bundle.Commitments, commitment[:])
+		bundle.Proofs = append(bundle.Proofs, proof[:])
+		bundle.Blobs = append(bundle.Blobs, blob[:])
+	}
+	return txs, bundle, nil
+}
+
+// syntheticBlob returns the i-th blob of the payload with the given header. Its content depends on the parent and
+// timestamp of the payload, so that blobs differ between payloads.
+func syntheticBlob(header *gethTypes.Header, i int) *kzg4844.Blob {
+	seed := binary.BigEndian.AppendUint64(header.ParentHash.Bytes(), header.Time)
+	seed = binary.BigEndian.AppendUint64(seed, uint64(i))
+	data := deterministicBytes(seed, "blob", gethParams.BlobTxFieldElementsPerBlob*31)
+	blob := &kzg4844.Blob{}
+	// The first byte of every field element is left to zero, so that it is below the modulus of the field.
+	for j := 0; j < gethParams.BlobTxFieldElementsPerBlob; j++ {
+		copy(blob[j*32+1:(j+1)*32], data[j*31:])
+	}
+	return blob
+}
+
+// syntheticRequests returns a deposit, a withdrawal and a consolidation request, encoded as in the engine API. They
+// are for public keys which are not validator keys, and the deposit signature is invalid, so the beacon chain
+// processes and then ignores them.
+func syntheticRequests(header *gethTypes.Header) ([][]byte, error) {
+	number := header.Number.Uint64()
+	seed := binary.BigEndian.AppendUint64(header.ParentHash.Bytes(), number)
+	address := deterministicBytes(seed, "address", fieldparams.FeeRecipientLength)
+	credentials := append([]byte{params.BeaconConfig().ETH1AddressWithdrawalPrefixByte}, make([]byte, 11)...)
+	requests := &pb.ExecutionRequests{
+		Deposits: []*pb.DepositRequest{{
+			Pubkey:                deterministicBytes(seed, "deposit", fieldparams.BLSPubkeyLength),
+			WithdrawalCredentials: append(credentials, address...),
+			Amount:                params.BeaconConfig().MinActivationBalance,
+			Signature:             deterministicBytes(seed, "signature", fieldparams.BLSSignatureLength),
+			Index:                 number,
+		}},
+		Withdrawals: []*pb.WithdrawalRequest{{
+			SourceAddress:   address,
+			ValidatorPubkey: deterministicBytes(seed, "withdrawal", fieldparams.BLSPubkeyLength),
+		}},
+		Consolidations: []*pb.ConsolidationRequest{{
+			SourceAddress: address,
+			SourcePubkey:  deterministicBytes(seed, "source", fieldparams.BLSPubkeyLength),
+			TargetPubkey:  deterministicBytes(seed, "target", fieldparams.BLSPubkeyLength),
+		}},
+	}
+	encoded, err := pb.EncodeExecutionRequests(requests)
+	if err != nil {
+		return nil, err
+	}
+	flat := make([][]byte, len(encoded))
+	for i := range encoded {
+		flat[i] = encoded[i]
+	}
+	return flat, nil
+}
+
+// deterministicBytes expands the seed and label to n bytes.
+func deterministicBytes(seed []byte, label string, n int) []byte {
+	b := make([]byte, 0, n+sha256.Size)
+	for counter := uint64(0); len(b) < n; counter++ {
+		h := sha256.New()
+		h.Write(seed)
+		h.Write([]byte(label))
+		h.Write(binary.BigEndian.AppendUint64(nil, counter))
+		b = h.Sum(b)
+	}
+	return b[:n]
+}
+
+// kzgToVersionedHash implements kzg_to_versioned_hash from EIP-4844.
+func kzgToVersionedHash(commitment []byte) common.Hash {
+	var c kzg4844.Commitment
+	copy(c[:], commitment)
+	return kzg4844.CalcBlobHashV1(sha256.New(), &c)
+}
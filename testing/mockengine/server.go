@@ -0,0 +1,147 @@
+// Package mockengine provides an in-process execution engine serving the engine API, for running a local beacon
+// chain without an execution client. Payloads are built deterministically, with optional synthetic blob
+// transactions and execution requests, and transactions are never executed.
+package mockengine
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	defaultHost = "127.0.0.1"
+	defaultPort = 8551
+)
+
+// jwtIatTolerance is the maximum difference between the issued at claim of a token and the time it is received,
+// as required by the engine API authentication spec.
+const jwtIatTolerance = 60 * time.Second
+
+// Server serves the engine API, and the eth_ methods used by the beacon node, over http.
+type Server struct {
+	cfg     *config
+	address string
+	chain   *chain
+	rpc     *gethRPC.Server
+	srv     *http.Server
+}
+
+// New creates a mock execution engine, whose chain starts at the configured genesis.
+func New(opts ...Option) (*Server, error) {
+	s := &Server{
+		cfg: &config{
+			host: defaultHost,
+			port: defaultPort,
+		},
+	}
+	for _, o := range opts {
+		if err := o(s); err != nil {
+			return nil, err
+		}
+	}
+	if s.cfg.genesis == nil {
+		return nil, errors.New("must provide a genesis for the execution chain")
+	}
+	s.chain = newChain(s.cfg)
+	eth, err := newEthAPI(s.chain)
+	if err != nil {
+		return nil, err
+	}
+	s.rpc = gethRPC.NewServer()
+	if err := s.rpc.RegisterName("engine", &engineAPI{chain: s.chain}); err != nil {
+		return nil, errors.Wrap(err, "could not register engine api")
+	}
+	if err := s.rpc.RegisterName("eth", eth); err != nil {
+		return nil, errors.Wrap(err, "could not register eth api")
+	}
+	s.address = net.JoinHostPort(s.cfg.host, fmt.Sprintf("%d", s.cfg.port))
+	s.srv = &http.Server{
+		Handler:           s,
+		Addr:              s.address,
+		ReadHeaderTimeout: time.Second,
+	}
+	return s, nil
+}
+
+// Address of the server.
+func (s *Server) Address() string {
+	return s.address
+}
+
+// GenesisHash returns the hash of the genesis block of the execution chain.
+func (s *Server) GenesisHash() common.Hash {
+	return s.chain.blockByNumber(0).Hash()
+}
+
+// Start serves the engine API until the context is canceled.
+func (s *Server) Start(ctx context.Context) error {
+	listener, err := net.Listen("tcp", s.address)
+	if err != nil {
+		return errors.Wrapf(err, "could not listen on %s", s.address)
+	}
+	log.WithFields(logrus.Fields{
+		"address":     listener.Addr().String(),
+		"genesisHash": s.GenesisHash(),
+		"chainId":     s.chain.chainConfig().ChainID,
+		"jwt":         len(s.cfg.secret) > 0,
+	}).Info("Mock execution engine listening")
+	errs := make(chan error, 1)
+	go func() {
+		errs <- s.srv.Serve(listener)
+	}()
+	select {
+	case <-ctx.Done():
+		s.rpc.Stop()
+		return s.srv.Shutdown(context.Background())
+	case err := <-errs:
+		return err
+	}
+}
+
+// ServeHTTP authenticates a request and passes it to the JSON-RPC server.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := s.authenticate(r); err != nil {
+		log.WithError(err).Debug("Rejected unauthenticated request")
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	s.rpc.ServeHTTP(w, r)
+}
+
+// authenticate checks the JWT token of a request, when a secret is configured.
+func (s *Server) authenticate(r *http.Request) error {
+	if len(s.cfg.secret) == 0 {
+		return nil
+	}
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return errors.New("missing bearer token")
+	}
+	claims := &jwt.RegisteredClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.Errorf("unexpected signing method %v", t.Header["alg"])
+		}
+		return s.cfg.secret, nil
+	}, jwt.WithoutClaimsValidation())
+	if err != nil {
+		return errors.Wrap(err, "invalid token")
+	}
+	if claims.IssuedAt == nil {
+		return errors.New("missing issued at claim")
+	}
+	if d := time.Since(claims.IssuedAt.Time); d > jwtIatTolerance || d < -jwtIatTolerance {
+		return errors.Errorf("stale token issued at %v", claims.IssuedAt.Time)
+	}
+	return nil
+}
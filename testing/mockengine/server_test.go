@@ -0,0 +1,203 @@
+package mockengine
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/network"
+	"github.com/prysmaticlabs/prysm/v5/network/authorization"
+	pb "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/interop"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+var testSecret = []byte("0123456789abcdef0123456789abcdef")
+
+// forkchoiceUpdatedResponse is the response to forkchoiceUpdated, as decoded by the beacon node.
+type forkchoiceUpdatedResponse struct {
+	Status    *pb.PayloadStatus  `json:"payloadStatus"`
+	PayloadId *pb.PayloadIDBytes `json:"payloadId"`
+}
+
+// setupServer serves a mock engine whose chain is at Prague from genesis, and returns a client authenticated the
+// same way as the beacon node.
+func setupServer(t *testing.T, opts ...Option) (*Server, *gethRPC.Client, *httptest.Server) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch, cfg.BellatrixForkEpoch, cfg.CapellaForkEpoch, cfg.DenebForkEpoch, cfg.ElectraForkEpoch = 0, 0, 0, 0, 0
+	params.OverrideBeaconConfig(cfg)
+	genesis := interop.GethTestnetGenesis(uint64(time.Now().Unix()), cfg)
+	s, err := New(append([]Option{WithGenesis(genesis), WithJwtSecret(testSecret)}, opts...)...)
+	require.NoError(t, err)
+	srv := httptest.NewServer(s)
+	t.Cleanup(srv.Close)
+	client, err := network.NewExecutionRPCClient(context.Background(), network.Endpoint{
+		Url:  srv.URL,
+		Auth: network.AuthorizationData{Method: authorization.Bearer, Value: string(testSecret)},
+	}, nil)
+	require.NoError(t, err)
+	t.Cleanup(client.Close)
+	return s, client, srv
+}
+
+func payloadAttributes(timestamp uint64) *pb.PayloadAttributesV3 {
+	return &pb.PayloadAttributesV3{
+		Timestamp:             timestamp,
+		PrevRandao:            make([]byte, 32),
+		SuggestedFeeRecipient: common.HexToAddress("0xfee").Bytes(),
+		Withdrawals: []*pb.Withdrawal{{
+			Index:          1,
+			ValidatorIndex: 2,
+			Address:        common.HexToAddress("0xabc").Bytes(),
+			Amount:         3,
+		}},
+		ParentBeaconBlockRoot: make([]byte, 32),
+	}
+}
+
+func TestServer_BuildAndImportPayload(t *testing.T) {
+	s, client, _ := setupServer(t, WithBlobsPerBlock(2), WithExecutionRequests())
+	ctx := context.Background()
+
+	var capabilities []string
+	require.NoError(t, client.CallContext(ctx, &capabilities, "engine_exchangeCapabilities", []string{"engine_getPayloadV4"}))
+	require.Equal(t, true, strings.Contains(strings.Join(capabilities, ","), "engine_getPayloadV4"))
+
+	genesis := &pb.ExecutionBlock{}
+	require.NoError(t, client.CallContext(ctx, genesis, "eth_getBlockByNumber", "0x0", false))
+	require.Equal(t, s.GenesisHash(), genesis.Hash)
+
+	state := &pb.ForkchoiceState{
+		HeadBlockHash:      genesis.Hash[:],
+		SafeBlockHash:      genesis.Hash[:],
+		FinalizedBlockHash: genesis.Hash[:],
+	}
+	attrs := payloadAttributes(genesis.Time + 12)
+	fcu := &forkchoiceUpdatedResponse{}
+	require.NoError(t, client.CallContext(ctx, fcu, "engine_forkchoiceUpdatedV3", state, attrs))
+	require.Equal(t, pb.PayloadStatus_VALID, fcu.Status.Status)
+	require.NotNil(t, fcu.PayloadId)
+	again := &forkchoiceUpdatedResponse{}
+	require.NoError(t, client.CallContext(ctx, again, "engine_forkchoiceUpdatedV3", state, attrs))
+	require.DeepEqual(t, fcu.PayloadId, again.PayloadId)
+
+	bundle := &pb.ExecutionBundleElectra{}
+	require.NoError(t, client.CallContext(ctx, bundle, "engine_getPayloadV4", fcu.PayloadId))
+	payload := bundle.Payload
+	require.Equal(t, uint64(1), payload.BlockNumber)
+	require.Equal(t, 1, len(payload.Withdrawals))
+	require.Equal(t, 2, len(payload.Transactions))
+	require.Equal(t, 2, len(bundle.BlobsBundle.Blobs))
+	versionedHashes := make([]common.Hash, len(bundle.BlobsBundle.KzgCommitments))
+	for i := range bundle.BlobsBundle.Blobs {
+		var blob kzg4844.Blob
+		var commitment kzg4844.Commitment
+		var proof kzg4844.Proof
+		copy(blob[:], bundle.BlobsBundle.Blobs[i])
+		copy(commitment[:], bundle.BlobsBundle.KzgCommitments[i])
+		copy(proof[:], bundle.BlobsBundle.Proofs[i])
+		require.NoError(t, kzg4844.VerifyBlobProof(&blob, commitment, proof))
+		versionedHashes[i] = kzgToVersionedHash(commitment[:])
+	}
+	requests, err := bundle.GetDecodedExecutionRequests()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(requests.Deposits))
+	require.Equal(t, 1, len(requests.Withdrawals))
+	require.Equal(t, 1, len(requests.Consolidations))
+
+	// The blobs of a pending payload are served until it is the parent of the head.
+	var blobs []*pb.BlobAndProofJson
+	require.NoError(t, client.CallContext(ctx, &blobs, "engine_getBlobsV1", versionedHashes))
+	require.Equal(t, 2, len(blobs))
+	require.NotNil(t, blobs[0])
+
+	// The payload is imported the way the beacon node sends it.
+	encodedRequests, err := pb.EncodeExecutionRequests(requests)
+	require.NoError(t, err)
+	beaconRoot := common.BytesToHash(attrs.ParentBeaconBlockRoot)
+	status := &pb.PayloadStatus{}
+	require.NoError(t, client.CallContext(ctx, status, "engine_newPayloadV4", payload, versionedHashes, &beaconRoot, encodedRequests))
+	require.Equal(t, pb.PayloadStatus_VALID, status.Status)
+	require.DeepEqual(t, payload.BlockHash, status.LatestValidHash)
+
+	state.HeadBlockHash = payload.BlockHash
+	require.NoError(t, client.CallContext(ctx, fcu, "engine_forkchoiceUpdatedV3", state, nil))
+	require.Equal(t, pb.PayloadStatus_VALID, fcu.Status.Status)
+	head := &pb.ExecutionBlock{}
+	require.NoError(t, client.CallContext(ctx, head, "eth_getBlockByNumber", "latest", false))
+	require.DeepEqual(t, payload.BlockHash, head.Hash[:])
+	require.NoError(t, client.CallContext(ctx, &blobs, "engine_getBlobsV1", versionedHashes))
+	require.Equal(t, true, blobs[0] == nil)
+
+	var bodies []*pb.ExecutionPayloadBody
+	require.NoError(t, client.CallContext(ctx, &bodies, "engine_getPayloadBodiesByRangeV1", hexutil.Uint64(1), hexutil.Uint64(2)))
+	require.Equal(t, 1, len(bodies))
+	require.Equal(t, 2, len(bodies[0].Transactions))
+}
+
+func TestServer_NewPayloadInvalid(t *testing.T) {
+	s, client, _ := setupServer(t)
+	ctx := context.Background()
+	genesisHash := s.GenesisHash()
+	state := &pb.ForkchoiceState{
+		HeadBlockHash:      genesisHash[:],
+		SafeBlockHash:      genesisHash[:],
+		FinalizedBlockHash: genesisHash[:],
+	}
+	fcu := &forkchoiceUpdatedResponse{}
+	require.NoError(t, client.CallContext(ctx, fcu, "engine_forkchoiceUpdatedV3", state, payloadAttributes(uint64(time.Now().Unix())+12)))
+	bundle := &pb.ExecutionBundleElectra{}
+	require.NoError(t, client.CallContext(ctx, bundle, "engine_getPayloadV4", fcu.PayloadId))
+	beaconRoot := common.Hash{}
+	requests := []hexutil.Bytes{}
+
+	payload := bundle.Payload
+	payload.GasLimit++
+	status := &pb.PayloadStatus{}
+	require.NoError(t, client.CallContext(ctx, status, "engine_newPayloadV4", payload, []common.Hash{}, &beaconRoot, requests))
+	require.Equal(t, pb.PayloadStatus_INVALID_BLOCK_HASH, status.Status)
+
+	payload.ParentHash = common.HexToHash("0x01").Bytes()
+	require.NoError(t, client.CallContext(ctx, status, "engine_newPayloadV4", payload, []common.Hash{}, &beaconRoot, requests))
+	require.Equal(t, pb.PayloadStatus_SYNCING, status.Status)
+
+	var unknown *pb.ExecutionBundleElectra
+	err := client.CallContext(ctx, &unknown, "engine_getPayloadV4", pb.PayloadIDBytes{1})
+	require.ErrorContains(t, "Unknown payload", err)
+}
+
+func TestServer_Authentication(t *testing.T) {
+	_, _, srv := setupServer(t)
+	request := func(token string) int {
+		req, err := http.NewRequest(http.MethodPost, srv.URL, strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"eth_chainId","params":[]}`))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		return resp.StatusCode
+	}
+	sign := func(secret []byte, issuedAt time.Time) string {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"iat": issuedAt.Unix()}).SignedString(secret)
+		require.NoError(t, err)
+		return token
+	}
+
+	require.Equal(t, http.StatusOK, request(sign(testSecret, time.Now())))
+	require.Equal(t, http.StatusUnauthorized, request(""))
+	require.Equal(t, http.StatusUnauthorized, request(sign([]byte("another secret"), time.Now())))
+	require.Equal(t, http.StatusUnauthorized, request(sign(testSecret, time.Now().Add(-2*jwtIatTolerance))))
+}
@@ -15,6 +15,7 @@ import (
 
 	beacon "github.com/prysmaticlabs/prysm/v5/api/client/beacon"
 	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	iface "github.com/prysmaticlabs/prysm/v5/validator/client/iface"
 	gomock "go.uber.org/mock/gomock"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
@@ -42,6 +43,21 @@ func (m *MockNodeClient) EXPECT() *MockNodeClientMockRecorder {
 	return m.recorder
 }
 
+// ClientVersions mocks base method.
+func (m *MockNodeClient) ClientVersions(arg0 context.Context) (*iface.ClientVersions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClientVersions", arg0)
+	ret0, _ := ret[0].(*iface.ClientVersions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClientVersions indicates an expected call of ClientVersions.
+func (mr *MockNodeClientMockRecorder) ClientVersions(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClientVersions", reflect.TypeOf((*MockNodeClient)(nil).ClientVersions), arg0)
+}
+
 // Genesis mocks base method.
 func (m *MockNodeClient) Genesis(arg0 context.Context, arg1 *emptypb.Empty) (*eth.Genesis, error) {
 	m.ctrl.T.Helper()
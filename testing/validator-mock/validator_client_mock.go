@@ -192,6 +192,21 @@ func (mr *MockValidatorClientMockRecorder) Host() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Host", reflect.TypeOf((*MockValidatorClient)(nil).Host))
 }
 
+// Liveness mocks base method.
+func (m *MockValidatorClient) Liveness(arg0 context.Context, arg1 primitives.Epoch, arg2 []primitives.ValidatorIndex) ([]*iface.ValidatorLiveness, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Liveness", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*iface.ValidatorLiveness)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Liveness indicates an expected call of Liveness.
+func (mr *MockValidatorClientMockRecorder) Liveness(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Liveness", reflect.TypeOf((*MockValidatorClient)(nil).Liveness), arg0, arg1, arg2)
+}
+
 // MultipleValidatorStatus mocks base method.
 func (m *MockValidatorClient) MultipleValidatorStatus(arg0 context.Context, arg1 *eth.MultipleValidatorStatusRequest) (*eth.MultipleValidatorStatusResponse, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,190 @@
+/**
+ * Fork choice simulator
+ *
+ * Replays a fork choice trace recorded by a beacon node started with
+ * --forkchoice-trace-file, printing the head after every head computation
+ * and comparing it with the recorded one. Fork choice parameters, like the
+ * proposer score boost and the late block reorg thresholds, can be changed
+ * to see how the node would have behaved with different values.
+ */
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/tracer"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	tracePath      = flag.String("trace", "", "Path to the fork choice trace to replay.")
+	configFile     = flag.String("config-file", "", "Path to a chain config file. Defaults to the config of the network the trace was recorded on.")
+	network        = flag.String("network", "", "Name of the network config to use, overriding the one recorded in the trace.")
+	scoreBoost     = flag.Uint64("proposer-score-boost", 0, "Proposer score boost, as a percentage of the committee weight.")
+	reorgWeight    = flag.Uint64("reorg-weight-threshold", 0, "Maximum weight of a late head to be reorged, as a percentage of the committee weight.")
+	reorgParent    = flag.Uint64("reorg-parent-weight-threshold", 0, "Minimum weight of the parent of a late head to reorg it, as a percentage of the committee weight.")
+	reorgEpochs    = flag.Uint64("reorg-max-epochs-since-finalization", 0, "Maximum number of epochs since finalization to reorg a late head.")
+	verbose        = flag.Bool("verbose", false, "Print every event of the trace, not only the head computations.")
+	stopOnMismatch = flag.Bool("stop-on-mismatch", false, "Stop at the first head that differs from the recorded one.")
+)
+
+func main() {
+	flag.Parse()
+	if *tracePath == "" {
+		log.Fatal("Please specify --trace <path> to the fork choice trace to replay")
+	}
+	f, err := os.Open(*tracePath) // #nosec G304
+	if err != nil {
+		log.WithError(err).Fatal("Could not open trace")
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Error("Could not close trace")
+		}
+	}()
+
+	r := tracer.NewReader(f)
+	first, err := r.Next()
+	if err != nil {
+		log.WithError(err).Fatal("Could not read the first event of the trace")
+	}
+	if err := setConfig(first.ConfigName); err != nil {
+		log.WithError(err).Fatal("Could not set the chain config")
+	}
+	if err := replay(context.Background(), r, first); err != nil {
+		log.WithError(err).Fatal("Could not replay trace")
+	}
+}
+
+// setConfig activates the chain config of the trace, unless another one was specified, and applies the
+// fork choice parameters given on the command line.
+func setConfig(recorded string) error {
+	switch {
+	case *configFile != "":
+		if err := params.LoadChainConfigFile(*configFile, nil); err != nil {
+			return err
+		}
+	case *network != "" || recorded != "":
+		name := *network
+		if name == "" {
+			name = recorded
+		}
+		cfg, err := params.ByName(name)
+		if err != nil {
+			return err
+		}
+		if err := params.SetActive(cfg); err != nil {
+			return err
+		}
+	}
+	cfg := params.BeaconConfig().Copy()
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "proposer-score-boost":
+			cfg.ProposerScoreBoost = *scoreBoost
+		case "reorg-weight-threshold":
+			cfg.ReorgWeightThreshold = *reorgWeight
+		case "reorg-parent-weight-threshold":
+			cfg.ReorgParentWeightThreshold = *reorgParent
+		case "reorg-max-epochs-since-finalization":
+			cfg.ReorgMaxEpochsSinceFinalization = primitives.Epoch(*reorgEpochs)
+		}
+	})
+	params.OverrideBeaconConfig(cfg)
+	fmt.Printf("config=%s proposer_score_boost=%d reorg_weight_threshold=%d reorg_parent_weight_threshold=%d reorg_max_epochs_since_finalization=%d\n",
+		cfg.ConfigName, cfg.ProposerScoreBoost, cfg.ReorgWeightThreshold, cfg.ReorgParentWeightThreshold, cfg.ReorgMaxEpochsSinceFinalization)
+	return nil
+}
+
+// replay applies the events of the trace starting with the given one. Heads are only computed where the node
+// computed them, since computing the head updates the justified balances, which are only recorded when the
+// node requested them.
+func replay(ctx context.Context, r *tracer.Reader, e *tracer.Event) error {
+	rp := tracer.NewReplayer()
+	fc := rp.ForkChoice()
+	var events, heads, mismatches, failures int
+	for ; ; events++ {
+		err := rp.Apply(ctx, e)
+		if e.Type == tracer.EventSnapshot && err != nil {
+			return err
+		}
+		if err != nil && err.Error() != e.Error {
+			failures++
+			fmt.Printf("%s %-19s error=%q recorded_error=%q\n", e.Time.Format("15:04:05.000"), e.Type, err, e.Error)
+		}
+		switch {
+		case e.Type == tracer.EventHead:
+			heads++
+			head := fc.CachedHeadRoot()
+			slot, err := fc.Slot(head)
+			if err != nil {
+				return err
+			}
+			line := fmt.Sprintf("%s %-19s slot=%d head=%#x", e.Time.Format("15:04:05.000"), e.Type, slot, bytesutil.Trunc(head[:]))
+			if recorded := bytesutil.ToBytes32(e.Root); recorded != head {
+				mismatches++
+				line += fmt.Sprintf(" MISMATCH recorded=%#x", bytesutil.Trunc(recorded[:]))
+			}
+			if proposerHead := fc.GetProposerHead(); proposerHead != head {
+				line += fmt.Sprintf(" proposer_head=%#x", bytesutil.Trunc(proposerHead[:]))
+			}
+			if fc.ShouldOverrideFCU() {
+				line += " override_fcu=true"
+			}
+			fmt.Println(line)
+			if *stopOnMismatch && mismatches > 0 {
+				return nil
+			}
+		case *verbose:
+			fmt.Printf("%s %-19s %s\n", e.Time.Format("15:04:05.000"), e.Type, describe(e))
+		}
+
+		e, err = r.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	fmt.Printf("events=%d heads=%d mismatches=%d errors=%d\n", events+1, heads, mismatches, failures)
+	return nil
+}
+
+// describe summarizes an event which is not a head computation.
+func describe(e *tracer.Event) string {
+	switch e.Type {
+	case tracer.EventInsertNode:
+		if e.Block == nil {
+			return ""
+		}
+		return fmt.Sprintf("slot=%d root=%#x parent=%#x", e.Block.Slot, bytesutil.Trunc(e.Block.Root), bytesutil.Trunc(e.Block.ParentRoot))
+	case tracer.EventInsertChain:
+		return fmt.Sprintf("blocks=%d", len(e.Chain))
+	case tracer.EventProcessAttestation:
+		return fmt.Sprintf("root=%#x target_epoch=%d validators=%d", bytesutil.Trunc(e.Root), e.TargetEpoch, len(e.Indices))
+	case tracer.EventNewSlot:
+		return fmt.Sprintf("slot=%d", e.Slot)
+	case tracer.EventSlashedIndex:
+		return fmt.Sprintf("validator=%d", e.ValidatorIndex)
+	case tracer.EventInvalidPayload, tracer.EventValidPayload:
+		return fmt.Sprintf("root=%#x", bytesutil.Trunc(e.Root))
+	case tracer.EventJustifiedCheckpoint, tracer.EventFinalizedCheckpoint:
+		if e.Checkpoint == nil {
+			return ""
+		}
+		return fmt.Sprintf("epoch=%d root=%#x", e.Checkpoint.Epoch, bytesutil.Trunc(e.Checkpoint.Root))
+	case tracer.EventJustifiedBalances:
+		return fmt.Sprintf("root=%#x validators=%d", bytesutil.Trunc(e.Root), len(e.Balances))
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,216 @@
+package accounts
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/contracts/deposit"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/derived"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+const (
+	// DepositDataFilePrefix is the prefix of the deposit data file name, followed by a timestamp.
+	DepositDataFilePrefix = "deposit_data-"
+	// KeystoreFilePrefix is the prefix of the keystore file names, followed by the derivation path and a timestamp.
+	KeystoreFilePrefix = "keystore-"
+	// depositCLIVersion is the version of the staking-deposit-cli whose deposit data format is produced,
+	// checked by the staking launchpad.
+	depositCLIVersion = "2.7.0"
+	// validatingKeyPathFilenameTemplate is the derivation path of a validating key in a keystore file name.
+	validatingKeyPathFilenameTemplate = "m_12381_3600_%d_0_0"
+)
+
+// DepositDataJSON is the deposit data of a validator, in the format of the deposit_data.json
+// files produced by the staking-deposit-cli.
+type DepositDataJSON struct {
+	PubKey                string `json:"pubkey"`
+	WithdrawalCredentials string `json:"withdrawal_credentials"`
+	Amount                uint64 `json:"amount"`
+	Signature             string `json:"signature"`
+	DepositMessageRoot    string `json:"deposit_message_root"`
+	DepositDataRoot       string `json:"deposit_data_root"`
+	ForkVersion           string `json:"fork_version"`
+	NetworkName           string `json:"network_name"`
+	DepositCLIVersion     string `json:"deposit_cli_version"`
+}
+
+// GenerateDeposits derives validating keys from a mnemonic, writes them as EIP-2335 keystores and
+// writes the deposit data of each of them to a deposit_data.json file, in the output directory.
+// Deposit signatures are verified against the active network configuration before being written.
+func (acm *CLIManager) GenerateDeposits(_ context.Context) error {
+	if acm.numAccounts <= 0 {
+		return errors.New("must generate at least 1 deposit")
+	}
+	if acm.withdrawalAddress == (common.Address{}) {
+		return errors.New("a withdrawal address is required")
+	}
+	amounts, err := acm.depositAmountsPerKey()
+	if err != nil {
+		return err
+	}
+	prefix := params.BeaconConfig().ETH1AddressWithdrawalPrefixByte
+	if acm.compoundingWithdrawals {
+		prefix = params.BeaconConfig().CompoundingWithdrawalPrefixByte
+	}
+	credentials := deposit.ExecutionAddressWithdrawalCredentials(prefix, acm.withdrawalAddress)
+
+	secretKeys, err := derived.SecretKeysFromMnemonic(
+		acm.mnemonic, acm.mnemonicLanguage, acm.mnemonic25thWord, acm.startIndex, acm.numAccounts,
+	)
+	if err != nil {
+		return errors.Wrap(err, "could not derive keys from mnemonic")
+	}
+
+	domain, err := signing.ComputeDomain(params.BeaconConfig().DomainDeposit, nil /*forkVersion*/, nil /*genesisValidatorsRoot*/)
+	if err != nil {
+		return errors.Wrap(err, "could not compute deposit domain")
+	}
+	deposits := make([]*DepositDataJSON, len(secretKeys))
+	keystores := make([]*keymanager.Keystore, len(secretKeys))
+	encryptor := keystorev4.New()
+	for i, secretKey := range secretKeys {
+		index := acm.startIndex + i
+		data, dataRoot, err := deposit.DepositInputWithCredentials(secretKey, credentials, amounts[i])
+		if err != nil {
+			return errors.Wrapf(err, "could not create deposit data for key at index %d", index)
+		}
+		if err := deposit.VerifyDepositSignature(data, domain); err != nil {
+			return errors.Wrapf(err, "could not verify deposit signature for key at index %d", index)
+		}
+		deposits[i], err = depositDataToJSON(data, dataRoot)
+		if err != nil {
+			return err
+		}
+		keystores[i], err = encryptKeystore(encryptor, secretKey, acm.keystoresPassword, index)
+		if err != nil {
+			return err
+		}
+	}
+	return writeDeposits(acm.depositsDir, acm.startIndex, keystores, deposits)
+}
+
+// depositAmountsPerKey returns the deposit amount of each key, checked against the limits
+// of the network configuration for the kind of withdrawal credentials.
+func (acm *CLIManager) depositAmountsPerKey() ([]uint64, error) {
+	cfg := params.BeaconConfig()
+	amounts := acm.depositAmounts
+	switch len(amounts) {
+	case 0:
+		amounts = []uint64{cfg.MaxEffectiveBalance}
+		fallthrough
+	case 1:
+		single := amounts[0]
+		amounts = make([]uint64, acm.numAccounts)
+		for i := range amounts {
+			amounts[i] = single
+		}
+	case acm.numAccounts:
+	default:
+		return nil, errors.Errorf("expected 1 or %d deposit amounts, got %d", acm.numAccounts, len(amounts))
+	}
+	maxAmount := cfg.MaxEffectiveBalance
+	if acm.compoundingWithdrawals {
+		maxAmount = cfg.MaxEffectiveBalanceElectra
+	}
+	for _, amount := range amounts {
+		if amount < cfg.MinDepositAmount || amount > maxAmount {
+			return nil, errors.Errorf("deposit amount %d Gwei is not between %d and %d Gwei", amount, cfg.MinDepositAmount, maxAmount)
+		}
+	}
+	return amounts, nil
+}
+
+func depositDataToJSON(data *ethpb.Deposit_Data, dataRoot [32]byte) (*DepositDataJSON, error) {
+	messageRoot, err := (&ethpb.DepositMessage{
+		PublicKey:             data.PublicKey,
+		WithdrawalCredentials: data.WithdrawalCredentials,
+		Amount:                data.Amount,
+	}).HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute deposit message root")
+	}
+	return &DepositDataJSON{
+		PubKey:                fmt.Sprintf("%x", data.PublicKey),
+		WithdrawalCredentials: fmt.Sprintf("%x", data.WithdrawalCredentials),
+		Amount:                data.Amount,
+		Signature:             fmt.Sprintf("%x", data.Signature),
+		DepositMessageRoot:    fmt.Sprintf("%x", messageRoot),
+		DepositDataRoot:       fmt.Sprintf("%x", dataRoot),
+		ForkVersion:           fmt.Sprintf("%x", params.BeaconConfig().GenesisForkVersion),
+		NetworkName:           params.BeaconConfig().ConfigName,
+		DepositCLIVersion:     depositCLIVersion,
+	}, nil
+}
+
+func encryptKeystore(encryptor *keystorev4.Encryptor, secretKey bls.SecretKey, password string, index int) (*keymanager.Keystore, error) {
+	pubKeyBytes := secretKey.PublicKey().Marshal()
+	cryptoFields, err := encryptor.Encrypt(secretKey.Marshal(), password)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not encrypt secret key for public key %#x", pubKeyBytes)
+	}
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+	return &keymanager.Keystore{
+		Crypto:      cryptoFields,
+		ID:          id.String(),
+		Pubkey:      fmt.Sprintf("%x", pubKeyBytes),
+		Version:     encryptor.Version(),
+		Description: encryptor.Name(),
+		Path:        fmt.Sprintf(derived.ValidatingKeyDerivationPathTemplate, index),
+	}, nil
+}
+
+// Writes the keystores and the deposit data to the output directory, using the file names
+// of the staking-deposit-cli. Existing files are never overwritten.
+func writeDeposits(outputDir string, startIndex int, keystores []*keymanager.Keystore, deposits []*DepositDataJSON) error {
+	if err := file.MkdirAll(outputDir); err != nil {
+		return errors.Wrapf(err, "could not create directory at path: %s", outputDir)
+	}
+	timestamp := time.Now().Unix()
+	files := make(map[string]interface{}, len(keystores)+1)
+	for i, k := range keystores {
+		name := fmt.Sprintf("%s%s-%d.json", KeystoreFilePrefix, fmt.Sprintf(validatingKeyPathFilenameTemplate, startIndex+i), timestamp)
+		files[name] = k
+	}
+	depositDataPath := filepath.Join(outputDir, fmt.Sprintf("%s%d.json", DepositDataFilePrefix, timestamp))
+	files[filepath.Base(depositDataPath)] = deposits
+
+	for name := range files {
+		path := filepath.Join(outputDir, name)
+		exists, err := file.Exists(path, file.Regular)
+		if err != nil {
+			return errors.Wrapf(err, "could not check if file exists: %s", path)
+		}
+		if exists {
+			return errors.Errorf("file already exists: %s", path)
+		}
+	}
+	for name, content := range files {
+		encoded, err := json.MarshalIndent(content, "", "\t")
+		if err != nil {
+			return errors.Wrapf(err, "could not marshal %s", name)
+		}
+		if err := file.WriteFile(filepath.Join(outputDir, name), encoded); err != nil {
+			return errors.Wrapf(err, "could not write %s", name)
+		}
+	}
+	log.WithField(
+		"depositDataPath", depositDataPath,
+	).Infof("Successfully generated %d keystores and their deposit data", len(keystores))
+	return nil
+}
@@ -0,0 +1,190 @@
+package accounts
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/contracts/deposit"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/derived"
+	constant "github.com/prysmaticlabs/prysm/v5/validator/testing"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+func TestGenerateDeposits(t *testing.T) {
+	outputDir := filepath.Join(t.TempDir(), "deposits")
+	password := "Passw0rdz4938%%"
+	address := common.HexToAddress("0x8ba1f109551bD432803012645Ac136ddd64DBA72")
+	amounts := []uint64{params.BeaconConfig().MinDepositAmount, params.BeaconConfig().MaxEffectiveBalanceElectra}
+	acm, err := NewCLIManager(
+		WithMnemonic(constant.TestMnemonic),
+		WithStartIndex(2),
+		WithNumAccounts(2),
+		WithDepositsDir(outputDir),
+		WithKeystoresPassword(password),
+		WithWithdrawalAddress(address),
+		WithCompoundingWithdrawals(true),
+		WithDepositAmounts(amounts),
+	)
+	require.NoError(t, err)
+	require.NoError(t, acm.GenerateDeposits(context.Background()))
+
+	secretKeys, err := derived.SecretKeysFromMnemonic(constant.TestMnemonic, derived.DefaultMnemonicLanguage, "", 2, 2)
+	require.NoError(t, err)
+	entries, err := os.ReadDir(outputDir)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(entries))
+
+	var deposits []*DepositDataJSON
+	decryptor := keystorev4.New()
+	for _, entry := range entries {
+		encoded, err := os.ReadFile(filepath.Join(outputDir, entry.Name()))
+		require.NoError(t, err)
+		if strings.HasPrefix(entry.Name(), DepositDataFilePrefix) {
+			require.NoError(t, json.Unmarshal(encoded, &deposits))
+			continue
+		}
+		keystore := &keymanager.Keystore{}
+		require.NoError(t, json.Unmarshal(encoded, keystore))
+		secretKeyBytes, err := decryptor.Decrypt(keystore.Crypto, password)
+		require.NoError(t, err)
+		switch {
+		case strings.HasPrefix(entry.Name(), KeystoreFilePrefix+"m_12381_3600_2_0_0-"):
+			assert.DeepEqual(t, secretKeys[0].Marshal(), secretKeyBytes)
+			assert.Equal(t, "m/12381/3600/2/0/0", keystore.Path)
+		case strings.HasPrefix(entry.Name(), KeystoreFilePrefix+"m_12381_3600_3_0_0-"):
+			assert.DeepEqual(t, secretKeys[1].Marshal(), secretKeyBytes)
+			assert.Equal(t, "m/12381/3600/3/0/0", keystore.Path)
+		default:
+			t.Fatalf("unexpected file %s", entry.Name())
+		}
+	}
+
+	require.Equal(t, 2, len(deposits))
+	domain, err := signing.ComputeDomain(params.BeaconConfig().DomainDeposit, nil, nil)
+	require.NoError(t, err)
+	wantCredentials := deposit.ExecutionAddressWithdrawalCredentials(params.BeaconConfig().CompoundingWithdrawalPrefixByte, address)
+	for i, d := range deposits {
+		assert.Equal(t, hex.EncodeToString(secretKeys[i].PublicKey().Marshal()), d.PubKey)
+		assert.Equal(t, hex.EncodeToString(wantCredentials), d.WithdrawalCredentials)
+		assert.Equal(t, amounts[i], d.Amount)
+		assert.Equal(t, hex.EncodeToString(params.BeaconConfig().GenesisForkVersion), d.ForkVersion)
+		assert.Equal(t, params.BeaconConfig().ConfigName, d.NetworkName)
+
+		pubKey, err := hex.DecodeString(d.PubKey)
+		require.NoError(t, err)
+		signature, err := hex.DecodeString(d.Signature)
+		require.NoError(t, err)
+		data := &ethpb.Deposit_Data{
+			PublicKey:             pubKey,
+			WithdrawalCredentials: wantCredentials,
+			Amount:                d.Amount,
+			Signature:             signature,
+		}
+		require.NoError(t, deposit.VerifyDepositSignature(data, domain))
+		dataRoot, err := data.HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, hex.EncodeToString(dataRoot[:]), d.DepositDataRoot)
+	}
+}
+
+func TestGenerateDeposits_ExecutionAddressCredentials(t *testing.T) {
+	outputDir := t.TempDir()
+	address := common.HexToAddress("0x8ba1f109551bD432803012645Ac136ddd64DBA72")
+	acm, err := NewCLIManager(
+		WithMnemonic(constant.TestMnemonic),
+		WithNumAccounts(3),
+		WithDepositsDir(outputDir),
+		WithKeystoresPassword("Passw0rdz4938%%"),
+		WithWithdrawalAddress(address),
+	)
+	require.NoError(t, err)
+	require.NoError(t, acm.GenerateDeposits(context.Background()))
+
+	matches, err := filepath.Glob(filepath.Join(outputDir, DepositDataFilePrefix+"*.json"))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(matches))
+	encoded, err := os.ReadFile(matches[0])
+	require.NoError(t, err)
+	var deposits []*DepositDataJSON
+	require.NoError(t, json.Unmarshal(encoded, &deposits))
+	require.Equal(t, 3, len(deposits))
+	wantCredentials := deposit.ExecutionAddressWithdrawalCredentials(params.BeaconConfig().ETH1AddressWithdrawalPrefixByte, address)
+	for _, d := range deposits {
+		assert.Equal(t, hex.EncodeToString(wantCredentials), d.WithdrawalCredentials)
+		assert.Equal(t, params.BeaconConfig().MaxEffectiveBalance, d.Amount)
+	}
+}
+
+func TestGenerateDeposits_InvalidOptions(t *testing.T) {
+	address := common.HexToAddress("0x8ba1f109551bD432803012645Ac136ddd64DBA72")
+	cfg := params.BeaconConfig()
+	tests := []struct {
+		name    string
+		opts    []Option
+		wantErr string
+	}{
+		{
+			name:    "no accounts",
+			opts:    []Option{WithWithdrawalAddress(address)},
+			wantErr: "must generate at least 1 deposit",
+		},
+		{
+			name:    "no withdrawal address",
+			opts:    []Option{WithNumAccounts(1)},
+			wantErr: "a withdrawal address is required",
+		},
+		{
+			name:    "amount count mismatch",
+			opts:    []Option{WithNumAccounts(3), WithWithdrawalAddress(address), WithDepositAmounts([]uint64{cfg.MinDepositAmount, cfg.MinDepositAmount})},
+			wantErr: "expected 1 or 3 deposit amounts, got 2",
+		},
+		{
+			name:    "amount too low",
+			opts:    []Option{WithNumAccounts(1), WithWithdrawalAddress(address), WithDepositAmounts([]uint64{cfg.MinDepositAmount - 1})},
+			wantErr: "is not between",
+		},
+		{
+			name:    "amount above execution address credentials limit",
+			opts:    []Option{WithNumAccounts(1), WithWithdrawalAddress(address), WithDepositAmounts([]uint64{cfg.MaxEffectiveBalance + 1})},
+			wantErr: "is not between",
+		},
+		{
+			name:    "invalid mnemonic",
+			opts:    []Option{WithNumAccounts(1), WithWithdrawalAddress(address), WithMnemonic("invalid mnemonic")},
+			wantErr: "could not derive keys from mnemonic",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			acm, err := NewCLIManager(append(tt.opts, WithDepositsDir(t.TempDir()))...)
+			require.NoError(t, err)
+			assert.ErrorContains(t, tt.wantErr, acm.GenerateDeposits(context.Background()))
+		})
+	}
+}
+
+func TestEncryptKeystore(t *testing.T) {
+	secretKey, err := bls.RandKey()
+	require.NoError(t, err)
+	encryptor := keystorev4.New()
+	keystore, err := encryptKeystore(encryptor, secretKey, "password", 7)
+	require.NoError(t, err)
+	assert.Equal(t, hex.EncodeToString(secretKey.PublicKey().Marshal()), keystore.Pubkey)
+	assert.Equal(t, "m/12381/3600/7/0/0", keystore.Path)
+	decrypted, err := encryptor.Decrypt(keystore.Crypto, "password")
+	require.NoError(t, err)
+	assert.DeepEqual(t, secretKey.Marshal(), decrypted)
+}
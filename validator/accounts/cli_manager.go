@@ -7,6 +7,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	grpcutil "github.com/prysmaticlabs/prysm/v5/api/grpc"
 	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
@@ -38,37 +39,43 @@ func NewCLIManager(opts ...Option) (*CLIManager, error) {
 // CLIManager defines a struct capable of performing various validator
 // wallet & account operations via the command line.
 type CLIManager struct {
-	wallet               *wallet.Wallet
-	keymanager           keymanager.IKeymanager
-	keymanagerKind       keymanager.Kind
-	showPrivateKeys      bool
-	listValidatorIndices bool
-	deletePublicKeys     bool
-	importPrivateKeys    bool
-	readPasswordFile     bool
-	skipMnemonicConfirm  bool
-	dialOpts             []grpc.DialOption
-	grpcHeaders          []string
-	beaconRPCProvider    string
-	walletKeyCount       int
-	privateKeyFile       string
-	passwordFilePath     string
-	keysDir              string
-	mnemonicLanguage     string
-	backupsDir           string
-	backupsPassword      string
-	filteredPubKeys      []bls.PublicKey
-	rawPubKeys           [][]byte
-	formattedPubKeys     []string
-	exitJSONOutputPath   string
-	walletDir            string
-	walletPassword       string
-	mnemonic             string
-	numAccounts          int
-	mnemonic25thWord     string
-	beaconApiEndpoint    string
-	beaconApiTimeout     time.Duration
-	inputReader          io.Reader
+	wallet                 *wallet.Wallet
+	keymanager             keymanager.IKeymanager
+	keymanagerKind         keymanager.Kind
+	showPrivateKeys        bool
+	listValidatorIndices   bool
+	deletePublicKeys       bool
+	importPrivateKeys      bool
+	readPasswordFile       bool
+	skipMnemonicConfirm    bool
+	dialOpts               []grpc.DialOption
+	grpcHeaders            []string
+	beaconRPCProvider      string
+	walletKeyCount         int
+	privateKeyFile         string
+	passwordFilePath       string
+	keysDir                string
+	mnemonicLanguage       string
+	backupsDir             string
+	backupsPassword        string
+	filteredPubKeys        []bls.PublicKey
+	rawPubKeys             [][]byte
+	formattedPubKeys       []string
+	exitJSONOutputPath     string
+	walletDir              string
+	walletPassword         string
+	mnemonic               string
+	numAccounts            int
+	mnemonic25thWord       string
+	startIndex             int
+	depositsDir            string
+	keystoresPassword      string
+	withdrawalAddress      common.Address
+	compoundingWithdrawals bool
+	depositAmounts         []uint64
+	beaconApiEndpoint      string
+	beaconApiTimeout       time.Duration
+	inputReader            io.Reader
 }
 
 func (acm *CLIManager) prepareBeaconClients(ctx context.Context) (*iface.ValidatorClient, *iface.NodeClient, error) {
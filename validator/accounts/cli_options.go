@@ -4,6 +4,7 @@ import (
 	"io"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v5/validator/accounts/wallet"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
@@ -252,3 +253,51 @@ func WithCustomReader(reader io.Reader) Option {
 		return nil
 	}
 }
+
+// WithStartIndex specifies the index of the first key derived from the mnemonic.
+func WithStartIndex(startIndex int) Option {
+	return func(acc *CLIManager) error {
+		acc.startIndex = startIndex
+		return nil
+	}
+}
+
+// WithDepositsDir specifies the directory keystores and deposit data are written to.
+func WithDepositsDir(depositsDir string) Option {
+	return func(acc *CLIManager) error {
+		acc.depositsDir = depositsDir
+		return nil
+	}
+}
+
+// WithKeystoresPassword specifies the password the generated keystores are encrypted with.
+func WithKeystoresPassword(keystoresPassword string) Option {
+	return func(acc *CLIManager) error {
+		acc.keystoresPassword = keystoresPassword
+		return nil
+	}
+}
+
+// WithWithdrawalAddress specifies the execution address of the withdrawal credentials of deposits.
+func WithWithdrawalAddress(withdrawalAddress common.Address) Option {
+	return func(acc *CLIManager) error {
+		acc.withdrawalAddress = withdrawalAddress
+		return nil
+	}
+}
+
+// WithCompoundingWithdrawals uses compounding (0x02) instead of execution address (0x01) withdrawal credentials.
+func WithCompoundingWithdrawals(compounding bool) Option {
+	return func(acc *CLIManager) error {
+		acc.compoundingWithdrawals = compounding
+		return nil
+	}
+}
+
+// WithDepositAmounts specifies the amounts of deposits in Gwei, either one for all or one per key.
+func WithDepositAmounts(depositAmounts []uint64) Option {
+	return func(acc *CLIManager) error {
+		acc.depositAmounts = depositAmounts
+		return nil
+	}
+}
@@ -104,6 +104,8 @@ func (_ *Wallet) InitializeKeymanager(_ context.Context, _ iface.InitKeymanagerC
 
 type Validator struct {
 	Km               keymanager.IKeymanager
+	Schedule         []*iface2.ScheduledDuties
+	History          []*iface2.DutyRecord
 	graffiti         string
 	proposerSettings *proposer.Settings
 }
@@ -178,6 +180,10 @@ func (_ *Validator) UpdateDomainDataCaches(_ context.Context, _ primitives.Slot)
 	panic("implement me")
 }
 
+func (_ *Validator) UpdateClientVersions(_ context.Context) {
+	panic("implement me")
+}
+
 func (_ *Validator) WaitForKeymanagerInitialization(_ context.Context) error {
 	panic("implement me")
 }
@@ -246,6 +252,16 @@ func (m *Validator) DeleteGraffiti(_ context.Context, _ [fieldparams.BLSPubkeyLe
 	return nil
 }
 
+// DutySchedule for mocking
+func (m *Validator) DutySchedule() []*iface2.ScheduledDuties {
+	return m.Schedule
+}
+
+// DutyHistory for mocking
+func (m *Validator) DutyHistory() []*iface2.DutyRecord {
+	return m.History
+}
+
 func (*Validator) StartEventStream(_ context.Context, _ []string, _ chan<- *event.Event) {
 	panic("implement me")
 }
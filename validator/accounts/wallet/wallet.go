@@ -19,6 +19,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/derived"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/local"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/v5/validator/keymanager/remote-web3signer"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/threshold"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 )
@@ -263,6 +264,16 @@ func NewWalletForWeb3Signer(cliCtx *cli.Context) *Wallet {
 	}
 }
 
+// NewWalletForThresholdSigner returns a new wallet for the threshold signer which is temporary and not stored locally.
+func NewWalletForThresholdSigner(cliCtx *cli.Context) *Wallet {
+	return &Wallet{
+		walletDir:      cliCtx.String(flags.WalletDirFlag.Name), // it's ok if there's an existing wallet
+		accountsPath:   "",
+		keymanagerKind: keymanager.Threshold,
+		walletPassword: "",
+	}
+}
+
 // OpenWallet instantiates a wallet from a specified path. It checks the
 // type of keymanager associated with the wallet by reading files in the wallet
 // path, if applicable. If a wallet does not exist, returns an appropriate error.
@@ -363,6 +374,14 @@ func (w *Wallet) InitializeKeymanager(ctx context.Context, cfg iface.InitKeymana
 		if err != nil {
 			return nil, errors.Wrap(err, "could not initialize web3signer keymanager")
 		}
+	case keymanager.Threshold:
+		if cfg.ThresholdSignerConfig == nil {
+			return nil, errors.New("threshold signer config is nil")
+		}
+		km, err = threshold.NewKeymanager(ctx, cfg.ThresholdSignerConfig)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not initialize threshold keymanager")
+		}
 	default:
 		return nil, fmt.Errorf("keymanager kind not supported: %s", w.keymanagerKind)
 	}
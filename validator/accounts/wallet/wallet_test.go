@@ -97,6 +97,17 @@ func TestWallet_InitializeKeymanager_web3Signer_nilConfig(t *testing.T) {
 	assert.Equal(t, nil, km)
 }
 
+func TestWallet_InitializeKeymanager_threshold_nilConfig(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(flags.WalletDirFlag.Name, filepath.Join(t.TempDir(), "new"), "")
+	w := wallet.NewWalletForThresholdSigner(cli.NewContext(&app, set, nil))
+	assert.Equal(t, keymanager.Threshold, w.KeymanagerKind())
+	km, err := w.InitializeKeymanager(context.Background(), iface.InitKeymanagerConfig{})
+	require.ErrorContains(t, "threshold signer config is nil", err)
+	assert.Equal(t, nil, km)
+}
+
 func TestOpenOrCreateNewWallet(t *testing.T) {
 	walletDir := filepath.Join(t.TempDir(), "wallet")
 	newDir := filepath.Join(t.TempDir(), "new")
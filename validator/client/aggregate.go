@@ -20,6 +20,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -34,9 +35,14 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot primitives
 
 	span.SetAttributes(trace.StringAttribute("validator", fmt.Sprintf("%#x", pubKey)))
 	fmtKey := fmt.Sprintf("%#x", pubKey[:])
+	missedReason := missedReasonUnknown
+	defer func() {
+		v.recordDuty(pubKey, slot, iface.RoleAggregator, missedReason)
+	}()
 
 	duty, err := v.duty(pubKey)
 	if err != nil {
+		missedReason = missedReasonNoAssignment
 		log.WithError(err).Error("Could not fetch validator assignment")
 		if v.emitAccountMetrics {
 			ValidatorAggFailVec.WithLabelValues(fmtKey).Inc()
@@ -49,6 +55,8 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot primitives
 	v.aggregatedSlotCommitteeIDCacheLock.Lock()
 	if v.aggregatedSlotCommitteeIDCache.Contains(k) {
 		v.aggregatedSlotCommitteeIDCacheLock.Unlock()
+		// The aggregate of the committee is submitted by another key.
+		missedReason = ""
 		return
 	}
 	v.aggregatedSlotCommitteeIDCache.Add(k, true)
@@ -58,6 +66,7 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot primitives
 	if v.distributed {
 		slotSig, err = v.attSelection(attSelectionKey{slot: slot, index: duty.ValidatorIndex})
 		if err != nil {
+			missedReason = missedReasonSigningError
 			log.WithError(err).Error("Could not find aggregated selection proof")
 			if v.emitAccountMetrics {
 				ValidatorAggFailVec.WithLabelValues(fmtKey).Inc()
@@ -67,6 +76,7 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot primitives
 	} else {
 		slotSig, err = v.signSlotWithSelectionProof(ctx, pubKey, slot)
 		if err != nil {
+			missedReason = missedReasonSigningError
 			log.WithError(err).Error("Could not sign slot")
 			if v.emitAccountMetrics {
 				ValidatorAggFailVec.WithLabelValues(fmtKey).Inc()
@@ -93,6 +103,7 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot primitives
 	if postElectra {
 		res, err := v.validatorClient.SubmitAggregateSelectionProofElectra(ctx, aggSelectionRequest, duty.ValidatorIndex, uint64(len(duty.Committee)))
 		if err != nil {
+			missedReason = missedReasonNoAggregate
 			v.handleSubmitAggSelectionProofError(err, slot, fmtKey)
 			return
 		}
@@ -100,6 +111,7 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot primitives
 	} else {
 		res, err := v.validatorClient.SubmitAggregateSelectionProof(ctx, aggSelectionRequest, duty.ValidatorIndex, uint64(len(duty.Committee)))
 		if err != nil {
+			missedReason = missedReasonNoAggregate
 			v.handleSubmitAggSelectionProofError(err, slot, fmtKey)
 			return
 		}
@@ -108,6 +120,7 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot primitives
 
 	sig, err := v.aggregateAndProofSig(ctx, pubKey, agg, slot)
 	if err != nil {
+		missedReason = missedReasonSigningError
 		log.WithError(err).Error("Could not sign aggregate and proof")
 		return
 	}
@@ -128,6 +141,7 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot primitives
 			},
 		})
 		if err != nil {
+			missedReason = missedReasonSubmissionError
 			log.WithError(err).Error("Could not submit signed aggregate and proof to beacon node")
 			if v.emitAccountMetrics {
 				ValidatorAggFailVec.WithLabelValues(fmtKey).Inc()
@@ -150,6 +164,7 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot primitives
 			},
 		})
 		if err != nil {
+			missedReason = missedReasonSubmissionError
 			log.WithError(err).Error("Could not submit signed aggregate and proof to beacon node")
 			if v.emitAccountMetrics {
 				ValidatorAggFailVec.WithLabelValues(fmtKey).Inc()
@@ -157,6 +172,7 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot primitives
 			return
 		}
 	}
+	missedReason = ""
 
 	if err := v.saveSubmittedAtt(agg.AggregateVal().GetData(), pubKey[:], true); err != nil {
 		log.WithError(err).Error("Could not add aggregator indices to logs")
@@ -35,6 +35,10 @@ func TestSubmitAggregateAndProof_GetDutiesRequestFailure(t *testing.T) {
 			validator.SubmitAggregateAndProof(context.Background(), 0, pubKey)
 
 			require.LogsContain(t, hook, "Could not fetch validator assignment")
+			history := validator.DutyHistory()
+			require.Equal(t, 1, len(history))
+			assert.Equal(t, iface.RoleAggregator, history[0].Role)
+			assert.Equal(t, missedReasonNoAssignment, history[0].MissedReason)
 		})
 	}
 }
@@ -183,6 +187,10 @@ func TestSubmitAggregateAndProof_Ok(t *testing.T) {
 			).Return(&ethpb.SignedAggregateSubmitResponse{AttestationDataRoot: make([]byte, 32)}, nil)
 
 			validator.SubmitAggregateAndProof(context.Background(), params.BeaconConfig().SlotsPerEpoch.Mul(electraForkEpoch), pubKey)
+			history := validator.DutyHistory()
+			require.Equal(t, true, len(history) > 0)
+			assert.Equal(t, iface.RoleAggregator, history[len(history)-1].Role)
+			assert.Equal(t, "", history[len(history)-1].MissedReason)
 		})
 	}
 }
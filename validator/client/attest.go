@@ -57,8 +57,14 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot primitives.Slot,
 
 	fmtKey := fmt.Sprintf("%#x", pubKey[:])
 	log := log.WithField("pubkey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:]))).WithField("slot", slot)
+	missedReason := missedReasonUnknown
+	defer func() {
+		v.recordDuty(pubKey, slot, iface.RoleAttester, missedReason)
+	}()
+
 	duty, err := v.duty(pubKey)
 	if err != nil {
+		missedReason = missedReasonNoAssignment
 		log.WithError(err).Error("Could not fetch validator assignment")
 		if v.emitAccountMetrics {
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
@@ -67,6 +73,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot primitives.Slot,
 		return
 	}
 	if len(duty.Committee) == 0 {
+		missedReason = missedReasonNoAssignment
 		log.Debug("Empty committee for validator duty, not attesting")
 		return
 	}
@@ -77,6 +84,9 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot primitives.Slot,
 	}
 	data, err := v.validatorClient.AttestationData(ctx, req)
 	if err != nil {
+		if isDeadlineError(err) {
+			missedReason = missedReasonLateAttestationData
+		}
 		log.WithError(err).Error("Could not request attestation to sign at slot")
 		if v.emitAccountMetrics {
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
@@ -87,6 +97,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot primitives.Slot,
 
 	sig, _, err := v.signAtt(ctx, pubKey, data, slot)
 	if err != nil {
+		missedReason = missedReasonSigningError
 		log.WithError(err).Error("Could not sign attestation")
 		if v.emitAccountMetrics {
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
@@ -114,6 +125,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot primitives.Slot,
 
 	_, signingRoot, err := v.domainAndSigningRoot(ctx, indexedAtt.GetData())
 	if err != nil {
+		missedReason = missedReasonSigningError
 		log.WithError(err).Error("Could not get domain and signing root from attestation")
 		if v.emitAccountMetrics {
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
@@ -127,6 +139,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot primitives.Slot,
 	if ok {
 		// Send the attestation to the beacon node.
 		if err := v.db.SlashableAttestationCheck(ctx, phase0Att, pubKey, signingRoot, v.emitAccountMetrics, ValidatorAttestFailVec); err != nil {
+			missedReason = missedReasonSlashingProtection
 			log.WithError(err).Error("Failed attestation slashing protection check")
 			log.WithFields(
 				attestationLogFields(pubKey, indexedAtt),
@@ -158,6 +171,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot primitives.Slot,
 			}
 		}
 		if !found {
+			missedReason = missedReasonNoAssignment
 			log.Errorf("Validator ID %d not found in committee of %v", duty.ValidatorIndex, duty.Committee)
 			if v.emitAccountMetrics {
 				ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
@@ -174,6 +188,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot primitives.Slot,
 		attResp, err = v.validatorClient.ProposeAttestation(ctx, attestation)
 	}
 	if err != nil {
+		missedReason = missedReasonSubmissionError
 		log.WithError(err).Error("Could not submit attestation to beacon node")
 		if v.emitAccountMetrics {
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
@@ -181,6 +196,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot primitives.Slot,
 		tracing.AnnotateError(span, err)
 		return
 	}
+	missedReason = ""
 
 	if err := v.saveSubmittedAtt(data, pubKey[:], false); err != nil {
 		log.WithError(err).Error("Could not save validator index for logging")
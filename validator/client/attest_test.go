@@ -26,6 +26,8 @@ import (
 	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"gopkg.in/d4l3k/messagediff.v1"
 )
 
@@ -106,6 +108,55 @@ func TestAttestToBlockHead_SubmitAttestation_RequestFailure(t *testing.T) {
 	}
 }
 
+func TestAttestToBlockHead_AttestationDataFailure(t *testing.T) {
+	tests := []struct {
+		name         string
+		err          error
+		missedReason string
+	}{
+		{
+			name:         "deadline exceeded",
+			err:          fmt.Errorf("could not get attestation data: %w", context.DeadlineExceeded),
+			missedReason: missedReasonLateAttestationData,
+		},
+		{
+			name:         "grpc deadline exceeded",
+			err:          status.Error(codes.DeadlineExceeded, "deadline exceeded"),
+			missedReason: missedReasonLateAttestationData,
+		},
+		{
+			name:         "other error",
+			err:          errors.New("something went wrong"),
+			missedReason: missedReasonUnknown,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator, m, validatorKey, finish := setup(t, false)
+			defer finish()
+			validator.duties = &ethpb.DutiesResponse{CurrentEpochDuties: []*ethpb.DutiesResponse_Duty{
+				{
+					PublicKey:      validatorKey.PublicKey().Marshal(),
+					CommitteeIndex: 5,
+					Committee:      make([]primitives.ValidatorIndex, 111),
+					ValidatorIndex: 0,
+				}}}
+			m.validatorClient.EXPECT().AttestationData(
+				gomock.Any(), // ctx
+				gomock.AssignableToTypeOf(&ethpb.AttestationDataRequest{}),
+			).Return(nil, tt.err)
+
+			var pubKey [fieldparams.BLSPubkeyLength]byte
+			copy(pubKey[:], validatorKey.PublicKey().Marshal())
+			validator.SubmitAttestation(context.Background(), 30, pubKey)
+
+			history := validator.DutyHistory()
+			require.Equal(t, 1, len(history))
+			assert.Equal(t, tt.missedReason, history[0].MissedReason)
+		})
+	}
+}
+
 func TestAttestToBlockHead_AttestsCorrectly(t *testing.T) {
 	for _, isSlashingProtectionMinimal := range [...]bool{false, true} {
 		t.Run(fmt.Sprintf("Phase 0 (SlashingProtectionMinimal:%v)", isSlashingProtectionMinimal), func(t *testing.T) {
@@ -24,9 +24,17 @@ func (c *beaconApiValidatorClient) attestationData(
 	query := buildURL("/eth/v1/validator/attestation_data", params)
 	produceAttestationDataResponseJson := structs.GetAttestationDataResponse{}
 
-	if err := c.jsonRestHandler.Get(ctx, query, &produceAttestationDataResponseJson); err != nil {
+	sszAttestationData, _, err := c.jsonRestHandler.GetSSZ(ctx, query, &produceAttestationDataResponseJson)
+	if err != nil {
 		return nil, err
 	}
+	if sszAttestationData != nil {
+		response := &ethpb.AttestationData{}
+		if err := response.UnmarshalSSZ(sszAttestationData); err != nil {
+			return nil, errors.Wrap(err, "failed to decode attestation data response ssz")
+		}
+		return response, nil
+	}
 
 	if produceAttestationDataResponseJson.Data == nil {
 		return nil, errors.New("attestation data is nil")
@@ -10,6 +10,8 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/validator/client/beacon-api/mock"
@@ -32,12 +34,14 @@ func TestGetAttestationData_ValidAttestation(t *testing.T) {
 	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
 	produceAttestationDataResponseJson := structs.GetAttestationDataResponse{}
 
-	jsonRestHandler.EXPECT().Get(
+	jsonRestHandler.EXPECT().GetSSZ(
 		gomock.Any(),
 		fmt.Sprintf("/eth/v1/validator/attestation_data?committee_index=%d&slot=%d", expectedCommitteeIndex, expectedSlot),
 		&produceAttestationDataResponseJson,
 	).Return(
 		nil,
+		nil,
+		nil,
 	).SetArg(
 		2,
 		structs.GetAttestationDataResponse{
@@ -75,6 +79,50 @@ func TestGetAttestationData_ValidAttestation(t *testing.T) {
 	assert.Equal(t, expectedTargetRoot, hexutil.Encode(resp.Target.Root))
 }
 
+func TestGetAttestationData_SSZ(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expected := &ethpb.AttestationData{
+		Slot:            5,
+		CommitteeIndex:  6,
+		BeaconBlockRoot: bytesutil.PadTo([]byte{1}, 32),
+		Source:          &ethpb.Checkpoint{Epoch: 7, Root: bytesutil.PadTo([]byte{2}, 32)},
+		Target:          &ethpb.Checkpoint{Epoch: 8, Root: bytesutil.PadTo([]byte{3}, 32)},
+	}
+	sszData, err := expected.MarshalSSZ()
+	require.NoError(t, err)
+
+	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
+	jsonRestHandler.EXPECT().GetSSZ(
+		gomock.Any(),
+		"/eth/v1/validator/attestation_data?committee_index=6&slot=5",
+		gomock.Any(),
+	).Return(
+		sszData,
+		nil,
+		nil,
+	).Times(1)
+
+	validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
+	resp, err := validatorClient.attestationData(ctx, 5, 6)
+	require.NoError(t, err)
+	assert.DeepEqual(t, expected, resp)
+
+	jsonRestHandler.EXPECT().GetSSZ(
+		gomock.Any(),
+		gomock.Any(),
+		gomock.Any(),
+	).Return(
+		sszData[1:],
+		nil,
+		nil,
+	).Times(1)
+	_, err = validatorClient.attestationData(ctx, 5, 6)
+	assert.ErrorContains(t, "failed to decode attestation data response ssz", err)
+}
+
 func TestGetAttestationData_InvalidData(t *testing.T) {
 	ctx := context.Background()
 
@@ -182,12 +230,14 @@ func TestGetAttestationData_InvalidData(t *testing.T) {
 
 			produceAttestationDataResponseJson := structs.GetAttestationDataResponse{}
 			jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
-			jsonRestHandler.EXPECT().Get(
+			jsonRestHandler.EXPECT().GetSSZ(
 				gomock.Any(),
 				"/eth/v1/validator/attestation_data?committee_index=2&slot=1",
 				&produceAttestationDataResponseJson,
 			).Return(
 				nil,
+				nil,
+				nil,
 			).SetArg(
 				2,
 				testCase.generateData(),
@@ -211,11 +261,13 @@ func TestGetAttestationData_JsonResponseError(t *testing.T) {
 
 	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
 	produceAttestationDataResponseJson := structs.GetAttestationDataResponse{}
-	jsonRestHandler.EXPECT().Get(
+	jsonRestHandler.EXPECT().GetSSZ(
 		gomock.Any(),
 		fmt.Sprintf("/eth/v1/validator/attestation_data?committee_index=%d&slot=%d", committeeIndex, slot),
 		&produceAttestationDataResponseJson,
 	).Return(
+		nil,
+		nil,
 		errors.New("some specific json response error"),
 	).Times(1)
 
@@ -10,6 +10,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/api/client/beacon"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -94,6 +95,32 @@ func (c *beaconApiNodeClient) Version(ctx context.Context, _ *empty.Empty) (*eth
 	}, nil
 }
 
+// ClientVersions returns the client versions of the beacon node and of its execution client.
+func (c *beaconApiNodeClient) ClientVersions(ctx context.Context) (*iface.ClientVersions, error) {
+	var versionResponse structs.GetVersionV2Response
+	if err := c.jsonRestHandler.Get(ctx, "/eth/v2/node/version", &versionResponse); err != nil {
+		return nil, err
+	}
+	if versionResponse.Data == nil || versionResponse.Data.BeaconNode == nil {
+		return nil, errors.New("empty version response")
+	}
+
+	versions := &iface.ClientVersions{BeaconNode: clientVersionFromJson(versionResponse.Data.BeaconNode)}
+	if versionResponse.Data.ExecutionClient != nil {
+		versions.ExecutionClient = clientVersionFromJson(versionResponse.Data.ExecutionClient)
+	}
+	return versions, nil
+}
+
+func clientVersionFromJson(cv *structs.ClientVersionV1) *version.ClientVersion {
+	return &version.ClientVersion{
+		Code:    cv.Code,
+		Name:    cv.Name,
+		Version: cv.Version,
+		Commit:  cv.Commit,
+	}
+}
+
 func (c *beaconApiNodeClient) Peers(ctx context.Context, in *empty.Empty) (*ethpb.Peers, error) {
 	if c.fallbackClient != nil {
 		return c.fallbackClient.Peers(ctx, in)
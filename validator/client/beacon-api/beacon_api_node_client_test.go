@@ -8,8 +8,11 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/validator/client/beacon-api/mock"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -288,3 +291,79 @@ func TestGetVersion(t *testing.T) {
 		})
 	}
 }
+
+func TestClientVersions(t *testing.T) {
+	const versionEndpoint = "/eth/v2/node/version"
+
+	beaconNode := &structs.ClientVersionV1{Code: "PM", Name: "Prysm", Version: "v5.2.0", Commit: "0xe4f61234"}
+	executionClient := &structs.ClientVersionV1{Code: "GE", Name: "Geth", Version: "v1.14.11", Commit: "0xf8d0a1b2"}
+
+	testCases := []struct {
+		name                 string
+		restEndpointResponse structs.GetVersionV2Response
+		restEndpointError    error
+		expectedResponse     *iface.ClientVersions
+		expectedError        string
+	}{
+		{
+			name:              "fails to query REST endpoint",
+			restEndpointError: errors.New("foo error"),
+			expectedError:     "foo error",
+		},
+		{
+			name:                 "returns nil version data",
+			restEndpointResponse: structs.GetVersionV2Response{Data: nil},
+			expectedError:        "empty version response",
+		},
+		{
+			name: "returns beacon node version only",
+			restEndpointResponse: structs.GetVersionV2Response{
+				Data: &structs.VersionV2{BeaconNode: beaconNode},
+			},
+			expectedResponse: &iface.ClientVersions{
+				BeaconNode: &version.ClientVersion{Code: "PM", Name: "Prysm", Version: "v5.2.0", Commit: "0xe4f61234"},
+			},
+		},
+		{
+			name: "returns both versions",
+			restEndpointResponse: structs.GetVersionV2Response{
+				Data: &structs.VersionV2{BeaconNode: beaconNode, ExecutionClient: executionClient},
+			},
+			expectedResponse: &iface.ClientVersions{
+				BeaconNode:      &version.ClientVersion{Code: "PM", Name: "Prysm", Version: "v5.2.0", Commit: "0xe4f61234"},
+				ExecutionClient: &version.ClientVersion{Code: "GE", Name: "Geth", Version: "v1.14.11", Commit: "0xf8d0a1b2"},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ctx := context.Background()
+
+			var versionResponse structs.GetVersionV2Response
+			jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
+			jsonRestHandler.EXPECT().Get(
+				gomock.Any(),
+				versionEndpoint,
+				&versionResponse,
+			).Return(
+				testCase.restEndpointError,
+			).SetArg(
+				2,
+				testCase.restEndpointResponse,
+			)
+
+			nodeClient := &beaconApiNodeClient{jsonRestHandler: jsonRestHandler}
+			versions, err := nodeClient.ClientVersions(ctx)
+
+			if testCase.expectedResponse == nil {
+				assert.ErrorContains(t, testCase.expectedError, err)
+			} else {
+				require.NoError(t, err)
+				assert.DeepEqual(t, testCase.expectedResponse, versions)
+			}
+		})
+	}
+}
@@ -63,6 +63,14 @@ func (c *beaconApiValidatorClient) CheckDoppelGanger(ctx context.Context, in *et
 	})
 }
 
+func (c *beaconApiValidatorClient) Liveness(ctx context.Context, epoch primitives.Epoch, indices []primitives.ValidatorIndex) ([]*iface.ValidatorLiveness, error) {
+	ctx, span := trace.StartSpan(ctx, "beacon-api.Liveness")
+	defer span.End()
+	return wrapInMetrics[[]*iface.ValidatorLiveness]("Liveness", func() ([]*iface.ValidatorLiveness, error) {
+		return c.validatorsLiveness(ctx, epoch, indices)
+	})
+}
+
 func (c *beaconApiValidatorClient) DomainData(ctx context.Context, in *ethpb.DomainRequest) (*ethpb.DomainResponse, error) {
 	if len(in.Domain) != 4 {
 		return nil, errors.Errorf("invalid domain type: %s", hexutil.Encode(in.Domain))
@@ -92,7 +100,7 @@ func (c *beaconApiValidatorClient) BeaconBlock(ctx context.Context, in *ethpb.Bl
 	defer span.End()
 
 	return wrapInMetrics[*ethpb.GenericBeaconBlock]("BeaconBlock", func() (*ethpb.GenericBeaconBlock, error) {
-		return c.beaconBlock(ctx, in.Slot, in.RandaoReveal, in.Graffiti)
+		return c.beaconBlock(ctx, in.Slot, in.RandaoReveal, in.Graffiti, in.BuilderBoostFactor)
 	})
 }
 
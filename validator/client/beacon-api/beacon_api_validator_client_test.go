@@ -30,12 +30,14 @@ func TestBeaconApiValidatorClient_GetAttestationDataValid(t *testing.T) {
 
 	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
 	produceAttestationDataResponseJson := structs.GetAttestationDataResponse{}
-	jsonRestHandler.EXPECT().Get(
+	jsonRestHandler.EXPECT().GetSSZ(
 		gomock.Any(),
 		fmt.Sprintf("/eth/v1/validator/attestation_data?committee_index=%d&slot=%d", committeeIndex, slot),
 		&produceAttestationDataResponseJson,
 	).Return(
 		nil,
+		nil,
+		nil,
 	).SetArg(
 		2,
 		generateValidAttestation(uint64(slot), uint64(committeeIndex)),
@@ -64,11 +66,13 @@ func TestBeaconApiValidatorClient_GetAttestationDataError(t *testing.T) {
 
 	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
 	produceAttestationDataResponseJson := structs.GetAttestationDataResponse{}
-	jsonRestHandler.EXPECT().Get(
+	jsonRestHandler.EXPECT().GetSSZ(
 		gomock.Any(),
 		fmt.Sprintf("/eth/v1/validator/attestation_data?committee_index=%d&slot=%d", committeeIndex, slot),
 		&produceAttestationDataResponseJson,
 	).Return(
+		nil,
+		nil,
 		errors.New("some specific json error"),
 	).SetArg(
 		2,
@@ -137,12 +141,11 @@ func TestBeaconApiValidatorClient_ProposeBeaconBlockValid(t *testing.T) {
 	ctx := context.Background()
 
 	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
-	jsonRestHandler.EXPECT().Post(
+	jsonRestHandler.EXPECT().PostSSZ(
 		gomock.Any(),
 		"/eth/v2/beacon/blocks",
 		map[string]string{"Eth-Consensus-Version": "phase0"},
 		gomock.Any(),
-		nil,
 	).Return(
 		nil,
 	).Times(2)
@@ -173,12 +176,11 @@ func TestBeaconApiValidatorClient_ProposeBeaconBlockError(t *testing.T) {
 	ctx := context.Background()
 
 	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
-	jsonRestHandler.EXPECT().Post(
+	jsonRestHandler.EXPECT().PostSSZ(
 		gomock.Any(),
 		"/eth/v2/beacon/blocks",
 		map[string]string{"Eth-Consensus-Version": "phase0"},
 		gomock.Any(),
-		nil,
 	).Return(
 		errors.New("foo error"),
 	).Times(2)
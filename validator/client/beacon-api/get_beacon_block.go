@@ -7,14 +7,17 @@ import (
 	"fmt"
 	"net/http"
 	neturl "net/url"
+	"strconv"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 type abstractProduceBlockResponseJson struct {
@@ -22,12 +25,15 @@ type abstractProduceBlockResponseJson struct {
 	Data    json.RawMessage `json:"data"`
 }
 
-func (c *beaconApiValidatorClient) beaconBlock(ctx context.Context, slot primitives.Slot, randaoReveal, graffiti []byte) (*ethpb.GenericBeaconBlock, error) {
+func (c *beaconApiValidatorClient) beaconBlock(ctx context.Context, slot primitives.Slot, randaoReveal, graffiti []byte, builderBoostFactor *wrapperspb.UInt64Value) (*ethpb.GenericBeaconBlock, error) {
 	queryParams := neturl.Values{}
 	queryParams.Add("randao_reveal", hexutil.Encode(randaoReveal))
 	if len(graffiti) > 0 {
 		queryParams.Add("graffiti", hexutil.Encode(graffiti))
 	}
+	if builderBoostFactor != nil {
+		queryParams.Add("builder_boost_factor", strconv.FormatUint(builderBoostFactor.Value, 10))
+	}
 
 	var ver string
 	var blinded bool
@@ -37,7 +43,18 @@ func (c *beaconApiValidatorClient) beaconBlock(ctx context.Context, slot primiti
 	// We try the blinded block endpoint first. If it fails, we assume that we got a full block and try the full block endpoint.
 	queryUrl := buildURL(fmt.Sprintf("/eth/v3/validator/blocks/%d", slot), queryParams)
 	produceBlockV3ResponseJson := structs.ProduceBlockV3Response{}
-	err := c.jsonRestHandler.Get(ctx, queryUrl, &produceBlockV3ResponseJson)
+	sszBlock, header, err := c.jsonRestHandler.GetSSZ(ctx, queryUrl, &produceBlockV3ResponseJson)
+	if err == nil && sszBlock != nil {
+		v, err := version.FromString(header.Get(api.VersionHeader))
+		if err != nil {
+			return nil, errors.Wrapf(err, "unsupported consensus version `%s`", header.Get(api.VersionHeader))
+		}
+		blinded, err := strconv.ParseBool(header.Get(api.ExecutionPayloadBlindedHeader))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse %s header", api.ExecutionPayloadBlindedHeader)
+		}
+		return processBlockSSZResponse(v, blinded, sszBlock)
+	}
 	errJson := &httputil.DefaultJsonError{}
 	if err != nil {
 		if !errors.As(err, &errJson) {
@@ -215,6 +232,68 @@ func processBlockResponse(ver string, isBlinded bool, decoder *json.Decoder) (*e
 	return response, nil
 }
 
+// processBlockSSZResponse decodes an SSZ-encoded block, as produced by the v3 block production endpoint.
+func processBlockSSZResponse(v int, isBlinded bool, data []byte) (*ethpb.GenericBeaconBlock, error) {
+	response := &ethpb.GenericBeaconBlock{IsBlinded: isBlinded}
+	var err error
+	switch {
+	case v == version.Phase0:
+		blk := &ethpb.BeaconBlock{}
+		err = blk.UnmarshalSSZ(data)
+		response.Block = &ethpb.GenericBeaconBlock_Phase0{Phase0: blk}
+	case v == version.Altair:
+		blk := &ethpb.BeaconBlockAltair{}
+		err = blk.UnmarshalSSZ(data)
+		response.Block = &ethpb.GenericBeaconBlock_Altair{Altair: blk}
+	case v == version.Bellatrix && isBlinded:
+		blk := &ethpb.BlindedBeaconBlockBellatrix{}
+		err = blk.UnmarshalSSZ(data)
+		response.Block = &ethpb.GenericBeaconBlock_BlindedBellatrix{BlindedBellatrix: blk}
+	case v == version.Bellatrix:
+		blk := &ethpb.BeaconBlockBellatrix{}
+		err = blk.UnmarshalSSZ(data)
+		response.Block = &ethpb.GenericBeaconBlock_Bellatrix{Bellatrix: blk}
+	case v == version.Capella && isBlinded:
+		blk := &ethpb.BlindedBeaconBlockCapella{}
+		err = blk.UnmarshalSSZ(data)
+		response.Block = &ethpb.GenericBeaconBlock_BlindedCapella{BlindedCapella: blk}
+	case v == version.Capella:
+		blk := &ethpb.BeaconBlockCapella{}
+		err = blk.UnmarshalSSZ(data)
+		response.Block = &ethpb.GenericBeaconBlock_Capella{Capella: blk}
+	case v == version.Deneb && isBlinded:
+		blk := &ethpb.BlindedBeaconBlockDeneb{}
+		err = blk.UnmarshalSSZ(data)
+		response.Block = &ethpb.GenericBeaconBlock_BlindedDeneb{BlindedDeneb: blk}
+	case v == version.Deneb:
+		blk := &ethpb.BeaconBlockContentsDeneb{}
+		err = blk.UnmarshalSSZ(data)
+		response.Block = &ethpb.GenericBeaconBlock_Deneb{Deneb: blk}
+	case v == version.Electra && isBlinded:
+		blk := &ethpb.BlindedBeaconBlockElectra{}
+		err = blk.UnmarshalSSZ(data)
+		response.Block = &ethpb.GenericBeaconBlock_BlindedElectra{BlindedElectra: blk}
+	case v == version.Electra:
+		blk := &ethpb.BeaconBlockContentsElectra{}
+		err = blk.UnmarshalSSZ(data)
+		response.Block = &ethpb.GenericBeaconBlock_Electra{Electra: blk}
+	case v == version.Fulu && isBlinded:
+		blk := &ethpb.BlindedBeaconBlockFulu{}
+		err = blk.UnmarshalSSZ(data)
+		response.Block = &ethpb.GenericBeaconBlock_BlindedFulu{BlindedFulu: blk}
+	case v == version.Fulu:
+		blk := &ethpb.BeaconBlockContentsFulu{}
+		err = blk.UnmarshalSSZ(data)
+		response.Block = &ethpb.GenericBeaconBlock_Fulu{Fulu: blk}
+	default:
+		return nil, errors.Errorf("unsupported consensus version `%s`", version.String(v))
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decode %s block response ssz", version.String(v))
+	}
+	return response, nil
+}
+
 func (c *beaconApiValidatorClient) fallBackToBlinded(
 	ctx context.Context,
 	slot primitives.Slot,
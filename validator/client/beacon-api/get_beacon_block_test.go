@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
@@ -18,6 +19,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/validator/client/beacon-api/mock"
 	testhelpers "github.com/prysmaticlabs/prysm/v5/validator/client/beacon-api/test-helpers"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 func TestGetBeaconBlock_RequestFailed(t *testing.T) {
@@ -27,16 +29,18 @@ func TestGetBeaconBlock_RequestFailed(t *testing.T) {
 	ctx := context.Background()
 
 	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
-	jsonRestHandler.EXPECT().Get(
+	jsonRestHandler.EXPECT().GetSSZ(
 		gomock.Any(),
 		gomock.Any(),
 		gomock.Any(),
 	).Return(
+		nil,
+		nil,
 		errors.New("foo error"),
 	).Times(1)
 
 	validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
-	_, err := validatorClient.beaconBlock(ctx, 1, []byte{1}, []byte{2})
+	_, err := validatorClient.beaconBlock(ctx, 1, []byte{1}, []byte{2}, nil)
 	assert.ErrorContains(t, "foo error", err)
 }
 
@@ -124,7 +128,7 @@ func TestGetBeaconBlock_Error(t *testing.T) {
 			ctx := context.Background()
 
 			jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
-			jsonRestHandler.EXPECT().Get(
+			jsonRestHandler.EXPECT().GetSSZ(
 				gomock.Any(),
 				gomock.Any(),
 				&structs.ProduceBlockV3Response{},
@@ -136,10 +140,12 @@ func TestGetBeaconBlock_Error(t *testing.T) {
 				},
 			).Return(
 				nil,
+				nil,
+				nil,
 			).Times(1)
 
 			validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
-			_, err := validatorClient.beaconBlock(ctx, 1, []byte{1}, []byte{2})
+			_, err := validatorClient.beaconBlock(ctx, 1, []byte{1}, []byte{2}, nil)
 			assert.ErrorContains(t, testCase.expectedErrorMessage, err)
 		})
 	}
@@ -160,7 +166,7 @@ func TestGetBeaconBlock_Phase0Valid(t *testing.T) {
 	ctx := context.Background()
 
 	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
-	jsonRestHandler.EXPECT().Get(
+	jsonRestHandler.EXPECT().GetSSZ(
 		gomock.Any(),
 		fmt.Sprintf("/eth/v3/validator/blocks/%d?graffiti=%s&randao_reveal=%s", slot, hexutil.Encode(graffiti), hexutil.Encode(randaoReveal)),
 		&structs.ProduceBlockV3Response{},
@@ -172,10 +178,12 @@ func TestGetBeaconBlock_Phase0Valid(t *testing.T) {
 		},
 	).Return(
 		nil,
+		nil,
+		nil,
 	).Times(1)
 
 	validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
-	beaconBlock, err := validatorClient.beaconBlock(ctx, slot, randaoReveal, graffiti)
+	beaconBlock, err := validatorClient.beaconBlock(ctx, slot, randaoReveal, graffiti, nil)
 	require.NoError(t, err)
 
 	expectedBeaconBlock := &ethpb.GenericBeaconBlock{
@@ -187,6 +195,42 @@ func TestGetBeaconBlock_Phase0Valid(t *testing.T) {
 	assert.DeepEqual(t, expectedBeaconBlock, beaconBlock)
 }
 
+func TestGetBeaconBlock_BuilderBoostFactor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	proto := testhelpers.GenerateProtoPhase0BeaconBlock()
+	block := testhelpers.GenerateJsonPhase0BeaconBlock()
+	bytes, err := json.Marshal(block)
+	require.NoError(t, err)
+
+	const slot = primitives.Slot(1)
+	randaoReveal := []byte{2}
+	ctx := context.Background()
+
+	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
+	jsonRestHandler.EXPECT().GetSSZ(
+		gomock.Any(),
+		fmt.Sprintf("/eth/v3/validator/blocks/%d?builder_boost_factor=50&randao_reveal=%s", slot, hexutil.Encode(randaoReveal)),
+		&structs.ProduceBlockV3Response{},
+	).SetArg(
+		2,
+		structs.ProduceBlockV3Response{
+			Version: "phase0",
+			Data:    bytes,
+		},
+	).Return(
+		nil,
+		nil,
+		nil,
+	).Times(1)
+
+	validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
+	beaconBlock, err := validatorClient.beaconBlock(ctx, slot, randaoReveal, nil, wrapperspb.UInt64(50))
+	require.NoError(t, err)
+	assert.DeepEqual(t, &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_Phase0{Phase0: proto}}, beaconBlock)
+}
+
 func TestGetBeaconBlock_AltairValid(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -203,7 +247,7 @@ func TestGetBeaconBlock_AltairValid(t *testing.T) {
 	ctx := context.Background()
 
 	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
-	jsonRestHandler.EXPECT().Get(
+	jsonRestHandler.EXPECT().GetSSZ(
 		gomock.Any(),
 		fmt.Sprintf("/eth/v3/validator/blocks/%d?graffiti=%s&randao_reveal=%s", slot, hexutil.Encode(graffiti), hexutil.Encode(randaoReveal)),
 		&structs.ProduceBlockV3Response{},
@@ -215,10 +259,12 @@ func TestGetBeaconBlock_AltairValid(t *testing.T) {
 		},
 	).Return(
 		nil,
+		nil,
+		nil,
 	).Times(1)
 
 	validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
-	beaconBlock, err := validatorClient.beaconBlock(ctx, slot, randaoReveal, graffiti)
+	beaconBlock, err := validatorClient.beaconBlock(ctx, slot, randaoReveal, graffiti, nil)
 	require.NoError(t, err)
 
 	expectedBeaconBlock := &ethpb.GenericBeaconBlock{
@@ -246,7 +292,7 @@ func TestGetBeaconBlock_BellatrixValid(t *testing.T) {
 	ctx := context.Background()
 
 	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
-	jsonRestHandler.EXPECT().Get(
+	jsonRestHandler.EXPECT().GetSSZ(
 		gomock.Any(),
 		fmt.Sprintf("/eth/v3/validator/blocks/%d?graffiti=%s&randao_reveal=%s", slot, hexutil.Encode(graffiti), hexutil.Encode(randaoReveal)),
 		&structs.ProduceBlockV3Response{},
@@ -259,10 +305,12 @@ func TestGetBeaconBlock_BellatrixValid(t *testing.T) {
 		},
 	).Return(
 		nil,
+		nil,
+		nil,
 	).Times(1)
 
 	validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
-	beaconBlock, err := validatorClient.beaconBlock(ctx, slot, randaoReveal, graffiti)
+	beaconBlock, err := validatorClient.beaconBlock(ctx, slot, randaoReveal, graffiti, nil)
 	require.NoError(t, err)
 
 	expectedBeaconBlock := &ethpb.GenericBeaconBlock{
@@ -291,7 +339,7 @@ func TestGetBeaconBlock_BlindedBellatrixValid(t *testing.T) {
 	ctx := context.Background()
 
 	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
-	jsonRestHandler.EXPECT().Get(
+	jsonRestHandler.EXPECT().GetSSZ(
 		gomock.Any(),
 		fmt.Sprintf("/eth/v3/validator/blocks/%d?graffiti=%s&randao_reveal=%s", slot, hexutil.Encode(graffiti), hexutil.Encode(randaoReveal)),
 		&structs.ProduceBlockV3Response{},
@@ -304,10 +352,12 @@ func TestGetBeaconBlock_BlindedBellatrixValid(t *testing.T) {
 		},
 	).Return(
 		nil,
+		nil,
+		nil,
 	).Times(1)
 
 	validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
-	beaconBlock, err := validatorClient.beaconBlock(ctx, slot, randaoReveal, graffiti)
+	beaconBlock, err := validatorClient.beaconBlock(ctx, slot, randaoReveal, graffiti, nil)
 	require.NoError(t, err)
 
 	expectedBeaconBlock := &ethpb.GenericBeaconBlock{
@@ -336,7 +386,7 @@ func TestGetBeaconBlock_CapellaValid(t *testing.T) {
 	ctx := context.Background()
 
 	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
-	jsonRestHandler.EXPECT().Get(
+	jsonRestHandler.EXPECT().GetSSZ(
 		gomock.Any(),
 		fmt.Sprintf("/eth/v3/validator/blocks/%d?graffiti=%s&randao_reveal=%s", slot, hexutil.Encode(graffiti), hexutil.Encode(randaoReveal)),
 		&structs.ProduceBlockV3Response{},
@@ -349,10 +399,12 @@ func TestGetBeaconBlock_CapellaValid(t *testing.T) {
 		},
 	).Return(
 		nil,
+		nil,
+		nil,
 	).Times(1)
 
 	validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
-	beaconBlock, err := validatorClient.beaconBlock(ctx, slot, randaoReveal, graffiti)
+	beaconBlock, err := validatorClient.beaconBlock(ctx, slot, randaoReveal, graffiti, nil)
 	require.NoError(t, err)
 
 	expectedBeaconBlock := &ethpb.GenericBeaconBlock{
@@ -381,7 +433,7 @@ func TestGetBeaconBlock_BlindedCapellaValid(t *testing.T) {
 	ctx := context.Background()
 
 	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
-	jsonRestHandler.EXPECT().Get(
+	jsonRestHandler.EXPECT().GetSSZ(
 		gomock.Any(),
 		fmt.Sprintf("/eth/v3/validator/blocks/%d?graffiti=%s&randao_reveal=%s", slot, hexutil.Encode(graffiti), hexutil.Encode(randaoReveal)),
 		&structs.ProduceBlockV3Response{},
@@ -394,10 +446,12 @@ func TestGetBeaconBlock_BlindedCapellaValid(t *testing.T) {
 		},
 	).Return(
 		nil,
+		nil,
+		nil,
 	).Times(1)
 
 	validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
-	beaconBlock, err := validatorClient.beaconBlock(ctx, slot, randaoReveal, graffiti)
+	beaconBlock, err := validatorClient.beaconBlock(ctx, slot, randaoReveal, graffiti, nil)
 	require.NoError(t, err)
 
 	expectedBeaconBlock := &ethpb.GenericBeaconBlock{
@@ -426,7 +480,7 @@ func TestGetBeaconBlock_DenebValid(t *testing.T) {
 	ctx := context.Background()
 
 	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
-	jsonRestHandler.EXPECT().Get(
+	jsonRestHandler.EXPECT().GetSSZ(
 		gomock.Any(),
 		fmt.Sprintf("/eth/v3/validator/blocks/%d?graffiti=%s&randao_reveal=%s", slot, hexutil.Encode(graffiti), hexutil.Encode(randaoReveal)),
 		&structs.ProduceBlockV3Response{},
@@ -439,10 +493,12 @@ func TestGetBeaconBlock_DenebValid(t *testing.T) {
 		},
 	).Return(
 		nil,
+		nil,
+		nil,
 	).Times(1)
 
 	validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
-	beaconBlock, err := validatorClient.beaconBlock(ctx, slot, randaoReveal, graffiti)
+	beaconBlock, err := validatorClient.beaconBlock(ctx, slot, randaoReveal, graffiti, nil)
 	require.NoError(t, err)
 
 	expectedBeaconBlock := &ethpb.GenericBeaconBlock{
@@ -471,7 +527,7 @@ func TestGetBeaconBlock_BlindedDenebValid(t *testing.T) {
 	ctx := context.Background()
 
 	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
-	jsonRestHandler.EXPECT().Get(
+	jsonRestHandler.EXPECT().GetSSZ(
 		gomock.Any(),
 		fmt.Sprintf("/eth/v3/validator/blocks/%d?graffiti=%s&randao_reveal=%s", slot, hexutil.Encode(graffiti), hexutil.Encode(randaoReveal)),
 		&structs.ProduceBlockV3Response{},
@@ -484,10 +540,12 @@ func TestGetBeaconBlock_BlindedDenebValid(t *testing.T) {
 		},
 	).Return(
 		nil,
+		nil,
+		nil,
 	).Times(1)
 
 	validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
-	beaconBlock, err := validatorClient.beaconBlock(ctx, slot, randaoReveal, graffiti)
+	beaconBlock, err := validatorClient.beaconBlock(ctx, slot, randaoReveal, graffiti, nil)
 	require.NoError(t, err)
 
 	expectedBeaconBlock := &ethpb.GenericBeaconBlock{
@@ -516,7 +574,7 @@ func TestGetBeaconBlock_ElectraValid(t *testing.T) {
 	ctx := context.Background()
 
 	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
-	jsonRestHandler.EXPECT().Get(
+	jsonRestHandler.EXPECT().GetSSZ(
 		gomock.Any(),
 		fmt.Sprintf("/eth/v3/validator/blocks/%d?graffiti=%s&randao_reveal=%s", slot, hexutil.Encode(graffiti), hexutil.Encode(randaoReveal)),
 		&structs.ProduceBlockV3Response{},
@@ -529,10 +587,12 @@ func TestGetBeaconBlock_ElectraValid(t *testing.T) {
 		},
 	).Return(
 		nil,
+		nil,
+		nil,
 	).Times(1)
 
 	validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
-	beaconBlock, err := validatorClient.beaconBlock(ctx, slot, randaoReveal, graffiti)
+	beaconBlock, err := validatorClient.beaconBlock(ctx, slot, randaoReveal, graffiti, nil)
 	require.NoError(t, err)
 
 	expectedBeaconBlock := &ethpb.GenericBeaconBlock{
@@ -561,7 +621,7 @@ func TestGetBeaconBlock_BlindedElectraValid(t *testing.T) {
 	ctx := context.Background()
 
 	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
-	jsonRestHandler.EXPECT().Get(
+	jsonRestHandler.EXPECT().GetSSZ(
 		gomock.Any(),
 		fmt.Sprintf("/eth/v3/validator/blocks/%d?graffiti=%s&randao_reveal=%s", slot, hexutil.Encode(graffiti), hexutil.Encode(randaoReveal)),
 		&structs.ProduceBlockV3Response{},
@@ -574,10 +634,12 @@ func TestGetBeaconBlock_BlindedElectraValid(t *testing.T) {
 		},
 	).Return(
 		nil,
+		nil,
+		nil,
 	).Times(1)
 
 	validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
-	beaconBlock, err := validatorClient.beaconBlock(ctx, slot, randaoReveal, graffiti)
+	beaconBlock, err := validatorClient.beaconBlock(ctx, slot, randaoReveal, graffiti, nil)
 	require.NoError(t, err)
 
 	expectedBeaconBlock := &ethpb.GenericBeaconBlock{
@@ -590,6 +652,102 @@ func TestGetBeaconBlock_BlindedElectraValid(t *testing.T) {
 	assert.DeepEqual(t, expectedBeaconBlock, beaconBlock)
 }
 
+func TestGetBeaconBlock_SSZ(t *testing.T) {
+	denebContents := testhelpers.GenerateProtoDenebBeaconBlockContents()
+	denebContentsSsz, err := denebContents.MarshalSSZ()
+	require.NoError(t, err)
+	blindedDeneb := testhelpers.GenerateProtoBlindedDenebBeaconBlock()
+	blindedDenebSsz, err := blindedDeneb.MarshalSSZ()
+	require.NoError(t, err)
+	phase0 := testhelpers.GenerateProtoPhase0BeaconBlock()
+	phase0Ssz, err := phase0.MarshalSSZ()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name                 string
+		consensusVersion     string
+		blinded              string
+		data                 []byte
+		expected             *ethpb.GenericBeaconBlock
+		expectedErrorMessage string
+	}{
+		{
+			name:             "phase0",
+			consensusVersion: "phase0",
+			blinded:          "false",
+			data:             phase0Ssz,
+			expected:         &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_Phase0{Phase0: phase0}},
+		},
+		{
+			name:             "deneb",
+			consensusVersion: "deneb",
+			blinded:          "false",
+			data:             denebContentsSsz,
+			expected:         &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_Deneb{Deneb: denebContents}},
+		},
+		{
+			name:             "blinded deneb",
+			consensusVersion: "deneb",
+			blinded:          "true",
+			data:             blindedDenebSsz,
+			expected:         &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_BlindedDeneb{BlindedDeneb: blindedDeneb}, IsBlinded: true},
+		},
+		{
+			name:                 "unsupported consensus version",
+			consensusVersion:     "foo",
+			blinded:              "false",
+			data:                 phase0Ssz,
+			expectedErrorMessage: "unsupported consensus version `foo`",
+		},
+		{
+			name:                 "invalid blinded header",
+			consensusVersion:     "deneb",
+			blinded:              "foo",
+			data:                 denebContentsSsz,
+			expectedErrorMessage: "failed to parse Eth-Execution-Payload-Blinded header",
+		},
+		{
+			name:                 "block decoding failed",
+			consensusVersion:     "deneb",
+			blinded:              "true",
+			data:                 denebContentsSsz,
+			expectedErrorMessage: "failed to decode deneb block response ssz",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+
+			header := http.Header{}
+			header.Set(api.VersionHeader, testCase.consensusVersion)
+			header.Set(api.ExecutionPayloadBlindedHeader, testCase.blinded)
+			jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
+			jsonRestHandler.EXPECT().GetSSZ(
+				gomock.Any(),
+				gomock.Any(),
+				&structs.ProduceBlockV3Response{},
+			).Return(
+				testCase.data,
+				header,
+				nil,
+			).Times(1)
+
+			validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
+			beaconBlock, err := validatorClient.beaconBlock(ctx, 1, []byte{1}, []byte{2}, nil)
+			if testCase.expectedErrorMessage != "" {
+				assert.ErrorContains(t, testCase.expectedErrorMessage, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.DeepEqual(t, testCase.expected, beaconBlock)
+		})
+	}
+}
+
 func TestGetBeaconBlock_FallbackToBlindedBlock(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -606,11 +764,13 @@ func TestGetBeaconBlock_FallbackToBlindedBlock(t *testing.T) {
 	ctx := context.Background()
 
 	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
-	jsonRestHandler.EXPECT().Get(
+	jsonRestHandler.EXPECT().GetSSZ(
 		gomock.Any(),
 		fmt.Sprintf("/eth/v3/validator/blocks/%d?graffiti=%s&randao_reveal=%s", slot, hexutil.Encode(graffiti), hexutil.Encode(randaoReveal)),
 		&structs.ProduceBlockV3Response{},
 	).Return(
+		nil,
+		nil,
 		&httputil.DefaultJsonError{Code: http.StatusNotFound},
 	).Times(1)
 	jsonRestHandler.EXPECT().Get(
@@ -628,7 +788,7 @@ func TestGetBeaconBlock_FallbackToBlindedBlock(t *testing.T) {
 	).Times(1)
 
 	validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
-	beaconBlock, err := validatorClient.beaconBlock(ctx, slot, randaoReveal, graffiti)
+	beaconBlock, err := validatorClient.beaconBlock(ctx, slot, randaoReveal, graffiti, nil)
 	require.NoError(t, err)
 
 	expectedBeaconBlock := &ethpb.GenericBeaconBlock{
@@ -657,11 +817,13 @@ func TestGetBeaconBlock_FallbackToFullBlock(t *testing.T) {
 	ctx := context.Background()
 
 	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
-	jsonRestHandler.EXPECT().Get(
+	jsonRestHandler.EXPECT().GetSSZ(
 		gomock.Any(),
 		fmt.Sprintf("/eth/v3/validator/blocks/%d?graffiti=%s&randao_reveal=%s", slot, hexutil.Encode(graffiti), hexutil.Encode(randaoReveal)),
 		&structs.ProduceBlockV3Response{},
 	).Return(
+		nil,
+		nil,
 		&httputil.DefaultJsonError{Code: http.StatusNotFound},
 	).Times(1)
 	jsonRestHandler.EXPECT().Get(
@@ -686,7 +848,7 @@ func TestGetBeaconBlock_FallbackToFullBlock(t *testing.T) {
 	).Times(1)
 
 	validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
-	beaconBlock, err := validatorClient.beaconBlock(ctx, slot, randaoReveal, graffiti)
+	beaconBlock, err := validatorClient.beaconBlock(ctx, slot, randaoReveal, graffiti, nil)
 	require.NoError(t, err)
 
 	expectedBeaconBlock := &ethpb.GenericBeaconBlock{
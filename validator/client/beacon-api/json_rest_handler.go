@@ -4,18 +4,25 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"strings"
+	"sync"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/api"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 )
 
+// sszAcceptHeader prefers SSZ-encoded responses, and accepts JSON from servers which cannot encode the response as SSZ.
+var sszAcceptHeader = fmt.Sprintf("%s;q=0.95,%s;q=0.9", api.OctetStreamMediaType, api.JsonMediaType)
+
 type JsonRestHandler interface {
 	Get(ctx context.Context, endpoint string, resp interface{}) error
+	GetSSZ(ctx context.Context, endpoint string, resp interface{}) ([]byte, http.Header, error)
 	Post(ctx context.Context, endpoint string, headers map[string]string, data *bytes.Buffer, resp interface{}) error
+	PostSSZ(ctx context.Context, endpoint string, headers map[string]string, data *bytes.Buffer) error
 	HttpClient() *http.Client
 	Host() string
 	SetHost(host string)
@@ -24,6 +31,9 @@ type JsonRestHandler interface {
 type BeaconApiJsonRestHandler struct {
 	client http.Client
 	host   string
+	// sszUnsupported records the host and endpoint pairs which answered an SSZ request body with
+	// http.StatusUnsupportedMediaType, so that SSZ is not sent to them again.
+	sszUnsupported sync.Map
 }
 
 // NewBeaconApiJsonRestHandler returns a JsonRestHandler
@@ -66,6 +76,38 @@ func (c *BeaconApiJsonRestHandler) Get(ctx context.Context, endpoint string, res
 	return decodeResp(httpResp, resp)
 }
 
+// GetSSZ sends a GET request which prefers an SSZ-encoded response over a JSON one.
+// When the server responds with SSZ, the raw body is returned and resp is left untouched. Otherwise, the body is decoded
+// as a JSON object into the passed in object and a nil body is returned. The response headers are returned in both cases.
+// If an HTTP error is returned, the body is decoded as a DefaultJsonError JSON object and returned as the error.
+func (c *BeaconApiJsonRestHandler) GetSSZ(ctx context.Context, endpoint string, resp interface{}) ([]byte, http.Header, error) {
+	url := c.host + endpoint
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to create request for endpoint %s", url)
+	}
+	req.Header.Set("Accept", sszAcceptHeader)
+
+	httpResp, err := c.client.Do(req)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to perform request for endpoint %s", url)
+	}
+	defer func() {
+		if err := httpResp.Body.Close(); err != nil {
+			return
+		}
+	}()
+
+	if !strings.HasPrefix(httpResp.Status, "2") || !strings.Contains(httpResp.Header.Get("Content-Type"), api.OctetStreamMediaType) {
+		return nil, httpResp.Header, decodeResp(httpResp, resp)
+	}
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to read response body for %s", url)
+	}
+	return body, httpResp.Header, nil
+}
+
 // Post sends a POST request and decodes the response body as a JSON object into the passed in object.
 // If an HTTP error is returned, the body is decoded as a DefaultJsonError JSON object and returned as the first return value.
 func (c *BeaconApiJsonRestHandler) Post(
@@ -74,6 +116,42 @@ func (c *BeaconApiJsonRestHandler) Post(
 	headers map[string]string,
 	data *bytes.Buffer,
 	resp interface{},
+) error {
+	return c.post(ctx, apiEndpoint, headers, api.JsonMediaType, data, resp)
+}
+
+// PostSSZ sends a POST request with an SSZ-encoded body.
+// If an HTTP error is returned, the body is decoded as a DefaultJsonError JSON object and returned as the error.
+// Servers which cannot decode SSZ request bodies respond with http.StatusUnsupportedMediaType. That response is
+// remembered for the host and endpoint, and later calls return it without sending the request.
+func (c *BeaconApiJsonRestHandler) PostSSZ(
+	ctx context.Context,
+	apiEndpoint string,
+	headers map[string]string,
+	data *bytes.Buffer,
+) error {
+	key := c.host + apiEndpoint
+	if _, ok := c.sszUnsupported.Load(key); ok {
+		return &httputil.DefaultJsonError{
+			Code:    http.StatusUnsupportedMediaType,
+			Message: fmt.Sprintf("endpoint %s does not support SSZ request bodies", key),
+		}
+	}
+	err := c.post(ctx, apiEndpoint, headers, api.OctetStreamMediaType, data, nil)
+	errJson := &httputil.DefaultJsonError{}
+	if errors.As(err, &errJson) && errJson.Code == http.StatusUnsupportedMediaType {
+		c.sszUnsupported.Store(key, struct{}{})
+	}
+	return err
+}
+
+func (c *BeaconApiJsonRestHandler) post(
+	ctx context.Context,
+	apiEndpoint string,
+	headers map[string]string,
+	contentType string,
+	data *bytes.Buffer,
+	resp interface{},
 ) error {
 	if data == nil {
 		return errors.New("data is nil")
@@ -88,7 +166,7 @@ func (c *BeaconApiJsonRestHandler) Post(
 	for headerKey, headerValue := range headers {
 		req.Header.Set(headerKey, headerValue)
 	}
-	req.Header.Set("Content-Type", api.JsonMediaType)
+	req.Header.Set("Content-Type", contentType)
 
 	httpResp, err := c.client.Do(req)
 	if err != nil {
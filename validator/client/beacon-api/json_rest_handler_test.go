@@ -49,6 +49,83 @@ func TestGet(t *testing.T) {
 	assert.DeepEqual(t, genesisJson, resp)
 }
 
+func TestGetSSZ(t *testing.T) {
+	ctx := context.Background()
+	const endpoint = "/example/rest/api/endpoint"
+	sszBytes := []byte{1, 2, 3, 4, 5}
+	genesisJson := &structs.GetGenesisResponse{
+		Data: &structs.Genesis{
+			GenesisTime:           "123",
+			GenesisValidatorsRoot: "0x456",
+			GenesisForkVersion:    "0x789",
+		},
+	}
+
+	t.Run("SSZ response", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, true, httputil.RespondWithSsz(r))
+			w.Header().Set("Content-Type", api.OctetStreamMediaType)
+			w.Header().Set(api.VersionHeader, "electra")
+			_, err := w.Write(sszBytes)
+			require.NoError(t, err)
+		})
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		jsonRestHandler := BeaconApiJsonRestHandler{
+			client: http.Client{Timeout: time.Second * 5},
+			host:   server.URL,
+		}
+		resp := &structs.GetGenesisResponse{}
+		body, header, err := jsonRestHandler.GetSSZ(ctx, endpoint, resp)
+		require.NoError(t, err)
+		assert.DeepEqual(t, sszBytes, body)
+		assert.Equal(t, "electra", header.Get(api.VersionHeader))
+		assert.DeepEqual(t, &structs.GetGenesisResponse{}, resp)
+	})
+	t.Run("JSON response", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+			marshalledJson, err := json.Marshal(genesisJson)
+			require.NoError(t, err)
+			w.Header().Set("Content-Type", api.JsonMediaType)
+			_, err = w.Write(marshalledJson)
+			require.NoError(t, err)
+		})
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		jsonRestHandler := BeaconApiJsonRestHandler{
+			client: http.Client{Timeout: time.Second * 5},
+			host:   server.URL,
+		}
+		resp := &structs.GetGenesisResponse{}
+		body, _, err := jsonRestHandler.GetSSZ(ctx, endpoint, resp)
+		require.NoError(t, err)
+		assert.Equal(t, true, body == nil)
+		assert.DeepEqual(t, genesisJson, resp)
+	})
+	t.Run("error response", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+			httputil.HandleError(w, "foo error", http.StatusInternalServerError)
+		})
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		jsonRestHandler := BeaconApiJsonRestHandler{
+			client: http.Client{Timeout: time.Second * 5},
+			host:   server.URL,
+		}
+		_, _, err := jsonRestHandler.GetSSZ(ctx, endpoint, nil)
+		errJson := &httputil.DefaultJsonError{}
+		require.Equal(t, true, errors.As(err, &errJson))
+		assert.Equal(t, http.StatusInternalServerError, errJson.Code)
+		assert.Equal(t, "foo error", errJson.Message)
+	})
+}
+
 func TestPost(t *testing.T) {
 	ctx := context.Background()
 	const endpoint = "/example/rest/api/endpoint"
@@ -95,6 +172,58 @@ func TestPost(t *testing.T) {
 	assert.DeepEqual(t, genesisJson, resp)
 }
 
+func TestPostSSZ(t *testing.T) {
+	ctx := context.Background()
+	const endpoint = "/example/rest/api/endpoint"
+	dataBytes := []byte{1, 2, 3, 4, 5}
+	headers := map[string]string{"foo": "bar"}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+		// Make sure the request headers have been set
+		assert.Equal(t, "bar", r.Header.Get("foo"))
+		assert.Equal(t, true, httputil.IsRequestSsz(r))
+
+		receivedBytes, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.DeepEqual(t, dataBytes, receivedBytes)
+		w.WriteHeader(http.StatusOK)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	jsonRestHandler := BeaconApiJsonRestHandler{
+		client: http.Client{Timeout: time.Second * 5},
+		host:   server.URL,
+	}
+	require.NoError(t, jsonRestHandler.PostSSZ(ctx, endpoint, headers, bytes.NewBuffer(dataBytes)))
+}
+
+func TestPostSSZ_UnsupportedMediaTypeIsRemembered(t *testing.T) {
+	ctx := context.Background()
+	const endpoint = "/example/rest/api/endpoint"
+	requests := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		http.Error(w, "Unsupported media type", http.StatusUnsupportedMediaType)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	jsonRestHandler := BeaconApiJsonRestHandler{
+		client: http.Client{Timeout: time.Second * 5},
+		host:   server.URL,
+	}
+	for i := 0; i < 2; i++ {
+		err := jsonRestHandler.PostSSZ(ctx, endpoint, nil, bytes.NewBuffer([]byte{1}))
+		errJson := &httputil.DefaultJsonError{}
+		require.Equal(t, true, errors.As(err, &errJson))
+		assert.Equal(t, http.StatusUnsupportedMediaType, errJson.Code)
+	}
+	assert.Equal(t, 1, requests)
+}
+
 func Test_decodeResp(t *testing.T) {
 	type j struct {
 		Foo string `json:"foo"`
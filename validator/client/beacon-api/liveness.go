@@ -0,0 +1,45 @@
+package beacon_api
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+)
+
+func (c *beaconApiValidatorClient) validatorsLiveness(ctx context.Context, epoch primitives.Epoch, indices []primitives.ValidatorIndex) ([]*iface.ValidatorLiveness, error) {
+	stringIndices := make([]string, len(indices))
+	for i, index := range indices {
+		stringIndices[i] = uint64ToString(index)
+	}
+
+	livenessResponse, err := c.liveness(ctx, epoch, stringIndices)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get liveness for epoch %d", epoch)
+	}
+
+	if livenessResponse == nil || livenessResponse.Data == nil {
+		return nil, errors.New("liveness response is nil")
+	}
+
+	result := make([]*iface.ValidatorLiveness, len(livenessResponse.Data))
+	for i, liveness := range livenessResponse.Data {
+		if liveness == nil {
+			return nil, errors.New("validator liveness is nil")
+		}
+
+		index, err := strconv.ParseUint(liveness.Index, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse validator index %s", liveness.Index)
+		}
+
+		result[i] = &iface.ValidatorLiveness{
+			Index:  primitives.ValidatorIndex(index),
+			IsLive: liveness.IsLive,
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,106 @@
+package beacon_api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/beacon-api/mock"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+	"go.uber.org/mock/gomock"
+)
+
+func TestLiveness_Nominal(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	marshalledIndexes, err := json.Marshal([]string{"1", "2"})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
+	jsonRestHandler.EXPECT().Post(
+		gomock.Any(),
+		livenessEndpoint,
+		nil,
+		bytes.NewBuffer(marshalledIndexes),
+		&structs.GetLivenessResponse{},
+	).SetArg(
+		4,
+		structs.GetLivenessResponse{
+			Data: []*structs.Liveness{
+				{Index: "1", IsLive: true},
+				{Index: "2", IsLive: false},
+			},
+		},
+	).Return(
+		nil,
+	).Times(1)
+
+	validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
+	liveness, err := validatorClient.Liveness(ctx, 42, []primitives.ValidatorIndex{1, 2})
+	require.NoError(t, err)
+
+	expected := []*iface.ValidatorLiveness{
+		{Index: 1, IsLive: true},
+		{Index: 2, IsLive: false},
+	}
+	assert.DeepEqual(t, expected, liveness)
+}
+
+func TestLiveness_Invalid(t *testing.T) {
+	testCases := []struct {
+		name             string
+		livenessResponse structs.GetLivenessResponse
+		expectedError    string
+	}{
+		{
+			name:             "nil data",
+			livenessResponse: structs.GetLivenessResponse{},
+			expectedError:    "liveness response is nil",
+		},
+		{
+			name:             "nil liveness",
+			livenessResponse: structs.GetLivenessResponse{Data: []*structs.Liveness{nil}},
+			expectedError:    "validator liveness is nil",
+		},
+		{
+			name:             "invalid index",
+			livenessResponse: structs.GetLivenessResponse{Data: []*structs.Liveness{{Index: "foo"}}},
+			expectedError:    "failed to parse validator index foo",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+
+			jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
+			jsonRestHandler.EXPECT().Post(
+				gomock.Any(),
+				livenessEndpoint,
+				nil,
+				gomock.Any(),
+				gomock.Any(),
+			).SetArg(
+				4,
+				testCase.livenessResponse,
+			).Return(
+				nil,
+			).Times(1)
+
+			validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
+			_, err := validatorClient.Liveness(ctx, 42, []primitives.ValidatorIndex{1})
+			assert.ErrorContains(t, testCase.expectedError, err)
+		})
+	}
+}
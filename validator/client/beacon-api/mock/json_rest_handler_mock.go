@@ -55,6 +55,22 @@ func (mr *MockJsonRestHandlerMockRecorder) Get(ctx, endpoint, resp any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockJsonRestHandler)(nil).Get), ctx, endpoint, resp)
 }
 
+// GetSSZ mocks base method.
+func (m *MockJsonRestHandler) GetSSZ(ctx context.Context, endpoint string, resp any) ([]byte, http.Header, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSSZ", ctx, endpoint, resp)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(http.Header)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSSZ indicates an expected call of GetSSZ.
+func (mr *MockJsonRestHandlerMockRecorder) GetSSZ(ctx, endpoint, resp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSSZ", reflect.TypeOf((*MockJsonRestHandler)(nil).GetSSZ), ctx, endpoint, resp)
+}
+
 // Host mocks base method.
 func (m *MockJsonRestHandler) Host() string {
 	m.ctrl.T.Helper()
@@ -97,6 +113,20 @@ func (mr *MockJsonRestHandlerMockRecorder) Post(ctx, endpoint, headers, data, re
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Post", reflect.TypeOf((*MockJsonRestHandler)(nil).Post), ctx, endpoint, headers, data, resp)
 }
 
+// PostSSZ mocks base method.
+func (m *MockJsonRestHandler) PostSSZ(ctx context.Context, endpoint string, headers map[string]string, data *bytes.Buffer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostSSZ", ctx, endpoint, headers, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PostSSZ indicates an expected call of PostSSZ.
+func (mr *MockJsonRestHandlerMockRecorder) PostSSZ(ctx, endpoint, headers, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostSSZ", reflect.TypeOf((*MockJsonRestHandler)(nil).PostSSZ), ctx, endpoint, headers, data)
+}
+
 // SetHost mocks base method.
 func (m *MockJsonRestHandler) SetHost(host string) {
 	m.ctrl.T.Helper()
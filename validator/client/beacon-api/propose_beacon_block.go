@@ -8,6 +8,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
+	ssz "github.com/prysmaticlabs/fastssz"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
@@ -18,9 +19,9 @@ import (
 func (c *beaconApiValidatorClient) proposeBeaconBlock(ctx context.Context, in *ethpb.GenericSignedBeaconBlock) (*ethpb.ProposeResponse, error) {
 	var consensusVersion string
 	var beaconBlockRoot [32]byte
+	var sszBlock ssz.Marshaler
 
 	var err error
-	var marshalledSignedBeaconBlockJson []byte
 	blinded := false
 
 	switch blockType := in.Block.(type) {
@@ -30,33 +31,21 @@ func (c *beaconApiValidatorClient) proposeBeaconBlock(ctx context.Context, in *e
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to compute block root for phase0 beacon block")
 		}
-
-		marshalledSignedBeaconBlockJson, err = marshallBeaconBlockPhase0(blockType.Phase0)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to marshall phase0 beacon block")
-		}
+		sszBlock = blockType.Phase0
 	case *ethpb.GenericSignedBeaconBlock_Altair:
 		consensusVersion = "altair"
 		beaconBlockRoot, err = blockType.Altair.Block.HashTreeRoot()
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to compute block root for altair beacon block")
 		}
-
-		marshalledSignedBeaconBlockJson, err = marshallBeaconBlockAltair(blockType.Altair)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to marshall altair beacon block")
-		}
+		sszBlock = blockType.Altair
 	case *ethpb.GenericSignedBeaconBlock_Bellatrix:
 		consensusVersion = "bellatrix"
 		beaconBlockRoot, err = blockType.Bellatrix.Block.HashTreeRoot()
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to compute block root for bellatrix beacon block")
 		}
-
-		marshalledSignedBeaconBlockJson, err = marshallBeaconBlockBellatrix(blockType.Bellatrix)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to marshall bellatrix beacon block")
-		}
+		sszBlock = blockType.Bellatrix
 	case *ethpb.GenericSignedBeaconBlock_BlindedBellatrix:
 		blinded = true
 		consensusVersion = "bellatrix"
@@ -64,22 +53,14 @@ func (c *beaconApiValidatorClient) proposeBeaconBlock(ctx context.Context, in *e
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to compute block root for blinded bellatrix beacon block")
 		}
-
-		marshalledSignedBeaconBlockJson, err = marshallBeaconBlockBlindedBellatrix(blockType.BlindedBellatrix)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to marshall blinded bellatrix beacon block")
-		}
+		sszBlock = blockType.BlindedBellatrix
 	case *ethpb.GenericSignedBeaconBlock_Capella:
 		consensusVersion = "capella"
 		beaconBlockRoot, err = blockType.Capella.Block.HashTreeRoot()
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to compute block root for capella beacon block")
 		}
-
-		marshalledSignedBeaconBlockJson, err = marshallBeaconBlockCapella(blockType.Capella)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to marshall capella beacon block")
-		}
+		sszBlock = blockType.Capella
 	case *ethpb.GenericSignedBeaconBlock_BlindedCapella:
 		blinded = true
 		consensusVersion = "capella"
@@ -87,25 +68,14 @@ func (c *beaconApiValidatorClient) proposeBeaconBlock(ctx context.Context, in *e
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to compute block root for blinded capella beacon block")
 		}
-
-		marshalledSignedBeaconBlockJson, err = marshallBeaconBlockBlindedCapella(blockType.BlindedCapella)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to marshall blinded capella beacon block")
-		}
+		sszBlock = blockType.BlindedCapella
 	case *ethpb.GenericSignedBeaconBlock_Deneb:
 		consensusVersion = "deneb"
 		beaconBlockRoot, err = blockType.Deneb.Block.HashTreeRoot()
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to compute block root for deneb beacon block")
 		}
-		signedBlock, err := structs.SignedBeaconBlockContentsDenebFromConsensus(blockType.Deneb)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to convert deneb beacon block contents")
-		}
-		marshalledSignedBeaconBlockJson, err = json.Marshal(signedBlock)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to marshal deneb beacon block contents")
-		}
+		sszBlock = blockType.Deneb
 	case *ethpb.GenericSignedBeaconBlock_BlindedDeneb:
 		blinded = true
 		consensusVersion = "deneb"
@@ -113,28 +83,14 @@ func (c *beaconApiValidatorClient) proposeBeaconBlock(ctx context.Context, in *e
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to compute block root for blinded deneb beacon block")
 		}
-		signedBlock, err := structs.SignedBlindedBeaconBlockDenebFromConsensus(blockType.BlindedDeneb)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to convert blinded deneb beacon block contents")
-		}
-		marshalledSignedBeaconBlockJson, err = json.Marshal(signedBlock)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to marshal blinded deneb beacon block contents")
-		}
+		sszBlock = blockType.BlindedDeneb
 	case *ethpb.GenericSignedBeaconBlock_Electra:
 		consensusVersion = "electra"
 		beaconBlockRoot, err = blockType.Electra.Block.HashTreeRoot()
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to compute block root for electra beacon block")
 		}
-		signedBlock, err := structs.SignedBeaconBlockContentsElectraFromConsensus(blockType.Electra)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to convert electra beacon block contents")
-		}
-		marshalledSignedBeaconBlockJson, err = json.Marshal(signedBlock)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to marshal electra beacon block contents")
-		}
+		sszBlock = blockType.Electra
 	case *ethpb.GenericSignedBeaconBlock_BlindedElectra:
 		blinded = true
 		consensusVersion = "electra"
@@ -142,28 +98,14 @@ func (c *beaconApiValidatorClient) proposeBeaconBlock(ctx context.Context, in *e
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to compute block root for blinded electra beacon block")
 		}
-		signedBlock, err := structs.SignedBlindedBeaconBlockElectraFromConsensus(blockType.BlindedElectra)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to convert blinded electra beacon block contents")
-		}
-		marshalledSignedBeaconBlockJson, err = json.Marshal(signedBlock)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to marshal blinded electra beacon block contents")
-		}
+		sszBlock = blockType.BlindedElectra
 	case *ethpb.GenericSignedBeaconBlock_Fulu:
 		consensusVersion = "fulu"
 		beaconBlockRoot, err = blockType.Fulu.Block.HashTreeRoot()
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to compute block root for fulu beacon block")
 		}
-		signedBlock, err := structs.SignedBeaconBlockContentsFuluFromConsensus(blockType.Fulu)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to convert fulu beacon block contents")
-		}
-		marshalledSignedBeaconBlockJson, err = json.Marshal(signedBlock)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to marshal fulu beacon block contents")
-		}
+		sszBlock = blockType.Fulu
 	case *ethpb.GenericSignedBeaconBlock_BlindedFulu:
 		blinded = true
 		consensusVersion = "fulu"
@@ -171,14 +113,7 @@ func (c *beaconApiValidatorClient) proposeBeaconBlock(ctx context.Context, in *e
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to compute block root for blinded fulu beacon block")
 		}
-		signedBlock, err := structs.SignedBlindedBeaconBlockFuluFromConsensus(blockType.BlindedFulu)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to convert blinded fulu beacon block contents")
-		}
-		marshalledSignedBeaconBlockJson, err = json.Marshal(signedBlock)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to marshal blinded fulu beacon block contents")
-		}
+		sszBlock = blockType.BlindedFulu
 	default:
 		return nil, errors.Errorf("unsupported block type %T", in.Block)
 	}
@@ -190,8 +125,20 @@ func (c *beaconApiValidatorClient) proposeBeaconBlock(ctx context.Context, in *e
 	}
 
 	headers := map[string]string{"Eth-Consensus-Version": consensusVersion}
-	err = c.jsonRestHandler.Post(ctx, endpoint, headers, bytes.NewBuffer(marshalledSignedBeaconBlockJson), nil)
+	marshalledSignedBeaconBlockSsz, err := sszBlock.MarshalSSZ()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal %s beacon block ssz", consensusVersion)
+	}
+	err = c.jsonRestHandler.PostSSZ(ctx, endpoint, headers, bytes.NewBuffer(marshalledSignedBeaconBlockSsz))
 	errJson := &httputil.DefaultJsonError{}
+	if errors.As(err, &errJson) && errJson.Code == http.StatusUnsupportedMediaType {
+		log.Debugf("Endpoint %s does not support SSZ, falling back to JSON for block publishing.", endpoint)
+		marshalledSignedBeaconBlockJson, jsonErr := marshallSignedBeaconBlockJson(in)
+		if jsonErr != nil {
+			return nil, jsonErr
+		}
+		err = c.jsonRestHandler.Post(ctx, endpoint, headers, bytes.NewBuffer(marshalledSignedBeaconBlockJson), nil)
+	}
 	if err != nil {
 		if !errors.As(err, &errJson) {
 			return nil, err
@@ -206,6 +153,103 @@ func (c *beaconApiValidatorClient) proposeBeaconBlock(ctx context.Context, in *e
 	return &ethpb.ProposeResponse{BlockRoot: beaconBlockRoot[:]}, nil
 }
 
+// marshallSignedBeaconBlockJson encodes the block as JSON, for servers which do not accept SSZ-encoded blocks.
+func marshallSignedBeaconBlockJson(in *ethpb.GenericSignedBeaconBlock) ([]byte, error) {
+	var marshalledSignedBeaconBlockJson []byte
+	var err error
+
+	switch blockType := in.Block.(type) {
+	case *ethpb.GenericSignedBeaconBlock_Phase0:
+		marshalledSignedBeaconBlockJson, err = marshallBeaconBlockPhase0(blockType.Phase0)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshall phase0 beacon block")
+		}
+	case *ethpb.GenericSignedBeaconBlock_Altair:
+		marshalledSignedBeaconBlockJson, err = marshallBeaconBlockAltair(blockType.Altair)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshall altair beacon block")
+		}
+	case *ethpb.GenericSignedBeaconBlock_Bellatrix:
+		marshalledSignedBeaconBlockJson, err = marshallBeaconBlockBellatrix(blockType.Bellatrix)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshall bellatrix beacon block")
+		}
+	case *ethpb.GenericSignedBeaconBlock_BlindedBellatrix:
+		marshalledSignedBeaconBlockJson, err = marshallBeaconBlockBlindedBellatrix(blockType.BlindedBellatrix)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshall blinded bellatrix beacon block")
+		}
+	case *ethpb.GenericSignedBeaconBlock_Capella:
+		marshalledSignedBeaconBlockJson, err = marshallBeaconBlockCapella(blockType.Capella)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshall capella beacon block")
+		}
+	case *ethpb.GenericSignedBeaconBlock_BlindedCapella:
+		marshalledSignedBeaconBlockJson, err = marshallBeaconBlockBlindedCapella(blockType.BlindedCapella)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshall blinded capella beacon block")
+		}
+	case *ethpb.GenericSignedBeaconBlock_Deneb:
+		signedBlock, err := structs.SignedBeaconBlockContentsDenebFromConsensus(blockType.Deneb)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to convert deneb beacon block contents")
+		}
+		marshalledSignedBeaconBlockJson, err = json.Marshal(signedBlock)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal deneb beacon block contents")
+		}
+	case *ethpb.GenericSignedBeaconBlock_BlindedDeneb:
+		signedBlock, err := structs.SignedBlindedBeaconBlockDenebFromConsensus(blockType.BlindedDeneb)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to convert blinded deneb beacon block contents")
+		}
+		marshalledSignedBeaconBlockJson, err = json.Marshal(signedBlock)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal blinded deneb beacon block contents")
+		}
+	case *ethpb.GenericSignedBeaconBlock_Electra:
+		signedBlock, err := structs.SignedBeaconBlockContentsElectraFromConsensus(blockType.Electra)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to convert electra beacon block contents")
+		}
+		marshalledSignedBeaconBlockJson, err = json.Marshal(signedBlock)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal electra beacon block contents")
+		}
+	case *ethpb.GenericSignedBeaconBlock_BlindedElectra:
+		signedBlock, err := structs.SignedBlindedBeaconBlockElectraFromConsensus(blockType.BlindedElectra)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to convert blinded electra beacon block contents")
+		}
+		marshalledSignedBeaconBlockJson, err = json.Marshal(signedBlock)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal blinded electra beacon block contents")
+		}
+	case *ethpb.GenericSignedBeaconBlock_Fulu:
+		signedBlock, err := structs.SignedBeaconBlockContentsFuluFromConsensus(blockType.Fulu)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to convert fulu beacon block contents")
+		}
+		marshalledSignedBeaconBlockJson, err = json.Marshal(signedBlock)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal fulu beacon block contents")
+		}
+	case *ethpb.GenericSignedBeaconBlock_BlindedFulu:
+		signedBlock, err := structs.SignedBlindedBeaconBlockFuluFromConsensus(blockType.BlindedFulu)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to convert blinded fulu beacon block contents")
+		}
+		marshalledSignedBeaconBlockJson, err = json.Marshal(signedBlock)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal blinded fulu beacon block contents")
+		}
+	default:
+		return nil, errors.Errorf("unsupported block type %T", in.Block)
+	}
+
+	return marshalledSignedBeaconBlockJson, nil
+}
+
 func marshallBeaconBlockPhase0(block *ethpb.SignedBeaconBlock) ([]byte, error) {
 	signedBeaconBlockJson := &structs.SignedBeaconBlock{
 		Signature: hexutil.Encode(block.Signature),
@@ -4,10 +4,12 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"net/http"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
@@ -57,6 +59,15 @@ func TestProposeBeaconBlock_Altair(t *testing.T) {
 
 	// Make sure that what we send in the POST body is the marshalled version of the protobuf block
 	headers := map[string]string{"Eth-Consensus-Version": "altair"}
+	// The JSON endpoint is only called when the server does not accept SSZ-encoded blocks
+	jsonRestHandler.EXPECT().PostSSZ(
+		gomock.Any(),
+		"/eth/v2/beacon/blocks",
+		headers,
+		gomock.Any(),
+	).Return(
+		&httputil.DefaultJsonError{Code: http.StatusUnsupportedMediaType},
+	).Times(1)
 	jsonRestHandler.EXPECT().Post(
 		gomock.Any(),
 		"/eth/v2/beacon/blocks",
@@ -77,6 +88,40 @@ func TestProposeBeaconBlock_Altair(t *testing.T) {
 	assert.DeepEqual(t, expectedBlockRoot[:], proposeResponse.BlockRoot)
 }
 
+func TestProposeBeaconBlock_Altair_SSZ(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
+
+	altairBlock := generateSignedAltairBlock()
+	genericSignedBlock := &ethpb.GenericSignedBeaconBlock{Block: altairBlock}
+
+	sszBlock, err := altairBlock.Altair.MarshalSSZ()
+	require.NoError(t, err)
+
+	// The JSON endpoint is not called when the server accepts the SSZ-encoded block
+	headers := map[string]string{"Eth-Consensus-Version": "altair"}
+	jsonRestHandler.EXPECT().PostSSZ(
+		gomock.Any(),
+		"/eth/v2/beacon/blocks",
+		headers,
+		bytes.NewBuffer(sszBlock),
+	).Return(
+		nil,
+	).Times(1)
+
+	validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
+	proposeResponse, err := validatorClient.proposeBeaconBlock(context.Background(), genericSignedBlock)
+	assert.NoError(t, err)
+	require.NotNil(t, proposeResponse)
+
+	expectedBlockRoot, err := altairBlock.Altair.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	// Make sure that the block root is set
+	assert.DeepEqual(t, expectedBlockRoot[:], proposeResponse.BlockRoot)
+}
+
 func generateSignedAltairBlock() *ethpb.GenericSignedBeaconBlock_Altair {
 	return &ethpb.GenericSignedBeaconBlock_Altair{
 		Altair: &ethpb.SignedBeaconBlockAltair{
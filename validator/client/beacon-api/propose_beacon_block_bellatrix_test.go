@@ -4,11 +4,13 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"net/http"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
@@ -74,6 +76,15 @@ func TestProposeBeaconBlock_Bellatrix(t *testing.T) {
 
 	// Make sure that what we send in the POST body is the marshalled version of the protobuf block
 	headers := map[string]string{"Eth-Consensus-Version": "bellatrix"}
+	// The JSON endpoint is only called when the server does not accept SSZ-encoded blocks
+	jsonRestHandler.EXPECT().PostSSZ(
+		gomock.Any(),
+		"/eth/v2/beacon/blocks",
+		headers,
+		gomock.Any(),
+	).Return(
+		&httputil.DefaultJsonError{Code: http.StatusUnsupportedMediaType},
+	).Times(1)
 	jsonRestHandler.EXPECT().Post(
 		gomock.Any(),
 		"/eth/v2/beacon/blocks",
@@ -94,6 +105,40 @@ func TestProposeBeaconBlock_Bellatrix(t *testing.T) {
 	assert.DeepEqual(t, expectedBlockRoot[:], proposeResponse.BlockRoot)
 }
 
+func TestProposeBeaconBlock_Bellatrix_SSZ(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
+
+	bellatrixBlock := generateSignedBellatrixBlock()
+	genericSignedBlock := &ethpb.GenericSignedBeaconBlock{Block: bellatrixBlock}
+
+	sszBlock, err := bellatrixBlock.Bellatrix.MarshalSSZ()
+	require.NoError(t, err)
+
+	// The JSON endpoint is not called when the server accepts the SSZ-encoded block
+	headers := map[string]string{"Eth-Consensus-Version": "bellatrix"}
+	jsonRestHandler.EXPECT().PostSSZ(
+		gomock.Any(),
+		"/eth/v2/beacon/blocks",
+		headers,
+		bytes.NewBuffer(sszBlock),
+	).Return(
+		nil,
+	).Times(1)
+
+	validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
+	proposeResponse, err := validatorClient.proposeBeaconBlock(context.Background(), genericSignedBlock)
+	assert.NoError(t, err)
+	require.NotNil(t, proposeResponse)
+
+	expectedBlockRoot, err := bellatrixBlock.Bellatrix.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	// Make sure that the block root is set
+	assert.DeepEqual(t, expectedBlockRoot[:], proposeResponse.BlockRoot)
+}
+
 func generateSignedBellatrixBlock() *ethpb.GenericSignedBeaconBlock_Bellatrix {
 	return &ethpb.GenericSignedBeaconBlock_Bellatrix{
 		Bellatrix: &ethpb.SignedBeaconBlockBellatrix{
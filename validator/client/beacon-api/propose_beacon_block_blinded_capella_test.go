@@ -4,11 +4,13 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"net/http"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 	enginev1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
@@ -77,6 +79,15 @@ func TestProposeBeaconBlock_BlindedCapella(t *testing.T) {
 
 	// Make sure that what we send in the POST body is the marshalled version of the protobuf block
 	headers := map[string]string{"Eth-Consensus-Version": "capella"}
+	// The JSON endpoint is only called when the server does not accept SSZ-encoded blocks
+	jsonRestHandler.EXPECT().PostSSZ(
+		gomock.Any(),
+		"/eth/v2/beacon/blinded_blocks",
+		headers,
+		gomock.Any(),
+	).Return(
+		&httputil.DefaultJsonError{Code: http.StatusUnsupportedMediaType},
+	).Times(1)
 	jsonRestHandler.EXPECT().Post(
 		gomock.Any(),
 		"/eth/v2/beacon/blinded_blocks",
@@ -97,6 +108,40 @@ func TestProposeBeaconBlock_BlindedCapella(t *testing.T) {
 	assert.DeepEqual(t, expectedBlockRoot[:], proposeResponse.BlockRoot)
 }
 
+func TestProposeBeaconBlock_BlindedCapella_SSZ(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
+
+	blindedCapellaBlock := generateSignedBlindedCapellaBlock()
+	genericSignedBlock := &ethpb.GenericSignedBeaconBlock{Block: blindedCapellaBlock}
+
+	sszBlock, err := blindedCapellaBlock.BlindedCapella.MarshalSSZ()
+	require.NoError(t, err)
+
+	// The JSON endpoint is not called when the server accepts the SSZ-encoded block
+	headers := map[string]string{"Eth-Consensus-Version": "capella"}
+	jsonRestHandler.EXPECT().PostSSZ(
+		gomock.Any(),
+		"/eth/v2/beacon/blinded_blocks",
+		headers,
+		bytes.NewBuffer(sszBlock),
+	).Return(
+		nil,
+	).Times(1)
+
+	validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
+	proposeResponse, err := validatorClient.proposeBeaconBlock(context.Background(), genericSignedBlock)
+	assert.NoError(t, err)
+	require.NotNil(t, proposeResponse)
+
+	expectedBlockRoot, err := blindedCapellaBlock.BlindedCapella.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	// Make sure that the block root is set
+	assert.DeepEqual(t, expectedBlockRoot[:], proposeResponse.BlockRoot)
+}
+
 func generateSignedBlindedCapellaBlock() *ethpb.GenericSignedBeaconBlock_BlindedCapella {
 	return &ethpb.GenericSignedBeaconBlock_BlindedCapella{
 		BlindedCapella: &ethpb.SignedBlindedBeaconBlockCapella{
@@ -4,10 +4,12 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"net/http"
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	rpctesting "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared/testing"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/validator/client/beacon-api/mock"
@@ -29,6 +31,15 @@ func TestProposeBeaconBlock_BlindedDeneb(t *testing.T) {
 	require.NoError(t, err)
 	// Make sure that what we send in the POST body is the marshalled version of the protobuf block
 	headers := map[string]string{"Eth-Consensus-Version": "deneb"}
+	// The JSON endpoint is only called when the server does not accept SSZ-encoded blocks
+	jsonRestHandler.EXPECT().PostSSZ(
+		gomock.Any(),
+		"/eth/v2/beacon/blinded_blocks",
+		headers,
+		gomock.Any(),
+	).Return(
+		&httputil.DefaultJsonError{Code: http.StatusUnsupportedMediaType},
+	).Times(1)
 	jsonRestHandler.EXPECT().Post(
 		gomock.Any(),
 		"/eth/v2/beacon/blinded_blocks",
@@ -48,3 +59,40 @@ func TestProposeBeaconBlock_BlindedDeneb(t *testing.T) {
 	// Make sure that the block root is set
 	assert.DeepEqual(t, expectedBlockRoot[:], proposeResponse.BlockRoot)
 }
+
+func TestProposeBeaconBlock_BlindedDeneb_SSZ(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
+
+	var block structs.SignedBlindedBeaconBlockDeneb
+	err := json.Unmarshal([]byte(rpctesting.BlindedDenebBlock), &block)
+	require.NoError(t, err)
+	genericSignedBlock, err := block.ToGeneric()
+	require.NoError(t, err)
+
+	sszBlock, err := genericSignedBlock.GetBlindedDeneb().MarshalSSZ()
+	require.NoError(t, err)
+
+	// The JSON endpoint is not called when the server accepts the SSZ-encoded block
+	headers := map[string]string{"Eth-Consensus-Version": "deneb"}
+	jsonRestHandler.EXPECT().PostSSZ(
+		gomock.Any(),
+		"/eth/v2/beacon/blinded_blocks",
+		headers,
+		bytes.NewBuffer(sszBlock),
+	).Return(
+		nil,
+	).Times(1)
+
+	validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
+	proposeResponse, err := validatorClient.proposeBeaconBlock(context.Background(), genericSignedBlock)
+	assert.NoError(t, err)
+	require.NotNil(t, proposeResponse)
+
+	expectedBlockRoot, err := genericSignedBlock.GetBlindedDeneb().HashTreeRoot()
+	require.NoError(t, err)
+
+	// Make sure that the block root is set
+	assert.DeepEqual(t, expectedBlockRoot[:], proposeResponse.BlockRoot)
+}
@@ -108,6 +108,14 @@ func TestProposeBeaconBlock_Error(t *testing.T) {
 				jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
 
 				headers := map[string]string{"Eth-Consensus-Version": testCase.consensusVersion}
+				jsonRestHandler.EXPECT().PostSSZ(
+					gomock.Any(),
+					testCase.endpoint,
+					headers,
+					gomock.Any(),
+				).Return(
+					&httputil.DefaultJsonError{Code: http.StatusUnsupportedMediaType},
+				).Times(1)
 				jsonRestHandler.EXPECT().Post(
 					gomock.Any(),
 					testCase.endpoint,
@@ -126,6 +134,58 @@ func TestProposeBeaconBlock_Error(t *testing.T) {
 	}
 }
 
+func TestProposeBeaconBlock_SSZError(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		returnedError        error
+		expectedErrorMessage string
+	}{
+		{
+			name:                 "error 202",
+			expectedErrorMessage: "block was successfully broadcast but failed validation",
+			returnedError: &httputil.DefaultJsonError{
+				Code:    http.StatusAccepted,
+				Message: "202 error",
+			},
+		},
+		{
+			name:                 "error 500",
+			expectedErrorMessage: "HTTP request unsuccessful (500: foo error)",
+			returnedError: &httputil.DefaultJsonError{
+				Code:    http.StatusInternalServerError,
+				Message: "foo error",
+			},
+		},
+		{
+			name:                 "other error",
+			expectedErrorMessage: "foo error",
+			returnedError:        errors.New("foo error"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
+
+			// The block is only published as JSON when the server does not accept SSZ
+			jsonRestHandler.EXPECT().PostSSZ(
+				gomock.Any(),
+				"/eth/v2/beacon/blocks",
+				map[string]string{"Eth-Consensus-Version": "capella"},
+				gomock.Any(),
+			).Return(
+				testCase.returnedError,
+			).Times(1)
+
+			validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
+			_, err := validatorClient.proposeBeaconBlock(context.Background(), &ethpb.GenericSignedBeaconBlock{Block: generateSignedCapellaBlock()})
+			assert.ErrorContains(t, testCase.expectedErrorMessage, err)
+		})
+	}
+}
+
 func TestProposeBeaconBlock_UnsupportedBlockType(t *testing.T) {
 	validatorClient := &beaconApiValidatorClient{}
 	_, err := validatorClient.proposeBeaconBlock(context.Background(), &ethpb.GenericSignedBeaconBlock{})
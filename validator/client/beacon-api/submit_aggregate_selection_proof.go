@@ -27,21 +27,11 @@ func (c *beaconApiValidatorClient) submitAggregateSelectionProof(
 		return nil, err
 	}
 
-	aggregateAttestationResponse, err := c.aggregateAttestation(ctx, in.Slot, attestationDataRoot, in.CommitteeIndex)
+	aggregatedAttestation, err := c.aggregateAttestation(ctx, in.Slot, attestationDataRoot, in.CommitteeIndex)
 	if err != nil {
 		return nil, err
 	}
 
-	var attData *structs.Attestation
-	if err := json.Unmarshal(aggregateAttestationResponse.Data, &attData); err != nil {
-		return nil, errors.Wrap(err, "failed to unmarshal aggregate attestation data")
-	}
-
-	aggregatedAttestation, err := convertAttestationToProto(attData)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to convert aggregate attestation json to proto")
-	}
-
 	return &ethpb.AggregateSelectionResponse{
 		AggregateAndProof: &ethpb.AggregateAttestationAndProof{
 			AggregatorIndex: index,
@@ -62,21 +52,11 @@ func (c *beaconApiValidatorClient) submitAggregateSelectionProofElectra(
 		return nil, err
 	}
 
-	aggregateAttestationResponse, err := c.aggregateAttestationElectra(ctx, in.Slot, attestationDataRoot, in.CommitteeIndex)
+	aggregatedAttestation, err := c.aggregateAttestationElectra(ctx, in.Slot, attestationDataRoot, in.CommitteeIndex)
 	if err != nil {
 		return nil, err
 	}
 
-	var attData *structs.AttestationElectra
-	if err := json.Unmarshal(aggregateAttestationResponse.Data, &attData); err != nil {
-		return nil, errors.Wrap(err, "failed to unmarshal aggregate attestation electra data")
-	}
-
-	aggregatedAttestation, err := convertAttestationElectraToProto(attData)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to convert aggregate attestation json to proto")
-	}
-
 	return &ethpb.AggregateSelectionElectraResponse{
 		AggregateAndProof: &ethpb.AggregateAttestationAndProofElectra{
 			AggregatorIndex: index,
@@ -122,7 +102,7 @@ func (c *beaconApiValidatorClient) aggregateAttestation(
 	slot primitives.Slot,
 	attestationDataRoot []byte,
 	committeeIndex primitives.CommitteeIndex,
-) (*structs.AggregateAttestationResponse, error) {
+) (*ethpb.Attestation, error) {
 	params := url.Values{}
 	params.Add("slot", strconv.FormatUint(uint64(slot), 10))
 	params.Add("attestation_data_root", hexutil.Encode(attestationDataRoot))
@@ -130,7 +110,7 @@ func (c *beaconApiValidatorClient) aggregateAttestation(
 	endpoint := buildURL("/eth/v2/validator/aggregate_attestation", params)
 
 	var aggregateAttestationResponse structs.AggregateAttestationResponse
-	err := c.jsonRestHandler.Get(ctx, endpoint, &aggregateAttestationResponse)
+	sszAttestation, _, err := c.jsonRestHandler.GetSSZ(ctx, endpoint, &aggregateAttestationResponse)
 	errJson := &httputil.DefaultJsonError{}
 	if err != nil {
 		// TODO: remove this when v2 becomes default
@@ -149,8 +129,23 @@ func (c *beaconApiValidatorClient) aggregateAttestation(
 			return nil, err
 		}
 	}
+	if sszAttestation != nil {
+		att := &ethpb.Attestation{}
+		if err := att.UnmarshalSSZ(sszAttestation); err != nil {
+			return nil, errors.Wrap(err, "failed to decode aggregate attestation response ssz")
+		}
+		return att, nil
+	}
 
-	return &aggregateAttestationResponse, nil
+	var attData *structs.Attestation
+	if err := json.Unmarshal(aggregateAttestationResponse.Data, &attData); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal aggregate attestation data")
+	}
+	att, err := convertAttestationToProto(attData)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert aggregate attestation json to proto")
+	}
+	return att, nil
 }
 
 func (c *beaconApiValidatorClient) aggregateAttestationElectra(
@@ -158,7 +153,7 @@ func (c *beaconApiValidatorClient) aggregateAttestationElectra(
 	slot primitives.Slot,
 	attestationDataRoot []byte,
 	committeeIndex primitives.CommitteeIndex,
-) (*structs.AggregateAttestationResponse, error) {
+) (*ethpb.AttestationElectra, error) {
 	params := url.Values{}
 	params.Add("slot", strconv.FormatUint(uint64(slot), 10))
 	params.Add("attestation_data_root", hexutil.Encode(attestationDataRoot))
@@ -166,9 +161,25 @@ func (c *beaconApiValidatorClient) aggregateAttestationElectra(
 	endpoint := buildURL("/eth/v2/validator/aggregate_attestation", params)
 
 	var aggregateAttestationResponse structs.AggregateAttestationResponse
-	if err := c.jsonRestHandler.Get(ctx, endpoint, &aggregateAttestationResponse); err != nil {
+	sszAttestation, _, err := c.jsonRestHandler.GetSSZ(ctx, endpoint, &aggregateAttestationResponse)
+	if err != nil {
 		return nil, err
 	}
+	if sszAttestation != nil {
+		att := &ethpb.AttestationElectra{}
+		if err := att.UnmarshalSSZ(sszAttestation); err != nil {
+			return nil, errors.Wrap(err, "failed to decode aggregate attestation electra response ssz")
+		}
+		return att, nil
+	}
 
-	return &aggregateAttestationResponse, nil
+	var attData *structs.AttestationElectra
+	if err := json.Unmarshal(aggregateAttestationResponse.Data, &attData); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal aggregate attestation electra data")
+	}
+	att, err := convertAttestationElectraToProto(attData)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert aggregate attestation json to proto")
+	}
+	return att, nil
 }
@@ -116,7 +116,7 @@ func TestSubmitAggregateSelectionProof(t *testing.T) {
 			).Times(1)
 
 			// Call attestation data to get attestation data root to query aggregate attestation.
-			jsonRestHandler.EXPECT().Get(
+			jsonRestHandler.EXPECT().GetSSZ(
 				gomock.Any(),
 				fmt.Sprintf("%s?committee_index=%d&slot=%d", attestationDataEndpoint, committeeIndex, slot),
 				&structs.GetAttestationDataResponse{},
@@ -124,6 +124,8 @@ func TestSubmitAggregateSelectionProof(t *testing.T) {
 				2,
 				attestationDataResponse,
 			).Return(
+				nil,
+				nil,
 				test.attestationDataErr,
 			).Times(test.attestationDataCalled)
 
@@ -131,7 +133,7 @@ func TestSubmitAggregateSelectionProof(t *testing.T) {
 			require.NoError(t, err)
 
 			// Call attestation data to get attestation data root to query aggregate attestation.
-			jsonRestHandler.EXPECT().Get(
+			jsonRestHandler.EXPECT().GetSSZ(
 				gomock.Any(),
 				fmt.Sprintf("%s?attestation_data_root=%s&committee_index=%d&slot=%d", aggregateAttestationEndpoint, hexutil.Encode(attestationDataRootBytes[:]), committeeIndex, slot),
 				&structs.AggregateAttestationResponse{},
@@ -141,6 +143,8 @@ func TestSubmitAggregateSelectionProof(t *testing.T) {
 					Data: attestationJSON,
 				},
 			).Return(
+				nil,
+				nil,
 				test.aggregateAttestationErr,
 			).Times(test.aggregateAttestationCalled)
 
@@ -236,7 +240,7 @@ func TestSubmitAggregateSelectionProofFallBack(t *testing.T) {
 	).Times(1)
 
 	// Call attestation data to get attestation data root to query aggregate attestation.
-	jsonRestHandler.EXPECT().Get(
+	jsonRestHandler.EXPECT().GetSSZ(
 		gomock.Any(),
 		fmt.Sprintf("%s?committee_index=%d&slot=%d", attestationDataEndpoint, committeeIndex, slot),
 		&structs.GetAttestationDataResponse{},
@@ -245,17 +249,21 @@ func TestSubmitAggregateSelectionProofFallBack(t *testing.T) {
 		attestationDataResponse,
 	).Return(
 		nil,
+		nil,
+		nil,
 	).Times(1)
 
 	attestationJSON, err := json.Marshal(jsonifyAttestation(aggregateAttestation))
 	require.NoError(t, err)
 
 	// Call attestation data to get attestation data root to query aggregate attestation.
-	jsonRestHandler.EXPECT().Get(
+	jsonRestHandler.EXPECT().GetSSZ(
 		gomock.Any(),
 		fmt.Sprintf("%s?attestation_data_root=%s&committee_index=%d&slot=%d", aggregateAttestationV2Endpoint, hexutil.Encode(attestationDataRootBytes[:]), committeeIndex, slot),
 		&structs.AggregateAttestationResponse{},
 	).Return(
+		nil,
+		nil,
 		&httputil.DefaultJsonError{
 			Code: http.StatusNotFound,
 		},
@@ -408,7 +416,7 @@ func TestSubmitAggregateSelectionProofElectra(t *testing.T) {
 			).Times(1)
 
 			// Call attestation data to get attestation data root to query aggregate attestation.
-			jsonRestHandler.EXPECT().Get(
+			jsonRestHandler.EXPECT().GetSSZ(
 				gomock.Any(),
 				fmt.Sprintf("%s?committee_index=%d&slot=%d", attestationDataEndpoint, committeeIndex, slot),
 				&structs.GetAttestationDataResponse{},
@@ -416,6 +424,8 @@ func TestSubmitAggregateSelectionProofElectra(t *testing.T) {
 				2,
 				attestationDataResponse,
 			).Return(
+				nil,
+				nil,
 				test.attestationDataErr,
 			).Times(test.attestationDataCalled)
 
@@ -423,7 +433,7 @@ func TestSubmitAggregateSelectionProofElectra(t *testing.T) {
 			require.NoError(t, err)
 
 			// Call attestation data to get attestation data root to query aggregate attestation.
-			jsonRestHandler.EXPECT().Get(
+			jsonRestHandler.EXPECT().GetSSZ(
 				gomock.Any(),
 				fmt.Sprintf("%s?attestation_data_root=%s&committee_index=%d&slot=%d", aggregateAttestationEndpoint, hexutil.Encode(attestationDataRootBytes[:]), committeeIndex, slot),
 				&structs.AggregateAttestationResponse{},
@@ -433,6 +443,8 @@ func TestSubmitAggregateSelectionProofElectra(t *testing.T) {
 					Data: attestationJSON,
 				},
 			).Return(
+				nil,
+				nil,
 				test.aggregateAttestationErr,
 			).Times(test.aggregateAttestationCalled)
 
@@ -479,3 +491,39 @@ func TestSubmitAggregateSelectionProofElectra(t *testing.T) {
 		})
 	}
 }
+
+func TestAggregateAttestation_SSZ(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	attestationDataRoot := testhelpers.FillByteSlice(32, 1)
+	expected := &ethpb.AttestationElectra{
+		AggregationBits: testhelpers.FillByteSlice(4, 74),
+		Data: &ethpb.AttestationData{
+			BeaconBlockRoot: testhelpers.FillByteSlice(32, 2),
+			Source:          &ethpb.Checkpoint{Root: testhelpers.FillByteSlice(32, 3)},
+			Target:          &ethpb.Checkpoint{Root: testhelpers.FillByteSlice(32, 4)},
+		},
+		Signature:     testhelpers.FillByteSlice(96, 82),
+		CommitteeBits: testhelpers.FillByteSlice(8, 83),
+	}
+	sszAttestation, err := expected.MarshalSSZ()
+	require.NoError(t, err)
+
+	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
+	jsonRestHandler.EXPECT().GetSSZ(
+		gomock.Any(),
+		fmt.Sprintf("/eth/v2/validator/aggregate_attestation?attestation_data_root=%s&committee_index=1&slot=123", hexutil.Encode(attestationDataRoot)),
+		&structs.AggregateAttestationResponse{},
+	).Return(
+		sszAttestation,
+		nil,
+		nil,
+	).Times(1)
+
+	validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
+	att, err := validatorClient.aggregateAttestationElectra(ctx, 123, attestationDataRoot, 1)
+	require.NoError(t, err)
+	assert.DeepEqual(t, expected, att)
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 
 	"github.com/pkg/errors"
+	ssz "github.com/prysmaticlabs/fastssz"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
@@ -19,7 +20,7 @@ func (c *beaconApiValidatorClient) submitSignedAggregateSelectionProof(ctx conte
 		return nil, errors.Wrap(err, "failed to marshal SignedAggregateAttestationAndProof")
 	}
 	headers := map[string]string{"Eth-Consensus-Version": version.String(in.SignedAggregateAndProof.Version())}
-	err = c.jsonRestHandler.Post(ctx, "/eth/v2/validator/aggregate_and_proofs", headers, bytes.NewBuffer(body), nil)
+	err = c.postSignedAggregate(ctx, headers, in.SignedAggregateAndProof, body)
 	errJson := &httputil.DefaultJsonError{}
 	if err != nil {
 		// TODO: remove this when v2 becomes default
@@ -55,7 +56,7 @@ func (c *beaconApiValidatorClient) submitSignedAggregateSelectionProofElectra(ct
 		return nil, errors.Wrap(err, "failed to marshal SignedAggregateAttestationAndProofElectra")
 	}
 	headers := map[string]string{"Eth-Consensus-Version": version.String(in.SignedAggregateAndProof.Version())}
-	if err = c.jsonRestHandler.Post(ctx, "/eth/v2/validator/aggregate_and_proofs", headers, bytes.NewBuffer(body), nil); err != nil {
+	if err = c.postSignedAggregate(ctx, headers, in.SignedAggregateAndProof, body); err != nil {
 		return nil, err
 	}
 
@@ -66,3 +67,27 @@ func (c *beaconApiValidatorClient) submitSignedAggregateSelectionProofElectra(ct
 
 	return &ethpb.SignedAggregateSubmitResponse{AttestationDataRoot: attestationDataRoot[:]}, nil
 }
+
+// postSignedAggregate submits the aggregate to the v2 endpoint as an SSZ list of one element,
+// and falls back to the already marshalled JSON body when the beacon node does not accept SSZ.
+func (c *beaconApiValidatorClient) postSignedAggregate(
+	ctx context.Context,
+	headers map[string]string,
+	aggregate ssz.Marshaler,
+	jsonBody []byte,
+) error {
+	const endpoint = "/eth/v2/validator/aggregate_and_proofs"
+	item, err := aggregate.MarshalSSZ()
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal signed aggregate ssz")
+	}
+	// A list of variable-size items starts with one 4-byte offset per item.
+	sszBody := append(ssz.WriteOffset(nil, 4), item...)
+	err = c.jsonRestHandler.PostSSZ(ctx, endpoint, headers, bytes.NewBuffer(sszBody))
+	errJson := &httputil.DefaultJsonError{}
+	if errors.As(err, &errJson) && errJson.Code == http.StatusUnsupportedMediaType {
+		log.Debugf("Endpoint %s does not support SSZ, falling back to JSON for publishing aggregate and proofs.", endpoint)
+		return c.jsonRestHandler.Post(ctx, endpoint, headers, bytes.NewBuffer(jsonBody), nil)
+	}
+	return err
+}
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/pkg/errors"
+	ssz "github.com/prysmaticlabs/fastssz"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
@@ -24,18 +25,16 @@ func TestSubmitSignedAggregateSelectionProof_Valid(t *testing.T) {
 	defer ctrl.Finish()
 
 	signedAggregateAndProof := generateSignedAggregateAndProofJson()
-	marshalledSignedAggregateSignedAndProof, err := json.Marshal([]*structs.SignedAggregateAttestationAndProof{jsonifySignedAggregateAndProof(signedAggregateAndProof)})
-	require.NoError(t, err)
+	sszSignedAggregateSignedAndProof := signedAggregateSSZList(t, signedAggregateAndProof)
 
 	ctx := context.Background()
 	headers := map[string]string{"Eth-Consensus-Version": version.String(signedAggregateAndProof.Message.Version())}
 	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
-	jsonRestHandler.EXPECT().Post(
+	jsonRestHandler.EXPECT().PostSSZ(
 		gomock.Any(),
 		"/eth/v2/validator/aggregate_and_proofs",
 		headers,
-		bytes.NewBuffer(marshalledSignedAggregateSignedAndProof),
-		nil,
+		bytes.NewBuffer(sszSignedAggregateSignedAndProof),
 	).Return(
 		nil,
 	).Times(1)
@@ -55,13 +54,50 @@ func TestSubmitSignedAggregateSelectionProof_BadRequest(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
+	signedAggregateAndProof := generateSignedAggregateAndProofJson()
+	sszSignedAggregateSignedAndProof := signedAggregateSSZList(t, signedAggregateAndProof)
+
+	ctx := context.Background()
+	headers := map[string]string{"Eth-Consensus-Version": version.String(signedAggregateAndProof.Message.Version())}
+	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
+	jsonRestHandler.EXPECT().PostSSZ(
+		gomock.Any(),
+		"/eth/v2/validator/aggregate_and_proofs",
+		headers,
+		bytes.NewBuffer(sszSignedAggregateSignedAndProof),
+	).Return(
+		errors.New("bad request"),
+	).Times(1)
+
+	validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
+	_, err := validatorClient.submitSignedAggregateSelectionProof(ctx, &ethpb.SignedAggregateSubmitRequest{
+		SignedAggregateAndProof: signedAggregateAndProof,
+	})
+	assert.ErrorContains(t, "bad request", err)
+}
+
+func TestSubmitSignedAggregateSelectionProof_JSONFallback(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
 	signedAggregateAndProof := generateSignedAggregateAndProofJson()
 	marshalledSignedAggregateSignedAndProof, err := json.Marshal([]*structs.SignedAggregateAttestationAndProof{jsonifySignedAggregateAndProof(signedAggregateAndProof)})
 	require.NoError(t, err)
+	sszSignedAggregateSignedAndProof := signedAggregateSSZList(t, signedAggregateAndProof)
 
 	ctx := context.Background()
 	headers := map[string]string{"Eth-Consensus-Version": version.String(signedAggregateAndProof.Message.Version())}
 	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
+	jsonRestHandler.EXPECT().PostSSZ(
+		gomock.Any(),
+		"/eth/v2/validator/aggregate_and_proofs",
+		headers,
+		bytes.NewBuffer(sszSignedAggregateSignedAndProof),
+	).Return(
+		&httputil.DefaultJsonError{
+			Code: http.StatusUnsupportedMediaType,
+		},
+	).Times(1)
 	jsonRestHandler.EXPECT().Post(
 		gomock.Any(),
 		"/eth/v2/validator/aggregate_and_proofs",
@@ -69,14 +105,18 @@ func TestSubmitSignedAggregateSelectionProof_BadRequest(t *testing.T) {
 		bytes.NewBuffer(marshalledSignedAggregateSignedAndProof),
 		nil,
 	).Return(
-		errors.New("bad request"),
+		nil,
 	).Times(1)
 
+	attestationDataRoot, err := signedAggregateAndProof.Message.Aggregate.Data.HashTreeRoot()
+	require.NoError(t, err)
+
 	validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
-	_, err = validatorClient.submitSignedAggregateSelectionProof(ctx, &ethpb.SignedAggregateSubmitRequest{
+	resp, err := validatorClient.submitSignedAggregateSelectionProof(ctx, &ethpb.SignedAggregateSubmitRequest{
 		SignedAggregateAndProof: signedAggregateAndProof,
 	})
-	assert.ErrorContains(t, "bad request", err)
+	require.NoError(t, err)
+	assert.DeepEqual(t, attestationDataRoot[:], resp.AttestationDataRoot)
 }
 
 func TestSubmitSignedAggregateSelectionProof_Fallback(t *testing.T) {
@@ -86,17 +126,16 @@ func TestSubmitSignedAggregateSelectionProof_Fallback(t *testing.T) {
 	signedAggregateAndProof := generateSignedAggregateAndProofJson()
 	marshalledSignedAggregateSignedAndProof, err := json.Marshal([]*structs.SignedAggregateAttestationAndProof{jsonifySignedAggregateAndProof(signedAggregateAndProof)})
 	require.NoError(t, err)
+	sszSignedAggregateSignedAndProof := signedAggregateSSZList(t, signedAggregateAndProof)
 
 	ctx := context.Background()
-
-	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
 	headers := map[string]string{"Eth-Consensus-Version": version.String(signedAggregateAndProof.Message.Version())}
-	jsonRestHandler.EXPECT().Post(
+	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
+	jsonRestHandler.EXPECT().PostSSZ(
 		gomock.Any(),
 		"/eth/v2/validator/aggregate_and_proofs",
 		headers,
-		bytes.NewBuffer(marshalledSignedAggregateSignedAndProof),
-		nil,
+		bytes.NewBuffer(sszSignedAggregateSignedAndProof),
 	).Return(
 		&httputil.DefaultJsonError{
 			Code: http.StatusNotFound,
@@ -128,18 +167,16 @@ func TestSubmitSignedAggregateSelectionProofElectra_Valid(t *testing.T) {
 	defer ctrl.Finish()
 
 	signedAggregateAndProofElectra := generateSignedAggregateAndProofElectraJson()
-	marshalledSignedAggregateSignedAndProofElectra, err := json.Marshal([]*structs.SignedAggregateAttestationAndProofElectra{jsonifySignedAggregateAndProofElectra(signedAggregateAndProofElectra)})
-	require.NoError(t, err)
+	sszSignedAggregateSignedAndProofElectra := signedAggregateSSZList(t, signedAggregateAndProofElectra)
 
 	ctx := context.Background()
 	headers := map[string]string{"Eth-Consensus-Version": version.String(signedAggregateAndProofElectra.Message.Version())}
 	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
-	jsonRestHandler.EXPECT().Post(
+	jsonRestHandler.EXPECT().PostSSZ(
 		gomock.Any(),
 		"/eth/v2/validator/aggregate_and_proofs",
 		headers,
-		bytes.NewBuffer(marshalledSignedAggregateSignedAndProofElectra),
-		nil,
+		bytes.NewBuffer(sszSignedAggregateSignedAndProofElectra),
 	).Return(
 		nil,
 	).Times(1)
@@ -159,13 +196,50 @@ func TestSubmitSignedAggregateSelectionProofElectra_BadRequest(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
+	signedAggregateAndProofElectra := generateSignedAggregateAndProofElectraJson()
+	sszSignedAggregateSignedAndProofElectra := signedAggregateSSZList(t, signedAggregateAndProofElectra)
+
+	ctx := context.Background()
+	headers := map[string]string{"Eth-Consensus-Version": version.String(signedAggregateAndProofElectra.Message.Version())}
+	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
+	jsonRestHandler.EXPECT().PostSSZ(
+		gomock.Any(),
+		"/eth/v2/validator/aggregate_and_proofs",
+		headers,
+		bytes.NewBuffer(sszSignedAggregateSignedAndProofElectra),
+	).Return(
+		errors.New("bad request"),
+	).Times(1)
+
+	validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
+	_, err := validatorClient.submitSignedAggregateSelectionProofElectra(ctx, &ethpb.SignedAggregateSubmitElectraRequest{
+		SignedAggregateAndProof: signedAggregateAndProofElectra,
+	})
+	assert.ErrorContains(t, "bad request", err)
+}
+
+func TestSubmitSignedAggregateSelectionProofElectra_JSONFallback(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
 	signedAggregateAndProofElectra := generateSignedAggregateAndProofElectraJson()
 	marshalledSignedAggregateSignedAndProofElectra, err := json.Marshal([]*structs.SignedAggregateAttestationAndProofElectra{jsonifySignedAggregateAndProofElectra(signedAggregateAndProofElectra)})
 	require.NoError(t, err)
+	sszSignedAggregateSignedAndProofElectra := signedAggregateSSZList(t, signedAggregateAndProofElectra)
 
 	ctx := context.Background()
 	headers := map[string]string{"Eth-Consensus-Version": version.String(signedAggregateAndProofElectra.Message.Version())}
 	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
+	jsonRestHandler.EXPECT().PostSSZ(
+		gomock.Any(),
+		"/eth/v2/validator/aggregate_and_proofs",
+		headers,
+		bytes.NewBuffer(sszSignedAggregateSignedAndProofElectra),
+	).Return(
+		&httputil.DefaultJsonError{
+			Code: http.StatusUnsupportedMediaType,
+		},
+	).Times(1)
 	jsonRestHandler.EXPECT().Post(
 		gomock.Any(),
 		"/eth/v2/validator/aggregate_and_proofs",
@@ -173,14 +247,25 @@ func TestSubmitSignedAggregateSelectionProofElectra_BadRequest(t *testing.T) {
 		bytes.NewBuffer(marshalledSignedAggregateSignedAndProofElectra),
 		nil,
 	).Return(
-		errors.New("bad request"),
+		nil,
 	).Times(1)
 
+	attestationDataRoot, err := signedAggregateAndProofElectra.Message.Aggregate.Data.HashTreeRoot()
+	require.NoError(t, err)
+
 	validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
-	_, err = validatorClient.submitSignedAggregateSelectionProofElectra(ctx, &ethpb.SignedAggregateSubmitElectraRequest{
+	resp, err := validatorClient.submitSignedAggregateSelectionProofElectra(ctx, &ethpb.SignedAggregateSubmitElectraRequest{
 		SignedAggregateAndProof: signedAggregateAndProofElectra,
 	})
-	assert.ErrorContains(t, "bad request", err)
+	require.NoError(t, err)
+	assert.DeepEqual(t, attestationDataRoot[:], resp.AttestationDataRoot)
+}
+
+// signedAggregateSSZList encodes the aggregate as an SSZ list holding a single element.
+func signedAggregateSSZList(t *testing.T, aggregate ssz.Marshaler) []byte {
+	item, err := aggregate.MarshalSSZ()
+	require.NoError(t, err)
+	return append(ssz.WriteOffset(nil, 4), item...)
 }
 
 func generateSignedAggregateAndProofJson() *ethpb.SignedAggregateAttestationAndProof {
@@ -0,0 +1,334 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+	dbCommon "github.com/prysmaticlabs/prysm/v5/validator/db/common"
+	"github.com/sirupsen/logrus"
+)
+
+var errDoppelGangerDetected = errors.New("Duplicate instances exists in the network for validator keys")
+
+// doppelGangerStatus tracks the doppelganger check of a validating key.
+type doppelGangerStatus struct {
+	// nextEpoch is the first epoch whose liveness has not been checked yet.
+	// Signatures of a previous run of the validator client may be included up to
+	// the epoch the key was found in, so only the following epochs are checked.
+	nextEpoch primitives.Epoch
+	// remainingEpochs is the number of epochs still to be checked before
+	// the duties of the key are performed.
+	remainingEpochs uint64
+}
+
+// CheckDoppelGanger keeps the duties of every validating key disabled until the key was
+// not seen live in the network for the configured number of epochs. Keys added to the
+// keymanager while the validator client runs are checked the same way.
+func (v *validator) CheckDoppelGanger(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "validator.CheckDoppelganger")
+	defer span.End()
+
+	if !features.Get().EnableDoppelGanger {
+		return nil
+	}
+	pubkeys, err := v.km.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return err
+	}
+	currentSlot := slots.CurrentSlot(v.genesisTime)
+
+	// Keys are checked against their slashing protection history before being tracked, a key
+	// whose check failed is checked again at the next call.
+	if untracked := v.untrackedDoppelGangerKeys(pubkeys); len(untracked) > 0 {
+		if err := v.checkDoppelGangerHistory(ctx, untracked); err != nil {
+			return err
+		}
+	}
+
+	pending := v.trackDoppelGangerKeys(pubkeys, slots.ToEpoch(currentSlot))
+	if len(pending) == 0 {
+		return nil
+	}
+	checkEpoch, ok := doppelGangerCheckEpoch(currentSlot)
+	if !ok {
+		return nil
+	}
+
+	due := make([][fieldparams.BLSPubkeyLength]byte, 0, len(pending))
+	for pubkey, nextEpoch := range pending {
+		if nextEpoch <= checkEpoch {
+			due = append(due, pubkey)
+		}
+	}
+	if len(due) == 0 {
+		return nil
+	}
+
+	if err := v.checkDoppelGangerLiveness(ctx, checkEpoch, due); err != nil {
+		if !errors.Is(err, iface.ErrNotSupported) {
+			return err
+		}
+		// The beacon node does not expose the liveness of validators,
+		// fall back to the Prysm specific doppelganger check.
+		if err := v.checkDoppelGangerHistory(ctx, due); err != nil {
+			return err
+		}
+	}
+
+	v.doppelGangerLock.Lock()
+	defer v.doppelGangerLock.Unlock()
+	for _, pubkey := range due {
+		s, ok := v.doppelGangerStatuses[pubkey]
+		if !ok {
+			continue
+		}
+		s.nextEpoch = checkEpoch + 1
+		s.remainingEpochs--
+		if s.remainingEpochs == 0 {
+			log.WithField("pubkey", fmt.Sprintf("%#x", bytesutil.Trunc(pubkey[:]))).
+				Info("No doppelganger found, starting to perform duties")
+		}
+	}
+	return nil
+}
+
+// doppelGangerCheckEpoch returns the epoch whose liveness can be checked at the slot. Attestations
+// can be included until the end of the epoch following their own, so the liveness of the previous
+// epoch is only checked during the last slot of the current epoch.
+func doppelGangerCheckEpoch(slot primitives.Slot) (primitives.Epoch, bool) {
+	epoch := slots.ToEpoch(slot)
+	if epoch == 0 || slots.ToEpoch(slot+1) == epoch {
+		return 0, false
+	}
+	return epoch - 1, true
+}
+
+// untrackedDoppelGangerKeys returns the keys the doppelganger check does not track yet.
+func (v *validator) untrackedDoppelGangerKeys(pubkeys [][fieldparams.BLSPubkeyLength]byte) [][fieldparams.BLSPubkeyLength]byte {
+	v.doppelGangerLock.RLock()
+	defer v.doppelGangerLock.RUnlock()
+
+	untracked := make([][fieldparams.BLSPubkeyLength]byte, 0)
+	for _, pubkey := range pubkeys {
+		if _, ok := v.doppelGangerStatuses[pubkey]; !ok {
+			untracked = append(untracked, pubkey)
+		}
+	}
+	return untracked
+}
+
+// trackDoppelGangerKeys starts the doppelganger check of the keys that were not tracked yet, stops tracking
+// the keys that were removed, and returns the next epoch to check for each key whose check is not over.
+func (v *validator) trackDoppelGangerKeys(
+	pubkeys [][fieldparams.BLSPubkeyLength]byte,
+	currentEpoch primitives.Epoch,
+) map[[fieldparams.BLSPubkeyLength]byte]primitives.Epoch {
+	v.doppelGangerLock.Lock()
+	defer v.doppelGangerLock.Unlock()
+
+	if v.doppelGangerStatuses == nil {
+		v.doppelGangerStatuses = make(map[[fieldparams.BLSPubkeyLength]byte]*doppelGangerStatus, len(pubkeys))
+	}
+	epochs := v.doppelGangerEpochs
+	if epochs == 0 {
+		epochs = params.BeaconConfig().DefaultDoppelGangerEpochs
+	}
+
+	current := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(pubkeys))
+	newKeys := 0
+	for _, pubkey := range pubkeys {
+		current[pubkey] = true
+		if _, ok := v.doppelGangerStatuses[pubkey]; ok {
+			continue
+		}
+		v.doppelGangerStatuses[pubkey] = &doppelGangerStatus{
+			nextEpoch:       currentEpoch + 1,
+			remainingEpochs: epochs,
+		}
+		newKeys++
+	}
+	for pubkey := range v.doppelGangerStatuses {
+		if !current[pubkey] {
+			delete(v.doppelGangerStatuses, pubkey)
+		}
+	}
+	if newKeys > 0 {
+		log.WithFields(logrus.Fields{
+			"keyCount": newKeys,
+			"epochs":   epochs,
+		}).Info("Running doppelganger check, duties of new keys are disabled until the check is over")
+	}
+
+	pending := make(map[[fieldparams.BLSPubkeyLength]byte]primitives.Epoch)
+	for pubkey, s := range v.doppelGangerStatuses {
+		if s.remainingEpochs > 0 {
+			pending[pubkey] = s.nextEpoch
+		}
+	}
+	return pending
+}
+
+// isDoppelGangerPending returns true if the duties of the key must not be performed
+// because its doppelganger check is not over yet.
+func (v *validator) isDoppelGangerPending(pubkey [fieldparams.BLSPubkeyLength]byte) bool {
+	if !features.Get().EnableDoppelGanger {
+		return false
+	}
+
+	v.doppelGangerLock.RLock()
+	defer v.doppelGangerLock.RUnlock()
+
+	// Keys which were not seen by the doppelganger check yet are not trusted either.
+	s, ok := v.doppelGangerStatuses[pubkey]
+	return !ok || s.remainingEpochs > 0
+}
+
+// checkDoppelGangerLiveness returns an error if any of the keys was live during the epoch.
+func (v *validator) checkDoppelGangerLiveness(ctx context.Context, epoch primitives.Epoch, pubkeys [][fieldparams.BLSPubkeyLength]byte) error {
+	statusRequestKeys := make([][]byte, len(pubkeys))
+	for i := range pubkeys {
+		statusRequestKeys[i] = pubkeys[i][:]
+	}
+	resp, err := v.validatorClient.MultipleValidatorStatus(ctx, &ethpb.MultipleValidatorStatusRequest{
+		PublicKeys: statusRequestKeys,
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not get validator statuses")
+	}
+	if resp == nil || len(resp.Statuses) != len(resp.PublicKeys) || len(resp.Indices) != len(resp.PublicKeys) {
+		return errors.New("invalid validator statuses response")
+	}
+
+	// Keys unknown to the beacon node can't be live.
+	indexToPubkey := make(map[primitives.ValidatorIndex][]byte, len(resp.PublicKeys))
+	indices := make([]primitives.ValidatorIndex, 0, len(resp.PublicKeys))
+	for i, s := range resp.Statuses {
+		if s == nil || s.Status == ethpb.ValidatorStatus_UNKNOWN_STATUS {
+			continue
+		}
+		indexToPubkey[resp.Indices[i]] = resp.PublicKeys[i]
+		indices = append(indices, resp.Indices[i])
+	}
+	if len(indices) == 0 {
+		return nil
+	}
+
+	liveness, err := v.validatorClient.Liveness(ctx, epoch, indices)
+	if err != nil {
+		return errors.Wrapf(err, "could not get liveness of epoch %d", epoch)
+	}
+
+	duplicates := make([][]byte, 0)
+	for _, l := range liveness {
+		if l == nil || !l.IsLive {
+			continue
+		}
+		pubkey, ok := indexToPubkey[l.Index]
+		if !ok {
+			continue
+		}
+		log.WithFields(logrus.Fields{
+			"pubkey":         hexutil.Encode(pubkey),
+			"validatorIndex": l.Index,
+			"epoch":          epoch,
+		}).Error("Validator was live while its duties were disabled")
+		duplicates = append(duplicates, pubkey)
+	}
+	if len(duplicates) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: %#x", errDoppelGangerDetected, duplicates)
+}
+
+// checkDoppelGangerHistory checks if the keys have any duplicates active in the network since
+// their latest attestation recorded in the slashing protection history.
+func (v *validator) checkDoppelGangerHistory(ctx context.Context, pubkeys [][fieldparams.BLSPubkeyLength]byte) error {
+	req := &ethpb.DoppelGangerRequest{ValidatorRequests: []*ethpb.DoppelGangerRequest_ValidatorRequest{}}
+	for _, pkey := range pubkeys {
+		copiedKey := pkey
+		attRec, err := v.db.AttestationHistoryForPubKey(ctx, copiedKey)
+		if err != nil {
+			return err
+		}
+		if len(attRec) == 0 {
+			// If no history exists we simply send in a zero
+			// value for the request epoch and root.
+			req.ValidatorRequests = append(req.ValidatorRequests,
+				&ethpb.DoppelGangerRequest_ValidatorRequest{
+					PublicKey:  copiedKey[:],
+					Epoch:      0,
+					SignedRoot: make([]byte, fieldparams.RootLength),
+				})
+			continue
+		}
+		r := retrieveLatestRecord(attRec)
+		if copiedKey != r.PubKey {
+			return errors.New("attestation record mismatched public key")
+		}
+		req.ValidatorRequests = append(req.ValidatorRequests,
+			&ethpb.DoppelGangerRequest_ValidatorRequest{
+				PublicKey:  r.PubKey[:],
+				Epoch:      r.Target,
+				SignedRoot: r.SigningRoot,
+			})
+	}
+	resp, err := v.validatorClient.CheckDoppelGanger(ctx, req)
+	if err != nil {
+		return err
+	}
+	// If nothing is returned by the beacon node, we return an
+	// error as it is unsafe for us to proceed.
+	if resp == nil || resp.Responses == nil || len(resp.Responses) == 0 {
+		return errors.New("beacon node returned 0 responses for doppelganger check")
+	}
+	return buildDuplicateError(resp.Responses)
+}
+
+func buildDuplicateError(response []*ethpb.DoppelGangerResponse_ValidatorResponse) error {
+	duplicates := make([][]byte, 0)
+	for _, valRes := range response {
+		if valRes.DuplicateExists {
+			var copiedKey [fieldparams.BLSPubkeyLength]byte
+			copy(copiedKey[:], valRes.PublicKey)
+			duplicates = append(duplicates, copiedKey[:])
+		}
+	}
+	if len(duplicates) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: %#x", errDoppelGangerDetected, duplicates)
+}
+
+// Ensures that the latest attestation history is retrieved.
+func retrieveLatestRecord(recs []*dbCommon.AttestationRecord) *dbCommon.AttestationRecord {
+	if len(recs) == 0 {
+		return nil
+	}
+	lastSource := recs[len(recs)-1].Source
+	chosenRec := recs[len(recs)-1]
+	for i := len(recs) - 1; i >= 0; i-- {
+		// Exit if we are now on a different source
+		// as it is assumed that all source records are
+		// byte sorted.
+		if recs[i].Source != lastSource {
+			break
+		}
+		// If we have a smaller target, we do
+		// change our chosen record.
+		if chosenRec.Target < recs[i].Target {
+			chosenRec = recs[i]
+		}
+	}
+	return chosenRec
+}
@@ -0,0 +1,231 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/config/features"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	validatormock "github.com/prysmaticlabs/prysm/v5/testing/validator-mock"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+	dbTest "github.com/prysmaticlabs/prysm/v5/validator/db/testing"
+	"go.uber.org/mock/gomock"
+)
+
+// genesisTimeAtEpoch returns a genesis time making the current epoch the given one.
+func genesisTimeAtEpoch(epoch primitives.Epoch) uint64 {
+	cfg := params.BeaconConfig()
+	return uint64(time.Now().Unix()) - uint64(epoch)*uint64(cfg.SlotsPerEpoch)*cfg.SecondsPerSlot
+}
+
+// genesisTimeAtLastSlotOfEpoch returns a genesis time making the current slot the last slot of the given epoch.
+func genesisTimeAtLastSlotOfEpoch(epoch primitives.Epoch) uint64 {
+	cfg := params.BeaconConfig()
+	return uint64(time.Now().Unix()) - (uint64(epoch+1)*uint64(cfg.SlotsPerEpoch)-1)*cfg.SecondsPerSlot
+}
+
+func expectStatuses(client *validatormock.MockValidatorClient, keys [][fieldparams.BLSPubkeyLength]byte) {
+	resp := &ethpb.MultipleValidatorStatusResponse{}
+	for i, key := range keys {
+		resp.PublicKeys = append(resp.PublicKeys, key[:])
+		resp.Statuses = append(resp.Statuses, &ethpb.ValidatorStatusResponse{Status: ethpb.ValidatorStatus_ACTIVE})
+		resp.Indices = append(resp.Indices, primitives.ValidatorIndex(i))
+	}
+	client.EXPECT().MultipleValidatorStatus(gomock.Any(), gomock.Any()).Return(resp, nil)
+}
+
+func expectHistoryCheck(client *validatormock.MockValidatorClient, keys [][fieldparams.BLSPubkeyLength]byte) {
+	resp := &ethpb.DoppelGangerResponse{}
+	for _, key := range keys {
+		resp.Responses = append(resp.Responses, &ethpb.DoppelGangerResponse_ValidatorResponse{PublicKey: key[:]})
+	}
+	client.EXPECT().CheckDoppelGanger(gomock.Any(), gomock.Any()).Return(resp, nil)
+}
+
+func TestValidator_CheckDoppelGanger_Liveness(t *testing.T) {
+	reset := features.InitWithReset(&features.Flags{EnableDoppelGanger: true})
+	defer reset()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := validatormock.NewMockValidatorClient(ctrl)
+	km := genMockKeymanager(t, 2)
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+
+	v := &validator{
+		validatorClient:    client,
+		km:                 km,
+		db:                 dbTest.SetupDB(t, keys, false),
+		genesisTime:        genesisTimeAtEpoch(10),
+		doppelGangerEpochs: 2,
+	}
+
+	// Keys are found at epoch 10 and checked against their history, their duties are disabled.
+	expectHistoryCheck(client, keys)
+	require.NoError(t, v.CheckDoppelGanger(ctx))
+	for _, key := range keys {
+		assert.Equal(t, true, v.isDoppelGangerPending(key))
+	}
+
+	// Epoch 10 may contain signatures of a previous run, so nothing is checked yet.
+	v.genesisTime = genesisTimeAtLastSlotOfEpoch(11)
+	require.NoError(t, v.CheckDoppelGanger(ctx))
+
+	// Attestations of epoch 11 can still be included, so its liveness is not checked yet.
+	v.genesisTime = genesisTimeAtEpoch(12)
+	require.NoError(t, v.CheckDoppelGanger(ctx))
+
+	// The liveness of epoch 11 is checked during the last slot of epoch 12, once however often the check runs.
+	v.genesisTime = genesisTimeAtLastSlotOfEpoch(12)
+	expectStatuses(client, keys)
+	client.EXPECT().Liveness(gomock.Any(), primitives.Epoch(11), []primitives.ValidatorIndex{0, 1}).Return(
+		[]*iface.ValidatorLiveness{{Index: 0}, {Index: 1}}, nil)
+	require.NoError(t, v.CheckDoppelGanger(ctx))
+	require.NoError(t, v.CheckDoppelGanger(ctx))
+	for _, key := range keys {
+		assert.Equal(t, true, v.isDoppelGangerPending(key))
+	}
+
+	// A key imported in the meantime starts its own check.
+	newKey := randKeypair(t)
+	require.NoError(t, km.add(newKey))
+
+	v.genesisTime = genesisTimeAtLastSlotOfEpoch(13)
+	expectHistoryCheck(client, [][fieldparams.BLSPubkeyLength]byte{newKey.pub})
+	expectStatuses(client, keys)
+	client.EXPECT().Liveness(gomock.Any(), primitives.Epoch(12), []primitives.ValidatorIndex{0, 1}).Return(
+		[]*iface.ValidatorLiveness{{Index: 0}, {Index: 1}}, nil)
+	require.NoError(t, v.CheckDoppelGanger(ctx))
+	for _, key := range keys {
+		assert.Equal(t, false, v.isDoppelGangerPending(key))
+	}
+	assert.Equal(t, true, v.isDoppelGangerPending(newKey.pub))
+
+	// The imported key is live while its duties are disabled.
+	v.genesisTime = genesisTimeAtLastSlotOfEpoch(15)
+	expectStatuses(client, [][fieldparams.BLSPubkeyLength]byte{newKey.pub})
+	client.EXPECT().Liveness(gomock.Any(), primitives.Epoch(14), []primitives.ValidatorIndex{0}).Return(
+		[]*iface.ValidatorLiveness{{Index: 0, IsLive: true}}, nil)
+	err = v.CheckDoppelGanger(ctx)
+	require.ErrorContains(t, "Duplicate instances exists in the network for validator keys", err)
+	assert.Equal(t, true, errors.Is(err, errDoppelGangerDetected))
+	assert.Equal(t, true, v.isDoppelGangerPending(newKey.pub))
+}
+
+func TestValidator_CheckDoppelGanger_LegacyFallback(t *testing.T) {
+	reset := features.InitWithReset(&features.Flags{EnableDoppelGanger: true})
+	defer reset()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := validatormock.NewMockValidatorClient(ctrl)
+	km := genMockKeymanager(t, 1)
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+
+	v := &validator{
+		validatorClient:    client,
+		km:                 km,
+		db:                 dbTest.SetupDB(t, keys, false),
+		genesisTime:        genesisTimeAtEpoch(10),
+		doppelGangerEpochs: 1,
+	}
+	expectHistoryCheck(client, keys)
+	require.NoError(t, v.CheckDoppelGanger(ctx))
+
+	// The beacon node does not support the liveness endpoint.
+	v.genesisTime = genesisTimeAtLastSlotOfEpoch(12)
+	expectStatuses(client, keys)
+	client.EXPECT().Liveness(gomock.Any(), primitives.Epoch(11), gomock.Any()).Return(nil, iface.ErrNotSupported)
+	client.EXPECT().CheckDoppelGanger(gomock.Any(), gomock.Any()).Return(&ethpb.DoppelGangerResponse{
+		Responses: []*ethpb.DoppelGangerResponse_ValidatorResponse{{PublicKey: keys[0][:]}},
+	}, nil)
+	require.NoError(t, v.CheckDoppelGanger(ctx))
+	assert.Equal(t, false, v.isDoppelGangerPending(keys[0]))
+}
+
+func TestValidator_CheckDoppelGanger_HistoryCheckFailed(t *testing.T) {
+	reset := features.InitWithReset(&features.Flags{EnableDoppelGanger: true})
+	defer reset()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := validatormock.NewMockValidatorClient(ctrl)
+	km := genMockKeymanager(t, 1)
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+
+	v := &validator{
+		validatorClient:    client,
+		km:                 km,
+		db:                 dbTest.SetupDB(t, keys, false),
+		genesisTime:        genesisTimeAtEpoch(10),
+		doppelGangerEpochs: 1,
+	}
+
+	// The key is not tracked until its history was checked.
+	client.EXPECT().CheckDoppelGanger(gomock.Any(), gomock.Any()).Return(nil, errors.New("connection refused"))
+	require.ErrorContains(t, "connection refused", v.CheckDoppelGanger(ctx))
+	assert.Equal(t, 1, len(v.untrackedDoppelGangerKeys(keys)))
+	assert.Equal(t, true, v.isDoppelGangerPending(keys[0]))
+
+	expectHistoryCheck(client, keys)
+	require.NoError(t, v.CheckDoppelGanger(ctx))
+	assert.Equal(t, 0, len(v.untrackedDoppelGangerKeys(keys)))
+	assert.Equal(t, true, v.isDoppelGangerPending(keys[0]))
+}
+
+func TestDoppelGangerCheckEpoch(t *testing.T) {
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	_, ok := doppelGangerCheckEpoch(slotsPerEpoch - 1)
+	assert.Equal(t, false, ok)
+	_, ok = doppelGangerCheckEpoch(2*slotsPerEpoch - 2)
+	assert.Equal(t, false, ok)
+	epoch, ok := doppelGangerCheckEpoch(2*slotsPerEpoch - 1)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, primitives.Epoch(0), epoch)
+	_, ok = doppelGangerCheckEpoch(2 * slotsPerEpoch)
+	assert.Equal(t, false, ok)
+}
+
+func TestValidator_CheckDoppelGanger_Disabled(t *testing.T) {
+	v := &validator{}
+	require.NoError(t, v.CheckDoppelGanger(context.Background()))
+	assert.Equal(t, false, v.isDoppelGangerPending([fieldparams.BLSPubkeyLength]byte{1}))
+}
+
+func TestRolesAt_SkipsDoppelGangerPendingKeys(t *testing.T) {
+	reset := features.InitWithReset(&features.Flags{EnableDoppelGanger: true})
+	defer reset()
+
+	v, _, validatorKey, finish := setup(t, false)
+	defer finish()
+
+	v.duties = &ethpb.DutiesResponse{
+		CurrentEpochDuties: []*ethpb.DutiesResponse_Duty{
+			{
+				CommitteeIndex: 1,
+				ProposerSlots:  []primitives.Slot{1},
+				PublicKey:      validatorKey.PublicKey().Marshal(),
+			},
+		},
+	}
+
+	roleMap, err := v.RolesAt(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(roleMap))
+}
@@ -0,0 +1,122 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// dutyHistoryLength is the number of duty outcomes kept in memory by the validator client.
+const dutyHistoryLength = 1024
+
+// Reasons for which a duty was missed.
+const (
+	missedReasonUnknown             = "unknown error"
+	missedReasonNoAssignment        = "no assignment"
+	missedReasonLateAttestationData = "late attestation data"
+	missedReasonLateBlockRoot       = "late block root"
+	missedReasonNoAggregate         = "no aggregate"
+	missedReasonBlockProduction     = "block production error"
+	missedReasonSigningError        = "signing error"
+	missedReasonSlashingProtection  = "slashing protection"
+	missedReasonSubmissionError     = "submission error"
+)
+
+// isDeadlineError returns true if the request to the beacon node did not complete before its deadline.
+func isDeadlineError(err error) bool {
+	return errors.Is(err, context.DeadlineExceeded) || status.Code(err) == codes.DeadlineExceeded
+}
+
+// recordDuty records the outcome of a duty, an empty missed reason meaning the duty was performed.
+func (v *validator) recordDuty(pubKey [fieldparams.BLSPubkeyLength]byte, slot primitives.Slot, role iface.ValidatorRole, missedReason string) {
+	v.dutyHistoryLock.Lock()
+	defer v.dutyHistoryLock.Unlock()
+
+	if len(v.dutyHistory) >= dutyHistoryLength {
+		v.dutyHistory = v.dutyHistory[len(v.dutyHistory)-dutyHistoryLength+1:]
+	}
+	v.dutyHistory = append(v.dutyHistory, &iface.DutyRecord{
+		PubKey:       pubKey,
+		Slot:         slot,
+		Role:         role,
+		MissedReason: missedReason,
+		Time:         time.Now(),
+	})
+}
+
+// DutyHistory returns the outcomes of the most recent duties, oldest first.
+func (v *validator) DutyHistory() []*iface.DutyRecord {
+	v.dutyHistoryLock.RLock()
+	defer v.dutyHistoryLock.RUnlock()
+
+	history := make([]*iface.DutyRecord, len(v.dutyHistory))
+	for i, record := range v.dutyHistory {
+		r := *record
+		history[i] = &r
+	}
+	return history
+}
+
+// DutySchedule returns the upcoming attester, proposer and sync committee duties
+// of every validating key in the duties cache.
+func (v *validator) DutySchedule() []*iface.ScheduledDuties {
+	v.dutiesLock.RLock()
+	defer v.dutiesLock.RUnlock()
+
+	if v.duties == nil {
+		return []*iface.ScheduledDuties{}
+	}
+
+	currentSlot := slots.CurrentSlot(v.genesisTime)
+	currentEpoch := slots.ToEpoch(currentSlot)
+
+	schedule := make([]*iface.ScheduledDuties, 0, len(v.duties.CurrentEpochDuties))
+	byPubKey := make(map[[fieldparams.BLSPubkeyLength]byte]*iface.ScheduledDuties, len(v.duties.CurrentEpochDuties))
+	add := func(duty *ethpb.DutiesResponse_Duty, epoch primitives.Epoch) {
+		if duty == nil {
+			return
+		}
+		pubKey := bytesutil.ToBytes48(duty.PublicKey)
+		scheduled, ok := byPubKey[pubKey]
+		if !ok {
+			scheduled = &iface.ScheduledDuties{
+				PubKey:              pubKey,
+				ValidatorIndex:      duty.ValidatorIndex,
+				AttesterSlots:       []primitives.Slot{},
+				ProposerSlots:       []primitives.Slot{},
+				SyncCommitteeEpochs: []primitives.Epoch{},
+			}
+			byPubKey[pubKey] = scheduled
+			schedule = append(schedule, scheduled)
+		}
+
+		if slots.ToEpoch(duty.AttesterSlot) == epoch && duty.AttesterSlot >= currentSlot {
+			scheduled.AttesterSlots = append(scheduled.AttesterSlots, duty.AttesterSlot)
+		}
+		for _, proposerSlot := range duty.ProposerSlots {
+			if proposerSlot != 0 && proposerSlot >= currentSlot {
+				scheduled.ProposerSlots = append(scheduled.ProposerSlots, proposerSlot)
+			}
+		}
+		if duty.IsSyncCommittee {
+			scheduled.SyncCommitteeEpochs = append(scheduled.SyncCommitteeEpochs, epoch)
+		}
+	}
+
+	for _, duty := range v.duties.CurrentEpochDuties {
+		add(duty, currentEpoch)
+	}
+	for _, duty := range v.duties.NextEpochDuties {
+		add(duty, currentEpoch+1)
+	}
+	return schedule
+}
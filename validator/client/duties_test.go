@@ -0,0 +1,87 @@
+package client
+
+import (
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+)
+
+func TestValidator_RecordDuty(t *testing.T) {
+	v := &validator{}
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	for i := 0; i < dutyHistoryLength+10; i++ {
+		v.recordDuty(pubKey, primitives.Slot(i), iface.RoleAttester, "")
+	}
+	v.recordDuty(pubKey, 2000, iface.RoleProposer, missedReasonSigningError)
+
+	history := v.DutyHistory()
+	require.Equal(t, dutyHistoryLength, len(history))
+	assert.Equal(t, primitives.Slot(11), history[0].Slot)
+	last := history[len(history)-1]
+	assert.Equal(t, primitives.Slot(2000), last.Slot)
+	assert.Equal(t, iface.RoleProposer, last.Role)
+	assert.Equal(t, missedReasonSigningError, last.MissedReason)
+
+	// The returned records are copies.
+	last.Slot = 0
+	assert.Equal(t, primitives.Slot(2000), v.DutyHistory()[dutyHistoryLength-1].Slot)
+}
+
+func TestValidator_DutySchedule(t *testing.T) {
+	v := &validator{genesisTime: genesisTimeAtEpoch(10)}
+	assert.Equal(t, 0, len(v.DutySchedule()))
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	currentEpochStart := primitives.Slot(10) * slotsPerEpoch
+	nextEpochStart := currentEpochStart + slotsPerEpoch
+	pubKey1 := [fieldparams.BLSPubkeyLength]byte{1}
+	pubKey2 := [fieldparams.BLSPubkeyLength]byte{2}
+	v.duties = &ethpb.DutiesResponse{
+		CurrentEpochDuties: []*ethpb.DutiesResponse_Duty{
+			{
+				PublicKey:       pubKey1[:],
+				ValidatorIndex:  1,
+				AttesterSlot:    nextEpochStart - 1,
+				ProposerSlots:   []primitives.Slot{currentEpochStart - 1, nextEpochStart - 2},
+				IsSyncCommittee: true,
+			},
+			{
+				PublicKey:      pubKey2[:],
+				ValidatorIndex: 2,
+				// Already past.
+				AttesterSlot: currentEpochStart - 1,
+			},
+		},
+		NextEpochDuties: []*ethpb.DutiesResponse_Duty{
+			{
+				PublicKey:       pubKey1[:],
+				ValidatorIndex:  1,
+				AttesterSlot:    nextEpochStart + 3,
+				IsSyncCommittee: true,
+			},
+		},
+	}
+
+	schedule := v.DutySchedule()
+	require.Equal(t, 2, len(schedule))
+	assert.DeepEqual(t, &iface.ScheduledDuties{
+		PubKey:              pubKey1,
+		ValidatorIndex:      1,
+		AttesterSlots:       []primitives.Slot{nextEpochStart - 1, nextEpochStart + 3},
+		ProposerSlots:       []primitives.Slot{nextEpochStart - 2},
+		SyncCommitteeEpochs: []primitives.Epoch{10, 11},
+	}, schedule[0])
+	assert.DeepEqual(t, &iface.ScheduledDuties{
+		PubKey:              pubKey2,
+		ValidatorIndex:      2,
+		AttesterSlots:       []primitives.Slot{},
+		ProposerSlots:       []primitives.Slot{},
+		SyncCommitteeEpochs: []primitives.Epoch{},
+	}, schedule[1])
+}
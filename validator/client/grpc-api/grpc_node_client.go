@@ -36,6 +36,11 @@ func (c *grpcNodeClient) Peers(ctx context.Context, in *empty.Empty) (*ethpb.Pee
 	return c.nodeClient.ListPeers(ctx, in)
 }
 
+// ClientVersions is not supported by the gRPC API of the beacon node.
+func (*grpcNodeClient) ClientVersions(_ context.Context) (*iface.ClientVersions, error) {
+	return nil, iface.ErrNotSupported
+}
+
 func (c *grpcNodeClient) IsHealthy(ctx context.Context) bool {
 	_, err := c.nodeClient.GetHealth(ctx, &ethpb.HealthRequest{})
 	if err != nil {
@@ -31,6 +31,10 @@ func (c *grpcValidatorClient) CheckDoppelGanger(ctx context.Context, in *ethpb.D
 	return c.beaconNodeValidatorClient.CheckDoppelGanger(ctx, in)
 }
 
+func (*grpcValidatorClient) Liveness(context.Context, primitives.Epoch, []primitives.ValidatorIndex) ([]*iface.ValidatorLiveness, error) {
+	return nil, iface.ErrNotSupported
+}
+
 func (c *grpcValidatorClient) DomainData(ctx context.Context, in *ethpb.DomainRequest) (*ethpb.DomainResponse, error) {
 	return c.beaconNodeValidatorClient.DomainData(ctx, in)
 }
@@ -6,12 +6,21 @@ import (
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/prysmaticlabs/prysm/v5/api/client/beacon"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 )
 
+// ClientVersions are the client versions of the beacon node and of its execution client.
+type ClientVersions struct {
+	BeaconNode *version.ClientVersion
+	// ExecutionClient is nil when the beacon node does not know the version of its execution client.
+	ExecutionClient *version.ClientVersion
+}
+
 type NodeClient interface {
 	SyncStatus(ctx context.Context, in *empty.Empty) (*ethpb.SyncStatus, error)
 	Genesis(ctx context.Context, in *empty.Empty) (*ethpb.Genesis, error)
 	Version(ctx context.Context, in *empty.Empty) (*ethpb.Version, error)
 	Peers(ctx context.Context, in *empty.Empty) (*ethpb.Peers, error)
+	ClientVersions(ctx context.Context) (*ClientVersions, error)
 	HealthTracker() *beacon.NodeHealthTracker
 }
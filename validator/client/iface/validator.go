@@ -33,6 +33,43 @@ const (
 	RoleSyncCommitteeAggregator
 )
 
+// String returns the name of the validator role.
+func (r ValidatorRole) String() string {
+	switch r {
+	case RoleAttester:
+		return "attester"
+	case RoleProposer:
+		return "proposer"
+	case RoleAggregator:
+		return "aggregator"
+	case RoleSyncCommittee:
+		return "sync_committee"
+	case RoleSyncCommitteeAggregator:
+		return "sync_committee_aggregator"
+	default:
+		return "unknown"
+	}
+}
+
+// ScheduledDuties defines the upcoming duties of a validating key known by the duties cache of the validator client.
+type ScheduledDuties struct {
+	PubKey              [fieldparams.BLSPubkeyLength]byte
+	ValidatorIndex      primitives.ValidatorIndex
+	AttesterSlots       []primitives.Slot
+	ProposerSlots       []primitives.Slot
+	SyncCommitteeEpochs []primitives.Epoch
+}
+
+// DutyRecord defines the outcome of a duty the validator client was assigned to.
+// An empty missed reason means the duty was performed.
+type DutyRecord struct {
+	PubKey       [fieldparams.BLSPubkeyLength]byte
+	Slot         primitives.Slot
+	Role         ValidatorRole
+	MissedReason string
+	Time         time.Time
+}
+
 // Validator interface defines the primary methods of a validator client.
 type Validator interface {
 	Done()
@@ -53,6 +90,7 @@ type Validator interface {
 	LogSubmittedAtts(slot primitives.Slot)
 	LogSubmittedSyncCommitteeMessages()
 	UpdateDomainDataCaches(ctx context.Context, slot primitives.Slot)
+	UpdateClientVersions(ctx context.Context)
 	WaitForKeymanagerInitialization(ctx context.Context) error
 	Keymanager() (keymanager.IKeymanager, error)
 	HandleKeyReload(ctx context.Context, currentKeys [][fieldparams.BLSPubkeyLength]byte) (bool, error)
@@ -67,6 +105,8 @@ type Validator interface {
 	Graffiti(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) ([]byte, error)
 	SetGraffiti(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, graffiti []byte) error
 	DeleteGraffiti(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) error
+	DutySchedule() []*ScheduledDuties
+	DutyHistory() []*DutyRecord
 	HealthTracker() *beacon.NodeHealthTracker
 	Host() string
 	ChangeHost()
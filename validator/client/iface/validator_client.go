@@ -120,6 +120,12 @@ func (s *SyncCommitteeSelection) UnmarshalJSON(input []byte) error {
 	return nil
 }
 
+// ValidatorLiveness reports whether a validator was seen performing its duties during an epoch.
+type ValidatorLiveness struct {
+	Index  primitives.ValidatorIndex
+	IsLive bool
+}
+
 type ValidatorClient interface {
 	Duties(ctx context.Context, in *ethpb.DutiesRequest) (*ethpb.DutiesResponse, error)
 	DomainData(ctx context.Context, in *ethpb.DomainRequest) (*ethpb.DomainResponse, error)
@@ -141,6 +147,7 @@ type ValidatorClient interface {
 	ProposeExit(ctx context.Context, in *ethpb.SignedVoluntaryExit) (*ethpb.ProposeExitResponse, error)
 	SubscribeCommitteeSubnets(ctx context.Context, in *ethpb.CommitteeSubnetsSubscribeRequest, duties []*ethpb.DutiesResponse_Duty) (*empty.Empty, error)
 	CheckDoppelGanger(ctx context.Context, in *ethpb.DoppelGangerRequest) (*ethpb.DoppelGangerResponse, error)
+	Liveness(ctx context.Context, epoch primitives.Epoch, indices []primitives.ValidatorIndex) ([]*ValidatorLiveness, error)
 	SyncMessageBlockRoot(ctx context.Context, in *empty.Empty) (*ethpb.SyncMessageBlockRootResponse, error)
 	SubmitSyncMessage(ctx context.Context, in *ethpb.SyncCommitteeMessage) (*empty.Empty, error)
 	SyncSubcommitteeIndex(ctx context.Context, in *ethpb.SyncSubcommitteeIndexRequest) (*ethpb.SyncSubcommitteeIndexResponse, error)
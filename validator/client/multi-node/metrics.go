@@ -0,0 +1,34 @@
+package multi_node
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	beaconNodeLatency = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_latency_seconds",
+			Help:      "Latency of requests sent in parallel to each beacon node in seconds. This metric captures only requests that didn't result in an error.",
+			Buckets:   []float64{0.001, 0.01, 0.025, 0.1, 0.25, 1, 2.5, 10},
+		},
+		[]string{"host", "action"},
+	)
+	failedBeaconNodeRequestCount = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_failed_request_count",
+			Help:      "Number of failed requests sent in parallel to each beacon node",
+		},
+		[]string{"host", "action"},
+	)
+	attestationDataAgreementCount = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_attestation_data_agreement_count",
+			Help:      "Number of times the attestation data returned by a beacon node agreed or disagreed with the data used for attesting",
+		},
+		[]string{"host", "result"},
+	)
+)
@@ -0,0 +1,274 @@
+package multi_node
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+)
+
+// DefaultAgreementTimeout is how long to wait for a majority of beacon nodes to agree on
+// attestation data once the first node has answered.
+const DefaultAgreementTimeout = 500 * time.Millisecond
+
+// ValidatorClientOpt configures the multi node validator client.
+type ValidatorClientOpt func(*validatorClient)
+
+// WithAgreementTimeout sets how long to wait for a majority of beacon nodes to agree on
+// attestation data once the first node has answered.
+func WithAgreementTimeout(timeout time.Duration) ValidatorClientOpt {
+	return func(c *validatorClient) {
+		c.agreementTimeout = timeout
+	}
+}
+
+// validatorClient sends requests to several beacon nodes at once. Attestation data is taken
+// from the fastest node that agrees with a majority of nodes, and signed attestations,
+// aggregates, sync committee messages and blocks are broadcast to all nodes. Every other
+// request is served by the primary client, which keeps the existing fail over behavior.
+type validatorClient struct {
+	iface.ValidatorClient
+	nodes            []iface.ValidatorClient
+	agreementTimeout time.Duration
+}
+
+// NewValidatorClient returns a validator client that serves duties from all the given nodes
+// in parallel and delegates everything else to the primary client.
+func NewValidatorClient(primary iface.ValidatorClient, nodes []iface.ValidatorClient, opts ...ValidatorClientOpt) iface.ValidatorClient {
+	c := &validatorClient{
+		ValidatorClient:  primary,
+		nodes:            nodes,
+		agreementTimeout: DefaultAgreementTimeout,
+	}
+	for _, o := range opts {
+		o(c)
+	}
+	return c
+}
+
+type attestationDataResult struct {
+	host string
+	data *ethpb.AttestationData
+	root [32]byte
+	err  error
+}
+
+// AttestationData requests attestation data from all beacon nodes and returns the response of the
+// fastest node whose data agrees with a majority of nodes. If no majority is reached before all
+// nodes answered or the agreement timeout elapsed, the data returned by most nodes is used.
+func (c *validatorClient) AttestationData(ctx context.Context, in *ethpb.AttestationDataRequest) (*ethpb.AttestationData, error) {
+	ctx, span := trace.StartSpan(ctx, "multi-node.AttestationData")
+	defer span.End()
+
+	results := make(chan attestationDataResult, len(c.nodes))
+	for _, n := range c.nodes {
+		go func(n iface.ValidatorClient) {
+			r := attestationDataResult{host: n.Host()}
+			r.data, r.err = withMetrics(r.host, "AttestationData", func() (*ethpb.AttestationData, error) {
+				return n.AttestationData(ctx, in)
+			})
+			if r.err == nil {
+				r.root, r.err = r.data.HashTreeRoot()
+			}
+			results <- r
+		}(n)
+	}
+
+	quorum := len(c.nodes)/2 + 1
+	votes := make(map[[32]byte]int)
+	var received []attestationDataResult
+	var timeout <-chan time.Time
+	var lastErr, ctxErr error
+loop:
+	for len(received) < len(c.nodes) {
+		select {
+		case r := <-results:
+			received = append(received, r)
+			if r.err != nil {
+				log.WithError(r.err).WithField("host", r.host).Debug("Could not get attestation data from beacon node")
+				lastErr = r.err
+				continue
+			}
+			votes[r.root]++
+			if votes[r.root] >= quorum {
+				break loop
+			}
+			if timeout == nil {
+				timer := time.NewTimer(c.agreementTimeout)
+				defer timer.Stop()
+				timeout = timer.C
+			}
+		case <-timeout:
+			break loop
+		case <-ctx.Done():
+			ctxErr = ctx.Err()
+			break loop
+		}
+	}
+
+	// Responses arrive in order, so the first response with the most votes comes from the fastest agreeing node.
+	var chosen *attestationDataResult
+	for i, r := range received {
+		if r.err != nil {
+			continue
+		}
+		if chosen == nil || votes[r.root] > votes[chosen.root] {
+			chosen = &received[i]
+		}
+	}
+	if chosen == nil {
+		if ctxErr != nil {
+			return nil, ctxErr
+		}
+		return nil, errors.Wrap(lastErr, "could not get attestation data from any beacon node")
+	}
+
+	go recordAgreement(chosen.root, received, results, len(c.nodes)-len(received))
+	return chosen.data, nil
+}
+
+// recordAgreement updates the agreement metrics of every beacon node, including the ones that
+// answered after the attestation data was chosen.
+func recordAgreement(root [32]byte, received []attestationDataResult, pending <-chan attestationDataResult, remaining int) {
+	record := func(r attestationDataResult) {
+		if r.err != nil {
+			return
+		}
+		result := "agree"
+		if r.root != root {
+			result = "disagree"
+		}
+		attestationDataAgreementCount.WithLabelValues(r.host, result).Inc()
+	}
+	for _, r := range received {
+		record(r)
+	}
+	for i := 0; i < remaining; i++ {
+		record(<-pending)
+	}
+}
+
+// ProposeAttestation broadcasts the attestation to all beacon nodes.
+func (c *validatorClient) ProposeAttestation(ctx context.Context, in *ethpb.Attestation) (*ethpb.AttestResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "multi-node.ProposeAttestation")
+	defer span.End()
+
+	return broadcast(ctx, c.nodes, "ProposeAttestation", func(n iface.ValidatorClient) (*ethpb.AttestResponse, error) {
+		return n.ProposeAttestation(ctx, in)
+	})
+}
+
+// ProposeAttestationElectra broadcasts the attestation to all beacon nodes.
+func (c *validatorClient) ProposeAttestationElectra(ctx context.Context, in *ethpb.SingleAttestation) (*ethpb.AttestResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "multi-node.ProposeAttestationElectra")
+	defer span.End()
+
+	return broadcast(ctx, c.nodes, "ProposeAttestationElectra", func(n iface.ValidatorClient) (*ethpb.AttestResponse, error) {
+		return n.ProposeAttestationElectra(ctx, in)
+	})
+}
+
+// SubmitSignedAggregateSelectionProof broadcasts the aggregate to all beacon nodes.
+func (c *validatorClient) SubmitSignedAggregateSelectionProof(ctx context.Context, in *ethpb.SignedAggregateSubmitRequest) (*ethpb.SignedAggregateSubmitResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "multi-node.SubmitSignedAggregateSelectionProof")
+	defer span.End()
+
+	return broadcast(ctx, c.nodes, "SubmitSignedAggregateSelectionProof", func(n iface.ValidatorClient) (*ethpb.SignedAggregateSubmitResponse, error) {
+		return n.SubmitSignedAggregateSelectionProof(ctx, in)
+	})
+}
+
+// SubmitSignedAggregateSelectionProofElectra broadcasts the aggregate to all beacon nodes.
+func (c *validatorClient) SubmitSignedAggregateSelectionProofElectra(ctx context.Context, in *ethpb.SignedAggregateSubmitElectraRequest) (*ethpb.SignedAggregateSubmitResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "multi-node.SubmitSignedAggregateSelectionProofElectra")
+	defer span.End()
+
+	return broadcast(ctx, c.nodes, "SubmitSignedAggregateSelectionProofElectra", func(n iface.ValidatorClient) (*ethpb.SignedAggregateSubmitResponse, error) {
+		return n.SubmitSignedAggregateSelectionProofElectra(ctx, in)
+	})
+}
+
+// SubmitSyncMessage broadcasts the sync committee message to all beacon nodes.
+func (c *validatorClient) SubmitSyncMessage(ctx context.Context, in *ethpb.SyncCommitteeMessage) (*empty.Empty, error) {
+	ctx, span := trace.StartSpan(ctx, "multi-node.SubmitSyncMessage")
+	defer span.End()
+
+	return broadcast(ctx, c.nodes, "SubmitSyncMessage", func(n iface.ValidatorClient) (*empty.Empty, error) {
+		return n.SubmitSyncMessage(ctx, in)
+	})
+}
+
+// SubmitSignedContributionAndProof broadcasts the sync committee contribution to all beacon nodes.
+func (c *validatorClient) SubmitSignedContributionAndProof(ctx context.Context, in *ethpb.SignedContributionAndProof) (*empty.Empty, error) {
+	ctx, span := trace.StartSpan(ctx, "multi-node.SubmitSignedContributionAndProof")
+	defer span.End()
+
+	return broadcast(ctx, c.nodes, "SubmitSignedContributionAndProof", func(n iface.ValidatorClient) (*empty.Empty, error) {
+		return n.SubmitSignedContributionAndProof(ctx, in)
+	})
+}
+
+// ProposeBeaconBlock broadcasts the signed block to all beacon nodes.
+func (c *validatorClient) ProposeBeaconBlock(ctx context.Context, in *ethpb.GenericSignedBeaconBlock) (*ethpb.ProposeResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "multi-node.ProposeBeaconBlock")
+	defer span.End()
+
+	return broadcast(ctx, c.nodes, "ProposeBeaconBlock", func(n iface.ValidatorClient) (*ethpb.ProposeResponse, error) {
+		return n.ProposeBeaconBlock(ctx, in)
+	})
+}
+
+type broadcastResult[Resp any] struct {
+	resp Resp
+	err  error
+}
+
+// broadcast sends a request to all beacon nodes in parallel and returns as soon as one of them
+// accepted it. Requests to slower nodes keep running until they finish or the context is done.
+// An error is returned only when every node rejected the request.
+func broadcast[Resp any](ctx context.Context, nodes []iface.ValidatorClient, action string, f func(iface.ValidatorClient) (Resp, error)) (Resp, error) {
+	results := make(chan broadcastResult[Resp], len(nodes))
+	for _, n := range nodes {
+		go func(n iface.ValidatorClient) {
+			host := n.Host()
+			resp, err := withMetrics(host, action, func() (Resp, error) {
+				return f(n)
+			})
+			if err != nil {
+				log.WithError(err).WithField("host", host).Debugf("%s failed on beacon node", action)
+			}
+			results <- broadcastResult[Resp]{resp: resp, err: err}
+		}(n)
+	}
+
+	var err error
+	for range nodes {
+		select {
+		case r := <-results:
+			if r.err == nil {
+				return r.resp, nil
+			}
+			err = r.err
+		case <-ctx.Done():
+			var resp Resp
+			return resp, ctx.Err()
+		}
+	}
+	var resp Resp
+	return resp, errors.Wrapf(err, "%s failed on all beacon nodes", action)
+}
+
+func withMetrics[Resp any](host, action string, f func() (Resp, error)) (Resp, error) {
+	now := time.Now()
+	resp, err := f()
+	if err == nil {
+		beaconNodeLatency.WithLabelValues(host, action).Observe(time.Since(now).Seconds())
+	} else {
+		failedBeaconNodeRequestCount.WithLabelValues(host, action).Inc()
+	}
+	return resp, err
+}
@@ -0,0 +1,178 @@
+package multi_node
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	validatormock "github.com/prysmaticlabs/prysm/v5/testing/validator-mock"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+	"go.uber.org/mock/gomock"
+)
+
+func attestationData(root byte) *ethpb.AttestationData {
+	return &ethpb.AttestationData{
+		Slot:            1,
+		BeaconBlockRoot: bytesutil.PadTo([]byte{root}, 32),
+		Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+		Target:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+	}
+}
+
+type nodeResponse struct {
+	delay time.Duration
+	data  *ethpb.AttestationData
+	err   error
+}
+
+func attestationDataNodes(ctrl *gomock.Controller, responses []nodeResponse) []iface.ValidatorClient {
+	nodes := make([]iface.ValidatorClient, len(responses))
+	for i, r := range responses {
+		n := validatormock.NewMockValidatorClient(ctrl)
+		n.EXPECT().Host().Return(fmt.Sprintf("http://node%d", i)).AnyTimes()
+		n.EXPECT().AttestationData(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, _ *ethpb.AttestationDataRequest) (*ethpb.AttestationData, error) {
+				select {
+				case <-time.After(r.delay):
+				case <-ctx.Done():
+					return nil, ctx.Err()
+				}
+				return r.data, r.err
+			},
+		).AnyTimes()
+		nodes[i] = n
+	}
+	return nodes
+}
+
+func TestAttestationData(t *testing.T) {
+	tests := []struct {
+		name      string
+		responses []nodeResponse
+		want      *ethpb.AttestationData
+		wantErr   string
+	}{
+		{
+			name: "all nodes agree",
+			responses: []nodeResponse{
+				{delay: 50 * time.Millisecond, data: attestationData(1)},
+				{delay: 0, data: attestationData(1)},
+				{delay: 100 * time.Millisecond, data: attestationData(1)},
+			},
+			want: attestationData(1),
+		},
+		{
+			name: "fastest node disagrees with majority",
+			responses: []nodeResponse{
+				{delay: 0, data: attestationData(2)},
+				{delay: 50 * time.Millisecond, data: attestationData(1)},
+				{delay: 100 * time.Millisecond, data: attestationData(1)},
+			},
+			want: attestationData(1),
+		},
+		{
+			name: "no majority before timeout",
+			responses: []nodeResponse{
+				{delay: 0, data: attestationData(2)},
+				{delay: time.Second, data: attestationData(1)},
+				{delay: time.Second, data: attestationData(1)},
+			},
+			want: attestationData(2),
+		},
+		{
+			name: "failing nodes are ignored",
+			responses: []nodeResponse{
+				{delay: 0, err: errors.New("bad node")},
+				{delay: 50 * time.Millisecond, data: attestationData(1)},
+			},
+			want: attestationData(1),
+		},
+		{
+			name: "all nodes fail",
+			responses: []nodeResponse{
+				{delay: 0, err: errors.New("bad node")},
+				{delay: 0, err: errors.New("bad node")},
+			},
+			wantErr: "could not get attestation data from any beacon node: bad node",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			c := NewValidatorClient(
+				validatormock.NewMockValidatorClient(ctrl),
+				attestationDataNodes(ctrl, tt.responses),
+				WithAgreementTimeout(200*time.Millisecond),
+			)
+			data, err := c.AttestationData(context.Background(), &ethpb.AttestationDataRequest{Slot: 1})
+			if tt.wantErr != "" {
+				require.ErrorContains(t, tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.DeepEqual(t, tt.want, data)
+		})
+	}
+}
+
+func TestBroadcast(t *testing.T) {
+	attestation := &ethpb.Attestation{}
+
+	t.Run("succeeds when one node accepts", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		failing := validatormock.NewMockValidatorClient(ctrl)
+		failing.EXPECT().Host().Return("http://node0")
+		failing.EXPECT().ProposeAttestation(gomock.Any(), attestation).Return(nil, errors.New("bad node"))
+		working := validatormock.NewMockValidatorClient(ctrl)
+		working.EXPECT().Host().Return("http://node1")
+		working.EXPECT().ProposeAttestation(gomock.Any(), attestation).DoAndReturn(
+			func(context.Context, *ethpb.Attestation) (*ethpb.AttestResponse, error) {
+				time.Sleep(50 * time.Millisecond)
+				return &ethpb.AttestResponse{AttestationDataRoot: []byte{1}}, nil
+			},
+		)
+
+		c := NewValidatorClient(validatormock.NewMockValidatorClient(ctrl), []iface.ValidatorClient{failing, working})
+		resp, err := c.ProposeAttestation(context.Background(), attestation)
+		require.NoError(t, err)
+		assert.DeepEqual(t, []byte{1}, resp.AttestationDataRoot)
+	})
+	t.Run("fails when all nodes reject", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		nodes := make([]iface.ValidatorClient, 2)
+		for i := range nodes {
+			n := validatormock.NewMockValidatorClient(ctrl)
+			n.EXPECT().Host().Return(fmt.Sprintf("http://node%d", i))
+			n.EXPECT().ProposeAttestation(gomock.Any(), attestation).Return(nil, errors.New("bad node"))
+			nodes[i] = n
+		}
+
+		c := NewValidatorClient(validatormock.NewMockValidatorClient(ctrl), nodes)
+		_, err := c.ProposeAttestation(context.Background(), attestation)
+		require.ErrorContains(t, "ProposeAttestation failed on all beacon nodes: bad node", err)
+	})
+}
+
+func TestDelegatesToPrimary(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	primary := validatormock.NewMockValidatorClient(ctrl)
+	primary.EXPECT().Host().Return("http://primary")
+	primary.EXPECT().SetHost("http://backup")
+	c := NewValidatorClient(primary, []iface.ValidatorClient{validatormock.NewMockValidatorClient(ctrl)})
+	assert.Equal(t, "http://primary", c.Host())
+	c.SetHost("http://backup")
+}
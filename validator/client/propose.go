@@ -2,6 +2,7 @@ package client
 
 // Validator client proposer functions.
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"time"
@@ -27,8 +28,10 @@ import (
 	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+	"github.com/prysmaticlabs/prysm/v5/validator/graffiti"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 const (
@@ -36,6 +39,11 @@ const (
 	signingRootErr          = "could not get signing root"
 	signExitErr             = "could not sign voluntary exit proposal"
 	failedBlockSignLocalErr = "block rejected by local protection"
+	// clientVersionsCacheTTL is the duration after which the client versions of the beacon node are requested again.
+	clientVersionsCacheTTL = time.Hour
+	// clientVersionsErrCacheTTL is the duration after which the client versions of the beacon node are requested
+	// again when the previous request failed.
+	clientVersionsErrCacheTTL = 5 * time.Minute
 )
 
 // ProposeBlock proposes a new beacon block for a given slot. This method collects the
@@ -58,11 +66,16 @@ func (v *validator) ProposeBlock(ctx context.Context, slot primitives.Slot, pubK
 	fmtKey := fmt.Sprintf("%#x", pubKey[:])
 	span.SetAttributes(trace.StringAttribute("validator", fmtKey))
 	log := log.WithField("pubkey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])))
+	missedReason := missedReasonUnknown
+	defer func() {
+		v.recordDuty(pubKey, slot, iface.RoleProposer, missedReason)
+	}()
 
 	// Sign randao reveal, it's used to request block from beacon node
 	epoch := primitives.Epoch(slot / params.BeaconConfig().SlotsPerEpoch)
 	randaoReveal, err := v.signRandaoReveal(ctx, pubKey, epoch, slot)
 	if err != nil {
+		missedReason = missedReasonSigningError
 		log.WithError(err).Error("Failed to sign randao reveal")
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
@@ -77,14 +90,17 @@ func (v *validator) ProposeBlock(ctx context.Context, slot primitives.Slot, pubK
 		// to produce the block.
 		log.WithError(err).Warn("Could not get graffiti")
 	}
+	g = v.renderGraffiti(g, pubKey, slot)
 
 	// Request block from beacon node
 	b, err := v.validatorClient.BeaconBlock(ctx, &ethpb.BlockRequest{
-		Slot:         slot,
-		RandaoReveal: randaoReveal,
-		Graffiti:     g,
+		Slot:               slot,
+		RandaoReveal:       randaoReveal,
+		Graffiti:           g,
+		BuilderBoostFactor: v.builderBoostFactor(pubKey),
 	})
 	if err != nil {
+		missedReason = missedReasonBlockProduction
 		log.WithField("slot", slot).WithError(err).Error("Failed to request block from beacon node")
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
@@ -95,6 +111,7 @@ func (v *validator) ProposeBlock(ctx context.Context, slot primitives.Slot, pubK
 	// Sign returned block from beacon node
 	wb, err := blocks.NewBeaconBlock(b.Block)
 	if err != nil {
+		missedReason = missedReasonBlockProduction
 		log.WithError(err).Error("Failed to wrap block")
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
@@ -104,6 +121,7 @@ func (v *validator) ProposeBlock(ctx context.Context, slot primitives.Slot, pubK
 
 	sig, signingRoot, err := v.signBlock(ctx, pubKey, epoch, slot, wb)
 	if err != nil {
+		missedReason = missedReasonSigningError
 		log.WithError(err).Error("Failed to sign block")
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
@@ -118,6 +136,7 @@ func (v *validator) ProposeBlock(ctx context.Context, slot primitives.Slot, pubK
 	}
 
 	if err := v.db.SlashableProposalCheck(ctx, pubKey, blk, signingRoot, v.emitAccountMetrics, ValidatorProposeFailVec); err != nil {
+		missedReason = missedReasonSlashingProtection
 		log.WithFields(
 			blockLogFields(pubKey, wb, nil),
 		).WithError(err).Error("Failed block slashing protection check")
@@ -170,12 +189,14 @@ func (v *validator) ProposeBlock(ctx context.Context, slot primitives.Slot, pubK
 
 	blkResp, err := v.validatorClient.ProposeBeaconBlock(ctx, genericSignedBlock)
 	if err != nil {
+		missedReason = missedReasonSubmissionError
 		log.WithField("slot", slot).WithError(err).Error("Failed to propose block")
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
 		}
 		return
 	}
+	missedReason = ""
 
 	span.SetAttributes(
 		trace.StringAttribute("blockRoot", fmt.Sprintf("%#x", blkResp.BlockRoot)),
@@ -530,6 +551,92 @@ func (v *validator) Graffiti(ctx context.Context, pubKey [fieldparams.BLSPubkeyL
 	return []byte{}, nil
 }
 
+// renderGraffiti replaces the placeholders of a graffiti template with the values of the block proposal.
+// A graffiti without placeholder is returned as is, an empty graffiti being replaced by the client version
+// graffiti by the beacon node.
+func (v *validator) renderGraffiti(g []byte, pubKey [fieldparams.BLSPubkeyLength]byte, slot primitives.Slot) []byte {
+	template := string(bytes.TrimRight(g, "\x00"))
+	if !graffiti.IsTemplate(template) {
+		return g
+	}
+
+	fields := &graffiti.TemplateFields{
+		Slot:  slot,
+		Label: v.graffitiStruct.Label(pubKey),
+	}
+	if duty, err := v.duty(pubKey); err == nil {
+		fields.ValidatorIndex = duty.ValidatorIndex
+	}
+	if versions := v.beaconNodeClientVersions(); versions != nil {
+		fields.BeaconNode = versions.BeaconNode
+		fields.ExecutionClient = versions.ExecutionClient
+	}
+	return bytesutil.PadTo([]byte(graffiti.RenderTemplate(template, fields)), graffiti.MaxLength)
+}
+
+// beaconNodeClientVersions returns the cached client versions of the beacon node and of its execution client,
+// or nil when they are not known. The versions are requested off the duty path by UpdateClientVersions.
+func (v *validator) beaconNodeClientVersions() *iface.ClientVersions {
+	v.clientVersionsLock.Lock()
+	defer v.clientVersionsLock.Unlock()
+	return v.clientVersions
+}
+
+// UpdateClientVersions requests the client versions of the beacon node and of its execution client once the
+// cached ones are older than clientVersionsCacheTTL. A failure is cached for clientVersionsErrCacheTTL, the
+// previous versions being kept in the meantime.
+func (v *validator) UpdateClientVersions(ctx context.Context) {
+	v.clientVersionsLock.Lock()
+	ttl := clientVersionsCacheTTL
+	if v.clientVersionsErr != nil {
+		ttl = clientVersionsErrCacheTTL
+	}
+	due := v.clientVersionsTime.IsZero() || time.Since(v.clientVersionsTime) >= ttl
+	v.clientVersionsLock.Unlock()
+	if !due {
+		return
+	}
+
+	versions, err := v.nodeClient.ClientVersions(ctx)
+	if err != nil {
+		log.WithError(err).Debug("Could not get client versions from the beacon node, using the version of the validator client")
+		// The beacon node is asked again at the next update when the caller gave up on the request.
+		if ctx.Err() != nil {
+			return
+		}
+	}
+
+	v.clientVersionsLock.Lock()
+	defer v.clientVersionsLock.Unlock()
+	if err == nil {
+		v.clientVersions = versions
+	}
+	v.clientVersionsErr = err
+	v.clientVersionsTime = time.Now()
+}
+
+// builderBoostFactor returns the builder boost factor of the given validator from the proposer settings.
+// The proposer config of the key takes priority over the default config. When no factor is set, nil is
+// returned and the beacon node uses its own default.
+func (v *validator) builderBoostFactor(pubKey [fieldparams.BLSPubkeyLength]byte) *wrapperspb.UInt64Value {
+	if v.proposerSettings == nil {
+		return nil
+	}
+	if v.proposerSettings.ProposeConfig != nil {
+		option, ok := v.proposerSettings.ProposeConfig[pubKey]
+		if ok && option != nil && option.BuilderConfig != nil && option.BuilderConfig.BuilderBoostFactor != nil {
+			return wrapperspb.UInt64(uint64(*option.BuilderConfig.BuilderBoostFactor))
+		}
+	}
+	if v.proposerSettings.DefaultConfig != nil {
+		builderConfig := v.proposerSettings.DefaultConfig.BuilderConfig
+		if builderConfig != nil && builderConfig.BuilderBoostFactor != nil {
+			return wrapperspb.UInt64(uint64(*builderConfig.BuilderBoostFactor))
+		}
+	}
+	return nil
+}
+
 func (v *validator) SetGraffiti(ctx context.Context, pubkey [fieldparams.BLSPubkeyLength]byte, graffiti []byte) error {
 	ctx, span := trace.StartSpan(ctx, "validator.SetGraffiti")
 	defer span.End()
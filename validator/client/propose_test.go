@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
@@ -18,6 +19,7 @@ import (
 	blocktest "github.com/prysmaticlabs/prysm/v5/consensus-types/blocks/testing"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	validatorType "github.com/prysmaticlabs/prysm/v5/consensus-types/validator"
 	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
@@ -27,6 +29,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/testing/util"
 	validatormock "github.com/prysmaticlabs/prysm/v5/testing/validator-mock"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
 	testing2 "github.com/prysmaticlabs/prysm/v5/validator/db/testing"
 	"github.com/prysmaticlabs/prysm/v5/validator/graffiti"
 	logTest "github.com/sirupsen/logrus/hooks/test"
@@ -211,6 +214,69 @@ func TestProposeBlock_RequestBlockFailed(t *testing.T) {
 	}
 }
 
+func TestProposeBlock_RequestsBuilderBoostFactor(t *testing.T) {
+	validator, m, validatorKey, finish := setup(t, false)
+	defer finish()
+	var pubKey [fieldparams.BLSPubkeyLength]byte
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+	boostFactor := validatorType.Uint64(0)
+	validator.proposerSettings = &proposer.Settings{
+		ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*proposer.Option{
+			pubKey: {
+				BuilderConfig: &proposer.BuilderConfig{Enabled: true, BuilderBoostFactor: &boostFactor},
+			},
+		},
+	}
+
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), // epoch
+	).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/)
+
+	m.validatorClient.EXPECT().BeaconBlock(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&ethpb.BlockRequest{}),
+	).DoAndReturn(func(_ context.Context, req *ethpb.BlockRequest) (*ethpb.GenericBeaconBlock, error) {
+		require.NotNil(t, req.BuilderBoostFactor)
+		assert.Equal(t, uint64(0), req.BuilderBoostFactor.Value)
+		return nil, errors.New("uh oh")
+	})
+
+	validator.ProposeBlock(context.Background(), 1, pubKey)
+}
+
+func TestBuilderBoostFactor(t *testing.T) {
+	pubKey := [fieldparams.BLSPubkeyLength]byte{'a'}
+	otherPubKey := [fieldparams.BLSPubkeyLength]byte{'b'}
+	keyFactor := validatorType.Uint64(0)
+	defaultFactor := validatorType.Uint64(90)
+	settings := &proposer.Settings{
+		ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*proposer.Option{
+			pubKey: {
+				BuilderConfig: &proposer.BuilderConfig{Enabled: true, BuilderBoostFactor: &keyFactor},
+			},
+			otherPubKey: {
+				BuilderConfig: &proposer.BuilderConfig{Enabled: true},
+			},
+		},
+		DefaultConfig: &proposer.Option{
+			BuilderConfig: &proposer.BuilderConfig{Enabled: true, BuilderBoostFactor: &defaultFactor},
+		},
+	}
+
+	v := &validator{}
+	assert.Equal(t, true, v.builderBoostFactor(pubKey) == nil)
+
+	v.proposerSettings = settings
+	require.NotNil(t, v.builderBoostFactor(pubKey))
+	assert.Equal(t, uint64(0), v.builderBoostFactor(pubKey).Value)
+	require.NotNil(t, v.builderBoostFactor(otherPubKey))
+	assert.Equal(t, uint64(90), v.builderBoostFactor(otherPubKey).Value)
+
+	v.proposerSettings.DefaultConfig = nil
+	assert.Equal(t, true, v.builderBoostFactor(otherPubKey) == nil)
+}
+
 func TestProposeBlock_ProposeBlockFailed(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -1132,6 +1198,72 @@ func TestGetGraffitiOrdered_Ok(t *testing.T) {
 	}
 }
 
+func TestRenderGraffiti(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	nodeClient := validatormock.NewMockNodeClient(ctrl)
+
+	pubKey := [fieldparams.BLSPubkeyLength]byte{'a'}
+	v := &validator{
+		nodeClient: nodeClient,
+		graffitiStruct: &graffiti.Graffiti{
+			Labels: map[string]string{hexutil.Encode(pubKey[:]): "home"},
+		},
+		duties: &ethpb.DutiesResponse{
+			CurrentEpochDuties: []*ethpb.DutiesResponse_Duty{{PublicKey: pubKey[:], ValidatorIndex: 12}},
+		},
+	}
+
+	// A graffiti without placeholder is kept as is.
+	static := bytesutil.PadTo([]byte("static {graffiti}"), 32)
+	assert.DeepEqual(t, static, v.renderGraffiti(static, pubKey, 100))
+	assert.DeepEqual(t, []byte{}, v.renderGraffiti([]byte{}, pubKey, 100))
+
+	// The client versions are requested off the duty path, until then the versions of the validator client are used.
+	cl := version.Client()
+	want := bytesutil.PadTo([]byte(cl.Code+cl.ShortCommit(4)), 32)
+	assert.DeepEqual(t, want, v.renderGraffiti([]byte("{client_version}"), pubKey, 100))
+
+	nodeClient.EXPECT().ClientVersions(gomock.Any()).Return(&iface.ClientVersions{
+		BeaconNode:      &version.ClientVersion{Code: "PM", Name: "Prysm", Version: "v5.2.0", Commit: "0xe4f61234"},
+		ExecutionClient: &version.ClientVersion{Code: "GE", Name: "Geth", Version: "v1.14.11", Commit: "0xf8d0a1b2"},
+	}, nil)
+	v.UpdateClientVersions(context.Background())
+	template := []byte("{label} #{index} at {slot} {client_version}")
+	want = bytesutil.PadTo([]byte("home #12 at 100 GEf8d0PMe4f6"), 32)
+	assert.DeepEqual(t, want, v.renderGraffiti(template, pubKey, 100))
+	want = bytesutil.PadTo([]byte("home #12 at 101 GEf8d0PMe4f6"), 32)
+	assert.DeepEqual(t, want, v.renderGraffiti(bytesutil.PadTo(template, 32), pubKey, 101))
+}
+
+func TestValidator_UpdateClientVersions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	nodeClient := validatormock.NewMockNodeClient(ctrl)
+	v := &validator{nodeClient: nodeClient}
+
+	versions := &iface.ClientVersions{BeaconNode: &version.ClientVersion{Code: "PM", Name: "Prysm", Version: "v5.2.0", Commit: "0xe4f61234"}}
+	nodeClient.EXPECT().ClientVersions(gomock.Any()).Return(versions, nil)
+	v.UpdateClientVersions(context.Background())
+	assert.DeepEqual(t, versions, v.beaconNodeClientVersions())
+
+	// The versions are cached.
+	v.UpdateClientVersions(context.Background())
+
+	// A failure keeps the previous versions and is cached as well.
+	v.clientVersionsTime = time.Now().Add(-clientVersionsCacheTTL)
+	nodeClient.EXPECT().ClientVersions(gomock.Any()).Return(nil, iface.ErrNotSupported)
+	v.UpdateClientVersions(context.Background())
+	v.UpdateClientVersions(context.Background())
+	assert.DeepEqual(t, versions, v.beaconNodeClientVersions())
+
+	// The beacon node is asked again once the failure expired.
+	v.clientVersionsTime = time.Now().Add(-clientVersionsErrCacheTTL)
+	nodeClient.EXPECT().ClientVersions(gomock.Any()).Return(versions, nil)
+	v.UpdateClientVersions(context.Background())
+	v.UpdateClientVersions(context.Background())
+}
+
 func Test_validator_DeleteGraffiti(t *testing.T) {
 	pubKey := [fieldparams.BLSPubkeyLength]byte{'a'}
 	tests := []struct {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
@@ -65,6 +66,9 @@ func run(ctx context.Context, v iface.Validator) {
 	if err := v.PushProposerSettings(ctx, km, headSlot, true); err != nil {
 		log.WithError(err).Fatal("Failed to update proposer settings")
 	}
+	// The client versions are used in graffiti templates, they are requested off the duty path.
+	go v.UpdateClientVersions(ctx)
+	var doppelGangerCheckInFlight atomic.Bool
 	for {
 		ctx, span := prysmTrace.StartSpan(ctx, "validator.processSlot")
 		select {
@@ -94,6 +98,10 @@ func run(ctx context.Context, v iface.Validator) {
 				continue
 			}
 
+			// Keep the duties of keys disabled until they pass the doppelganger check,
+			// including the keys added since the previous slot.
+			runDoppelGangerCheck(ctx, v, &doppelGangerCheckInFlight)
+
 			// call push proposer settings often to account for the following edge cases:
 			// proposer is activated at the start of epoch and tries to propose immediately
 			// account has changed in the middle of an epoch
@@ -101,9 +109,10 @@ func run(ctx context.Context, v iface.Validator) {
 				log.WithError(err).Warn("Failed to update proposer settings")
 			}
 
-			// Start fetching domain data for the next epoch.
+			// Start fetching domain data and client versions for the next epoch.
 			if slots.IsEpochEnd(slot) {
 				go v.UpdateDomainDataCaches(ctx, slot+1)
+				go v.UpdateClientVersions(ctx)
 			}
 
 			var wg sync.WaitGroup
@@ -136,6 +145,24 @@ func run(ctx context.Context, v iface.Validator) {
 	}
 }
 
+// runDoppelGangerCheck runs the doppelganger check off the duty path of the slot, the duties of the keys
+// which did not pass the check yet stay disabled in the meantime. A check still in flight from a previous
+// slot is not started again.
+func runDoppelGangerCheck(ctx context.Context, v iface.Validator, inFlight *atomic.Bool) {
+	if !inFlight.CompareAndSwap(false, true) {
+		return
+	}
+	go func() {
+		defer inFlight.Store(false)
+		if err := v.CheckDoppelGanger(ctx); err != nil {
+			if errors.Is(err, errDoppelGangerDetected) {
+				log.WithError(err).Fatal("Could not succeed with doppelganger check")
+			}
+			log.WithError(err).Warn("Could not check for doppelgangers")
+		}
+	}()
+}
+
 func onAccountsChanged(ctx context.Context, v iface.Validator, current [][48]byte, ac chan [][fieldparams.BLSPubkeyLength]byte) {
 	anyActive, err := v.HandleKeyReload(ctx, current)
 	if err != nil {
@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"math/bits"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -370,3 +371,37 @@ func TestUpdateProposerSettingsAt_EpochEndOk(t *testing.T) {
 	// can't test "Failed to update proposer settings" because of log.fatal
 	assert.LogsContain(t, hook, "Mock updated proposer settings")
 }
+
+type blockingDoppelGangerValidator struct {
+	*testutil.FakeValidator
+	calls   atomic.Int32
+	release chan struct{}
+}
+
+func (v *blockingDoppelGangerValidator) CheckDoppelGanger(context.Context) error {
+	v.calls.Add(1)
+	<-v.release
+	return nil
+}
+
+func TestRunDoppelGangerCheck_SkipsWhileInFlight(t *testing.T) {
+	v := &blockingDoppelGangerValidator{FakeValidator: &testutil.FakeValidator{}, release: make(chan struct{})}
+	var inFlight atomic.Bool
+
+	runDoppelGangerCheck(context.Background(), v, &inFlight)
+	require.Equal(t, true, inFlight.Load())
+	// The check of the previous slot is still in flight, so no other check is started.
+	runDoppelGangerCheck(context.Background(), v, &inFlight)
+
+	close(v.release)
+	for inFlight.Load() {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(t, int32(1), v.calls.Load())
+
+	runDoppelGangerCheck(context.Background(), v, &inFlight)
+	for inFlight.Load() {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(t, int32(2), v.calls.Load())
+}
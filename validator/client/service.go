@@ -15,6 +15,7 @@ import (
 	grpcutil "github.com/prysmaticlabs/prysm/v5/api/grpc"
 	"github.com/prysmaticlabs/prysm/v5/async/event"
 	lruwrpr "github.com/prysmaticlabs/prysm/v5/cache/lru"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/config/proposer"
@@ -24,6 +25,7 @@ import (
 	beaconApi "github.com/prysmaticlabs/prysm/v5/validator/client/beacon-api"
 	beaconChainClientFactory "github.com/prysmaticlabs/prysm/v5/validator/client/beacon-chain-client-factory"
 	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+	multinode "github.com/prysmaticlabs/prysm/v5/validator/client/multi-node"
 	nodeclientfactory "github.com/prysmaticlabs/prysm/v5/validator/client/node-client-factory"
 	validatorclientfactory "github.com/prysmaticlabs/prysm/v5/validator/client/validator-client-factory"
 	"github.com/prysmaticlabs/prysm/v5/validator/db"
@@ -32,6 +34,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/local"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/v5/validator/keymanager/remote-web3signer"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/threshold"
 	"go.opencensus.io/plugin/ocgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -51,12 +54,15 @@ type ValidatorService struct {
 	graffitiStruct          *graffiti.Graffiti
 	interopKeysConfig       *local.InteropKeymanagerConfig
 	web3SignerConfig        *remoteweb3signer.SetupConfig
+	thresholdSignerConfig   *threshold.SetupConfig
 	proposerSettings        *proposer.Settings
 	validatorsRegBatchSize  int
+	doppelGangerEpochs      uint64
 	useWeb                  bool
 	emitAccountMetrics      bool
 	logValidatorPerformance bool
 	distributed             bool
+	parallelBeaconNodes     bool
 }
 
 // Config for the validator service.
@@ -73,12 +79,15 @@ type Config struct {
 	BeaconNodeCert          string
 	BeaconApiEndpoint       string
 	BeaconApiTimeout        time.Duration
+	ParallelBeaconNodes     bool
 	Graffiti                string
 	GraffitiStruct          *graffiti.Graffiti
 	InteropKmConfig         *local.InteropKeymanagerConfig
 	Web3SignerConfig        *remoteweb3signer.SetupConfig
+	ThresholdSignerConfig   *threshold.SetupConfig
 	ProposerSettings        *proposer.Settings
 	ValidatorsRegBatchSize  int
+	DoppelGangerEpochs      uint64
 	UseWeb                  bool
 	LogValidatorPerformance bool
 	EmitAccountMetrics      bool
@@ -100,12 +109,15 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		graffitiStruct:          cfg.GraffitiStruct,
 		interopKeysConfig:       cfg.InteropKmConfig,
 		web3SignerConfig:        cfg.Web3SignerConfig,
+		thresholdSignerConfig:   cfg.ThresholdSignerConfig,
 		proposerSettings:        cfg.ProposerSettings,
 		validatorsRegBatchSize:  cfg.ValidatorsRegBatchSize,
+		doppelGangerEpochs:      cfg.DoppelGangerEpochs,
 		useWeb:                  cfg.UseWeb,
 		emitAccountMetrics:      cfg.EmitAccountMetrics,
 		logValidatorPerformance: cfg.LogValidatorPerformance,
 		distributed:             cfg.Distributed,
+		parallelBeaconNodes:     cfg.ParallelBeaconNodes,
 	}
 
 	dialOpts := ConstructDialOptions(
@@ -178,6 +190,9 @@ func (v *ValidatorService) Start() {
 	)
 
 	validatorClient := validatorclientfactory.NewValidatorClient(v.conn, restHandler)
+	if v.parallelBeaconNodes {
+		validatorClient = v.parallelValidatorClient(validatorClient, hosts)
+	}
 
 	valStruct := &validator{
 		slotFeed:                       new(event.Feed),
@@ -200,6 +215,7 @@ func (v *ValidatorService) Start() {
 		db:                             v.db,
 		km:                             nil,
 		web3SignerConfig:               v.web3SignerConfig,
+		thresholdSignerConfig:          v.thresholdSignerConfig,
 		proposerSettings:               v.proposerSettings,
 		signedValidatorRegistrations:   make(map[[fieldparams.BLSPubkeyLength]byte]*ethpb.SignedValidatorRegistrationV1),
 		validatorsRegBatchSize:         v.validatorsRegBatchSize,
@@ -215,12 +231,35 @@ func (v *ValidatorService) Start() {
 		emitAccountMetrics:             v.emitAccountMetrics,
 		useWeb:                         v.useWeb,
 		distributed:                    v.distributed,
+		doppelGangerEpochs:             v.doppelGangerEpochs,
+		doppelGangerStatuses:           make(map[[fieldparams.BLSPubkeyLength]byte]*doppelGangerStatus),
 	}
 
 	v.validator = valStruct
 	go run(v.ctx, v.validator)
 }
 
+// parallelValidatorClient wraps the validator client so that duties are sent to all beacon nodes at once.
+func (v *ValidatorService) parallelValidatorClient(primary iface.ValidatorClient, hosts []string) iface.ValidatorClient {
+	if !features.Get().EnableBeaconRESTApi {
+		log.Warn("Parallel beacon nodes require the beacon REST API, using a single beacon node")
+		return primary
+	}
+	if len(hosts) < 2 {
+		log.Warn("Parallel beacon nodes require more than one beacon REST API provider, using a single beacon node")
+		return primary
+	}
+	nodes := make([]iface.ValidatorClient, len(hosts))
+	for i, host := range hosts {
+		nodes[i] = beaconApi.NewBeaconApiValidatorClient(beaconApi.NewBeaconApiJsonRestHandler(
+			http.Client{Timeout: v.conn.GetBeaconApiTimeout()},
+			host,
+		))
+	}
+	log.WithField("hosts", hosts).Info("Sending duties to beacon nodes in parallel")
+	return multinode.NewValidatorClient(primary, nodes)
+}
+
 // Stop the validator service.
 func (v *ValidatorService) Stop() error {
 	v.cancel()
@@ -348,3 +387,19 @@ func (v *ValidatorService) DeleteGraffiti(ctx context.Context, pubKey [fieldpara
 	}
 	return v.validator.DeleteGraffiti(ctx, pubKey)
 }
+
+// DutySchedule returns the upcoming duties of the validating keys.
+func (v *ValidatorService) DutySchedule() ([]*iface.ScheduledDuties, error) {
+	if v.validator == nil {
+		return nil, errors.New("validator is unavailable")
+	}
+	return v.validator.DutySchedule(), nil
+}
+
+// DutyHistory returns the outcomes of the most recent duties of the validating keys.
+func (v *ValidatorService) DutyHistory() ([]*iface.DutyRecord, error) {
+	if v.validator == nil {
+		return nil, errors.New("validator is unavailable")
+	}
+	return v.validator.DutyHistory(), nil
+}
@@ -32,8 +32,16 @@ func (v *validator) SubmitSyncCommitteeMessage(ctx context.Context, slot primiti
 
 	v.waitOneThirdOrValidBlock(ctx, slot)
 
+	missedReason := missedReasonUnknown
+	defer func() {
+		v.recordDuty(pubKey, slot, iface.RoleSyncCommittee, missedReason)
+	}()
+
 	res, err := v.validatorClient.SyncMessageBlockRoot(ctx, &emptypb.Empty{})
 	if err != nil {
+		if isDeadlineError(err) {
+			missedReason = missedReasonLateBlockRoot
+		}
 		log.WithError(err).Error("Could not request sync message block root to sign")
 		tracing.AnnotateError(span, err)
 		return
@@ -41,18 +49,21 @@ func (v *validator) SubmitSyncCommitteeMessage(ctx context.Context, slot primiti
 
 	duty, err := v.duty(pubKey)
 	if err != nil {
+		missedReason = missedReasonNoAssignment
 		log.WithError(err).Error("Could not fetch validator assignment")
 		return
 	}
 
 	d, err := v.domainData(ctx, slots.ToEpoch(slot), params.BeaconConfig().DomainSyncCommittee[:])
 	if err != nil {
+		missedReason = missedReasonSigningError
 		log.WithError(err).Error("Could not get sync committee domain data")
 		return
 	}
 	sszRoot := primitives.SSZBytes(res.Root)
 	r, err := signing.ComputeSigningRoot(&sszRoot, d.SignatureDomain)
 	if err != nil {
+		missedReason = missedReasonSigningError
 		log.WithError(err).Error("Could not get sync committee message signing root")
 		return
 	}
@@ -67,6 +78,7 @@ func (v *validator) SubmitSyncCommitteeMessage(ctx context.Context, slot primiti
 		SigningSlot: slot,
 	})
 	if err != nil {
+		missedReason = missedReasonSigningError
 		log.WithError(err).Error("Could not sign sync committee message")
 		return
 	}
@@ -78,9 +90,11 @@ func (v *validator) SubmitSyncCommitteeMessage(ctx context.Context, slot primiti
 		Signature:      sig.Marshal(),
 	}
 	if _, err := v.validatorClient.SubmitSyncMessage(ctx, msg); err != nil {
+		missedReason = missedReasonSubmissionError
 		log.WithError(err).Error("Could not submit sync committee message")
 		return
 	}
+	missedReason = ""
 
 	msgSlot := msg.Slot
 	slotTime := time.Unix(int64(v.genesisTime+uint64(msgSlot)*params.BeaconConfig().SecondsPerSlot), 0)
@@ -100,8 +114,14 @@ func (v *validator) SubmitSignedContributionAndProof(ctx context.Context, slot p
 	defer span.End()
 	span.SetAttributes(trace.StringAttribute("validator", fmt.Sprintf("%#x", pubKey)))
 
+	missedReason := missedReasonUnknown
+	defer func() {
+		v.recordDuty(pubKey, slot, iface.RoleSyncCommitteeAggregator, missedReason)
+	}()
+
 	duty, err := v.duty(pubKey)
 	if err != nil {
+		missedReason = missedReasonNoAssignment
 		log.WithError(err).Error("Could not fetch validator assignment")
 		return
 	}
@@ -111,22 +131,27 @@ func (v *validator) SubmitSignedContributionAndProof(ctx context.Context, slot p
 		Slot:      slot,
 	})
 	if err != nil {
+		missedReason = missedReasonNoAssignment
 		log.WithError(err).Error("Could not get sync subcommittee index")
 		return
 	}
 	if len(indexRes.Indices) == 0 {
+		missedReason = missedReasonNoAssignment
 		log.Debug("Empty subcommittee index list, do nothing")
 		return
 	}
 
 	selectionProofs, err := v.selectionProofs(ctx, slot, pubKey, indexRes, duty.ValidatorIndex)
 	if err != nil {
+		missedReason = missedReasonSigningError
 		log.WithError(err).Error("Could not get selection proofs")
 		return
 	}
 
 	v.waitToSlotTwoThirds(ctx, slot)
 
+	// The duty is missed unless a contribution is submitted for one of the subcommittees.
+	missedReason = missedReasonNoAggregate
 	for i, comIdx := range indexRes.Indices {
 		isAggregator, err := altair.IsSyncCommitteeAggregator(selectionProofs[i])
 		if err != nil {
@@ -144,6 +169,7 @@ func (v *validator) SubmitSignedContributionAndProof(ctx context.Context, slot p
 			SubnetId:  subnet,
 		})
 		if err != nil {
+			missedReason = missedReasonNoAggregate
 			log.WithError(err).Error("Could not get sync committee contribution")
 			return
 		}
@@ -163,6 +189,7 @@ func (v *validator) SubmitSignedContributionAndProof(ctx context.Context, slot p
 		}
 		sig, err := v.signContributionAndProof(ctx, pubKey, contributionAndProof, slot)
 		if err != nil {
+			missedReason = missedReasonSigningError
 			log.WithError(err).Error("Could not sign contribution and proof")
 			return
 		}
@@ -171,9 +198,11 @@ func (v *validator) SubmitSignedContributionAndProof(ctx context.Context, slot p
 			Message:   contributionAndProof,
 			Signature: sig,
 		}); err != nil {
+			missedReason = missedReasonSubmissionError
 			log.WithError(err).Error("Could not submit signed contribution and proof")
 			return
 		}
+		missedReason = ""
 
 		contributionSlot := contributionAndProof.Contribution.Slot
 		slotTime := time.Unix(int64(v.genesisTime+uint64(contributionSlot)*params.BeaconConfig().SecondsPerSlot), 0)
@@ -15,6 +15,7 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -428,6 +429,10 @@ func TestSubmitSignedContributionAndProof_CouldNotSubmitContribution(t *testing.
 
 			validator.SubmitSignedContributionAndProof(context.Background(), 1, pubKey)
 			require.LogsContain(t, hook, "Could not submit signed contribution and proof")
+			history := validator.DutyHistory()
+			require.Equal(t, 1, len(history))
+			assert.Equal(t, iface.RoleSyncCommitteeAggregator, history[0].Role)
+			assert.Equal(t, missedReasonSubmissionError, history[0].MissedReason)
 		})
 	}
 }
@@ -509,6 +514,10 @@ func TestSubmitSignedContributionAndProof_Ok(t *testing.T) {
 			).Return(&emptypb.Empty{}, nil)
 
 			validator.SubmitSignedContributionAndProof(context.Background(), 1, pubKey)
+			history := validator.DutyHistory()
+			require.Equal(t, 1, len(history))
+			assert.Equal(t, iface.RoleSyncCommitteeAggregator, history[0].Role)
+			assert.Equal(t, "", history[0].MissedReason)
 		})
 	}
 }
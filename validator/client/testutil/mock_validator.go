@@ -203,6 +203,9 @@ func (*FakeValidator) LogSubmittedAtts(_ primitives.Slot) {}
 // UpdateDomainDataCaches for mocking.
 func (*FakeValidator) UpdateDomainDataCaches(context.Context, primitives.Slot) {}
 
+// UpdateClientVersions for mocking.
+func (*FakeValidator) UpdateClientVersions(context.Context) {}
+
 // BalancesByPubkeys for mocking.
 func (fv *FakeValidator) BalancesByPubkeys(_ context.Context) map[[fieldparams.BLSPubkeyLength]byte]uint64 {
 	return fv.Balances
@@ -329,3 +332,13 @@ func (*FakeValidator) Host() string {
 func (fv *FakeValidator) ChangeHost() {
 	fv.Host()
 }
+
+// DutySchedule for mocking
+func (*FakeValidator) DutySchedule() []*iface.ScheduledDuties {
+	return nil
+}
+
+// DutyHistory for mocking
+func (*FakeValidator) DutyHistory() []*iface.DutyRecord {
+	return nil
+}
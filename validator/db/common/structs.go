@@ -26,3 +26,51 @@ type AttestationRecord struct {
 	Target      primitives.Epoch
 	SigningRoot []byte
 }
+
+// Watermarks below which a validator public key refuses to sign. Attestations with a source epoch lower than
+// Source or a target epoch lower than or equal to Target, and blocks with a slot lower than or equal to Slot,
+// are refused unless they repeat a signing recorded in the database. A nil watermark is not enforced.
+type Watermarks struct {
+	Source *primitives.Epoch
+	Target *primitives.Epoch
+	Slot   *primitives.Slot
+}
+
+// Covers returns true if every watermark of other is enforced by w.
+func (w *Watermarks) Covers(other *Watermarks) bool {
+	if other.Source != nil && (w.Source == nil || *w.Source < *other.Source) {
+		return false
+	}
+	if other.Target != nil && (w.Target == nil || *w.Target < *other.Target) {
+		return false
+	}
+	if other.Slot != nil && (w.Slot == nil || *w.Slot < *other.Slot) {
+		return false
+	}
+	return true
+}
+
+// Anomaly found in the slashing protection data of a validator public key.
+type Anomaly struct {
+	// PubKey as stored in the database, which may be malformed.
+	PubKey  []byte
+	Message string
+	// Repair holds the watermarks protecting against the anomaly once raised.
+	// It is nil if raising watermarks does not protect against the anomaly, or if they are already high enough.
+	Repair *Watermarks
+}
+
+// Max returns the highest of the watermarks of w and other.
+func (w *Watermarks) Max(other *Watermarks) *Watermarks {
+	result := &Watermarks{Source: w.Source, Target: w.Target, Slot: w.Slot}
+	if other.Source != nil && (result.Source == nil || *result.Source < *other.Source) {
+		result.Source = other.Source
+	}
+	if other.Target != nil && (result.Target == nil || *result.Target < *other.Target) {
+		result.Target = other.Target
+	}
+	if other.Slot != nil && (result.Slot == nil || *result.Slot < *other.Slot) {
+		result.Slot = other.Slot
+	}
+	return result
+}
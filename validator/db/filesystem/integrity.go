@@ -0,0 +1,188 @@
+package filesystem
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+	"gopkg.in/yaml.v3"
+)
+
+// VerifySlashingProtection checks that every file of the slashing protection directory belongs to a single
+// public key and contains a consistent slashing protection, and returns the anomalies found.
+func (s *Store) VerifySlashingProtection(_ context.Context) ([]*common.Anomaly, error) {
+	slashingProtectionDirPath := s.slashingProtectionDirPath()
+
+	// If the slashing protection directory does not exist, there is nothing to verify.
+	exists, err := file.Exists(slashingProtectionDirPath, file.Directory)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not check if %s exists", slashingProtectionDirPath)
+	}
+
+	if !exists {
+		return nil, nil
+	}
+
+	entries, err := os.ReadDir(slashingProtectionDirPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read database directory")
+	}
+
+	var anomalies []*common.Anomaly
+	type protectionFile struct {
+		name       string
+		watermarks *common.Watermarks
+	}
+	files := make(map[[fieldparams.BLSPubkeyLength]byte]protectionFile, len(entries))
+	for _, entry := range entries {
+		// Check the file name is the public key the file belongs to.
+		publicKeyHex := strings.TrimSuffix(entry.Name(), ".yaml")
+		publicKeyBytes, err := hexutil.Decode(publicKeyHex)
+		if !entry.Type().IsRegular() || !strings.HasSuffix(entry.Name(), ".yaml") || err != nil ||
+			len(publicKeyBytes) != fieldparams.BLSPubkeyLength {
+			anomalies = append(anomalies, &common.Anomaly{
+				PubKey:  publicKeyBytes,
+				Message: fmt.Sprintf("unexpected file %s in slashing protection directory", entry.Name()),
+			})
+			continue
+		}
+
+		publicKey := [fieldparams.BLSPubkeyLength]byte{}
+		copy(publicKey[:], publicKeyBytes)
+
+		// Read the slashing protection of the file, which may not be the one used for the public key.
+		enc, err := os.ReadFile(path.Join(slashingProtectionDirPath, entry.Name()))
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not read %s", entry.Name())
+		}
+
+		validatorSlashingProtection := &ValidatorSlashingProtection{}
+		if err := yaml.Unmarshal(enc, validatorSlashingProtection); err != nil {
+			anomalies = append(anomalies, &common.Anomaly{
+				PubKey:  publicKeyBytes,
+				Message: fmt.Sprintf("could not unmarshal %s: %v", entry.Name(), err),
+			})
+			continue
+		}
+
+		current := protectionFile{name: entry.Name(), watermarks: watermarks(validatorSlashingProtection)}
+
+		// Only the file with the lower case file name is used for the public key,
+		// so it needs the watermarks of all the files of the public key.
+		if other, ok := files[publicKey]; ok {
+			current.watermarks = current.watermarks.Max(other.watermarks)
+			anomalies = append(anomalies, &common.Anomaly{
+				PubKey:  publicKeyBytes,
+				Message: fmt.Sprintf("duplicate slashing protection files %s and %s", other.name, current.name),
+				Repair:  current.watermarks,
+			})
+		}
+		files[publicKey] = current
+
+		// Based on EIP-3076 (minimal database), the recorded source epoch can't be greater than the recorded target epoch.
+		target := validatorSlashingProtection.LastSignedAttestationTargetEpoch
+		source := validatorSlashingProtection.LastSignedAttestationSourceEpoch
+		if target == nil && source != 0 {
+			sourceEpoch := primitives.Epoch(source)
+			anomalies = append(anomalies, &common.Anomaly{
+				PubKey:  publicKeyBytes,
+				Message: fmt.Sprintf("source epoch %d is recorded without target epoch", source),
+				Repair:  &common.Watermarks{Target: &sourceEpoch},
+			})
+		}
+		if target != nil && source > *target {
+			sourceEpoch := primitives.Epoch(source)
+			anomalies = append(anomalies, &common.Anomaly{
+				PubKey:  publicKeyBytes,
+				Message: fmt.Sprintf("source epoch %d is greater than target epoch %d", source, *target),
+				Repair:  &common.Watermarks{Target: &sourceEpoch},
+			})
+		}
+	}
+
+	return anomalies, nil
+}
+
+// SlashingProtectionWatermarks returns the last signed source and target epochs and the latest signed block slot of a public key.
+func (s *Store) SlashingProtectionWatermarks(_ context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) (*common.Watermarks, error) {
+	// Get validator slashing protection.
+	validatorSlashingProtection, err := s.validatorSlashingProtection(pubKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get validator slashing protection")
+	}
+
+	// If there is no validator slashing protection, no watermark is enforced.
+	if validatorSlashingProtection == nil {
+		return &common.Watermarks{}, nil
+	}
+
+	return watermarks(validatorSlashingProtection), nil
+}
+
+// RaiseSlashingProtectionWatermarks raises the last signed source and target epochs and the latest signed block slot
+// of a public key to the given watermarks. Watermarks are never lowered.
+func (s *Store) RaiseSlashingProtectionWatermarks(
+	_ context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, watermarks *common.Watermarks,
+) error {
+	// Get validator slashing protection.
+	validatorSlashingProtection, err := s.validatorSlashingProtection(pubKey)
+	if err != nil {
+		return errors.Wrap(err, "could not get validator slashing protection")
+	}
+
+	if validatorSlashingProtection == nil {
+		validatorSlashingProtection = &ValidatorSlashingProtection{}
+	}
+
+	if watermarks.Source != nil && uint64(*watermarks.Source) > validatorSlashingProtection.LastSignedAttestationSourceEpoch {
+		validatorSlashingProtection.LastSignedAttestationSourceEpoch = uint64(*watermarks.Source)
+	}
+
+	if watermarks.Target != nil {
+		target := uint64(*watermarks.Target)
+		if current := validatorSlashingProtection.LastSignedAttestationTargetEpoch; current == nil || *current < target {
+			validatorSlashingProtection.LastSignedAttestationTargetEpoch = &target
+		}
+	}
+
+	if watermarks.Slot != nil {
+		slot := uint64(*watermarks.Slot)
+		if current := validatorSlashingProtection.LatestSignedBlockSlot; current == nil || *current < slot {
+			validatorSlashingProtection.LatestSignedBlockSlot = &slot
+		}
+	}
+
+	// Save the validator slashing protection.
+	if err := s.saveValidatorSlashingProtection(pubKey, validatorSlashingProtection); err != nil {
+		return errors.Wrap(err, "could not save validator slashing protection")
+	}
+
+	return nil
+}
+
+// watermarks returns the watermarks enforced by a validator slashing protection.
+func watermarks(validatorSlashingProtection *ValidatorSlashingProtection) *common.Watermarks {
+	result := &common.Watermarks{}
+
+	// The source epoch is only meaningful once an attestation was signed.
+	if validatorSlashingProtection.LastSignedAttestationTargetEpoch != nil {
+		source := primitives.Epoch(validatorSlashingProtection.LastSignedAttestationSourceEpoch)
+		target := primitives.Epoch(*validatorSlashingProtection.LastSignedAttestationTargetEpoch)
+		result.Source, result.Target = &source, &target
+	}
+
+	if validatorSlashingProtection.LatestSignedBlockSlot != nil {
+		slot := primitives.Slot(*validatorSlashingProtection.LatestSignedBlockSlot)
+		result.Slot = &slot
+	}
+
+	return result
+}
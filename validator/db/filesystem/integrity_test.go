@@ -0,0 +1,102 @@
+package filesystem
+
+import (
+	"context"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+)
+
+func TestStore_VerifySlashingProtection(t *testing.T) {
+	ctx := context.Background()
+
+	// Create some pubkeys.
+	pubKeys := getPubKeys(t, 3)
+
+	// Create a new store.
+	store, err := NewStore(t.TempDir(), &Config{PubKeys: pubKeys})
+	require.NoError(t, err, "NewStore should not return an error")
+
+	// A fresh database has no anomaly.
+	anomalies, err := store.VerifySlashingProtection(ctx)
+	require.NoError(t, err, "VerifySlashingProtection should not return an error")
+	require.Equal(t, 0, len(anomalies), "there should be no anomaly")
+
+	// Record an attestation with a source greater than its target for the first public key.
+	source, target := uint64(10), uint64(8)
+	err = store.saveValidatorSlashingProtection(pubKeys[0], &ValidatorSlashingProtection{
+		LastSignedAttestationSourceEpoch: source,
+		LastSignedAttestationTargetEpoch: &target,
+	})
+	require.NoError(t, err, "saveValidatorSlashingProtection should not return an error")
+
+	// Write an upper case duplicate of the file of the second public key, with a higher block slot.
+	dirPath := store.slashingProtectionDirPath()
+	duplicateName := "0x" + strings.ToUpper(hexutil.Encode(pubKeys[1][:])[2:]) + ".yaml"
+	err = file.WriteFile(path.Join(dirPath, duplicateName), []byte("latestSignedBlockSlot: 42\n"))
+	require.NoError(t, err, "WriteFile should not return an error")
+
+	// Write an unreadable file for the third public key and an unexpected file.
+	err = file.WriteFile(store.pubkeySlashingProtectionFilePath(pubKeys[2]), []byte("{"))
+	require.NoError(t, err, "WriteFile should not return an error")
+	require.NoError(t, file.WriteFile(path.Join(dirPath, "notes.txt"), []byte("hello")))
+
+	anomalies, err = store.VerifySlashingProtection(ctx)
+	require.NoError(t, err, "VerifySlashingProtection should not return an error")
+	require.Equal(t, 4, len(anomalies), "there should be 4 anomalies")
+
+	sourceAnomaly := findAnomaly(anomalies, "source epoch 10 is greater than target epoch 8")
+	require.NotNil(t, sourceAnomaly, "missing source anomaly")
+	assert.DeepEqual(t, pubKeys[0][:], sourceAnomaly.PubKey)
+	assert.Equal(t, primitives.Epoch(10), *sourceAnomaly.Repair.Target)
+
+	duplicateAnomaly := findAnomaly(anomalies, "duplicate slashing protection files")
+	require.NotNil(t, duplicateAnomaly, "missing duplicate anomaly")
+	assert.DeepEqual(t, pubKeys[1][:], duplicateAnomaly.PubKey)
+	assert.Equal(t, primitives.Slot(42), *duplicateAnomaly.Repair.Slot)
+
+	unmarshalAnomaly := findAnomaly(anomalies, "could not unmarshal")
+	require.NotNil(t, unmarshalAnomaly, "missing unmarshal anomaly")
+	assert.DeepEqual(t, pubKeys[2][:], unmarshalAnomaly.PubKey)
+	assert.Equal(t, (*common.Watermarks)(nil), unmarshalAnomaly.Repair)
+
+	require.NotNil(t, findAnomaly(anomalies, "unexpected file notes.txt"), "missing unexpected file anomaly")
+
+	// Repair the anomalies.
+	require.NoError(t, store.RaiseSlashingProtectionWatermarks(ctx, pubKeys[0], sourceAnomaly.Repair))
+	require.NoError(t, store.RaiseSlashingProtectionWatermarks(ctx, pubKeys[1], duplicateAnomaly.Repair))
+
+	watermarks, err := store.SlashingProtectionWatermarks(ctx, pubKeys[0])
+	require.NoError(t, err, "SlashingProtectionWatermarks should not return an error")
+	assert.Equal(t, primitives.Epoch(10), *watermarks.Source)
+	assert.Equal(t, primitives.Epoch(10), *watermarks.Target)
+
+	watermarks, err = store.SlashingProtectionWatermarks(ctx, pubKeys[1])
+	require.NoError(t, err, "SlashingProtectionWatermarks should not return an error")
+	assert.Equal(t, primitives.Slot(42), *watermarks.Slot)
+	assert.Equal(t, true, watermarks.Covers(duplicateAnomaly.Repair))
+
+	// The files remain, but the source anomaly is gone.
+	require.NoError(t, os.Remove(path.Join(dirPath, "notes.txt")))
+	anomalies, err = store.VerifySlashingProtection(ctx)
+	require.NoError(t, err, "VerifySlashingProtection should not return an error")
+	require.Equal(t, 2, len(anomalies), "there should be 2 anomalies")
+}
+
+func findAnomaly(anomalies []*common.Anomaly, prefix string) *common.Anomaly {
+	for _, anomaly := range anomalies {
+		if strings.HasPrefix(anomaly.Message, prefix) {
+			return anomaly
+		}
+	}
+
+	return nil
+}
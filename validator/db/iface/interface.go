@@ -82,4 +82,9 @@ type ValidatorDB interface {
 
 	// EIP-3076 slashing protection related methods
 	ImportStandardProtectionJSON(ctx context.Context, r io.Reader) error
+
+	// Slashing protection integrity related methods
+	VerifySlashingProtection(ctx context.Context) ([]*common.Anomaly, error)
+	SlashingProtectionWatermarks(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) (*common.Watermarks, error)
+	RaiseSlashingProtectionWatermarks(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, watermarks *common.Watermarks) error
 }
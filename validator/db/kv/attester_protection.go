@@ -107,6 +107,9 @@ func (s *Store) AttestationHistoryForPubKey(ctx context.Context, pubKey [fieldpa
 		}
 		signingRootsBucket := pkBucket.Bucket(attestationSigningRootsBucket)
 		sourceEpochsBucket := pkBucket.Bucket(attestationSourceEpochsBucket)
+		if signingRootsBucket == nil || sourceEpochsBucket == nil {
+			return nil
+		}
 
 		return sourceEpochsBucket.ForEach(func(sourceBytes, targetEpochsList []byte) error {
 			targetEpochs := make([]primitives.Epoch, 0)
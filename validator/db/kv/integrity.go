@@ -0,0 +1,366 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+	bolt "go.etcd.io/bbolt"
+)
+
+// sourceTarget is an attestation recorded in the source or target epochs bucket of a public key.
+type sourceTarget struct {
+	source primitives.Epoch
+	target primitives.Epoch
+}
+
+// VerifySlashingProtection checks that the slashing protection buckets of every public key
+// are well-formed and consistent with each other, and returns the anomalies found.
+func (s *Store) VerifySlashingProtection(ctx context.Context) ([]*common.Anomaly, error) {
+	_, span := trace.StartSpan(ctx, "Validator.VerifySlashingProtection")
+	defer span.End()
+
+	var anomalies []*common.Anomaly
+	err := s.view(func(tx *bolt.Tx) error {
+		pubKeys, malformed := slashingProtectionPublicKeys(tx)
+		anomalies = append(anomalies, malformed...)
+		for _, pubKey := range pubKeys {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			anomalies = append(anomalies, verifyAttestations(tx, pubKey)...)
+			anomalies = append(anomalies, verifyProposals(tx, pubKey)...)
+		}
+		return nil
+	})
+	return anomalies, err
+}
+
+// SlashingProtectionWatermarks returns the lowest signed source and target epochs and the lowest signed proposal slot of a public key.
+func (s *Store) SlashingProtectionWatermarks(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) (*common.Watermarks, error) {
+	_, span := trace.StartSpan(ctx, "Validator.SlashingProtectionWatermarks")
+	defer span.End()
+
+	watermarks := &common.Watermarks{}
+	err := s.view(func(tx *bolt.Tx) error {
+		if source, ok := uint64At(tx.Bucket(lowestSignedSourceBucket), pubKey[:]); ok {
+			epoch := primitives.Epoch(source)
+			watermarks.Source = &epoch
+		}
+		if target, ok := uint64At(tx.Bucket(lowestSignedTargetBucket), pubKey[:]); ok {
+			epoch := primitives.Epoch(target)
+			watermarks.Target = &epoch
+		}
+		if slot, ok := uint64At(tx.Bucket(lowestSignedProposalsBucket), pubKey[:]); ok {
+			lowestSlot := primitives.Slot(slot)
+			watermarks.Slot = &lowestSlot
+		}
+		return nil
+	})
+	return watermarks, err
+}
+
+// RaiseSlashingProtectionWatermarks raises the lowest signed source and target epochs and the lowest and
+// highest signed proposal slots of a public key to the given watermarks. Watermarks are never lowered.
+func (s *Store) RaiseSlashingProtectionWatermarks(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, watermarks *common.Watermarks,
+) error {
+	_, span := trace.StartSpan(ctx, "Validator.RaiseSlashingProtectionWatermarks")
+	defer span.End()
+
+	return s.update(func(tx *bolt.Tx) error {
+		// Create the history buckets of the public key, so that raised watermarks are not reported as orphaned.
+		if watermarks.Source != nil || watermarks.Target != nil {
+			pkBucket, err := tx.Bucket(pubKeysBucket).CreateBucketIfNotExists(pubKey[:])
+			if err != nil {
+				return errors.Wrap(err, "could not create public key bucket")
+			}
+			for _, name := range [][]byte{attestationSigningRootsBucket, attestationSourceEpochsBucket, attestationTargetEpochsBucket} {
+				if _, err := pkBucket.CreateBucketIfNotExists(name); err != nil {
+					return errors.Wrapf(err, "could not create %s", name)
+				}
+			}
+		}
+		if watermarks.Slot != nil {
+			if _, err := tx.Bucket(historicProposalsBucket).CreateBucketIfNotExists(pubKey[:]); err != nil {
+				return errors.Wrap(err, "could not create proposal history bucket")
+			}
+		}
+
+		if watermarks.Source != nil {
+			if err := raiseUint64(tx.Bucket(lowestSignedSourceBucket), pubKey[:], uint64(*watermarks.Source)); err != nil {
+				return errors.Wrap(err, "could not raise lowest signed source epoch")
+			}
+		}
+		if watermarks.Target != nil {
+			if err := raiseUint64(tx.Bucket(lowestSignedTargetBucket), pubKey[:], uint64(*watermarks.Target)); err != nil {
+				return errors.Wrap(err, "could not raise lowest signed target epoch")
+			}
+		}
+		if watermarks.Slot != nil {
+			if err := raiseUint64(tx.Bucket(lowestSignedProposalsBucket), pubKey[:], uint64(*watermarks.Slot)); err != nil {
+				return errors.Wrap(err, "could not raise lowest signed proposal slot")
+			}
+			if err := raiseUint64(tx.Bucket(highestSignedProposalsBucket), pubKey[:], uint64(*watermarks.Slot)); err != nil {
+				return errors.Wrap(err, "could not raise highest signed proposal slot")
+			}
+		}
+		return nil
+	})
+}
+
+// slashingProtectionPublicKeys returns the sorted public keys found in any slashing protection bucket,
+// along with anomalies for the keys which are not valid public keys.
+func slashingProtectionPublicKeys(tx *bolt.Tx) ([][fieldparams.BLSPubkeyLength]byte, []*common.Anomaly) {
+	seen := make(map[[fieldparams.BLSPubkeyLength]byte]bool)
+	var anomalies []*common.Anomaly
+	for _, name := range [][]byte{
+		pubKeysBucket,
+		lowestSignedSourceBucket,
+		lowestSignedTargetBucket,
+		historicProposalsBucket,
+		lowestSignedProposalsBucket,
+		highestSignedProposalsBucket,
+	} {
+		bkt := tx.Bucket(name)
+		if bkt == nil {
+			continue
+		}
+		// The cursor is used instead of ForEach, which can't fail here.
+		c := bkt.Cursor()
+		for k, _ := c.First(); k != nil; k, _ = c.Next() {
+			if len(k) != fieldparams.BLSPubkeyLength {
+				anomalies = append(anomalies, &common.Anomaly{
+					PubKey:  bytesutil.SafeCopyBytes(k),
+					Message: fmt.Sprintf("malformed public key of %d bytes in %s", len(k), name),
+				})
+				continue
+			}
+			seen[bytesutil.ToBytes48(k)] = true
+		}
+	}
+
+	pubKeys := make([][fieldparams.BLSPubkeyLength]byte, 0, len(seen))
+	for pubKey := range seen {
+		pubKeys = append(pubKeys, pubKey)
+	}
+	sort.Slice(pubKeys, func(i, j int) bool { return bytes.Compare(pubKeys[i][:], pubKeys[j][:]) < 0 })
+	return pubKeys, anomalies
+}
+
+// verifyAttestations checks the attestation history of a public key against its lowest signed epochs.
+func verifyAttestations(tx *bolt.Tx, pubKey [fieldparams.BLSPubkeyLength]byte) []*common.Anomaly {
+	var messages []string
+	report := func(format string, args ...interface{}) {
+		messages = append(messages, fmt.Sprintf(format, args...))
+	}
+
+	_, sourceExists := uint64At(tx.Bucket(lowestSignedSourceBucket), pubKey[:])
+	_, targetExists := uint64At(tx.Bucket(lowestSignedTargetBucket), pubKey[:])
+
+	pkBucket := tx.Bucket(pubKeysBucket).Bucket(pubKey[:])
+	if pkBucket == nil {
+		if sourceExists || targetExists {
+			// The history of the public key is lost, raising watermarks can't help without knowing it.
+			return newAnomalies(pubKey, []string{"lowest signed epochs are recorded but the attestation history bucket is missing"}, nil)
+		}
+		return nil
+	}
+
+	signingRoots := pkBucket.Bucket(attestationSigningRootsBucket)
+	if signingRoots == nil {
+		report("missing %s", attestationSigningRootsBucket)
+	}
+	bySource, ok := epochPairs(pkBucket.Bucket(attestationSourceEpochsBucket), false)
+	if !ok {
+		report("missing or corrupted %s", attestationSourceEpochsBucket)
+	}
+	byTarget, ok := epochPairs(pkBucket.Bucket(attestationTargetEpochsBucket), true)
+	if !ok {
+		report("missing or corrupted %s", attestationTargetEpochsBucket)
+	}
+
+	all := make(map[sourceTarget]bool, len(bySource))
+	for _, att := range sortedSourceTargets(bySource) {
+		all[att] = true
+		if !byTarget[att] {
+			report("attestation (source %d, target %d) is missing from %s", att.source, att.target, attestationTargetEpochsBucket)
+		}
+	}
+	for _, att := range sortedSourceTargets(byTarget) {
+		all[att] = true
+		if !bySource[att] {
+			report("attestation (source %d, target %d) is missing from %s", att.source, att.target, attestationSourceEpochsBucket)
+		}
+	}
+
+	sources := make(map[primitives.Epoch]primitives.Epoch, len(all))
+	var maxSource, maxTarget primitives.Epoch
+	for _, att := range sortedSourceTargets(all) {
+		if att.source > att.target {
+			report("attestation has source %d greater than target %d", att.source, att.target)
+		}
+		if source, ok := sources[att.target]; ok {
+			report("conflicting attestations with sources %d and %d are recorded for target %d", source, att.source, att.target)
+		}
+		sources[att.target] = att.source
+		if signingRoots != nil {
+			if root := signingRoots.Get(bytesutil.EpochToBytesBigEndian(att.target)); len(root) != 0 && len(root) != fieldparams.RootLength {
+				report("signing root of %d bytes is recorded for target %d", len(root), att.target)
+			}
+		}
+		maxSource = max(maxSource, att.source)
+		maxTarget = max(maxTarget, att.target)
+	}
+	if len(all) != 0 && !sourceExists {
+		report("attestations are recorded but the lowest signed source epoch is missing")
+	}
+	if len(all) != 0 && !targetExists {
+		report("attestations are recorded but the lowest signed target epoch is missing")
+	}
+
+	var repair *common.Watermarks
+	if len(all) != 0 {
+		repair = &common.Watermarks{Source: &maxSource, Target: &maxTarget}
+	}
+	return newAnomalies(pubKey, messages, repair)
+}
+
+// verifyProposals checks the proposal history of a public key against its lowest and highest signed slots.
+func verifyProposals(tx *bolt.Tx, pubKey [fieldparams.BLSPubkeyLength]byte) []*common.Anomaly {
+	var messages []string
+	report := func(format string, args ...interface{}) {
+		messages = append(messages, fmt.Sprintf(format, args...))
+	}
+
+	lowest, lowestExists := uint64At(tx.Bucket(lowestSignedProposalsBucket), pubKey[:])
+	highest, highestExists := uint64At(tx.Bucket(highestSignedProposalsBucket), pubKey[:])
+
+	valBucket := tx.Bucket(historicProposalsBucket).Bucket(pubKey[:])
+	if valBucket == nil {
+		if lowestExists || highestExists {
+			return newAnomalies(pubKey, []string{"signed proposal slots are recorded but the proposal history bucket is missing"}, nil)
+		}
+		return nil
+	}
+
+	var (
+		maxSlot   primitives.Slot
+		proposals int
+	)
+	c := valBucket.Cursor()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if len(k) != 8 {
+			report("malformed proposal slot of %d bytes", len(k))
+			continue
+		}
+		slot := bytesutil.BytesToSlotBigEndian(k)
+		if len(v) != 0 && len(v) != fieldparams.RootLength {
+			report("signing root of %d bytes is recorded for slot %d", len(v), slot)
+		}
+		maxSlot = max(maxSlot, slot)
+		proposals++
+	}
+	if proposals != 0 && !lowestExists {
+		report("proposals are recorded but the lowest signed proposal slot is missing")
+	}
+	if proposals != 0 && !highestExists {
+		report("proposals are recorded but the highest signed proposal slot is missing")
+	}
+	if highestExists && primitives.Slot(highest) < maxSlot {
+		report("highest signed proposal slot %d is lower than the recorded proposal at slot %d", highest, maxSlot)
+	}
+	if lowestExists && highestExists && lowest > highest {
+		report("lowest signed proposal slot %d is greater than the highest signed proposal slot %d", lowest, highest)
+	}
+
+	var repair *common.Watermarks
+	if proposals != 0 {
+		repair = &common.Watermarks{Slot: &maxSlot}
+	}
+	return newAnomalies(pubKey, messages, repair)
+}
+
+// epochPairs decodes the attestations of a source or target epochs bucket.
+// It returns false if the bucket is missing or one of its entries is malformed.
+func epochPairs(bkt *bolt.Bucket, byTarget bool) (map[sourceTarget]bool, bool) {
+	pairs := make(map[sourceTarget]bool)
+	if bkt == nil {
+		return pairs, false
+	}
+	ok := true
+	c := bkt.Cursor()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if len(k) != 8 || len(v)%8 != 0 {
+			ok = false
+			continue
+		}
+		epoch := bytesutil.BytesToEpochBigEndian(k)
+		for i := 0; i < len(v); i += 8 {
+			other := bytesutil.BytesToEpochBigEndian(v[i : i+8])
+			if byTarget {
+				pairs[sourceTarget{source: other, target: epoch}] = true
+			} else {
+				pairs[sourceTarget{source: epoch, target: other}] = true
+			}
+		}
+	}
+	return pairs, ok
+}
+
+func sortedSourceTargets(atts map[sourceTarget]bool) []sourceTarget {
+	sorted := make([]sourceTarget, 0, len(atts))
+	for att := range atts {
+		sorted = append(sorted, att)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].target != sorted[j].target {
+			return sorted[i].target < sorted[j].target
+		}
+		return sorted[i].source < sorted[j].source
+	})
+	return sorted
+}
+
+func newAnomalies(pubKey [fieldparams.BLSPubkeyLength]byte, messages []string, repair *common.Watermarks) []*common.Anomaly {
+	result := make([]*common.Anomaly, len(messages))
+	for i, message := range messages {
+		result[i] = &common.Anomaly{
+			PubKey:  bytesutil.SafeCopyBytes(pubKey[:]),
+			Message: message,
+			Repair:  repair,
+		}
+	}
+	return result
+}
+
+// uint64At returns the big endian number stored at the key of the bucket, if any.
+func uint64At(bkt *bolt.Bucket, key []byte) (uint64, bool) {
+	if bkt == nil {
+		return 0, false
+	}
+	// 8 because bytesutil.BytesToUint64BigEndian will return 0 if input is less than 8 bytes.
+	enc := bkt.Get(key)
+	if len(enc) < 8 {
+		return 0, false
+	}
+	return bytesutil.BytesToUint64BigEndian(enc), true
+}
+
+// raiseUint64 stores the number at the key of the bucket unless a greater number is already stored.
+func raiseUint64(bkt *bolt.Bucket, key []byte, value uint64) error {
+	if bkt == nil {
+		return errors.New("bucket does not exist")
+	}
+	if current, ok := uint64At(bkt, key); ok && current >= value {
+		return nil
+	}
+	return bkt.Put(key, bytesutil.Uint64ToBytesBigEndian(value))
+}
@@ -0,0 +1,112 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestStore_VerifySlashingProtection(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	lostPubKey := [fieldparams.BLSPubkeyLength]byte{2}
+	db := setupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+
+	require.NoError(t, db.saveAttestationRecords(ctx, []*common.AttestationRecord{
+		{PubKey: pubKey, Source: 1, Target: 2, SigningRoot: make([]byte, 32)},
+		{PubKey: pubKey, Source: 2, Target: 3, SigningRoot: make([]byte, 32)},
+	}))
+	require.NoError(t, db.SaveProposalHistoryForSlot(ctx, pubKey, 10, make([]byte, 32)))
+
+	anomalies, err := db.VerifySlashingProtection(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(anomalies))
+
+	// Lose the lowest signed source epoch and the highest signed proposal of the public key,
+	// record watermarks of a public key without history and store a malformed public key.
+	require.NoError(t, db.update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(lowestSignedSourceBucket).Delete(pubKey[:]); err != nil {
+			return err
+		}
+		if err := tx.Bucket(highestSignedProposalsBucket).Delete(pubKey[:]); err != nil {
+			return err
+		}
+		if err := tx.Bucket(lowestSignedTargetBucket).Put(lostPubKey[:], bytesutil.EpochToBytesBigEndian(5)); err != nil {
+			return err
+		}
+		return tx.Bucket(lowestSignedTargetBucket).Put([]byte{3}, bytesutil.EpochToBytesBigEndian(5))
+	}))
+
+	anomalies, err = db.VerifySlashingProtection(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(anomalies))
+
+	assert.DeepEqual(t, []byte{3}, anomalies[0].PubKey)
+	assert.Equal(t, "malformed public key of 1 bytes in lowest-signed-target-bucket", anomalies[0].Message)
+	assert.Equal(t, (*common.Watermarks)(nil), anomalies[0].Repair)
+
+	assert.DeepEqual(t, pubKey[:], anomalies[1].PubKey)
+	assert.Equal(t, "attestations are recorded but the lowest signed source epoch is missing", anomalies[1].Message)
+	require.NotNil(t, anomalies[1].Repair)
+	assert.Equal(t, primitives.Epoch(2), *anomalies[1].Repair.Source)
+	assert.Equal(t, primitives.Epoch(3), *anomalies[1].Repair.Target)
+
+	assert.DeepEqual(t, pubKey[:], anomalies[2].PubKey)
+	assert.Equal(t, "proposals are recorded but the highest signed proposal slot is missing", anomalies[2].Message)
+	require.NotNil(t, anomalies[2].Repair)
+	assert.Equal(t, primitives.Slot(10), *anomalies[2].Repair.Slot)
+
+	assert.DeepEqual(t, lostPubKey[:], anomalies[3].PubKey)
+	assert.Equal(t, "lowest signed epochs are recorded but the attestation history bucket is missing", anomalies[3].Message)
+	assert.Equal(t, (*common.Watermarks)(nil), anomalies[3].Repair)
+
+	// Raising the watermarks repairs the anomalies of the public key.
+	require.NoError(t, db.RaiseSlashingProtectionWatermarks(ctx, pubKey, anomalies[1].Repair.Max(anomalies[2].Repair)))
+
+	anomalies, err = db.VerifySlashingProtection(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(anomalies))
+	assert.DeepEqual(t, []byte{3}, anomalies[0].PubKey)
+	assert.DeepEqual(t, lostPubKey[:], anomalies[1].PubKey)
+}
+
+func TestStore_RaiseSlashingProtectionWatermarks(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	db := setupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+
+	require.NoError(t, db.saveAttestationRecords(ctx, []*common.AttestationRecord{
+		{PubKey: pubKey, Source: 4, Target: 5, SigningRoot: make([]byte, 32)},
+	}))
+	require.NoError(t, db.SaveProposalHistoryForSlot(ctx, pubKey, 10, make([]byte, 32)))
+
+	source, target, slot := primitives.Epoch(2), primitives.Epoch(8), primitives.Slot(12)
+	require.NoError(t, db.RaiseSlashingProtectionWatermarks(ctx, pubKey, &common.Watermarks{
+		Source: &source,
+		Target: &target,
+		Slot:   &slot,
+	}))
+
+	// Watermarks are raised, but never lowered.
+	watermarks, err := db.SlashingProtectionWatermarks(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Epoch(4), *watermarks.Source)
+	assert.Equal(t, primitives.Epoch(8), *watermarks.Target)
+	assert.Equal(t, primitives.Slot(12), *watermarks.Slot)
+
+	highest, exists, err := db.HighestSignedProposal(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	assert.Equal(t, primitives.Slot(12), highest)
+
+	// The raised watermarks are enforced.
+	err = db.SlashableAttestationCheck(ctx, createAttestation(4, 7), pubKey, [32]byte{1}, false, nil)
+	assert.ErrorContains(t, "could not sign attestation lower than or equal to lowest target epoch", err)
+}
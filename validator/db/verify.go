@@ -0,0 +1,388 @@
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/filesystem"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/iface"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/validator/helpers"
+	"github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history/format"
+	"github.com/sirupsen/logrus"
+)
+
+// VerifyDatabase verifies the slashing protection of the validator databases found in the data directory and,
+// if an EIP-3076 interchange file is given, cross-checks them against it. Anomalies are logged per public key.
+// When repair is true, the watermarks of the public keys with repairable anomalies are raised so that the
+// validator refuses to sign anything the anomalies could let it sign twice.
+// Without repair, an error is returned if any anomaly is found.
+func VerifyDatabase(ctx context.Context, dataDir, interchangeFilePath string, repair bool) error {
+	validatorDBs, err := openExistingDatabases(ctx, dataDir)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		for _, validatorDB := range validatorDBs {
+			if err := validatorDB.Close(); err != nil {
+				log.WithError(err).Error("Failed to close database")
+			}
+		}
+	}()
+
+	var unrepaired int
+	for _, validatorDB := range validatorDBs {
+		var interchange io.Reader
+		if interchangeFilePath != "" {
+			enc, err := file.ReadFileAsBytes(interchangeFilePath)
+			if err != nil {
+				return errors.Wrap(err, "could not read slashing protection JSON file")
+			}
+			interchange = bytes.NewReader(enc)
+		}
+
+		anomalies, err := VerifySlashingProtection(ctx, validatorDB, interchange)
+		if err != nil {
+			return errors.Wrapf(err, "could not verify database at %s", validatorDB.DatabasePath())
+		}
+
+		logAnomalies(validatorDB, anomalies)
+
+		if !repair {
+			unrepaired += len(anomalies)
+			continue
+		}
+
+		repaired, err := RepairSlashingProtection(ctx, validatorDB, anomalies)
+		if err != nil {
+			return errors.Wrapf(err, "could not repair database at %s", validatorDB.DatabasePath())
+		}
+
+		for _, anomaly := range anomalies {
+			if anomaly.Repair == nil {
+				unrepaired++
+			}
+		}
+
+		log.WithFields(logrus.Fields{
+			"database":   validatorDB.DatabasePath(),
+			"publicKeys": repaired,
+		}).Info("Raised slashing protection watermarks")
+	}
+
+	switch {
+	case unrepaired == 0:
+		return nil
+	case repair:
+		log.WithField("anomalies", unrepaired).Warn("Some slashing protection anomalies can't be repaired by raising watermarks")
+		return nil
+	default:
+		return errors.Errorf("found %d slashing protection anomalies", unrepaired)
+	}
+}
+
+// VerifySlashingProtection returns the anomalies found in the slashing protection of the database and, if an
+// EIP-3076 interchange file is given, the signings of the file the database does not protect against.
+// The repair of an anomaly is only kept if the watermarks of the database are not already high enough.
+func VerifySlashingProtection(ctx context.Context, validatorDB iface.ValidatorDB, interchange io.Reader) ([]*common.Anomaly, error) {
+	anomalies, err := validatorDB.VerifySlashingProtection(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not verify slashing protection")
+	}
+
+	if interchange != nil {
+		crossChecked, err := crossCheckInterchange(ctx, validatorDB, interchange)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not cross-check slashing protection JSON file")
+		}
+
+		anomalies = append(anomalies, crossChecked...)
+	}
+
+	for _, anomaly := range anomalies {
+		if anomaly.Repair == nil {
+			continue
+		}
+
+		if len(anomaly.PubKey) != fieldparams.BLSPubkeyLength {
+			anomaly.Repair = nil
+			continue
+		}
+
+		watermarks, err := validatorDB.SlashingProtectionWatermarks(ctx, bytesutil.ToBytes48(anomaly.PubKey))
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get slashing protection watermarks of %#x", anomaly.PubKey)
+		}
+
+		if watermarks.Covers(anomaly.Repair) {
+			anomaly.Repair = nil
+		}
+	}
+
+	return anomalies, nil
+}
+
+// RepairSlashingProtection raises the watermarks of the public keys with repairable anomalies,
+// and returns the number of public keys whose watermarks were raised.
+func RepairSlashingProtection(ctx context.Context, validatorDB iface.ValidatorDB, anomalies []*common.Anomaly) (int, error) {
+	repairs := make(map[[fieldparams.BLSPubkeyLength]byte]*common.Watermarks)
+	var pubKeys [][fieldparams.BLSPubkeyLength]byte
+	for _, anomaly := range anomalies {
+		if anomaly.Repair == nil || len(anomaly.PubKey) != fieldparams.BLSPubkeyLength {
+			continue
+		}
+
+		pubKey := bytesutil.ToBytes48(anomaly.PubKey)
+		repair, ok := repairs[pubKey]
+		if !ok {
+			pubKeys = append(pubKeys, pubKey)
+			repair = &common.Watermarks{}
+		}
+
+		repairs[pubKey] = repair.Max(anomaly.Repair)
+	}
+
+	for _, pubKey := range pubKeys {
+		repair := repairs[pubKey]
+		if err := validatorDB.RaiseSlashingProtectionWatermarks(ctx, pubKey, repair); err != nil {
+			return 0, errors.Wrapf(err, "could not raise slashing protection watermarks of %#x", pubKey)
+		}
+
+		fields := logrus.Fields{"publicKey": fmt.Sprintf("%#x", pubKey)}
+		if repair.Source != nil {
+			fields["sourceEpoch"] = *repair.Source
+		}
+		if repair.Target != nil {
+			fields["targetEpoch"] = *repair.Target
+		}
+		if repair.Slot != nil {
+			fields["slot"] = *repair.Slot
+		}
+		log.WithFields(fields).Info("Raised slashing protection watermarks of public key")
+	}
+
+	return len(pubKeys), nil
+}
+
+// crossCheckInterchange returns, for each public key of the EIP-3076 interchange file, an anomaly if some
+// of its signed attestations or blocks are neither recorded in the database nor below its watermarks.
+func crossCheckInterchange(ctx context.Context, validatorDB iface.ValidatorDB, r io.Reader) ([]*common.Anomaly, error) {
+	interchangeJSON := &format.EIPSlashingProtectionFormat{}
+	if err := json.NewDecoder(r).Decode(interchangeJSON); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal slashing protection JSON file")
+	}
+
+	// Unlike importing, verifying must not write the genesis validators root of the file into the database.
+	if interchangeJSON.Metadata.GenesisValidatorsRoot != "" {
+		gvr, err := helpers.RootFromHex(interchangeJSON.Metadata.GenesisValidatorsRoot)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a valid root: %w", interchangeJSON.Metadata.GenesisValidatorsRoot, err)
+		}
+
+		dbGvr, err := validatorDB.GenesisValidatorsRoot(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not retrieve genesis validators root from db")
+		}
+
+		if len(dbGvr) != 0 && !bytes.Equal(dbGvr, gvr[:]) {
+			return nil, fmt.Errorf(
+				"genesis validators root %#x of the slashing protection JSON file does not match the one of the database %#x",
+				gvr, dbGvr,
+			)
+		}
+	}
+
+	// A public key may appear several times in the file.
+	var pubKeys [][fieldparams.BLSPubkeyLength]byte
+	dataByPubKey := make(map[[fieldparams.BLSPubkeyLength]byte][]*format.ProtectionData)
+	for _, data := range interchangeJSON.Data {
+		pubKey, err := helpers.PubKeyFromHex(data.Pubkey)
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s is not a valid public key", data.Pubkey)
+		}
+
+		if _, ok := dataByPubKey[pubKey]; !ok {
+			pubKeys = append(pubKeys, pubKey)
+		}
+
+		dataByPubKey[pubKey] = append(dataByPubKey[pubKey], data)
+	}
+
+	var anomalies []*common.Anomaly
+	for _, pubKey := range pubKeys {
+		pubKeyAnomalies, err := crossCheckPubKey(ctx, validatorDB, pubKey, dataByPubKey[pubKey])
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not cross-check public key %#x", pubKey)
+		}
+
+		anomalies = append(anomalies, pubKeyAnomalies...)
+	}
+
+	return anomalies, nil
+}
+
+// crossCheckPubKey cross-checks the slashing protection of a public key against its data of the interchange file.
+func crossCheckPubKey(
+	ctx context.Context,
+	validatorDB iface.ValidatorDB,
+	pubKey [fieldparams.BLSPubkeyLength]byte,
+	data []*format.ProtectionData,
+) ([]*common.Anomaly, error) {
+	watermarks, err := validatorDB.SlashingProtectionWatermarks(ctx, pubKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get slashing protection watermarks")
+	}
+
+	attestations, err := validatorDB.AttestationHistoryForPubKey(ctx, pubKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get attestation history")
+	}
+
+	recordedAttestations := make(map[[2]primitives.Epoch]bool, len(attestations))
+	for _, att := range attestations {
+		recordedAttestations[[2]primitives.Epoch{att.Source, att.Target}] = true
+	}
+
+	proposals, err := validatorDB.ProposalHistoryForPubKey(ctx, pubKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get proposal history")
+	}
+
+	recordedProposals := make(map[primitives.Slot]bool, len(proposals))
+	for _, proposal := range proposals {
+		recordedProposals[proposal.Slot] = true
+	}
+
+	var (
+		unprotectedAttestations, unprotectedBlocks int
+		maxSource, maxTarget                       primitives.Epoch
+		maxSlot                                    primitives.Slot
+	)
+
+	for _, d := range data {
+		for _, att := range d.SignedAttestations {
+			source, err := helpers.EpochFromString(att.SourceEpoch)
+			if err != nil {
+				return nil, errors.Wrapf(err, "%s is not a valid epoch", att.SourceEpoch)
+			}
+
+			target, err := helpers.EpochFromString(att.TargetEpoch)
+			if err != nil {
+				return nil, errors.Wrapf(err, "%s is not a valid epoch", att.TargetEpoch)
+			}
+
+			maxSource, maxTarget = max(maxSource, source), max(maxTarget, target)
+			if !recordedAttestations[[2]primitives.Epoch{source, target}] &&
+				!watermarks.Covers(&common.Watermarks{Source: &source, Target: &target}) {
+				unprotectedAttestations++
+			}
+		}
+
+		for _, blk := range d.SignedBlocks {
+			slot, err := helpers.SlotFromString(blk.Slot)
+			if err != nil {
+				return nil, errors.Wrapf(err, "%s is not a valid slot", blk.Slot)
+			}
+
+			maxSlot = max(maxSlot, slot)
+			if !recordedProposals[slot] && !watermarks.Covers(&common.Watermarks{Slot: &slot}) {
+				unprotectedBlocks++
+			}
+		}
+	}
+
+	var anomalies []*common.Anomaly
+	if unprotectedAttestations != 0 {
+		anomalies = append(anomalies, &common.Anomaly{
+			PubKey: pubKey[:],
+			Message: fmt.Sprintf(
+				"%d signed attestations of the slashing protection JSON file are not protected against, up to target epoch %d",
+				unprotectedAttestations, maxTarget,
+			),
+			Repair: &common.Watermarks{Source: &maxSource, Target: &maxTarget},
+		})
+	}
+
+	if unprotectedBlocks != 0 {
+		anomalies = append(anomalies, &common.Anomaly{
+			PubKey: pubKey[:],
+			Message: fmt.Sprintf(
+				"%d signed blocks of the slashing protection JSON file are not protected against, up to slot %d",
+				unprotectedBlocks, maxSlot,
+			),
+			Repair: &common.Watermarks{Slot: &maxSlot},
+		})
+	}
+
+	return anomalies, nil
+}
+
+// openExistingDatabases opens the complete and the minimal validator databases of the data directory, if they exist.
+func openExistingDatabases(ctx context.Context, dataDir string) ([]iface.ValidatorDB, error) {
+	var validatorDBs []iface.ValidatorDB
+
+	kvPath := filepath.Join(dataDir, kv.ProtectionDbFileName)
+	kvExists, err := file.Exists(kvPath, file.Regular)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not check if %s exists", kvPath)
+	}
+
+	if kvExists {
+		log.WithField("path", kvPath).Info("Opening complete slashing protection database")
+		validatorDB, err := kv.NewKVStore(ctx, dataDir, nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not open complete slashing protection database")
+		}
+
+		validatorDBs = append(validatorDBs, validatorDB)
+	}
+
+	filesystemPath := filepath.Join(dataDir, filesystem.DatabaseDirName)
+	filesystemExists, err := file.Exists(filesystemPath, file.Directory)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not check if %s exists", filesystemPath)
+	}
+
+	if filesystemExists {
+		log.WithField("path", filesystemPath).Info("Opening minimal slashing protection database")
+		validatorDB, err := filesystem.NewStore(dataDir, nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not open minimal slashing protection database")
+		}
+
+		validatorDBs = append(validatorDBs, validatorDB)
+	}
+
+	if len(validatorDBs) == 0 {
+		return nil, fmt.Errorf("no validator database found in %s", dataDir)
+	}
+
+	return validatorDBs, nil
+}
+
+// logAnomalies logs the anomalies found in a database.
+func logAnomalies(validatorDB iface.ValidatorDB, anomalies []*common.Anomaly) {
+	if len(anomalies) == 0 {
+		log.WithField("database", validatorDB.DatabasePath()).Info("No slashing protection anomaly found")
+		return
+	}
+
+	for _, anomaly := range anomalies {
+		log.WithFields(logrus.Fields{
+			"database":   validatorDB.DatabasePath(),
+			"publicKey":  fmt.Sprintf("%#x", anomaly.PubKey),
+			"repairable": anomaly.Repair != nil,
+		}).Warn(anomaly.Message)
+	}
+}
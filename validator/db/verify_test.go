@@ -0,0 +1,139 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/filesystem"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/iface"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history/format"
+)
+
+func TestVerifyDatabase_NoDatabase(t *testing.T) {
+	err := VerifyDatabase(context.Background(), t.TempDir(), "", false)
+	require.ErrorContains(t, "no validator database found", err)
+}
+
+func TestVerifySlashingProtection_Interchange(t *testing.T) {
+	ctx := context.Background()
+	pubKey := getPubkeyFromString(t, "0x80000060606fa05c7339dd7bcd0d3e4d8b573fa30dea2fdb4997031a703e3300326e3c054be682f92d9c367cd647bbea")
+	genesisValidatorsRoot := [fieldparams.RootLength]byte{1}
+
+	for _, minimal := range []bool{false, true} {
+		t.Run(fmt.Sprintf("minimal=%v", minimal), func(t *testing.T) {
+			var (
+				validatorDB iface.ValidatorDB
+				err         error
+			)
+
+			if minimal {
+				validatorDB, err = filesystem.NewStore(t.TempDir(), nil)
+			} else {
+				validatorDB, err = kv.NewKVStore(ctx, t.TempDir(), nil)
+			}
+			require.NoError(t, err)
+			t.Cleanup(func() {
+				require.NoError(t, validatorDB.Close())
+			})
+
+			require.NoError(t, validatorDB.SaveGenesisValidatorsRoot(ctx, genesisValidatorsRoot[:]))
+			require.NoError(t, validatorDB.SaveAttestationForPubKey(ctx, pubKey, [fieldparams.RootLength]byte{}, &ethpb.IndexedAttestation{
+				Data: &ethpb.AttestationData{
+					Source: &ethpb.Checkpoint{Epoch: 1},
+					Target: &ethpb.Checkpoint{Epoch: 2},
+				},
+			}))
+
+			// The interchange file knows about more recent signings than the database.
+			interchange := interchangeJSON(t, genesisValidatorsRoot, pubKey, [][2]uint64{{1, 2}, {3, 4}}, []uint64{5})
+
+			anomalies, err := VerifySlashingProtection(ctx, validatorDB, strings.NewReader(interchange))
+			require.NoError(t, err)
+			require.Equal(t, 2, len(anomalies))
+			assert.Equal(t, "1 signed attestations of the slashing protection JSON file are not protected against, up to target epoch 4", anomalies[0].Message)
+			assert.Equal(t, primitives.Epoch(3), *anomalies[0].Repair.Source)
+			assert.Equal(t, primitives.Epoch(4), *anomalies[0].Repair.Target)
+			assert.Equal(t, "1 signed blocks of the slashing protection JSON file are not protected against, up to slot 5", anomalies[1].Message)
+			assert.Equal(t, primitives.Slot(5), *anomalies[1].Repair.Slot)
+
+			repaired, err := RepairSlashingProtection(ctx, validatorDB, anomalies)
+			require.NoError(t, err)
+			assert.Equal(t, 1, repaired)
+
+			// The database now protects against everything the interchange file signed.
+			anomalies, err = VerifySlashingProtection(ctx, validatorDB, strings.NewReader(interchange))
+			require.NoError(t, err)
+			require.Equal(t, 0, len(anomalies))
+
+			// An interchange file of another chain can't be cross-checked.
+			otherChain := interchangeJSON(t, [fieldparams.RootLength]byte{2}, pubKey, nil, nil)
+			_, err = VerifySlashingProtection(ctx, validatorDB, strings.NewReader(otherChain))
+			require.ErrorContains(t, "does not match the one of the database", err)
+		})
+	}
+}
+
+func TestVerifyDatabase(t *testing.T) {
+	ctx := context.Background()
+	pubKey := getPubkeyFromString(t, "0x80000060606fa05c7339dd7bcd0d3e4d8b573fa30dea2fdb4997031a703e3300326e3c054be682f92d9c367cd647bbea")
+	dataDir := t.TempDir()
+
+	// Create a minimal database with no signing for the public key.
+	validatorDB, err := filesystem.NewStore(dataDir, &filesystem.Config{PubKeys: [][fieldparams.BLSPubkeyLength]byte{pubKey}})
+	require.NoError(t, err)
+	require.NoError(t, validatorDB.Close())
+
+	interchangeFilePath := filepath.Join(t.TempDir(), "interchange.json")
+	interchange := interchangeJSON(t, [fieldparams.RootLength]byte{1}, pubKey, [][2]uint64{{3, 4}}, nil)
+	require.NoError(t, file.WriteFile(interchangeFilePath, []byte(interchange)))
+
+	// The database is consistent on its own.
+	require.NoError(t, VerifyDatabase(ctx, dataDir, "", false))
+
+	// But does not protect against the signings of the interchange file.
+	err = VerifyDatabase(ctx, dataDir, interchangeFilePath, false)
+	require.ErrorContains(t, "found 1 slashing protection anomalies", err)
+
+	require.NoError(t, VerifyDatabase(ctx, dataDir, interchangeFilePath, true))
+	require.NoError(t, VerifyDatabase(ctx, dataDir, interchangeFilePath, false))
+}
+
+func interchangeJSON(
+	t *testing.T,
+	genesisValidatorsRoot [fieldparams.RootLength]byte,
+	pubKey [fieldparams.BLSPubkeyLength]byte,
+	attestations [][2]uint64,
+	slots []uint64,
+) string {
+	data := &format.ProtectionData{Pubkey: hexutil.Encode(pubKey[:])}
+	for _, att := range attestations {
+		data.SignedAttestations = append(data.SignedAttestations, &format.SignedAttestation{
+			SourceEpoch: fmt.Sprint(att[0]),
+			TargetEpoch: fmt.Sprint(att[1]),
+		})
+	}
+
+	for _, slot := range slots {
+		data.SignedBlocks = append(data.SignedBlocks, &format.SignedBlock{Slot: fmt.Sprint(slot)})
+	}
+
+	interchange := &format.EIPSlashingProtectionFormat{Data: []*format.ProtectionData{data}}
+	interchange.Metadata.InterchangeFormatVersion = format.InterchangeFormatVersion
+	interchange.Metadata.GenesisValidatorsRoot = hexutil.Encode(genesisValidatorsRoot[:])
+
+	enc, err := json.Marshal(interchange)
+	require.NoError(t, err)
+	return string(enc)
+}
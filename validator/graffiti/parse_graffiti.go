@@ -5,7 +5,9 @@ import (
 	"os"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/crypto/hash"
 	"gopkg.in/yaml.v2"
@@ -16,13 +18,15 @@ const (
 	hex0xPrefix       = "0x"
 )
 
-// Graffiti is a graffiti container.
+// Graffiti is a graffiti container. Every graffiti may be a template, see RenderTemplate.
 type Graffiti struct {
 	Hash     [32]byte
 	Default  string                               `yaml:"default,omitempty"`
 	Ordered  []string                             `yaml:"ordered,omitempty"`
 	Random   []string                             `yaml:"random,omitempty"`
 	Specific map[primitives.ValidatorIndex]string `yaml:"specific,omitempty"`
+	// Labels are the values of the {label} placeholder, by hex encoded validator public key.
+	Labels map[string]string `yaml:"labels,omitempty"`
 }
 
 // ParseGraffitiFile parses the graffiti file and returns the graffiti struct.
@@ -53,12 +57,36 @@ func ParseGraffitiFile(f string) (*Graffiti, error) {
 		g.Random[i] = ParseHexGraffiti(v)
 	}
 
+	if len(g.Labels) != 0 {
+		labels := make(map[string]string, len(g.Labels))
+		for k, l := range g.Labels {
+			labels[normalizePubkey(k)] = l
+		}
+		g.Labels = labels
+	}
+
 	g.Default = ParseHexGraffiti(g.Default)
 	g.Hash = hash.Hash(yamlFile)
 
 	return g, nil
 }
 
+// Label returns the label of the validator public key, or an empty string if none is set.
+func (g *Graffiti) Label(pubKey [fieldparams.BLSPubkeyLength]byte) string {
+	if g == nil {
+		return ""
+	}
+	return g.Labels[hexutil.Encode(pubKey[:])]
+}
+
+func normalizePubkey(k string) string {
+	k = strings.ToLower(k)
+	if !strings.HasPrefix(k, hex0xPrefix) {
+		k = hex0xPrefix + k
+	}
+	return k
+}
+
 // ParseHexGraffiti checks if a graffiti input is being represented in hex and converts it to ASCII if so
 func ParseHexGraffiti(rawGraffiti string) string {
 	splitGraffiti := strings.SplitN(rawGraffiti, ":", 2)
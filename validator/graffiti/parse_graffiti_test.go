@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/crypto/hash"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
@@ -109,6 +110,30 @@ specific:
 	require.DeepEqual(t, wanted, got)
 }
 
+func TestParseGraffitiFile_Labels(t *testing.T) {
+	pubKey1 := [fieldparams.BLSPubkeyLength]byte{0xab}
+	pubKey2 := [fieldparams.BLSPubkeyLength]byte{0xcd}
+	input := []byte(`
+default: "{label} {client_version}"
+labels:
+  "0xAB0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000": home
+  "cd0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000": office`)
+
+	dirName := t.TempDir() + "somedir"
+	err := os.MkdirAll(dirName, os.ModePerm)
+	require.NoError(t, err)
+	someFileName := filepath.Join(dirName, "somefile.txt")
+	require.NoError(t, os.WriteFile(someFileName, input, os.ModePerm))
+
+	got, err := ParseGraffitiFile(someFileName)
+	require.NoError(t, err)
+
+	assert.Equal(t, "{label} {client_version}", got.Default)
+	assert.Equal(t, "home", got.Label(pubKey1))
+	assert.Equal(t, "office", got.Label(pubKey2))
+	assert.Equal(t, "", got.Label([fieldparams.BLSPubkeyLength]byte{}))
+}
+
 func TestParseGraffitiFile_AllFields(t *testing.T) {
 	input := []byte(`default: "Mr T was here"
 
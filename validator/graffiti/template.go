@@ -0,0 +1,160 @@
+package graffiti
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+)
+
+// MaxLength is the maximum length of the graffiti of a block, in bytes.
+const MaxLength = fieldparams.RootLength
+
+// Placeholders of the graffiti templates.
+const (
+	// ClientVersionPlaceholder is replaced by the client version graffiti recommended by the engine API,
+	// e.g. GEabcdPMe4f6, shortened to the space left by the rest of the graffiti.
+	ClientVersionPlaceholder = "{client_version}"
+	// LabelPlaceholder is replaced by the label of the validating key, truncated to the space left by
+	// the rest of the graffiti.
+	LabelPlaceholder          = "{label}"
+	ValidatorIndexPlaceholder = "{index}"
+	SlotPlaceholder           = "{slot}"
+	CLCodePlaceholder         = "{cl_code}"
+	CLVersionPlaceholder      = "{cl_version}"
+	CLCommitPlaceholder       = "{cl_commit}"
+	ELCodePlaceholder         = "{el_code}"
+	ELVersionPlaceholder      = "{el_version}"
+	ELCommitPlaceholder       = "{el_commit}"
+)
+
+// templateCommitLength is the number of hex characters of a commit hash rendered by the commit placeholders.
+const templateCommitLength = 4
+
+// TemplateFields are the values the placeholders of a graffiti template are replaced with.
+type TemplateFields struct {
+	// BeaconNode is the version of the beacon node, the version of the validator client is used when nil.
+	BeaconNode *version.ClientVersion
+	// ExecutionClient is the version of the execution client of the beacon node, if known.
+	ExecutionClient *version.ClientVersion
+	ValidatorIndex  primitives.ValidatorIndex
+	Slot            primitives.Slot
+	Label           string
+}
+
+// IsTemplate returns true if the graffiti contains any placeholder.
+func IsTemplate(graffiti string) bool {
+	for _, p := range []string{
+		ClientVersionPlaceholder, LabelPlaceholder, ValidatorIndexPlaceholder, SlotPlaceholder,
+		CLCodePlaceholder, CLVersionPlaceholder, CLCommitPlaceholder,
+		ELCodePlaceholder, ELVersionPlaceholder, ELCommitPlaceholder,
+	} {
+		if strings.Contains(graffiti, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// RenderTemplate replaces the placeholders of a graffiti template and returns a graffiti of at most MaxLength bytes.
+// Unknown placeholders are kept as they are. When the rendered graffiti is too long, space is given in priority order to:
+//  1. the text of the template and the placeholders of fixed length: index, slot, client codes, versions and commits,
+//     the graffiti being truncated if they don't fit,
+//  2. the label, truncated to the space left,
+//  3. the client version graffiti, in the longest form fitting into the space left, or removed.
+func RenderTemplate(template string, f *TemplateFields) string {
+	cl := f.BeaconNode
+	if cl == nil {
+		cl = version.Client()
+	}
+	el := f.ExecutionClient
+
+	fixed := map[string]string{
+		ValidatorIndexPlaceholder: strconv.FormatUint(uint64(f.ValidatorIndex), 10),
+		SlotPlaceholder:           strconv.FormatUint(uint64(f.Slot), 10),
+		CLCodePlaceholder:         cl.Code,
+		CLVersionPlaceholder:      cl.Version,
+		CLCommitPlaceholder:       cl.ShortCommit(templateCommitLength),
+	}
+	if el != nil {
+		fixed[ELCodePlaceholder] = el.Code
+		fixed[ELVersionPlaceholder] = el.Version
+		fixed[ELCommitPlaceholder] = el.ShortCommit(templateCommitLength)
+	} else {
+		fixed[ELCodePlaceholder] = ""
+		fixed[ELVersionPlaceholder] = ""
+		fixed[ELCommitPlaceholder] = ""
+	}
+
+	segments := splitTemplate(template)
+	used := 0
+	for i, s := range segments {
+		if v, ok := fixed[s]; ok {
+			segments[i] = v
+		}
+		if segments[i] != LabelPlaceholder && segments[i] != ClientVersionPlaceholder {
+			used += len(segments[i])
+		}
+	}
+	for i, s := range segments {
+		if s == LabelPlaceholder {
+			segments[i] = truncate(f.Label, remaining(used))
+			used += len(segments[i])
+		}
+	}
+	for i, s := range segments {
+		if s == ClientVersionPlaceholder {
+			segments[i] = version.ClientGraffiti(el, cl, remaining(used))
+			used += len(segments[i])
+		}
+	}
+	return truncate(strings.Join(segments, ""), MaxLength)
+}
+
+// splitTemplate splits a template into its text and its placeholders.
+func splitTemplate(template string) []string {
+	var segments []string
+	for len(template) > 0 {
+		start := strings.IndexByte(template, '{')
+		if start < 0 {
+			segments = append(segments, template)
+			break
+		}
+		end := strings.IndexByte(template[start:], '}')
+		if end < 0 {
+			segments = append(segments, template)
+			break
+		}
+		end += start + 1
+		// The placeholder starts at the last opening brace, e.g. in "{{slot}".
+		start = strings.LastIndexByte(template[:end], '{')
+		if start > 0 {
+			segments = append(segments, template[:start])
+		}
+		segments = append(segments, template[start:end])
+		template = template[end:]
+	}
+	return segments
+}
+
+// remaining returns the number of bytes left in the graffiti once used bytes are taken.
+func remaining(used int) int {
+	if used >= MaxLength {
+		return 0
+	}
+	return MaxLength - used
+}
+
+// truncate returns the longest prefix of s of at most n bytes which does not split a UTF-8 character.
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
@@ -0,0 +1,102 @@
+package graffiti
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+)
+
+func TestIsTemplate(t *testing.T) {
+	assert.Equal(t, false, IsTemplate("Mr T was here"))
+	assert.Equal(t, false, IsTemplate("{unknown}"))
+	assert.Equal(t, true, IsTemplate("Mr T {client_version}"))
+	assert.Equal(t, true, IsTemplate("{slot}"))
+}
+
+func TestRenderTemplate(t *testing.T) {
+	el := &version.ClientVersion{Code: "GE", Name: "Geth", Version: "v1.14.11", Commit: "0xf8d0a1b2"}
+	cl := &version.ClientVersion{Code: "PM", Name: "Prysm", Version: "v5.2.0", Commit: "0xe4f61234"}
+	fields := &TemplateFields{
+		BeaconNode:      cl,
+		ExecutionClient: el,
+		ValidatorIndex:  1234,
+		Slot:            5678,
+		Label:           "my-staking-node",
+	}
+
+	tests := []struct {
+		name     string
+		template string
+		fields   *TemplateFields
+		want     string
+	}{
+		{
+			name:     "static",
+			template: "Mr T was here",
+			fields:   fields,
+			want:     "Mr T was here",
+		},
+		{
+			name:     "client version",
+			template: "{client_version}",
+			fields:   fields,
+			want:     "GEf8d0PMe4f6",
+		},
+		{
+			name:     "all fields",
+			template: "{el_code}{el_commit} {cl_code}{cl_version} #{index}@{slot}",
+			fields:   fields,
+			want:     "GEf8d0 PMv5.2.0 #1234@5678",
+		},
+		{
+			name:     "unknown placeholders are kept",
+			template: "{foo} {{slot}",
+			fields:   fields,
+			want:     "{foo} {5678",
+		},
+		{
+			name:     "client version shortened",
+			template: "Mr T was here, {label} {client_version}",
+			fields:   &TemplateFields{BeaconNode: cl, ExecutionClient: el, Label: "nodes"},
+			want:     "Mr T was here, nodes GEf8PMe4",
+		},
+		{
+			name:     "label kept before client version",
+			template: "{index} {label} {client_version}",
+			fields:   fields,
+			want:     "1234 my-staking-node GEf8PMe4",
+		},
+		{
+			name:     "label truncated",
+			template: "Validator {index} of {label}{client_version}",
+			fields:   fields,
+			want:     "Validator 1234 of my-staking-nod",
+		},
+		{
+			name:     "text truncated",
+			template: "This text is longer than 32 bytes {slot}",
+			fields:   fields,
+			want:     "This text is longer than 32 byte",
+		},
+		{
+			name:     "multibyte characters are not split",
+			template: "ééééééééééééééé {label}",
+			fields:   &TemplateFields{BeaconNode: cl, Label: "ö"},
+			want:     "ééééééééééééééé ",
+		},
+		{
+			name:     "unknown execution client",
+			template: "{client_version}-{el_code}",
+			fields:   &TemplateFields{BeaconNode: cl},
+			want:     "PMe4f6-",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RenderTemplate(tt.template, tt.fields)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, true, len(got) <= MaxLength)
+		})
+	}
+}
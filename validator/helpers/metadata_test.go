@@ -162,6 +162,17 @@ func (db *ValidatorDBMock) ImportStandardProtectionJSON(ctx context.Context, r i
 	panic("not implemented")
 }
 
+// Slashing protection integrity related methods
+func (db *ValidatorDBMock) VerifySlashingProtection(ctx context.Context) ([]*common.Anomaly, error) {
+	panic("not implemented")
+}
+func (db *ValidatorDBMock) SlashingProtectionWatermarks(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) (*common.Watermarks, error) {
+	panic("not implemented")
+}
+func (db *ValidatorDBMock) RaiseSlashingProtectionWatermarks(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, watermarks *common.Watermarks) error {
+	panic("not implemented")
+}
+
 func Test_validateMetadata(t *testing.T) {
 	goodRoot := [32]byte{1}
 	goodStr := make([]byte, hex.EncodedLen(len(goodRoot)))
@@ -60,23 +60,45 @@ func NewKeymanager(
 func (km *Keymanager) RecoverAccountsFromMnemonic(
 	ctx context.Context, mnemonic, mnemonicLanguage, mnemonicPassphrase string, numAccounts int,
 ) error {
-	seed, err := seedFromMnemonic(mnemonic, mnemonicLanguage, mnemonicPassphrase)
+	secretKeys, err := SecretKeysFromMnemonic(mnemonic, mnemonicLanguage, mnemonicPassphrase, 0, numAccounts)
 	if err != nil {
-		return errors.Wrap(err, "could not initialize new wallet seed file")
+		return err
 	}
 	privKeys := make([][]byte, numAccounts)
 	pubKeys := make([][]byte, numAccounts)
-	for i := 0; i < numAccounts; i++ {
+	for i, secretKey := range secretKeys {
+		privKeys[i] = secretKey.Marshal()
+		pubKeys[i] = secretKey.PublicKey().Marshal()
+	}
+	return km.localKM.ImportKeypairs(ctx, privKeys, pubKeys)
+}
+
+// SecretKeysFromMnemonic derives numKeys validating keys from a mnemonic phrase, following the
+// EIP-2334 validating key path from the account index startIndex onwards.
+func SecretKeysFromMnemonic(
+	mnemonic, mnemonicLanguage, mnemonicPassphrase string, startIndex, numKeys int,
+) ([]bls.SecretKey, error) {
+	if startIndex < 0 || numKeys < 0 {
+		return nil, errors.New("start index and number of keys cannot be negative")
+	}
+	seed, err := seedFromMnemonic(mnemonic, mnemonicLanguage, mnemonicPassphrase)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not initialize new wallet seed file")
+	}
+	secretKeys := make([]bls.SecretKey, numKeys)
+	for i := 0; i < numKeys; i++ {
 		privKey, err := util.PrivateKeyFromSeedAndPath(
-			seed, fmt.Sprintf(ValidatingKeyDerivationPathTemplate, i),
+			seed, fmt.Sprintf(ValidatingKeyDerivationPathTemplate, startIndex+i),
 		)
 		if err != nil {
-			return err
+			return nil, err
+		}
+		secretKeys[i], err = bls.SecretKeyFromBytes(privKey.Marshal())
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not convert key at index %d", startIndex+i)
 		}
-		privKeys[i] = privKey.Marshal()
-		pubKeys[i] = privKey.PublicKey().Marshal()
 	}
-	return km.localKM.ImportKeypairs(ctx, privKeys, pubKeys)
+	return secretKeys, nil
 }
 
 // ExtractKeystores retrieves the secret keys for specified public keys
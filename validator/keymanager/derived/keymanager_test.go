@@ -76,6 +76,27 @@ func TestDerivedKeymanager_RecoverSeedRoundTrip(t *testing.T) {
 	assert.DeepEqual(t, wanted, got)
 }
 
+func TestSecretKeysFromMnemonic(t *testing.T) {
+	derivedSeed, err := seedFromMnemonic(constant.TestMnemonic, DefaultMnemonicLanguage, "")
+	require.NoError(t, err)
+	startIndex, numKeys := 3, 2
+	secretKeys, err := SecretKeysFromMnemonic(constant.TestMnemonic, DefaultMnemonicLanguage, "", startIndex, numKeys)
+	require.NoError(t, err)
+	require.Equal(t, numKeys, len(secretKeys))
+	for i, secretKey := range secretKeys {
+		privKey, err := util.PrivateKeyFromSeedAndPath(
+			derivedSeed, fmt.Sprintf(ValidatingKeyDerivationPathTemplate, startIndex+i),
+		)
+		require.NoError(t, err)
+		assert.DeepEqual(t, privKey.Marshal(), secretKey.Marshal())
+	}
+
+	_, err = SecretKeysFromMnemonic(constant.TestMnemonic, DefaultMnemonicLanguage, "", -1, numKeys)
+	assert.ErrorContains(t, "cannot be negative", err)
+	_, err = SecretKeysFromMnemonic("invalid mnemonic", DefaultMnemonicLanguage, "", 0, numKeys)
+	assert.ErrorContains(t, "could not initialize new wallet seed file", err)
+}
+
 func TestDerivedKeymanager_FetchValidatingPublicKeys(t *testing.T) {
 	derivedSeed, err := seedFromMnemonic(constant.TestMnemonic, DefaultMnemonicLanguage, "")
 	require.NoError(t, err)
@@ -36,6 +36,8 @@ import (
 const (
 	maxRetries = 60
 	retryDelay = 10 * time.Second
+	// publicKeysPath is the web3signer API listing the public keys of the keys it holds.
+	publicKeysPath = "/api/v1/eth2/publicKeys"
 )
 
 // SetupConfig includes configuration values for initializing.
@@ -55,6 +57,11 @@ type SetupConfig struct {
 	// a static list of public keys to be passed by the user to determine what accounts should sign.
 	// This will provide a layer of safety against slashing if the web3signer is shared across validators.
 	ProvidedPublicKeys []string
+
+	// KeyDiscoveryInterval enables periodic discovery of the keys held by web3signer when set.
+	// Keys are fetched from PublicKeysURL, or from the public keys API of web3signer if no URL is set,
+	// and keys added to or removed from web3signer are added to or removed from the keymanager.
+	KeyDiscoveryInterval time.Duration
 }
 
 // Keymanager defines the web3signer keymanager.
@@ -67,6 +74,7 @@ type Keymanager struct {
 	validator             *validator.Validate
 	retriesRemaining      int
 	keyFilePath           string
+	discoveredKeys        map[string][48]byte // keys found by the last key discovery, only used by the discovery routine
 	lock                  sync.RWMutex
 }
 
@@ -161,9 +169,105 @@ func NewKeymanager(ctx context.Context, cfg *SetupConfig) (*Keymanager, error) {
 		km.lock.Unlock()
 	}
 
+	if cfg.KeyDiscoveryInterval > 0 {
+		discoveryURL := cfg.PublicKeysURL
+		km.discoveredKeys = make(map[string][48]byte)
+		if discoveryURL == "" {
+			discoveryURL = strings.TrimSuffix(cfg.BaseEndpoint, "/") + publicKeysPath
+		} else {
+			// Keys loaded from the public keys URL were discovered already.
+			maps.Copy(km.discoveredKeys, flagLoadedKeys)
+		}
+		go km.discoverKeysPeriodically(ctx, discoveryURL, cfg.KeyDiscoveryInterval)
+	}
+
 	return km, nil
 }
 
+// discoverKeysPeriodically polls the public keys URL until the context is canceled.
+func (km *Keymanager) discoverKeysPeriodically(ctx context.Context, url string, interval time.Duration) {
+	log.WithFields(logrus.Fields{"url": url, "interval": interval}).Info("Discovering keys held by web3signer")
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := km.discoverKeys(ctx, url); err != nil {
+			log.WithError(err).Error("Could not discover keys held by web3signer")
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			log.Debug("Stopping key discovery")
+			return
+		}
+	}
+}
+
+// discoverKeys fetches the keys held by web3signer and compares them with the keys found by the previous
+// discovery. New keys are added to the keymanager and keys no longer held by web3signer are removed from it,
+// while keys added by other means are kept.
+func (km *Keymanager) discoverKeys(ctx context.Context, url string) error {
+	fetched, err := km.client.GetPublicKeys(ctx, url)
+	if err != nil {
+		erroredResponsesTotal.Inc()
+		return errors.Wrapf(err, "could not get public keys from %s", url)
+	}
+	discovered := make(map[string][48]byte, len(fetched))
+	for _, key := range fetched {
+		decodedKey, err := hexutil.Decode(key)
+		if err != nil {
+			return errors.Wrapf(err, "could not decode public key %s", key)
+		}
+		if len(decodedKey) != fieldparams.BLSPubkeyLength {
+			return fmt.Errorf("public key %s has invalid length (expected %d, got %d)", key, fieldparams.BLSPubkeyLength, len(decodedKey))
+		}
+		discovered[hexutil.Encode(decodedKey)] = bytesutil.ToBytes48(decodedKey)
+	}
+	discoveredKeysCount.Set(float64(len(discovered)))
+
+	combinedKeys := make(map[string][48]byte)
+	km.lock.RLock()
+	for _, key := range km.providedPublicKeys {
+		combinedKeys[hexutil.Encode(key[:])] = key
+	}
+	km.lock.RUnlock()
+
+	var added, removed []string
+	for encodedKey := range km.discoveredKeys {
+		if _, ok := discovered[encodedKey]; ok {
+			continue
+		}
+		if _, ok := combinedKeys[encodedKey]; ok {
+			delete(combinedKeys, encodedKey)
+			removed = append(removed, encodedKey)
+		}
+	}
+	for encodedKey, key := range discovered {
+		if _, ok := combinedKeys[encodedKey]; ok {
+			continue
+		}
+		combinedKeys[encodedKey] = key
+		added = append(added, encodedKey)
+	}
+	km.discoveredKeys = discovered
+	if len(added) == 0 && len(removed) == 0 {
+		return nil
+	}
+
+	log.WithFields(logrus.Fields{
+		"added":   len(added),
+		"removed": len(removed),
+	}).Info("Keys held by web3signer changed")
+	log.WithFields(logrus.Fields{
+		"added":   added,
+		"removed": removed,
+	}).Debug("Discovered key changes")
+	if km.keyFilePath != "" {
+		return km.savePublicKeysToFile(combinedKeys)
+	}
+	km.updatePublicKeys(maps.Values(combinedKeys))
+	return nil
+}
+
 func (km *Keymanager) refreshRemoteKeysFromFileChangesWithRetry(ctx context.Context, retryDelay time.Duration) error {
 	if ctx.Err() != nil {
 		return ctx.Err()
@@ -377,12 +481,14 @@ func (km *Keymanager) FetchValidatingPublicKeys(_ context.Context) ([][fieldpara
 
 // Sign signs the message by using a remote web3signer server.
 func (km *Keymanager) Sign(ctx context.Context, request *validatorpb.SignRequest) (bls.Signature, error) {
-	signRequest, err := getSignRequestJson(ctx, km.validator, request, km.genesisValidatorsRoot)
+	signRequest, requestType, err := getSignRequestJson(ctx, km.validator, request, km.genesisValidatorsRoot)
 	if err != nil {
 		erroredResponsesTotal.Inc()
 		return nil, err
 	}
+	start := time.Now()
 	signature, err := km.client.Sign(ctx, hexutil.Encode(request.PublicKey), signRequest)
+	signRequestDurationSeconds.WithLabelValues(requestType).Observe(time.Since(start).Seconds())
 	if err != nil {
 		erroredResponsesTotal.Inc()
 		return nil, errors.Wrap(err, "failed to sign the request")
@@ -392,13 +498,14 @@ func (km *Keymanager) Sign(ctx context.Context, request *validatorpb.SignRequest
 	return signature, nil
 }
 
-// getSignRequestJson returns a json request based on the SignRequest type.
-func getSignRequestJson(ctx context.Context, validator *validator.Validate, request *validatorpb.SignRequest, genesisValidatorsRoot []byte) (internal.SignRequestJson, error) {
+// getSignRequestJson returns a json request based on the SignRequest type, along with its web3signer type
+// such as ATTESTATION or BLOCK_V2.
+func getSignRequestJson(ctx context.Context, validator *validator.Validate, request *validatorpb.SignRequest, genesisValidatorsRoot []byte) (internal.SignRequestJson, string, error) {
 	if request == nil {
-		return nil, errors.New("nil sign request provided")
+		return nil, "", errors.New("nil sign request provided")
 	}
 	if !bytesutil.IsValidRoot(genesisValidatorsRoot) {
-		return nil, fmt.Errorf("invalid genesis validators root length, genesis root: %v", genesisValidatorsRoot)
+		return nil, "", fmt.Errorf("invalid genesis validators root length, genesis root: %v", genesisValidatorsRoot)
 	}
 	switch request.Object.(type) {
 	case *validatorpb.SignRequest_Block:
@@ -430,6 +537,10 @@ func getSignRequestJson(ctx context.Context, validator *validator.Validate, requ
 		return handleBlockElectra(ctx, validator, request, genesisValidatorsRoot)
 	case *validatorpb.SignRequest_BlindedBlockElectra:
 		return handleBlindedBlockElectra(ctx, validator, request, genesisValidatorsRoot)
+	case *validatorpb.SignRequest_BlockFulu:
+		return handleBlockFulu(ctx, validator, request, genesisValidatorsRoot)
+	case *validatorpb.SignRequest_BlindedBlockFulu:
+		return handleBlindedBlockFulu(ctx, validator, request, genesisValidatorsRoot)
 	// We do not support "DEPOSIT" type.
 	/*
 		case *validatorpb.:
@@ -450,248 +561,281 @@ func getSignRequestJson(ctx context.Context, validator *validator.Validate, requ
 	case *validatorpb.SignRequest_Registration:
 		return handleRegistration(ctx, validator, request)
 	default:
-		return nil, fmt.Errorf("web3signer sign request type %T not supported", request.Object)
+		return nil, "", fmt.Errorf("web3signer sign request type %T not supported", request.Object)
 	}
 }
 
-func handleBlock(ctx context.Context, validator *validator.Validate, request *validatorpb.SignRequest, genesisValidatorsRoot []byte) ([]byte, error) {
+func handleBlock(ctx context.Context, validator *validator.Validate, request *validatorpb.SignRequest, genesisValidatorsRoot []byte) ([]byte, string, error) {
 	bockSignRequest, err := types.GetBlockSignRequest(request, genesisValidatorsRoot)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	if err = validator.StructCtx(ctx, bockSignRequest); err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	blockSignRequestsTotal.Inc()
-	return json.Marshal(bockSignRequest)
+	return marshalSignRequest(bockSignRequest.Type, bockSignRequest)
 }
 
-func handleAttestationData(ctx context.Context, validator *validator.Validate, request *validatorpb.SignRequest, genesisValidatorsRoot []byte) ([]byte, error) {
+func handleAttestationData(ctx context.Context, validator *validator.Validate, request *validatorpb.SignRequest, genesisValidatorsRoot []byte) ([]byte, string, error) {
 	attestationSignRequest, err := types.GetAttestationSignRequest(request, genesisValidatorsRoot)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	if err = validator.StructCtx(ctx, attestationSignRequest); err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	attestationSignRequestsTotal.Inc()
-	return json.Marshal(attestationSignRequest)
+	return marshalSignRequest(attestationSignRequest.Type, attestationSignRequest)
 }
 
-func handleAggregateAttestationAndProof(ctx context.Context, validator *validator.Validate, request *validatorpb.SignRequest, genesisValidatorsRoot []byte) ([]byte, error) {
+func handleAggregateAttestationAndProof(ctx context.Context, validator *validator.Validate, request *validatorpb.SignRequest, genesisValidatorsRoot []byte) ([]byte, string, error) {
 	aggregateAndProofSignRequest, err := types.GetAggregateAndProofSignRequest(request, genesisValidatorsRoot)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	if err = validator.StructCtx(ctx, aggregateAndProofSignRequest); err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	aggregateAndProofSignRequestsTotal.Inc()
-	return json.Marshal(aggregateAndProofSignRequest)
+	return marshalSignRequest(aggregateAndProofSignRequest.Type, aggregateAndProofSignRequest)
 }
 
-func handleAggregateAttestationAndProofV2(ctx context.Context, fork int, validator *validator.Validate, request *validatorpb.SignRequest, genesisValidatorsRoot []byte) ([]byte, error) {
+func handleAggregateAttestationAndProofV2(ctx context.Context, fork int, validator *validator.Validate, request *validatorpb.SignRequest, genesisValidatorsRoot []byte) ([]byte, string, error) {
 	aggregateAndProofSignRequestV2, err := types.GetAggregateAndProofV2SignRequest(fork, request, genesisValidatorsRoot)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	if err = validator.StructCtx(ctx, aggregateAndProofSignRequestV2); err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	aggregateAndProofSignRequestsTotal.Inc()
-	return json.Marshal(aggregateAndProofSignRequestV2)
+	return marshalSignRequest(aggregateAndProofSignRequestV2.Type, aggregateAndProofSignRequestV2)
 }
 
-func handleAggregationSlot(ctx context.Context, validator *validator.Validate, request *validatorpb.SignRequest, genesisValidatorsRoot []byte) ([]byte, error) {
+func handleAggregationSlot(ctx context.Context, validator *validator.Validate, request *validatorpb.SignRequest, genesisValidatorsRoot []byte) ([]byte, string, error) {
 	aggregationSlotSignRequest, err := types.GetAggregationSlotSignRequest(request, genesisValidatorsRoot)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	if err = validator.StructCtx(ctx, aggregationSlotSignRequest); err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	aggregationSlotSignRequestsTotal.Inc()
-	return json.Marshal(aggregationSlotSignRequest)
+	return marshalSignRequest(aggregationSlotSignRequest.Type, aggregationSlotSignRequest)
 }
 
-func handleBlockAltair(ctx context.Context, validator *validator.Validate, request *validatorpb.SignRequest, genesisValidatorsRoot []byte) ([]byte, error) {
+func handleBlockAltair(ctx context.Context, validator *validator.Validate, request *validatorpb.SignRequest, genesisValidatorsRoot []byte) ([]byte, string, error) {
 	blockv2AltairSignRequest, err := types.GetBlockAltairSignRequest(request, genesisValidatorsRoot)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	if err = validator.StructCtx(ctx, blockv2AltairSignRequest); err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	blockAltairSignRequestsTotal.Inc()
-	return json.Marshal(blockv2AltairSignRequest)
+	return marshalSignRequest(blockv2AltairSignRequest.Type, blockv2AltairSignRequest)
 }
 
-func handleBlockBellatrix(ctx context.Context, validator *validator.Validate, request *validatorpb.SignRequest, genesisValidatorsRoot []byte) ([]byte, error) {
+func handleBlockBellatrix(ctx context.Context, validator *validator.Validate, request *validatorpb.SignRequest, genesisValidatorsRoot []byte) ([]byte, string, error) {
 	blockv2BellatrixSignRequest, err := types.GetBlockV2BlindedSignRequest(request, genesisValidatorsRoot)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	if err = validator.StructCtx(ctx, blockv2BellatrixSignRequest); err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	blockBellatrixSignRequestsTotal.Inc()
-	return json.Marshal(blockv2BellatrixSignRequest)
+	return marshalSignRequest(blockv2BellatrixSignRequest.Type, blockv2BellatrixSignRequest)
 }
 
-func handleBlindedBlockBellatrix(ctx context.Context, validator *validator.Validate, request *validatorpb.SignRequest, genesisValidatorsRoot []byte) ([]byte, error) {
+func handleBlindedBlockBellatrix(ctx context.Context, validator *validator.Validate, request *validatorpb.SignRequest, genesisValidatorsRoot []byte) ([]byte, string, error) {
 	blindedBlockv2SignRequest, err := types.GetBlockV2BlindedSignRequest(request, genesisValidatorsRoot)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	if err = validator.StructCtx(ctx, blindedBlockv2SignRequest); err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	blindedBlockBellatrixSignRequestsTotal.Inc()
-	return json.Marshal(blindedBlockv2SignRequest)
+	return marshalSignRequest(blindedBlockv2SignRequest.Type, blindedBlockv2SignRequest)
 }
 
-func handleBlockCapella(ctx context.Context, validator *validator.Validate, request *validatorpb.SignRequest, genesisValidatorsRoot []byte) ([]byte, error) {
+func handleBlockCapella(ctx context.Context, validator *validator.Validate, request *validatorpb.SignRequest, genesisValidatorsRoot []byte) ([]byte, string, error) {
 	blockv2CapellaSignRequest, err := types.GetBlockV2BlindedSignRequest(request, genesisValidatorsRoot)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	if err = validator.StructCtx(ctx, blockv2CapellaSignRequest); err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	blockCapellaSignRequestsTotal.Inc()
-	return json.Marshal(blockv2CapellaSignRequest)
+	return marshalSignRequest(blockv2CapellaSignRequest.Type, blockv2CapellaSignRequest)
 }
 
-func handleBlindedBlockCapella(ctx context.Context, validator *validator.Validate, request *validatorpb.SignRequest, genesisValidatorsRoot []byte) ([]byte, error) {
+func handleBlindedBlockCapella(ctx context.Context, validator *validator.Validate, request *validatorpb.SignRequest, genesisValidatorsRoot []byte) ([]byte, string, error) {
 	blindedBlockv2CapellaSignRequest, err := types.GetBlockV2BlindedSignRequest(request, genesisValidatorsRoot)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	if err = validator.StructCtx(ctx, blindedBlockv2CapellaSignRequest); err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	blindedBlockCapellaSignRequestsTotal.Inc()
-	return json.Marshal(blindedBlockv2CapellaSignRequest)
+	return marshalSignRequest(blindedBlockv2CapellaSignRequest.Type, blindedBlockv2CapellaSignRequest)
 }
 
-func handleBlockDeneb(ctx context.Context, validator *validator.Validate, request *validatorpb.SignRequest, genesisValidatorsRoot []byte) ([]byte, error) {
+func handleBlockDeneb(ctx context.Context, validator *validator.Validate, request *validatorpb.SignRequest, genesisValidatorsRoot []byte) ([]byte, string, error) {
 	blockv2DenebSignRequest, err := types.GetBlockV2BlindedSignRequest(request, genesisValidatorsRoot)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	if err = validator.StructCtx(ctx, blockv2DenebSignRequest); err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	blockDenebSignRequestsTotal.Inc()
-	return json.Marshal(blockv2DenebSignRequest)
+	return marshalSignRequest(blockv2DenebSignRequest.Type, blockv2DenebSignRequest)
 }
 
-func handleBlindedBlockDeneb(ctx context.Context, validator *validator.Validate, request *validatorpb.SignRequest, genesisValidatorsRoot []byte) ([]byte, error) {
+func handleBlindedBlockDeneb(ctx context.Context, validator *validator.Validate, request *validatorpb.SignRequest, genesisValidatorsRoot []byte) ([]byte, string, error) {
 	blindedBlockv2DenebSignRequest, err := types.GetBlockV2BlindedSignRequest(request, genesisValidatorsRoot)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	if err = validator.StructCtx(ctx, blindedBlockv2DenebSignRequest); err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	blindedBlockDenebSignRequestsTotal.Inc()
-	return json.Marshal(blindedBlockv2DenebSignRequest)
+	return marshalSignRequest(blindedBlockv2DenebSignRequest.Type, blindedBlockv2DenebSignRequest)
 }
 
-func handleBlockElectra(ctx context.Context, validator *validator.Validate, request *validatorpb.SignRequest, genesisValidatorsRoot []byte) ([]byte, error) {
+func handleBlockElectra(ctx context.Context, validator *validator.Validate, request *validatorpb.SignRequest, genesisValidatorsRoot []byte) ([]byte, string, error) {
 	blockv2ElectraSignRequest, err := types.GetBlockV2BlindedSignRequest(request, genesisValidatorsRoot)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	if err = validator.StructCtx(ctx, blockv2ElectraSignRequest); err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	remoteBlockSignRequestsTotal.WithLabelValues("electra", "false").Inc()
-	return json.Marshal(blockv2ElectraSignRequest)
+	return marshalSignRequest(blockv2ElectraSignRequest.Type, blockv2ElectraSignRequest)
 }
 
-func handleBlindedBlockElectra(ctx context.Context, validator *validator.Validate, request *validatorpb.SignRequest, genesisValidatorsRoot []byte) ([]byte, error) {
+func handleBlindedBlockElectra(ctx context.Context, validator *validator.Validate, request *validatorpb.SignRequest, genesisValidatorsRoot []byte) ([]byte, string, error) {
 	blindedBlockv2ElectraSignRequest, err := types.GetBlockV2BlindedSignRequest(request, genesisValidatorsRoot)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	if err = validator.StructCtx(ctx, blindedBlockv2ElectraSignRequest); err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	remoteBlockSignRequestsTotal.WithLabelValues("electra", "true").Inc()
-	return json.Marshal(blindedBlockv2ElectraSignRequest)
+	return marshalSignRequest(blindedBlockv2ElectraSignRequest.Type, blindedBlockv2ElectraSignRequest)
 }
 
-func handleRandaoReveal(ctx context.Context, validator *validator.Validate, request *validatorpb.SignRequest, genesisValidatorsRoot []byte) ([]byte, error) {
+func handleBlockFulu(ctx context.Context, validator *validator.Validate, request *validatorpb.SignRequest, genesisValidatorsRoot []byte) ([]byte, string, error) {
+	blockv2FuluSignRequest, err := types.GetBlockV2BlindedSignRequest(request, genesisValidatorsRoot)
+	if err != nil {
+		return nil, "", err
+	}
+	if err = validator.StructCtx(ctx, blockv2FuluSignRequest); err != nil {
+		return nil, "", err
+	}
+	remoteBlockSignRequestsTotal.WithLabelValues("fulu", "false").Inc()
+	return marshalSignRequest(blockv2FuluSignRequest.Type, blockv2FuluSignRequest)
+}
+
+func handleBlindedBlockFulu(ctx context.Context, validator *validator.Validate, request *validatorpb.SignRequest, genesisValidatorsRoot []byte) ([]byte, string, error) {
+	blindedBlockv2FuluSignRequest, err := types.GetBlockV2BlindedSignRequest(request, genesisValidatorsRoot)
+	if err != nil {
+		return nil, "", err
+	}
+	if err = validator.StructCtx(ctx, blindedBlockv2FuluSignRequest); err != nil {
+		return nil, "", err
+	}
+	remoteBlockSignRequestsTotal.WithLabelValues("fulu", "true").Inc()
+	return marshalSignRequest(blindedBlockv2FuluSignRequest.Type, blindedBlockv2FuluSignRequest)
+}
+
+func handleRandaoReveal(ctx context.Context, validator *validator.Validate, request *validatorpb.SignRequest, genesisValidatorsRoot []byte) ([]byte, string, error) {
 	randaoRevealSignRequest, err := types.GetRandaoRevealSignRequest(request, genesisValidatorsRoot)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	if err = validator.StructCtx(ctx, randaoRevealSignRequest); err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	randaoRevealSignRequestsTotal.Inc()
-	return json.Marshal(randaoRevealSignRequest)
+	return marshalSignRequest(randaoRevealSignRequest.Type, randaoRevealSignRequest)
 }
 
-func handleVoluntaryExit(ctx context.Context, validator *validator.Validate, request *validatorpb.SignRequest, genesisValidatorsRoot []byte) ([]byte, error) {
+func handleVoluntaryExit(ctx context.Context, validator *validator.Validate, request *validatorpb.SignRequest, genesisValidatorsRoot []byte) ([]byte, string, error) {
 	voluntaryExitRequest, err := types.GetVoluntaryExitSignRequest(request, genesisValidatorsRoot)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	if err = validator.StructCtx(ctx, voluntaryExitRequest); err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	voluntaryExitSignRequestsTotal.Inc()
-	return json.Marshal(voluntaryExitRequest)
+	return marshalSignRequest(voluntaryExitRequest.Type, voluntaryExitRequest)
 }
 
-func handleSyncMessageBlockRoot(ctx context.Context, validator *validator.Validate, request *validatorpb.SignRequest, genesisValidatorsRoot []byte) ([]byte, error) {
+func handleSyncMessageBlockRoot(ctx context.Context, validator *validator.Validate, request *validatorpb.SignRequest, genesisValidatorsRoot []byte) ([]byte, string, error) {
 	syncCommitteeMessageRequest, err := types.GetSyncCommitteeMessageSignRequest(request, genesisValidatorsRoot)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	if err = validator.StructCtx(ctx, syncCommitteeMessageRequest); err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	syncCommitteeMessageSignRequestsTotal.Inc()
-	return json.Marshal(syncCommitteeMessageRequest)
+	return marshalSignRequest(syncCommitteeMessageRequest.Type, syncCommitteeMessageRequest)
 }
 
-func handleSyncAggregatorSelectionData(ctx context.Context, validator *validator.Validate, request *validatorpb.SignRequest, genesisValidatorsRoot []byte) ([]byte, error) {
+func handleSyncAggregatorSelectionData(ctx context.Context, validator *validator.Validate, request *validatorpb.SignRequest, genesisValidatorsRoot []byte) ([]byte, string, error) {
 	syncCommitteeSelectionProofRequest, err := types.GetSyncCommitteeSelectionProofSignRequest(request, genesisValidatorsRoot)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	if err = validator.StructCtx(ctx, syncCommitteeSelectionProofRequest); err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	syncCommitteeSelectionProofSignRequestsTotal.Inc()
-	return json.Marshal(syncCommitteeSelectionProofRequest)
+	return marshalSignRequest(syncCommitteeSelectionProofRequest.Type, syncCommitteeSelectionProofRequest)
 }
 
-func handleContributionAndProof(ctx context.Context, validator *validator.Validate, request *validatorpb.SignRequest, genesisValidatorsRoot []byte) ([]byte, error) {
+func handleContributionAndProof(ctx context.Context, validator *validator.Validate, request *validatorpb.SignRequest, genesisValidatorsRoot []byte) ([]byte, string, error) {
 	contributionAndProofRequest, err := types.GetSyncCommitteeContributionAndProofSignRequest(request, genesisValidatorsRoot)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	if err = validator.StructCtx(ctx, contributionAndProofRequest); err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	syncCommitteeContributionAndProofSignRequestsTotal.Inc()
-	return json.Marshal(contributionAndProofRequest)
+	return marshalSignRequest(contributionAndProofRequest.Type, contributionAndProofRequest)
 }
 
-func handleRegistration(ctx context.Context, validator *validator.Validate, request *validatorpb.SignRequest) ([]byte, error) {
+func handleRegistration(ctx context.Context, validator *validator.Validate, request *validatorpb.SignRequest) ([]byte, string, error) {
 	validatorRegistrationRequest, err := types.GetValidatorRegistrationSignRequest(request)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	if err = validator.StructCtx(ctx, validatorRegistrationRequest); err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	validatorRegistrationSignRequestsTotal.Inc()
-	return json.Marshal(validatorRegistrationRequest)
+	return marshalSignRequest(validatorRegistrationRequest.Type, validatorRegistrationRequest)
+}
+
+// marshalSignRequest encodes the sign request and returns it along with its web3signer type.
+func marshalSignRequest(requestType string, signRequest interface{}) ([]byte, string, error) {
+	b, err := json.Marshal(signRequest)
+	if err != nil {
+		return nil, "", err
+	}
+	return b, requestType, nil
 }
 
 // SubscribeAccountChanges returns the event subscription for changes to public keys.
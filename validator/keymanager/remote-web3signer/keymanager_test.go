@@ -15,6 +15,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/go-playground/validator/v10"
 	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/io/file"
@@ -439,6 +440,22 @@ func TestKeymanager_Sign(t *testing.T) {
 			want:    desiredSig,
 			wantErr: false,
 		},
+		{
+			name: "BLOCK_V2_FULU",
+			args: args{
+				request: mock.GetMockSignRequest("BLOCK_V2_FULU"),
+			},
+			want:    desiredSig,
+			wantErr: false,
+		},
+		{
+			name: "BLOCK_V2_BLINDED_FULU",
+			args: args{
+				request: mock.GetMockSignRequest("BLOCK_V2_BLINDED_FULU"),
+			},
+			want:    desiredSig,
+			wantErr: false,
+		},
 		{
 			name: "RANDAO_REVEAL",
 			args: args{
@@ -729,3 +746,95 @@ func TestKeymanager_DeletePublicKeys_WithFile(t *testing.T) {
 	require.Equal(t, len(keys), 1)
 	require.Equal(t, hexutil.Encode(keys[0][:]), publicKeys[1])
 }
+
+func TestKeymanager_DiscoverKeys(t *testing.T) {
+	ctx := context.Background()
+	root, err := hexutil.Decode("0x270d43e74ce340de4bca2b1936beca0f4f5408d9e78aec4850920baf659d5b69")
+	require.NoError(t, err)
+	providedKey := "0xa2b5aaad9c6efefe7bb9b1243a043404f3362937cfb6b31833929833173f476630ea2cfeb0d9ddf15f97ca8685948820"
+	firstKey := "0x8000a9a6d3f5e22d783eefaadbcf0298146adb5d95b04db910a0d4e16976b30229d0b1e7b9cda6c7e0bfa11f72efe055"
+	secondKey := "0x800057e262bfe42413c2cfce948ff77f11efeea19721f590c8b5b2f32fecb0e164cafba987c80465878408d05b97c9be"
+	km, err := NewKeymanager(ctx, &SetupConfig{
+		BaseEndpoint:          "http://example.com",
+		GenesisValidatorsRoot: root,
+		ProvidedPublicKeys:    []string{providedKey},
+	})
+	require.NoError(t, err)
+	km.discoveredKeys = make(map[string][48]byte)
+	client := &MockClient{PublicKeys: []string{firstKey, secondKey}}
+	km.client = client
+
+	keysChan := make(chan [][48]byte, 2)
+	sub := km.SubscribeAccountChanges(keysChan)
+	defer sub.Unsubscribe()
+
+	toStrings := func(keys [][48]byte) []string {
+		s := make([]string, len(keys))
+		for i, key := range keys {
+			s[i] = hexutil.Encode(key[:])
+		}
+		slices.Sort(s)
+		return s
+	}
+
+	// New keys are added to the provided keys.
+	require.NoError(t, km.discoverKeys(ctx, "http://example.com/api/v1/eth2/publicKeys"))
+	got := <-keysChan
+	want := []string{providedKey, firstKey, secondKey}
+	slices.Sort(want)
+	assert.Equal(t, want, toStrings(got))
+
+	// Keys no longer held by web3signer are removed, keys provided by other means are kept.
+	client.PublicKeys = []string{secondKey}
+	require.NoError(t, km.discoverKeys(ctx, "http://example.com/api/v1/eth2/publicKeys"))
+	got = <-keysChan
+	want = []string{providedKey, secondKey}
+	slices.Sort(want)
+	assert.Equal(t, want, toStrings(got))
+
+	// Nothing is sent when the keys did not change.
+	require.NoError(t, km.discoverKeys(ctx, "http://example.com/api/v1/eth2/publicKeys"))
+	assert.Equal(t, 0, len(keysChan))
+
+	client.PublicKeys = []string{"0x1234"}
+	require.ErrorContains(t, "public key 0x1234 has invalid length", km.discoverKeys(ctx, "http://example.com/api/v1/eth2/publicKeys"))
+}
+
+func TestKeymanager_KeyDiscoveryInterval(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	root, err := hexutil.Decode("0x270d43e74ce340de4bca2b1936beca0f4f5408d9e78aec4850920baf659d5b69")
+	require.NoError(t, err)
+	key := "0xa2b5aaad9c6efefe7bb9b1243a043404f3362937cfb6b31833929833173f476630ea2cfeb0d9ddf15f97ca8685948820"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/eth2/publicKeys", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode([]string{key}))
+	}))
+	defer srv.Close()
+
+	km, err := NewKeymanager(ctx, &SetupConfig{
+		BaseEndpoint:          srv.URL,
+		GenesisValidatorsRoot: root,
+		KeyDiscoveryInterval:  time.Hour,
+	})
+	require.NoError(t, err)
+
+	// The keys are discovered right away without waiting for the interval.
+	assert.Eventually(t, func() bool {
+		keys, err := km.FetchValidatingPublicKeys(ctx)
+		return err == nil && len(keys) == 1 && hexutil.Encode(keys[0][:]) == key
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestGetSignRequestJson_Type(t *testing.T) {
+	root, err := hexutil.Decode("0x270d43e74ce340de4bca2b1936beca0f4f5408d9e78aec4850920baf659d5b69")
+	require.NoError(t, err)
+	for _, want := range []string{"AGGREGATION_SLOT", "ATTESTATION", "BLOCK_V2", "RANDAO_REVEAL", "VALIDATOR_REGISTRATION"} {
+		t.Run(want, func(t *testing.T) {
+			_, requestType, err := getSignRequestJson(context.Background(), validator.New(), mock.GetMockSignRequest(want), root)
+			require.NoError(t, err)
+			assert.Equal(t, want, requestType)
+		})
+	}
+}
@@ -67,6 +67,17 @@ var (
 		Help: "Total number of block sign requests with fork and blinded block check",
 	}, []string{"fork", "isBlinded"})
 
+	signRequestDurationSeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "remote_web3signer_sign_request_duration_seconds",
+		Help:    "Time (in seconds) spent waiting for web3signer to sign a request, by sign type",
+		Buckets: []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5},
+	}, []string{"type"})
+
+	discoveredKeysCount = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "remote_web3signer_discovered_keys",
+		Help: "Number of public keys found during the last key discovery on web3signer",
+	})
+
 	randaoRevealSignRequestsTotal = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "remote_web3signer_randao_reveal_sign_requests_total",
 		Help: "Total number of randao reveal sign requests",
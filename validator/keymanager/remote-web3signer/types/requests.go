@@ -302,7 +302,7 @@ func GetSyncCommitteeContributionAndProofSignRequest(request *validatorpb.SignRe
 }
 
 // GetBlockV2BlindedSignRequest maps the request for signing types (GetBlockV2 id defined by the remote signer interface and not the beacon APIs)
-// Supports Bellatrix, Capella, Deneb
+// Supports Bellatrix, Capella, Deneb, Electra, Fulu
 func GetBlockV2BlindedSignRequest(request *validatorpb.SignRequest, genesisValidatorsRoot []byte) (*BlockV2BlindedSignRequest, error) {
 	if request == nil {
 		return nil, errors.New("nil sign request provided")
@@ -423,6 +423,34 @@ func GetBlockV2BlindedSignRequest(request *validatorpb.SignRequest, genesisValid
 			return nil, err
 		}
 		b = beaconBlock
+	case *validatorpb.SignRequest_BlockFulu:
+		version = "FULU"
+		blockFulu, ok := request.Object.(*validatorpb.SignRequest_BlockFulu)
+		if !ok {
+			return nil, errors.New("failed to cast request object to fulu block")
+		}
+		if blockFulu == nil {
+			return nil, errors.New("invalid sign request: fulu block is nil")
+		}
+		beaconBlock, err := blocks.NewBeaconBlock(blockFulu.BlockFulu)
+		if err != nil {
+			return nil, err
+		}
+		b = beaconBlock
+	case *validatorpb.SignRequest_BlindedBlockFulu:
+		version = "FULU"
+		blindedBlockFulu, ok := request.Object.(*validatorpb.SignRequest_BlindedBlockFulu)
+		if !ok {
+			return nil, errors.New("failed to cast request object to blinded fulu block")
+		}
+		if blindedBlockFulu == nil {
+			return nil, errors.New("invalid sign request: blinded fulu block is nil")
+		}
+		beaconBlock, err := blocks.NewBeaconBlock(blindedBlockFulu.BlindedBlockFulu)
+		if err != nil {
+			return nil, err
+		}
+		b = beaconBlock
 	default:
 		return nil, errors.New("invalid sign request - invalid object type")
 	}
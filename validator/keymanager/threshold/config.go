@@ -0,0 +1,233 @@
+package threshold
+
+import (
+	"encoding/json"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+// DefaultSignTimeout is how long to wait for partial signatures of the peers when no timeout is configured.
+const DefaultSignTimeout = 3 * time.Second
+
+// SetupConfig includes the configuration values for initializing a threshold keymanager.
+type SetupConfig struct {
+	// Index of the key shares held by this validator client, starting at 1.
+	Index uint64
+	// Threshold is the number of partial signatures needed to produce a validator signature.
+	Threshold uint64
+	// ListenAddress is the host:port on which partial signatures of the peers are received.
+	// No server is started when it is empty.
+	ListenAddress string
+	// AuthSecret is shared by all validator clients of the cluster and authenticates their messages.
+	AuthSecret []byte
+	// Peers maps the share index of every other validator client of the cluster to its URL.
+	Peers map[uint64]string
+	// Shares are the key shares of the distributed validators.
+	Shares []*Share
+	// SignTimeout is how long to wait for partial signatures of the peers.
+	SignTimeout time.Duration
+}
+
+// Share is the key share of a distributed validator held by this validator client.
+type Share struct {
+	// PublicKey is the public key of the distributed validator.
+	PublicKey bls.PublicKey
+	// SecretKey is the secret key share of this validator client.
+	SecretKey bls.SecretKey
+	// PublicShares maps the share index of every validator client of the cluster to its public key share.
+	PublicShares map[uint64]bls.PublicKey
+}
+
+// fileConfig is the JSON representation of the threshold signer configuration file.
+type fileConfig struct {
+	Index         uint64 `json:"index"`
+	Threshold     uint64 `json:"threshold"`
+	ListenAddress string `json:"listen_address"`
+	// AuthSecretFile is the path to a file containing the secret shared by the cluster.
+	AuthSecretFile string `json:"auth_secret_file"`
+	// PasswordFile is the path to a file containing the password of the keystores.
+	PasswordFile string       `json:"password_file"`
+	SignTimeout  string       `json:"sign_timeout,omitempty"`
+	Peers        []*filePeer  `json:"peers"`
+	Validators   []*fileShare `json:"validators"`
+}
+
+type filePeer struct {
+	Index uint64 `json:"index"`
+	URL   string `json:"url"`
+}
+
+type fileShare struct {
+	PublicKey    string            `json:"public_key"`
+	PublicShares map[uint64]string `json:"public_shares"`
+	// Keystore is the path to an EIP-2335 keystore of the secret key share.
+	Keystore string `json:"keystore"`
+}
+
+// LoadSetupConfig reads a threshold signer configuration file and decrypts the key shares it refers to.
+// Relative paths in the file are resolved from the directory of the file.
+func LoadSetupConfig(path string) (*SetupConfig, error) {
+	enc, err := file.ReadFileAsBytes(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read threshold signer config file")
+	}
+	fc := &fileConfig{}
+	if err := json.Unmarshal(enc, fc); err != nil {
+		return nil, errors.Wrap(err, "could not decode threshold signer config file")
+	}
+	dir := filepath.Dir(path)
+	resolve := func(p string) string {
+		if filepath.IsAbs(p) {
+			return p
+		}
+		return filepath.Join(dir, p)
+	}
+
+	cfg := &SetupConfig{
+		Index:         fc.Index,
+		Threshold:     fc.Threshold,
+		ListenAddress: fc.ListenAddress,
+		Peers:         make(map[uint64]string, len(fc.Peers)),
+		SignTimeout:   DefaultSignTimeout,
+	}
+	if fc.SignTimeout != "" {
+		cfg.SignTimeout, err = time.ParseDuration(fc.SignTimeout)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not parse sign timeout")
+		}
+	}
+	if fc.AuthSecretFile == "" {
+		return nil, errors.New("auth_secret_file is required")
+	}
+	secret, err := file.ReadFileAsBytes(resolve(fc.AuthSecretFile))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read auth secret file")
+	}
+	cfg.AuthSecret = []byte(strings.TrimSpace(string(secret)))
+	for _, p := range fc.Peers {
+		if _, ok := cfg.Peers[p.Index]; ok {
+			return nil, errors.Errorf("duplicate peer index %d", p.Index)
+		}
+		u, err := url.ParseRequestURI(p.URL)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return nil, errors.Errorf("peer %d url must be in the format of http(s)://host:port, got %q", p.Index, p.URL)
+		}
+		cfg.Peers[p.Index] = strings.TrimSuffix(p.URL, "/")
+	}
+
+	if len(fc.Validators) != 0 && fc.PasswordFile == "" {
+		return nil, errors.New("password_file is required to decrypt the key shares")
+	}
+	var password string
+	if fc.PasswordFile != "" {
+		enc, err := file.ReadFileAsBytes(resolve(fc.PasswordFile))
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read password file")
+		}
+		password = strings.TrimSpace(string(enc))
+	}
+	decryptor := keystorev4.New()
+	for _, v := range fc.Validators {
+		share, err := loadShare(decryptor, v, resolve(v.Keystore), password)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not load key share of validator %s", v.PublicKey)
+		}
+		cfg.Shares = append(cfg.Shares, share)
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func loadShare(decryptor *keystorev4.Encryptor, v *fileShare, keystorePath, password string) (*Share, error) {
+	pubKey, err := publicKeyFromHex(v.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	share := &Share{
+		PublicKey:    pubKey,
+		PublicShares: make(map[uint64]bls.PublicKey, len(v.PublicShares)),
+	}
+	for index, s := range v.PublicShares {
+		share.PublicShares[index], err = publicKeyFromHex(s)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid public key share %d", index)
+		}
+	}
+
+	enc, err := file.ReadFileAsBytes(keystorePath)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read keystore")
+	}
+	ks := &keymanager.Keystore{}
+	if err := json.Unmarshal(enc, ks); err != nil {
+		return nil, errors.Wrap(err, "could not decode keystore")
+	}
+	secret, err := decryptor.Decrypt(ks.Crypto, password)
+	if err != nil {
+		if strings.Contains(err.Error(), keymanager.IncorrectPasswordErrMsg) {
+			return nil, errors.New("incorrect password for keystore")
+		}
+		return nil, errors.Wrap(err, "could not decrypt keystore")
+	}
+	share.SecretKey, err = bls.SecretKeyFromBytes(secret)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid secret key share")
+	}
+	return share, nil
+}
+
+func publicKeyFromHex(s string) (bls.PublicKey, error) {
+	b, err := hexutil.Decode(s)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not decode public key %s", s)
+	}
+	return bls.PublicKeyFromBytes(b)
+}
+
+// validate checks that the configuration describes a consistent cluster.
+func (cfg *SetupConfig) validate() error {
+	if cfg.Index == 0 {
+		return errors.New("share index must be set and start at 1")
+	}
+	if cfg.Threshold < 2 {
+		return errors.New("threshold must be at least 2")
+	}
+	if _, ok := cfg.Peers[cfg.Index]; ok {
+		return errors.Errorf("share index %d is both used locally and by a peer", cfg.Index)
+	}
+	if uint64(len(cfg.Peers))+1 < cfg.Threshold {
+		return errors.Errorf("threshold %d is larger than the cluster size %d", cfg.Threshold, len(cfg.Peers)+1)
+	}
+	if len(cfg.AuthSecret) == 0 {
+		return errors.New("auth secret must not be empty")
+	}
+	if cfg.SignTimeout <= 0 {
+		return errors.New("sign timeout must be positive")
+	}
+	for _, s := range cfg.Shares {
+		own, ok := s.PublicShares[cfg.Index]
+		if !ok {
+			return errors.Errorf("validator %#x has no public key share for index %d", s.PublicKey.Marshal(), cfg.Index)
+		}
+		if !own.Equals(s.SecretKey.PublicKey()) {
+			return errors.Errorf("secret key share of validator %#x does not match its public key share", s.PublicKey.Marshal())
+		}
+		for index := range cfg.Peers {
+			if _, ok := s.PublicShares[index]; !ok {
+				return errors.Errorf("validator %#x has no public key share for peer %d", s.PublicKey.Marshal(), index)
+			}
+		}
+	}
+	return nil
+}
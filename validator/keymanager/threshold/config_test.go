@@ -0,0 +1,135 @@
+package threshold
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+const password = "secretPassw0rd$1999"
+
+// writeConfig writes a configuration file for share 1 of a 2 of 3 cluster and returns its path.
+func writeConfig(t *testing.T, update func(fc map[string]interface{})) (string, bls.SecretKey) {
+	dir := t.TempDir()
+	sk, err := bls.RandKey()
+	require.NoError(t, err)
+	shares, err := bls.SplitSecretKey(sk, 2, 3)
+	require.NoError(t, err)
+
+	encryptor := keystorev4.New()
+	cryptoFields, err := encryptor.Encrypt(shares[1].Marshal(), password)
+	require.NoError(t, err)
+	enc, err := json.Marshal(&keymanager.Keystore{
+		Crypto:      cryptoFields,
+		Pubkey:      fmt.Sprintf("%x", shares[1].PublicKey().Marshal()),
+		Version:     encryptor.Version(),
+		Description: encryptor.Name(),
+	})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "share.json"), enc, 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "password.txt"), []byte(password+"\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "secret.txt"), []byte("cluster-secret\n"), 0600))
+
+	publicShares := make(map[string]string, len(shares))
+	for index, s := range shares {
+		publicShares[fmt.Sprintf("%d", index)] = hexutil.Encode(s.PublicKey().Marshal())
+	}
+	fc := map[string]interface{}{
+		"index":            1,
+		"threshold":        2,
+		"listen_address":   "127.0.0.1:9000",
+		"auth_secret_file": "secret.txt",
+		"password_file":    "password.txt",
+		"sign_timeout":     "2s",
+		"peers": []map[string]interface{}{
+			{"index": 2, "url": "http://127.0.0.1:9001/"},
+			{"index": 3, "url": "http://127.0.0.1:9002"},
+		},
+		"validators": []map[string]interface{}{
+			{
+				"public_key":    hexutil.Encode(sk.PublicKey().Marshal()),
+				"public_shares": publicShares,
+				"keystore":      "share.json",
+			},
+		},
+	}
+	if update != nil {
+		update(fc)
+	}
+	enc, err = json.Marshal(fc)
+	require.NoError(t, err)
+	path := filepath.Join(dir, "threshold.json")
+	require.NoError(t, os.WriteFile(path, enc, 0600))
+	return path, sk
+}
+
+func TestLoadSetupConfig(t *testing.T) {
+	path, sk := writeConfig(t, nil)
+	cfg, err := LoadSetupConfig(path)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), cfg.Index)
+	assert.Equal(t, uint64(2), cfg.Threshold)
+	assert.Equal(t, "127.0.0.1:9000", cfg.ListenAddress)
+	assert.DeepEqual(t, []byte("cluster-secret"), cfg.AuthSecret)
+	assert.Equal(t, 2*time.Second, cfg.SignTimeout)
+	assert.DeepEqual(t, map[uint64]string{2: "http://127.0.0.1:9001", 3: "http://127.0.0.1:9002"}, cfg.Peers)
+	require.Equal(t, 1, len(cfg.Shares))
+	assert.DeepEqual(t, sk.PublicKey().Marshal(), cfg.Shares[0].PublicKey.Marshal())
+	assert.Equal(t, 3, len(cfg.Shares[0].PublicShares))
+}
+
+func TestLoadSetupConfig_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		update  func(fc map[string]interface{})
+		wantErr string
+	}{
+		{
+			name:    "wrong password",
+			update:  func(fc map[string]interface{}) { fc["password_file"] = "secret.txt" },
+			wantErr: "incorrect password for keystore",
+		},
+		{
+			name:    "missing auth secret",
+			update:  func(fc map[string]interface{}) { delete(fc, "auth_secret_file") },
+			wantErr: "auth_secret_file is required",
+		},
+		{
+			name:    "invalid peer url",
+			update:  func(fc map[string]interface{}) { fc["peers"].([]map[string]interface{})[0]["url"] = "127.0.0.1:9001" },
+			wantErr: "peer 2 url must be in the format of http(s)://host:port",
+		},
+		{
+			name:    "threshold larger than cluster",
+			update:  func(fc map[string]interface{}) { fc["threshold"] = 4 },
+			wantErr: "threshold 4 is larger than the cluster size 3",
+		},
+		{
+			name:    "index used by peer",
+			update:  func(fc map[string]interface{}) { fc["index"] = 2 },
+			wantErr: "share index 2 is both used locally and by a peer",
+		},
+		{
+			name:    "invalid sign timeout",
+			update:  func(fc map[string]interface{}) { fc["sign_timeout"] = "soon" },
+			wantErr: "could not parse sign timeout",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, _ := writeConfig(t, tt.update)
+			_, err := LoadSetupConfig(path)
+			require.ErrorContains(t, tt.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,18 @@
+/*
+Package threshold defines a keymanager for distributed validators which signs with a BLS secret key
+share instead of a full validator key. A validator key is split into n shares, each held by a different
+validator client of the cluster, and any t of the partial signatures made with the shares can be combined
+into the signature of the validator key using Lagrange interpolation.
+
+When the validator client requests a signature, the keymanager signs the signing root with its own share
+and sends the partial signature to every peer of the cluster over HTTP. Messages between peers are
+authenticated with an HMAC of the request, keyed with a secret shared by the cluster. The keymanager then
+waits until it received partial signatures for the same signing root from enough peers, checks each of
+them against the public key share of its sender, and combines them into the validator signature.
+
+Partial signatures are only produced for signing roots the local validator client decided to sign, so no
+subset of fewer than t validator clients can produce a signature on its own. This also means that all
+validator clients of the cluster must sign identical data, for example by using the same beacon node.
+Duties whose signing root is not deterministic, such as validator registrations, cannot be signed.
+*/
+package threshold
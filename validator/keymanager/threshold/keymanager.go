@@ -0,0 +1,205 @@
+package threshold
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/logrusorgru/aurora"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/async/event"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v5/validator/accounts/petnames"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+	"github.com/sirupsen/logrus"
+)
+
+// Keymanager signs with key shares of distributed validators and combines its partial signatures
+// with the ones of its peers into validator signatures.
+type Keymanager struct {
+	index               uint64
+	threshold           uint64
+	authSecret          []byte
+	peers               map[uint64]string
+	shares              map[[fieldparams.BLSPubkeyLength]byte]*Share
+	publicKeys          [][fieldparams.BLSPubkeyLength]byte
+	partials            *partialsStore
+	signTimeout         time.Duration
+	httpClient          *http.Client
+	accountsChangedFeed *event.Feed
+}
+
+// NewKeymanager instantiates a new threshold keymanager. When a listen address is configured, partial
+// signatures of the peers are received on it until the context is canceled.
+func NewKeymanager(ctx context.Context, cfg *SetupConfig) (*Keymanager, error) {
+	ctx, span := trace.StartSpan(ctx, "threshold-keymanager.NewKeymanager")
+	defer span.End()
+
+	if cfg == nil {
+		return nil, errors.New("threshold signer config is nil")
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid threshold signer config")
+	}
+	km := &Keymanager{
+		index:               cfg.Index,
+		threshold:           cfg.Threshold,
+		authSecret:          cfg.AuthSecret,
+		peers:               cfg.Peers,
+		shares:              make(map[[fieldparams.BLSPubkeyLength]byte]*Share, len(cfg.Shares)),
+		partials:            newPartialsStore(cfg.Threshold),
+		signTimeout:         cfg.SignTimeout,
+		httpClient:          &http.Client{Timeout: cfg.SignTimeout},
+		accountsChangedFeed: new(event.Feed),
+	}
+	for _, s := range cfg.Shares {
+		pubKey := bytesutil.ToBytes48(s.PublicKey.Marshal())
+		if _, ok := km.shares[pubKey]; ok {
+			return nil, fmt.Errorf("duplicate key share for validator %#x", pubKey)
+		}
+		km.shares[pubKey] = s
+		km.publicKeys = append(km.publicKeys, pubKey)
+	}
+
+	if cfg.ListenAddress != "" {
+		srv := &http.Server{
+			Addr:              cfg.ListenAddress,
+			Handler:           km.Handler(),
+			ReadHeaderTimeout: time.Second,
+		}
+		go func() {
+			log.WithField("address", cfg.ListenAddress).Info("Receiving partial signatures of the cluster")
+			if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				log.WithError(err).Error("Partial signature server failed")
+			}
+		}()
+		go func() {
+			<-ctx.Done()
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+			if err := srv.Shutdown(shutdownCtx); err != nil {
+				log.WithError(err).Error("Could not shut down partial signature server")
+			}
+		}()
+	}
+	log.WithFields(logrus.Fields{
+		"index":      cfg.Index,
+		"threshold":  cfg.Threshold,
+		"peers":      len(cfg.Peers),
+		"validators": len(km.publicKeys),
+	}).Info("Initialized threshold keymanager")
+	return km, nil
+}
+
+// Handler returns the HTTP handler receiving partial signatures of the peers.
+func (km *Keymanager) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(PartialSignaturesPath, km.handlePartialSignature)
+	return mux
+}
+
+// FetchValidatingPublicKeys returns the public keys of the distributed validators.
+func (km *Keymanager) FetchValidatingPublicKeys(_ context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	return km.publicKeys, nil
+}
+
+// Sign signs the signing root with the key share of the validator, sends the partial signature to the
+// peers, and combines it with the partial signatures of the peers into the signature of the validator.
+func (km *Keymanager) Sign(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	ctx, span := trace.StartSpan(ctx, "threshold-keymanager.Sign")
+	defer span.End()
+
+	pubKey := bytesutil.ToBytes48(req.PublicKey)
+	share, ok := km.shares[pubKey]
+	if !ok {
+		return nil, errors.New("no key share found for public key")
+	}
+	if len(req.SigningRoot) != 32 {
+		return nil, errors.New("signing root must be 32 bytes")
+	}
+	partial := share.SecretKey.Sign(req.SigningRoot)
+	p := km.partials.add(partialsKey{pubKey: pubKey, signingRoot: bytesutil.ToBytes32(req.SigningRoot)}, km.index, partial)
+
+	msg := &partialSignatureMessage{
+		Index:       km.index,
+		PublicKey:   hexutil.Encode(req.PublicKey),
+		SigningRoot: hexutil.Encode(req.SigningRoot),
+		Signature:   hexutil.Encode(partial.Marshal()),
+	}
+	for index, peerURL := range km.peers {
+		go func(index uint64, peerURL string) {
+			// The partial signature is sent even if this validator client stops waiting, as peers may still need it.
+			sendCtx, cancel := context.WithTimeout(context.Background(), km.signTimeout)
+			defer cancel()
+			if err := km.sendPartialSignature(sendCtx, peerURL, msg); err != nil {
+				log.WithError(err).WithField("peer", index).Warn("Could not send partial signature")
+			}
+		}(index, peerURL)
+	}
+
+	select {
+	case <-p.complete:
+	case <-ctx.Done():
+		return nil, errors.Wrap(ctx.Err(), "did not receive enough partial signatures")
+	case <-time.After(km.signTimeout):
+		return nil, fmt.Errorf("did not receive %d partial signatures within %s", km.threshold, km.signTimeout)
+	}
+
+	indices, partials := km.partials.collect(p)
+	sig, err := bls.RecoverSignature(indices, partials)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not recover signature from partial signatures")
+	}
+	if !sig.Verify(share.PublicKey, req.SigningRoot) {
+		return nil, errors.New("recovered signature is invalid")
+	}
+	log.WithField("publicKey", fmt.Sprintf("%#x", bytesutil.Trunc(req.PublicKey))).Debug("Recovered signature from partial signatures")
+	return sig, nil
+}
+
+// SubscribeAccountChanges creates an event subscription for a channel
+// to listen for public key changes at runtime.
+func (km *Keymanager) SubscribeAccountChanges(pubKeysChan chan [][fieldparams.BLSPubkeyLength]byte) event.Subscription {
+	return km.accountsChangedFeed.Subscribe(pubKeysChan)
+}
+
+// ExtractKeystores is not supported for the threshold keymanager type.
+func (*Keymanager) ExtractKeystores(
+	_ context.Context, _ []bls.PublicKey, _ string,
+) ([]*keymanager.Keystore, error) {
+	return nil, errors.New("extracting keys is not supported for a threshold keymanager")
+}
+
+// DeleteKeystores is not supported for the threshold keymanager type.
+func (*Keymanager) DeleteKeystores(context.Context, [][]byte) ([]*keymanager.KeyStatus, error) {
+	return nil, errors.New("Wrong wallet type: threshold. Only Imported or Derived wallets can delete accounts")
+}
+
+// ListKeymanagerAccounts prints the distributed validators of the keymanager.
+func (km *Keymanager) ListKeymanagerAccounts(_ context.Context, _ keymanager.ListKeymanagerAccountConfig) error {
+	au := aurora.NewAurora(true)
+	fmt.Printf("(keymanager kind) %s\n", au.BrightGreen("threshold").Bold())
+	fmt.Printf("(share index) %d, (threshold) %d of %d\n", km.index, km.threshold, len(km.peers)+1)
+	if len(km.publicKeys) == 1 {
+		fmt.Print("Showing 1 validator account\n")
+	} else if len(km.publicKeys) == 0 {
+		fmt.Print("No accounts found\n")
+		return nil
+	} else {
+		fmt.Printf("Showing %d validator accounts\n", len(km.publicKeys))
+	}
+	for _, pubKey := range km.publicKeys {
+		fmt.Println("")
+		fmt.Printf("%s\n", au.BrightGreen(petnames.DeterministicName(pubKey[:], "-")).Bold())
+		fmt.Printf("%s %#x\n", au.BrightCyan("[validating public key]").Bold(), pubKey)
+		fmt.Printf("%s %#x\n", au.BrightCyan("[public key share]").Bold(), km.shares[pubKey].SecretKey.PublicKey().Marshal())
+		fmt.Println(" ")
+	}
+	return nil
+}
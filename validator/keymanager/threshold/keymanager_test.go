@@ -0,0 +1,225 @@
+package threshold
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+var authSecret = []byte("cluster-secret")
+
+// setupCluster splits a validator key into shares and starts a keymanager for every share.
+// Keymanagers whose index is not in online are not started and never send partial signatures.
+func setupCluster(t *testing.T, threshold, total uint64, online ...uint64) (bls.SecretKey, map[uint64]*Keymanager) {
+	sk, err := bls.RandKey()
+	require.NoError(t, err)
+	secretShares, err := bls.SplitSecretKey(sk, threshold, total)
+	require.NoError(t, err)
+	publicShares := make(map[uint64]bls.PublicKey, total)
+	for index, s := range secretShares {
+		publicShares[index] = s.PublicKey()
+	}
+
+	handlers := make(map[uint64]*handlerRef, total)
+	urls := make(map[uint64]string, total)
+	for index := uint64(1); index <= total; index++ {
+		ref := &handlerRef{}
+		srv := httptest.NewServer(ref)
+		t.Cleanup(srv.Close)
+		handlers[index] = ref
+		urls[index] = srv.URL
+	}
+
+	kms := make(map[uint64]*Keymanager, len(online))
+	for _, index := range online {
+		peers := make(map[uint64]string, total-1)
+		for i, u := range urls {
+			if i != index {
+				peers[i] = u
+			}
+		}
+		km, err := NewKeymanager(context.Background(), &SetupConfig{
+			Index:       index,
+			Threshold:   threshold,
+			AuthSecret:  authSecret,
+			Peers:       peers,
+			SignTimeout: time.Second,
+			Shares: []*Share{{
+				PublicKey:    sk.PublicKey(),
+				SecretKey:    secretShares[index],
+				PublicShares: publicShares,
+			}},
+		})
+		require.NoError(t, err)
+		handlers[index].handler = km.Handler()
+		kms[index] = km
+	}
+	return sk, kms
+}
+
+// handlerRef lets test servers be started before the keymanagers handling their requests.
+type handlerRef struct {
+	handler http.Handler
+}
+
+func (h *handlerRef) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if h.handler == nil {
+		http.Error(w, "offline", http.StatusServiceUnavailable)
+		return
+	}
+	h.handler.ServeHTTP(w, r)
+}
+
+func TestKeymanager_Sign(t *testing.T) {
+	sk, kms := setupCluster(t, 2, 3, 1, 3)
+	root := bytesutil.PadTo([]byte("signing root"), 32)
+	req := &validatorpb.SignRequest{PublicKey: sk.PublicKey().Marshal(), SigningRoot: root}
+
+	sigs := make(chan []byte, len(kms))
+	errs := make(chan error, len(kms))
+	for _, km := range kms {
+		go func(km *Keymanager) {
+			sig, err := km.Sign(context.Background(), req)
+			if err != nil {
+				errs <- err
+				return
+			}
+			sigs <- sig.Marshal()
+		}(km)
+	}
+	want := sk.Sign(root).Marshal()
+	for range kms {
+		select {
+		case err := <-errs:
+			t.Fatal(err)
+		case sig := <-sigs:
+			assert.DeepEqual(t, want, sig)
+		}
+	}
+
+	keys, err := kms[1].FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, len(keys))
+	assert.DeepEqual(t, bytesutil.ToBytes48(sk.PublicKey().Marshal()), keys[0])
+}
+
+func TestKeymanager_Sign_NotEnoughPartialSignatures(t *testing.T) {
+	sk, kms := setupCluster(t, 2, 3, 1)
+	req := &validatorpb.SignRequest{PublicKey: sk.PublicKey().Marshal(), SigningRoot: make([]byte, 32)}
+	_, err := kms[1].Sign(context.Background(), req)
+	require.ErrorContains(t, "did not receive 2 partial signatures within 1s", err)
+
+	other, err := bls.RandKey()
+	require.NoError(t, err)
+	req.PublicKey = other.PublicKey().Marshal()
+	_, err = kms[1].Sign(context.Background(), req)
+	require.ErrorContains(t, "no key share found for public key", err)
+}
+
+func TestKeymanager_HandlePartialSignature(t *testing.T) {
+	sk, kms := setupCluster(t, 2, 3, 1, 2)
+	km := kms[1]
+	root := make([]byte, 32)
+	share := km.shares[bytesutil.ToBytes48(sk.PublicKey().Marshal())]
+
+	// Peer 2 signs with its own share, so its partial signature matches its public key share.
+	peerShare := kms[2].shares[bytesutil.ToBytes48(sk.PublicKey().Marshal())].SecretKey
+	valid := &partialSignatureMessage{
+		Index:       2,
+		PublicKey:   hexutil.Encode(sk.PublicKey().Marshal()),
+		SigningRoot: hexutil.Encode(root),
+		Signature:   hexutil.Encode(peerShare.Sign(root).Marshal()),
+	}
+	wrongShare := *valid
+	wrongShare.Signature = hexutil.Encode(share.SecretKey.Sign(root).Marshal())
+	unknownPeer := *valid
+	unknownPeer.Index = 5
+
+	tests := []struct {
+		name       string
+		msg        *partialSignatureMessage
+		secret     []byte
+		timestamp  time.Time
+		wantStatus int
+		wantErr    string
+	}{
+		{
+			name:       "valid",
+			msg:        valid,
+			secret:     authSecret,
+			timestamp:  time.Now(),
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "wrong auth secret",
+			msg:        valid,
+			secret:     []byte("other-secret"),
+			timestamp:  time.Now(),
+			wantStatus: http.StatusUnauthorized,
+			wantErr:    "invalid message authentication code",
+		},
+		{
+			name:       "stale timestamp",
+			msg:        valid,
+			secret:     authSecret,
+			timestamp:  time.Now().Add(-time.Minute),
+			wantStatus: http.StatusUnauthorized,
+			wantErr:    "away from the local clock",
+		},
+		{
+			name:       "signed with another share",
+			msg:        &wrongShare,
+			secret:     authSecret,
+			timestamp:  time.Now(),
+			wantStatus: http.StatusBadRequest,
+			wantErr:    "partial signature does not match the public key share of the peer",
+		},
+		{
+			name:       "unknown peer",
+			msg:        &unknownPeer,
+			secret:     authSecret,
+			timestamp:  time.Now(),
+			wantStatus: http.StatusBadRequest,
+			wantErr:    "unknown peer index 5",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, err := json.Marshal(tt.msg)
+			require.NoError(t, err)
+			timestamp := strconv.FormatInt(tt.timestamp.Unix(), 10)
+			req := httptest.NewRequest(http.MethodPost, PartialSignaturesPath, bytes.NewReader(body))
+			req.Header.Set(timestampHeader, timestamp)
+			req.Header.Set(macHeader, hex.EncodeToString(mac(tt.secret, timestamp, body)))
+			w := httptest.NewRecorder()
+			km.Handler().ServeHTTP(w, req)
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.StringContains(t, tt.wantErr, w.Body.String())
+		})
+	}
+
+	p := km.partials.entries[partialsKey{pubKey: bytesutil.ToBytes48(sk.PublicKey().Marshal()), signingRoot: [32]byte{}}]
+	require.NotNil(t, p)
+	assert.Equal(t, 1, len(p.signatures))
+}
+
+func TestKeymanager_UnsupportedOperations(t *testing.T) {
+	_, kms := setupCluster(t, 2, 2, 1)
+	_, err := kms[1].ExtractKeystores(context.Background(), nil, "")
+	require.ErrorContains(t, "extracting keys is not supported", err)
+	_, err = kms[1].DeleteKeystores(context.Background(), nil)
+	require.ErrorContains(t, "Wrong wallet type: threshold", err)
+}
@@ -0,0 +1,98 @@
+package threshold
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+)
+
+// partialSignatureTTL is how long partial signatures are kept. Signing roots are only signed
+// within a few slots, so older partial signatures are never needed anymore.
+const partialSignatureTTL = 10 * time.Minute
+
+type partialsKey struct {
+	pubKey      [fieldparams.BLSPubkeyLength]byte
+	signingRoot [32]byte
+}
+
+// partials holds the partial signatures received for a signing root of a validator.
+type partials struct {
+	signatures map[uint64]bls.Signature
+	// complete is closed once threshold partial signatures were received.
+	complete chan struct{}
+	created  time.Time
+}
+
+// partialsStore collects partial signatures, either made locally or received from peers,
+// until enough of them were collected to recover the validator signature.
+type partialsStore struct {
+	threshold uint64
+	lock      sync.Mutex
+	entries   map[partialsKey]*partials
+}
+
+func newPartialsStore(threshold uint64) *partialsStore {
+	return &partialsStore{
+		threshold: threshold,
+		entries:   make(map[partialsKey]*partials),
+	}
+}
+
+// add stores the partial signature of the share with the given index and returns the partial
+// signatures of the signing root. Adding a partial signature twice for the same index is a no-op.
+func (s *partialsStore) add(key partialsKey, index uint64, sig bls.Signature) *partials {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.prune()
+	p, ok := s.entries[key]
+	if !ok {
+		p = &partials{
+			signatures: make(map[uint64]bls.Signature),
+			complete:   make(chan struct{}),
+			created:    time.Now(),
+		}
+		s.entries[key] = p
+	}
+	if _, ok := p.signatures[index]; ok {
+		return p
+	}
+	p.signatures[index] = sig
+	if uint64(len(p.signatures)) == s.threshold {
+		close(p.complete)
+	}
+	return p
+}
+
+// collect returns threshold partial signatures of the signing root along with the indices of their
+// shares, preferring the lowest indices so that all validator clients recover the same way.
+func (s *partialsStore) collect(p *partials) ([]uint64, []bls.Signature) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	indices := make([]uint64, 0, len(p.signatures))
+	for index := range p.signatures {
+		indices = append(indices, index)
+	}
+	sort.Slice(indices, func(i, j int) bool { return indices[i] < indices[j] })
+	if uint64(len(indices)) > s.threshold {
+		indices = indices[:s.threshold]
+	}
+	sigs := make([]bls.Signature, len(indices))
+	for i, index := range indices {
+		sigs[i] = p.signatures[index]
+	}
+	return indices, sigs
+}
+
+// prune removes expired partial signatures. The caller must hold the lock.
+func (s *partialsStore) prune() {
+	for key, p := range s.entries {
+		if time.Since(p.created) > partialSignatureTTL {
+			delete(s.entries, key)
+		}
+	}
+}
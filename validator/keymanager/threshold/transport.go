@@ -0,0 +1,167 @@
+package threshold
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+)
+
+const (
+	// PartialSignaturesPath is the path on which validator clients of the cluster receive partial signatures.
+	PartialSignaturesPath = "/threshold/v1/partial_signatures"
+
+	timestampHeader = "Prysm-Threshold-Timestamp"
+	macHeader       = "Prysm-Threshold-Mac"
+	// maxClockDrift is the largest difference allowed between the timestamp of a message and the local clock.
+	maxClockDrift = 30 * time.Second
+	maxBodySize   = 1 << 16
+)
+
+// partialSignatureMessage is sent to the peers after signing a signing root with a key share.
+type partialSignatureMessage struct {
+	Index       uint64 `json:"index"`
+	PublicKey   string `json:"public_key"`
+	SigningRoot string `json:"signing_root"`
+	Signature   string `json:"signature"`
+}
+
+// mac authenticates a message sent at the given unix timestamp.
+func mac(secret []byte, timestamp string, body []byte) []byte {
+	h := hmac.New(sha256.New, secret)
+	h.Write([]byte(timestamp))
+	h.Write([]byte{'\n'})
+	h.Write(body)
+	return h.Sum(nil)
+}
+
+// authenticate checks that the request was sent recently by a member of the cluster.
+func authenticate(secret []byte, r *http.Request, body []byte) error {
+	timestamp := r.Header.Get(timestampHeader)
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return errors.New("missing or invalid timestamp")
+	}
+	drift := time.Since(time.Unix(seconds, 0))
+	if drift > maxClockDrift || drift < -maxClockDrift {
+		return errors.Errorf("timestamp is %s away from the local clock", drift)
+	}
+	got, err := hex.DecodeString(r.Header.Get(macHeader))
+	if err != nil || !hmac.Equal(got, mac(secret, timestamp, body)) {
+		return errors.New("invalid message authentication code")
+	}
+	return nil
+}
+
+// sendPartialSignature sends a partial signature to a peer of the cluster.
+func (km *Keymanager) sendPartialSignature(ctx context.Context, peerURL string, msg *partialSignatureMessage) error {
+	ctx, span := trace.StartSpan(ctx, "threshold-keymanager.sendPartialSignature")
+	defer span.End()
+
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return errors.Wrap(err, "could not encode partial signature")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, peerURL+PartialSignaturesPath, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "could not create request")
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(timestampHeader, timestamp)
+	req.Header.Set(macHeader, hex.EncodeToString(mac(km.authSecret, timestamp, body)))
+	resp, err := km.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Error("Could not close response body")
+		}
+	}()
+	if resp.StatusCode != http.StatusOK {
+		b, err := io.ReadAll(io.LimitReader(resp.Body, maxBodySize))
+		if err != nil {
+			return errors.Errorf("peer responded with status %d", resp.StatusCode)
+		}
+		return errors.Errorf("peer responded with status %d: %s", resp.StatusCode, string(b))
+	}
+	return nil
+}
+
+// handlePartialSignature receives a partial signature from a peer of the cluster.
+func (km *Keymanager) handlePartialSignature(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "threshold-keymanager.handlePartialSignature")
+	defer span.End()
+
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize))
+	if err != nil {
+		http.Error(w, "could not read request body", http.StatusBadRequest)
+		return
+	}
+	if err := authenticate(km.authSecret, r, body); err != nil {
+		log.WithError(err).WithField("remoteAddress", r.RemoteAddr).Warn("Rejected unauthenticated partial signature")
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	msg := &partialSignatureMessage{}
+	if err := json.Unmarshal(body, msg); err != nil {
+		http.Error(w, "could not decode partial signature: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := km.addPeerPartialSignature(msg); err != nil {
+		log.WithError(err).WithField("peer", msg.Index).Warn("Rejected partial signature")
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// addPeerPartialSignature verifies a partial signature of a peer against its public key share and stores it.
+func (km *Keymanager) addPeerPartialSignature(msg *partialSignatureMessage) error {
+	if _, ok := km.peers[msg.Index]; !ok {
+		return fmt.Errorf("unknown peer index %d", msg.Index)
+	}
+	pubKey, err := hexutil.Decode(msg.PublicKey)
+	if err != nil {
+		return errors.Wrap(err, "could not decode public key")
+	}
+	share, ok := km.shares[bytesutil.ToBytes48(pubKey)]
+	if !ok {
+		return fmt.Errorf("no key share for public key %s", msg.PublicKey)
+	}
+	root, err := hexutil.Decode(msg.SigningRoot)
+	if err != nil || len(root) != 32 {
+		return errors.New("signing root must be 32 bytes")
+	}
+	sigBytes, err := hexutil.Decode(msg.Signature)
+	if err != nil {
+		return errors.Wrap(err, "could not decode signature")
+	}
+	sig, err := bls.SignatureFromBytes(sigBytes)
+	if err != nil {
+		return errors.Wrap(err, "invalid signature")
+	}
+	if !sig.Verify(share.PublicShares[msg.Index], root) {
+		return errors.New("partial signature does not match the public key share of the peer")
+	}
+	km.partials.add(partialsKey{pubKey: bytesutil.ToBytes48(pubKey), signingRoot: bytesutil.ToBytes32(root)}, msg.Index, sig)
+	return nil
+}
@@ -120,6 +120,9 @@ const (
 	Derived
 	// Web3Signer keymanager capable of signing data using a remote signer called Web3Signer.
 	Web3Signer
+	// Threshold keymanager signing with a key share of a distributed validator, combining its
+	// partial signatures with the ones of the other validator clients of the cluster.
+	Threshold
 )
 
 // IncorrectPasswordErrMsg defines a common error string representing an EIP-2335
@@ -139,6 +142,8 @@ func (k Kind) String() string {
 		return "direct"
 	case Web3Signer:
 		return "web3signer"
+	case Threshold:
+		return "threshold"
 	default:
 		return fmt.Sprintf("%d", int(k))
 	}
@@ -153,6 +158,8 @@ func ParseKind(k string) (Kind, error) {
 		return Local, nil
 	case "web3signer":
 		return Web3Signer, nil
+	case "threshold":
+		return Threshold, nil
 	default:
 		return 0, fmt.Errorf("%s is not an allowed keymanager", k)
 	}
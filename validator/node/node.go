@@ -44,6 +44,7 @@ import (
 	g "github.com/prysmaticlabs/prysm/v5/validator/graffiti"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/local"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/v5/validator/keymanager/remote-web3signer"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/threshold"
 	"github.com/prysmaticlabs/prysm/v5/validator/rpc"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
@@ -184,7 +185,7 @@ func (c *ValidatorClient) Close() {
 // If it does, it returns the legacy location.
 func (c *ValidatorClient) getLegacyDatabaseLocation(
 	isInteropNumValidatorsSet bool,
-	isWeb3SignerURLFlagSet bool,
+	isTemporaryWallet bool,
 	dataDir string,
 	dataFile string,
 	walletDir string,
@@ -201,7 +202,7 @@ func (c *ValidatorClient) getLegacyDatabaseLocation(
 	// We look in the previous, legacy directories.
 	// See https://github.com/prysmaticlabs/prysm/issues/13391
 	legacyDataDir := c.wallet.AccountsDir()
-	if isWeb3SignerURLFlagSet {
+	if isTemporaryWallet {
 		legacyDataDir = walletDir
 	}
 
@@ -237,6 +238,8 @@ func (c *ValidatorClient) initializeFromCLI(cliCtx *cli.Context, router *http.Se
 		// Custom Check For Web3Signer
 		if isWeb3SignerURLFlagSet {
 			c.wallet = wallet.NewWalletForWeb3Signer(cliCtx)
+		} else if cliCtx.IsSet(flags.ThresholdSignerConfigFlag.Name) {
+			c.wallet = wallet.NewWalletForThresholdSigner(cliCtx)
 		} else {
 			w, err := wallet.OpenWalletOrElseCli(cliCtx, func(cliCtx *cli.Context) (*wallet.Wallet, error) {
 				return nil, wallet.ErrNoWalletFound
@@ -277,6 +280,8 @@ func (c *ValidatorClient) initializeForWeb(cliCtx *cli.Context, router *http.Ser
 	if cliCtx.IsSet(flags.Web3SignerURLFlag.Name) {
 		// Custom Check For Web3Signer
 		c.wallet = wallet.NewWalletForWeb3Signer(cliCtx)
+	} else if cliCtx.IsSet(flags.ThresholdSignerConfigFlag.Name) {
+		c.wallet = wallet.NewWalletForThresholdSigner(cliCtx)
 	} else {
 		// Read the wallet password file from the cli context.
 		if err := setWalletPasswordFilePath(cliCtx); err != nil {
@@ -325,14 +330,14 @@ func (c *ValidatorClient) initializeDB(cliCtx *cli.Context) error {
 	kvDataFile := filepath.Join(kvDataDir, kv.ProtectionDbFileName)
 	walletDir := cliCtx.String(flags.WalletDirFlag.Name)
 	isInteropNumValidatorsSet := cliCtx.IsSet(flags.InteropNumValidators.Name)
-	isWeb3SignerURLFlagSet := cliCtx.IsSet(flags.Web3SignerURLFlag.Name)
+	isTemporaryWallet := cliCtx.IsSet(flags.Web3SignerURLFlag.Name) || cliCtx.IsSet(flags.ThresholdSignerConfigFlag.Name)
 	clearFlag := cliCtx.Bool(cmd.ClearDB.Name)
 	forceClearFlag := cliCtx.Bool(cmd.ForceClearDB.Name)
 
 	// Workaround for https://github.com/prysmaticlabs/prysm/issues/13391
 	kvDataDir, _, err := c.getLegacyDatabaseLocation(
 		isInteropNumValidatorsSet,
-		isWeb3SignerURLFlagSet,
+		isTemporaryWallet,
 		kvDataDir,
 		kvDataFile,
 		walletDir,
@@ -482,6 +487,14 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 		return err
 	}
 
+	var thresholdSignerConfig *threshold.SetupConfig
+	if c.cliCtx.IsSet(flags.ThresholdSignerConfigFlag.Name) {
+		thresholdSignerConfig, err = threshold.LoadSetupConfig(c.cliCtx.String(flags.ThresholdSignerConfigFlag.Name))
+		if err != nil {
+			return errors.Wrap(err, "could not load threshold signer config")
+		}
+	}
+
 	ps, err := proposerSettings(c.cliCtx, c.db)
 	if err != nil {
 		return err
@@ -498,13 +511,16 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 		BeaconNodeGRPCEndpoint:  c.cliCtx.String(flags.BeaconRPCProviderFlag.Name),
 		BeaconNodeCert:          c.cliCtx.String(flags.CertFlag.Name),
 		BeaconApiEndpoint:       c.cliCtx.String(flags.BeaconRESTApiProviderFlag.Name),
+		ParallelBeaconNodes:     c.cliCtx.Bool(flags.ParallelBeaconNodesFlag.Name),
 		BeaconApiTimeout:        time.Second * 30,
 		Graffiti:                g.ParseHexGraffiti(c.cliCtx.String(flags.GraffitiFlag.Name)),
 		GraffitiStruct:          graffitiStruct,
 		InteropKmConfig:         interopKmConfig,
 		Web3SignerConfig:        web3signerConfig,
+		ThresholdSignerConfig:   thresholdSignerConfig,
 		ProposerSettings:        ps,
 		ValidatorsRegBatchSize:  c.cliCtx.Int(flags.ValidatorsRegistrationBatchSizeFlag.Name),
+		DoppelGangerEpochs:      c.cliCtx.Uint64(flags.DoppelGangerEpochsFlag.Name),
 		UseWeb:                  c.cliCtx.Bool(flags.EnableWebFlag.Name),
 		LogValidatorPerformance: !c.cliCtx.Bool(flags.DisablePenaltyRewardLogFlag.Name),
 		EmitAccountMetrics:      !c.cliCtx.Bool(flags.DisableAccountMetricsFlag.Name),
@@ -551,6 +567,9 @@ func Web3SignerConfig(cliCtx *cli.Context) (*remoteweb3signer.SetupConfig, error
 		if cliCtx.IsSet(flags.Web3SignerKeyFileFlag.Name) {
 			web3signerConfig.KeyFilePath = cliCtx.String(flags.Web3SignerKeyFileFlag.Name)
 		}
+		if cliCtx.IsSet(flags.Web3SignerKeyDiscoveryIntervalFlag.Name) {
+			web3signerConfig.KeyDiscoveryInterval = cliCtx.Duration(flags.Web3SignerKeyDiscoveryIntervalFlag.Name)
+		}
 	}
 	return web3signerConfig, nil
 }
@@ -8,6 +8,7 @@ import (
 	"path"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/prysmaticlabs/prysm/v5/cmd"
 	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
@@ -210,9 +211,10 @@ func TestClearDB(t *testing.T) {
 // TestWeb3SignerConfig tests the web3 signer config returns the correct values.
 func TestWeb3SignerConfig(t *testing.T) {
 	type args struct {
-		baseURL          string
-		publicKeysOrURLs []string
-		persistentFile   string
+		baseURL           string
+		publicKeysOrURLs  []string
+		persistentFile    string
+		discoveryInterval string
 	}
 	tests := []struct {
 		name       string
@@ -280,6 +282,17 @@ func TestWeb3SignerConfig(t *testing.T) {
 				KeyFilePath:  "/remote/key/file.txt",
 			},
 		},
+		{
+			name: "happy path with key discovery",
+			args: &args{
+				baseURL:           "http://localhost:8545",
+				discoveryInterval: "1m",
+			},
+			want: &remoteweb3signer.SetupConfig{
+				BaseEndpoint:         "http://localhost:8545",
+				KeyDiscoveryInterval: time.Minute,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -287,6 +300,7 @@ func TestWeb3SignerConfig(t *testing.T) {
 			set := flag.NewFlagSet(tt.name, 0)
 			set.String("validators-external-signer-url", tt.args.baseURL, "baseUrl")
 			set.String(flags.Web3SignerKeyFileFlag.Name, "", "")
+			set.Duration(flags.Web3SignerKeyDiscoveryIntervalFlag.Name, 0, "")
 			c := &cli.StringSliceFlag{
 				Name: "validators-external-signer-public-keys",
 			}
@@ -299,6 +313,9 @@ func TestWeb3SignerConfig(t *testing.T) {
 			if tt.args.persistentFile != "" {
 				require.NoError(t, set.Set(flags.Web3SignerKeyFileFlag.Name, tt.args.persistentFile))
 			}
+			if tt.args.discoveryInterval != "" {
+				require.NoError(t, set.Set(flags.Web3SignerKeyDiscoveryIntervalFlag.Name, tt.args.discoveryInterval))
+			}
 			cliCtx := cli.NewContext(&app, set, nil)
 			got, err := Web3SignerConfig(cliCtx)
 			if tt.wantErrMsg != "" {
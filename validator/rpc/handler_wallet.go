@@ -156,6 +156,8 @@ func (s *Server) WalletConfig(w http.ResponseWriter, r *http.Request) {
 		keymanagerKind = importedKeymanagerKind
 	case keymanager.Web3Signer:
 		keymanagerKind = web3signerKeymanagerKind
+	case keymanager.Threshold:
+		keymanagerKind = thresholdKeymanagerKind
 	}
 	httputil.WriteJson(w, &WalletResponse{
 		WalletPath:     s.walletDir,
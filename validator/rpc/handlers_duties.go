@@ -0,0 +1,115 @@
+package rpc
+
+import (
+	"bytes"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+)
+
+// GetDutySchedule lists the upcoming attester, proposer and sync committee duties of the validating keys,
+// as known by the duties cache of the validator client. The optional pubkey query parameter
+// restricts the response to a single key.
+func (s *Server) GetDutySchedule(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "validator.web.duties.GetDutySchedule")
+	defer span.End()
+
+	if s.validatorService == nil {
+		httputil.HandleError(w, "Validator service not ready.", http.StatusServiceUnavailable)
+		return
+	}
+	_, pubkey, ok := shared.HexFromQuery(w, r, "pubkey", fieldparams.BLSPubkeyLength, false)
+	if !ok {
+		return
+	}
+
+	schedule, err := s.validatorService.DutySchedule()
+	if err != nil {
+		httputil.HandleError(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	data := make([]*ScheduledDuties, 0, len(schedule))
+	for _, duties := range schedule {
+		if pubkey != nil && !bytes.Equal(pubkey, duties.PubKey[:]) {
+			continue
+		}
+		scheduled := &ScheduledDuties{
+			Pubkey:              hexutil.Encode(duties.PubKey[:]),
+			ValidatorIndex:      strconv.FormatUint(uint64(duties.ValidatorIndex), 10),
+			AttesterSlots:       make([]string, len(duties.AttesterSlots)),
+			ProposerSlots:       make([]string, len(duties.ProposerSlots)),
+			SyncCommitteeEpochs: make([]string, len(duties.SyncCommitteeEpochs)),
+		}
+		for i, slot := range duties.AttesterSlots {
+			scheduled.AttesterSlots[i] = strconv.FormatUint(uint64(slot), 10)
+		}
+		for i, slot := range duties.ProposerSlots {
+			scheduled.ProposerSlots[i] = strconv.FormatUint(uint64(slot), 10)
+		}
+		for i, epoch := range duties.SyncCommitteeEpochs {
+			scheduled.SyncCommitteeEpochs[i] = strconv.FormatUint(uint64(epoch), 10)
+		}
+		data = append(data, scheduled)
+	}
+
+	httputil.WriteJson(w, &DutyScheduleResponse{Data: data})
+}
+
+// GetDutyHistory lists the most recent duties performed or missed by the validating keys, oldest first,
+// with the reason of every missed duty. The optional pubkey query parameter restricts the response
+// to a single key, and the optional missed query parameter to the missed duties.
+func (s *Server) GetDutyHistory(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "validator.web.duties.GetDutyHistory")
+	defer span.End()
+
+	if s.validatorService == nil {
+		httputil.HandleError(w, "Validator service not ready.", http.StatusServiceUnavailable)
+		return
+	}
+	_, pubkey, ok := shared.HexFromQuery(w, r, "pubkey", fieldparams.BLSPubkeyLength, false)
+	if !ok {
+		return
+	}
+	missedOnly := false
+	if rawMissed := r.URL.Query().Get("missed"); rawMissed != "" {
+		var err error
+		missedOnly, err = strconv.ParseBool(rawMissed)
+		if err != nil {
+			httputil.HandleError(w, "missed is invalid: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	history, err := s.validatorService.DutyHistory()
+	if err != nil {
+		httputil.HandleError(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	data := make([]*DutyRecord, 0, len(history))
+	for _, record := range history {
+		if pubkey != nil && !bytes.Equal(pubkey, record.PubKey[:]) {
+			continue
+		}
+		if missedOnly && record.MissedReason == "" {
+			continue
+		}
+		data = append(data, &DutyRecord{
+			Pubkey:       hexutil.Encode(record.PubKey[:]),
+			Slot:         strconv.FormatUint(uint64(record.Slot), 10),
+			Duty:         record.Role.String(),
+			Performed:    record.MissedReason == "",
+			MissedReason: record.MissedReason,
+			Time:         record.Time.UTC().Format(time.RFC3339),
+		})
+	}
+
+	httputil.WriteJson(w, &DutyHistoryResponse{Data: data})
+}
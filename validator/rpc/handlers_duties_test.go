@@ -0,0 +1,134 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	mock "github.com/prysmaticlabs/prysm/v5/validator/accounts/testing"
+	"github.com/prysmaticlabs/prysm/v5/validator/client"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+)
+
+func TestServer_GetDutySchedule(t *testing.T) {
+	pubkey1 := [fieldparams.BLSPubkeyLength]byte{1}
+	pubkey2 := [fieldparams.BLSPubkeyLength]byte{2}
+	m := &mock.Validator{
+		Schedule: []*iface.ScheduledDuties{
+			{
+				PubKey:              pubkey1,
+				ValidatorIndex:      10,
+				AttesterSlots:       []primitives.Slot{33, 70},
+				ProposerSlots:       []primitives.Slot{40},
+				SyncCommitteeEpochs: []primitives.Epoch{1, 2},
+			},
+			{
+				PubKey:              pubkey2,
+				ValidatorIndex:      11,
+				AttesterSlots:       []primitives.Slot{34},
+				ProposerSlots:       []primitives.Slot{},
+				SyncCommitteeEpochs: []primitives.Epoch{},
+			},
+		},
+	}
+	vs, err := client.NewValidatorService(context.Background(), &client.Config{Validator: m})
+	require.NoError(t, err)
+	s := &Server{validatorService: vs}
+
+	req := httptest.NewRequest(http.MethodGet, "/v2/validator/duties/schedule", nil)
+	w := httptest.NewRecorder()
+	s.GetDutySchedule(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := &DutyScheduleResponse{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+	require.Equal(t, 2, len(resp.Data))
+	assert.DeepEqual(t, &ScheduledDuties{
+		Pubkey:              hexutil.Encode(pubkey1[:]),
+		ValidatorIndex:      "10",
+		AttesterSlots:       []string{"33", "70"},
+		ProposerSlots:       []string{"40"},
+		SyncCommitteeEpochs: []string{"1", "2"},
+	}, resp.Data[0])
+
+	// Filter on a single key.
+	req = httptest.NewRequest(http.MethodGet, "/v2/validator/duties/schedule?pubkey="+hexutil.Encode(pubkey2[:]), nil)
+	w = httptest.NewRecorder()
+	s.GetDutySchedule(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp = &DutyScheduleResponse{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+	require.Equal(t, 1, len(resp.Data))
+	assert.Equal(t, "11", resp.Data[0].ValidatorIndex)
+
+	req = httptest.NewRequest(http.MethodGet, "/v2/validator/duties/schedule?pubkey=0x12", nil)
+	w = httptest.NewRecorder()
+	s.GetDutySchedule(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_GetDutyHistory(t *testing.T) {
+	pubkey := [fieldparams.BLSPubkeyLength]byte{1}
+	recordTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	m := &mock.Validator{
+		History: []*iface.DutyRecord{
+			{PubKey: pubkey, Slot: 32, Role: iface.RoleAttester, Time: recordTime},
+			{PubKey: pubkey, Slot: 33, Role: iface.RoleProposer, MissedReason: "signing error", Time: recordTime},
+			{PubKey: [fieldparams.BLSPubkeyLength]byte{2}, Slot: 33, Role: iface.RoleSyncCommittee, MissedReason: "submission error", Time: recordTime},
+		},
+	}
+	vs, err := client.NewValidatorService(context.Background(), &client.Config{Validator: m})
+	require.NoError(t, err)
+	s := &Server{validatorService: vs}
+
+	req := httptest.NewRequest(http.MethodGet, "/v2/validator/duties/history", nil)
+	w := httptest.NewRecorder()
+	s.GetDutyHistory(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := &DutyHistoryResponse{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+	require.Equal(t, 3, len(resp.Data))
+	assert.DeepEqual(t, &DutyRecord{
+		Pubkey:    hexutil.Encode(pubkey[:]),
+		Slot:      "32",
+		Duty:      "attester",
+		Performed: true,
+		Time:      "2024-01-02T03:04:05Z",
+	}, resp.Data[0])
+
+	// Filter on the missed duties of a single key.
+	req = httptest.NewRequest(http.MethodGet, "/v2/validator/duties/history?missed=true&pubkey="+hexutil.Encode(pubkey[:]), nil)
+	w = httptest.NewRecorder()
+	s.GetDutyHistory(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp = &DutyHistoryResponse{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+	require.Equal(t, 1, len(resp.Data))
+	assert.Equal(t, "proposer", resp.Data[0].Duty)
+	assert.Equal(t, false, resp.Data[0].Performed)
+	assert.Equal(t, "signing error", resp.Data[0].MissedReason)
+
+	req = httptest.NewRequest(http.MethodGet, "/v2/validator/duties/history?missed=maybe", nil)
+	w = httptest.NewRecorder()
+	s.GetDutyHistory(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_Duties_ValidatorServiceNil(t *testing.T) {
+	s := &Server{}
+
+	w := httptest.NewRecorder()
+	s.GetDutySchedule(w, httptest.NewRequest(http.MethodGet, "/v2/validator/duties/schedule", nil))
+	require.Equal(t, http.StatusServiceUnavailable, w.Code)
+
+	w = httptest.NewRecorder()
+	s.GetDutyHistory(w, httptest.NewRequest(http.MethodGet, "/v2/validator/duties/history", nil))
+	require.Equal(t, http.StatusServiceUnavailable, w.Code)
+}
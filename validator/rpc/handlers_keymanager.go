@@ -858,6 +858,132 @@ func (s *Server) DeleteGasLimit(w http.ResponseWriter, r *http.Request) {
 	httputil.HandleError(w, fmt.Sprintf("No gas limit found for pubkey %q", rawPubkey), http.StatusNotFound)
 }
 
+// defaultBuilderBoostFactor is the builder boost factor used by the beacon node when the validator does not set one.
+const defaultBuilderBoostFactor = 100
+
+// GetBuilderBoostFactor returns the builder boost factor used when proposing blocks by public key
+func (s *Server) GetBuilderBoostFactor(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "validator.keymanagerAPI.GetBuilderBoostFactor")
+	defer span.End()
+
+	if s.validatorService == nil {
+		httputil.HandleError(w, "Validator service not ready", http.StatusServiceUnavailable)
+		return
+	}
+
+	rawPubkey, pubkey, ok := shared.HexFromRoute(w, r, "pubkey", fieldparams.BLSPubkeyLength)
+	if !ok {
+		return
+	}
+
+	resp := &GetBuilderBoostFactorResponse{
+		Data: &BuilderBoostFactorMetaData{
+			Pubkey:             rawPubkey,
+			BuilderBoostFactor: fmt.Sprintf("%d", defaultBuilderBoostFactor),
+		},
+	}
+	settings := s.validatorService.ProposerSettings()
+	if settings != nil {
+		proposerOption, found := settings.ProposeConfig[bytesutil.ToBytes48(pubkey)]
+		if found && proposerOption.BuilderConfig != nil && proposerOption.BuilderConfig.BuilderBoostFactor != nil {
+			resp.Data.BuilderBoostFactor = fmt.Sprintf("%d", *proposerOption.BuilderConfig.BuilderBoostFactor)
+		} else if settings.DefaultConfig != nil && settings.DefaultConfig.BuilderConfig != nil && settings.DefaultConfig.BuilderConfig.BuilderBoostFactor != nil {
+			resp.Data.BuilderBoostFactor = fmt.Sprintf("%d", *settings.DefaultConfig.BuilderConfig.BuilderBoostFactor)
+		}
+	}
+	httputil.WriteJson(w, resp)
+}
+
+// SetBuilderBoostFactor updates the builder boost factor by public key
+func (s *Server) SetBuilderBoostFactor(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.keymanagerAPI.SetBuilderBoostFactor")
+	defer span.End()
+
+	if s.validatorService == nil {
+		httputil.HandleError(w, "Validator service not ready", http.StatusServiceUnavailable)
+		return
+	}
+	_, pubkey, ok := shared.HexFromRoute(w, r, "pubkey", fieldparams.BLSPubkeyLength)
+	if !ok {
+		return
+	}
+
+	var req SetBuilderBoostFactorRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	switch {
+	case errors.Is(err, io.EOF):
+		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	case err != nil:
+		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	factor, valid := shared.ValidateUint(w, "builder_boost_factor", req.BuilderBoostFactor)
+	if !valid {
+		return
+	}
+	boostFactor := validator.Uint64(factor)
+
+	settings := s.validatorService.ProposerSettings()
+	if settings == nil {
+		httputil.HandleError(w, "No proposer settings were found to update", http.StatusInternalServerError)
+		return
+	}
+	proposerOption, found := settings.ProposeConfig[bytesutil.ToBytes48(pubkey)]
+	if !found {
+		proposerOption = settings.DefaultConfig.Clone()
+	}
+	if proposerOption == nil || proposerOption.BuilderConfig == nil || !proposerOption.BuilderConfig.Enabled {
+		httputil.HandleError(w, "Builder boost factor changes only apply when builder is enabled", http.StatusInternalServerError)
+		return
+	}
+	proposerOption.BuilderConfig.BuilderBoostFactor = &boostFactor
+	if settings.ProposeConfig == nil {
+		settings.ProposeConfig = make(map[[fieldparams.BLSPubkeyLength]byte]*proposer.Option)
+	}
+	settings.ProposeConfig[bytesutil.ToBytes48(pubkey)] = proposerOption
+
+	// save the settings
+	if err := s.validatorService.SetProposerSettings(ctx, settings); err != nil {
+		httputil.HandleError(w, "Could not set proposer settings: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// DeleteBuilderBoostFactor deletes the builder boost factor by public key, so that the default config or the
+// beacon node default applies again
+func (s *Server) DeleteBuilderBoostFactor(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.keymanagerAPI.DeleteBuilderBoostFactor")
+	defer span.End()
+
+	if s.validatorService == nil {
+		httputil.HandleError(w, "Validator service not ready", http.StatusServiceUnavailable)
+		return
+	}
+	rawPubkey, pubkey, ok := shared.HexFromRoute(w, r, "pubkey", fieldparams.BLSPubkeyLength)
+	if !ok {
+		return
+	}
+
+	proposerSettings := s.validatorService.ProposerSettings()
+	if proposerSettings != nil && proposerSettings.ProposeConfig != nil {
+		proposerOption, found := proposerSettings.ProposeConfig[bytesutil.ToBytes48(pubkey)]
+		if found && proposerOption.BuilderConfig != nil && proposerOption.BuilderConfig.BuilderBoostFactor != nil {
+			proposerOption.BuilderConfig.BuilderBoostFactor = nil
+			// save the settings
+			if err := s.validatorService.SetProposerSettings(ctx, proposerSettings); err != nil {
+				httputil.HandleError(w, "Could not set proposer settings: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+	}
+	httputil.HandleError(w, fmt.Sprintf("No builder boost factor found for pubkey %q", rawPubkey), http.StatusNotFound)
+}
+
 func (s *Server) GetGraffiti(w http.ResponseWriter, r *http.Request) {
 	ctx, span := trace.StartSpan(r.Context(), "validator.keymanagerAPI.Graffiti")
 	defer span.End()
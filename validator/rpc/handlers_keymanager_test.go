@@ -1332,6 +1332,294 @@ func TestServer_DeleteGasLimit(t *testing.T) {
 	}
 }
 
+func TestServer_GetBuilderBoostFactor(t *testing.T) {
+	ctx := context.Background()
+	byteval, err := hexutil.Decode("0xaf2e7ba294e03438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2c06bd3713cb442072ae591493")
+	byteval2, err2 := hexutil.Decode("0x1234567878903438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2c06bd3713cb442072ae591493")
+	require.NoError(t, err)
+	require.NoError(t, err2)
+	keyFactor := validator.Uint64(0)
+	defaultFactor := validator.Uint64(90)
+
+	tests := []struct {
+		name   string
+		args   *proposer.Settings
+		pubkey [48]byte
+		want   uint64
+	}{
+		{
+			name: "ProposerSetting for specific pubkey exists",
+			args: &proposer.Settings{
+				ProposeConfig: map[[48]byte]*proposer.Option{
+					bytesutil.ToBytes48(byteval): {
+						BuilderConfig: &proposer.BuilderConfig{BuilderBoostFactor: &keyFactor},
+					},
+				},
+				DefaultConfig: &proposer.Option{
+					BuilderConfig: &proposer.BuilderConfig{BuilderBoostFactor: &defaultFactor},
+				},
+			},
+			pubkey: bytesutil.ToBytes48(byteval),
+			want:   0,
+		},
+		{
+			name: "ProposerSetting for specific pubkey does not exist",
+			args: &proposer.Settings{
+				ProposeConfig: map[[48]byte]*proposer.Option{
+					bytesutil.ToBytes48(byteval): {
+						BuilderConfig: &proposer.BuilderConfig{BuilderBoostFactor: &keyFactor},
+					},
+				},
+				DefaultConfig: &proposer.Option{
+					BuilderConfig: &proposer.BuilderConfig{BuilderBoostFactor: &defaultFactor},
+				},
+			},
+			pubkey: bytesutil.ToBytes48(byteval2),
+			want:   90,
+		},
+		{
+			name:   "No proposerSetting at all",
+			args:   nil,
+			pubkey: bytesutil.ToBytes48(byteval),
+			want:   defaultBuilderBoostFactor,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &mock.Validator{}
+			err := m.SetProposerSettings(ctx, tt.args)
+			require.NoError(t, err)
+			vs, err := client.NewValidatorService(ctx, &client.Config{
+				Validator: m,
+			})
+			require.NoError(t, err)
+			s := &Server{
+				validatorService: vs,
+			}
+			req := httptest.NewRequest(http.MethodGet, "/eth/v1/validator/{pubkey}/builder_boost_factor", nil)
+			req.SetPathValue("pubkey", hexutil.Encode(tt.pubkey[:]))
+			w := httptest.NewRecorder()
+			w.Body = &bytes.Buffer{}
+			s.GetBuilderBoostFactor(w, req)
+			assert.Equal(t, http.StatusOK, w.Code)
+			resp := &GetBuilderBoostFactorResponse{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+			assert.Equal(t, fmt.Sprintf("%d", tt.want), resp.Data.BuilderBoostFactor)
+		})
+	}
+}
+
+func TestServer_SetBuilderBoostFactor(t *testing.T) {
+	ctx := context.Background()
+	pubkey1, err := hexutil.Decode("0xaf2e7ba294e03438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2c06bd3713cb442072ae591493")
+	pubkey2, err2 := hexutil.Decode("0xbedefeaa94e03438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2cdddddddddddddddddddddddd")
+	require.NoError(t, err)
+	require.NoError(t, err2)
+
+	tests := []struct {
+		name             string
+		pubkey           []byte
+		proposerSettings *proposer.Settings
+		wantErr          string
+	}{
+		{
+			name:             "ProposerSettings is nil",
+			pubkey:           pubkey1,
+			proposerSettings: nil,
+			wantErr:          "No proposer settings were found to update",
+		},
+		{
+			name:   "builder is not enabled in the default config",
+			pubkey: pubkey1,
+			proposerSettings: &proposer.Settings{
+				DefaultConfig: &proposer.Option{
+					BuilderConfig: &proposer.BuilderConfig{Enabled: false},
+				},
+			},
+			wantErr: "Builder boost factor changes only apply when builder is enabled",
+		},
+		{
+			name:   "builder is not enabled for the pubkey",
+			pubkey: pubkey1,
+			proposerSettings: &proposer.Settings{
+				ProposeConfig: map[[48]byte]*proposer.Option{
+					bytesutil.ToBytes48(pubkey1): {},
+				},
+				DefaultConfig: &proposer.Option{
+					BuilderConfig: &proposer.BuilderConfig{Enabled: true},
+				},
+			},
+			wantErr: "Builder boost factor changes only apply when builder is enabled",
+		},
+		{
+			name:   "ProposerSettings.ProposeConfig is nil AND builder is enabled in the default config",
+			pubkey: pubkey1,
+			proposerSettings: &proposer.Settings{
+				DefaultConfig: &proposer.Option{
+					BuilderConfig: &proposer.BuilderConfig{Enabled: true},
+				},
+			},
+		},
+		{
+			name:   "ProposerSettings.ProposeConfig is defined for another pubkey",
+			pubkey: pubkey1,
+			proposerSettings: &proposer.Settings{
+				ProposeConfig: map[[48]byte]*proposer.Option{
+					bytesutil.ToBytes48(pubkey2): {
+						BuilderConfig: &proposer.BuilderConfig{Enabled: true},
+					},
+				},
+				DefaultConfig: &proposer.Option{
+					BuilderConfig: &proposer.BuilderConfig{Enabled: true},
+				},
+			},
+		},
+		{
+			name:   "ProposerSettings.ProposeConfig is defined for pubkey",
+			pubkey: pubkey1,
+			proposerSettings: &proposer.Settings{
+				ProposeConfig: map[[48]byte]*proposer.Option{
+					bytesutil.ToBytes48(pubkey1): {
+						BuilderConfig: &proposer.BuilderConfig{Enabled: true},
+					},
+				},
+			},
+		},
+	}
+	for _, isSlashingProtectionMinimal := range [...]bool{false, true} {
+		for _, tt := range tests {
+			t.Run(fmt.Sprintf("%s/isSlashingProtectionMinimal:%v", tt.name, isSlashingProtectionMinimal), func(t *testing.T) {
+				m := &mock.Validator{}
+				err := m.SetProposerSettings(ctx, tt.proposerSettings)
+				require.NoError(t, err)
+				validatorDB := dbtest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{}, isSlashingProtectionMinimal)
+				vs, err := client.NewValidatorService(ctx, &client.Config{
+					Validator: m,
+					DB:        validatorDB,
+				})
+				require.NoError(t, err)
+				s := &Server{
+					validatorService: vs,
+					db:               validatorDB,
+				}
+
+				var buf bytes.Buffer
+				require.NoError(t, json.NewEncoder(&buf).Encode(&SetBuilderBoostFactorRequest{BuilderBoostFactor: "0"}))
+				req := httptest.NewRequest(http.MethodPost, "/eth/v1/validator/{pubkey}/builder_boost_factor", &buf)
+				req.SetPathValue("pubkey", hexutil.Encode(tt.pubkey))
+				w := httptest.NewRecorder()
+				w.Body = &bytes.Buffer{}
+
+				s.SetBuilderBoostFactor(w, req)
+
+				if tt.wantErr != "" {
+					assert.NotEqual(t, http.StatusOK, w.Code)
+					require.StringContains(t, tt.wantErr, w.Body.String())
+					return
+				}
+				assert.Equal(t, http.StatusAccepted, w.Code)
+				option := s.validatorService.ProposerSettings().ProposeConfig[bytesutil.ToBytes48(tt.pubkey)]
+				require.NotNil(t, option.BuilderConfig.BuilderBoostFactor)
+				assert.Equal(t, validator.Uint64(0), *option.BuilderConfig.BuilderBoostFactor)
+				if tt.proposerSettings.DefaultConfig != nil {
+					assert.Equal(t, true, tt.proposerSettings.DefaultConfig.BuilderConfig.BuilderBoostFactor == nil, "default config must not be modified")
+				}
+			})
+		}
+	}
+}
+
+func TestServer_SetBuilderBoostFactor_InvalidValue(t *testing.T) {
+	s := &Server{
+		validatorService: &client.ValidatorService{},
+	}
+	var buf bytes.Buffer
+	require.NoError(t, json.NewEncoder(&buf).Encode(&SetBuilderBoostFactorRequest{BuilderBoostFactor: "-1"}))
+	req := httptest.NewRequest(http.MethodPost, "/eth/v1/validator/{pubkey}/builder_boost_factor", &buf)
+	req.SetPathValue("pubkey", "0xaf2e7ba294e03438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2c06bd3713cb442072ae591493")
+	w := httptest.NewRecorder()
+	w.Body = &bytes.Buffer{}
+
+	s.SetBuilderBoostFactor(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	require.StringContains(t, "builder_boost_factor", w.Body.String())
+}
+
+func TestServer_DeleteBuilderBoostFactor(t *testing.T) {
+	ctx := context.Background()
+	pubkey1, err := hexutil.Decode("0xaf2e7ba294e03438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2c06bd3713cb442072ae591493")
+	pubkey2, err2 := hexutil.Decode("0xbedefeaa94e03438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2cdddddddddddddddddddddddd")
+	require.NoError(t, err)
+	require.NoError(t, err2)
+	factor := validator.Uint64(0)
+
+	tests := []struct {
+		name             string
+		pubkey           []byte
+		proposerSettings *proposer.Settings
+		wantCode         int
+	}{
+		{
+			name:   "delete existing builder boost factor",
+			pubkey: pubkey1,
+			proposerSettings: &proposer.Settings{
+				ProposeConfig: map[[48]byte]*proposer.Option{
+					bytesutil.ToBytes48(pubkey1): {
+						BuilderConfig: &proposer.BuilderConfig{Enabled: true, BuilderBoostFactor: &factor},
+					},
+				},
+			},
+			wantCode: http.StatusNoContent,
+		},
+		{
+			name:   "delete nonexistent builder boost factor",
+			pubkey: pubkey2,
+			proposerSettings: &proposer.Settings{
+				ProposeConfig: map[[48]byte]*proposer.Option{
+					bytesutil.ToBytes48(pubkey1): {
+						BuilderConfig: &proposer.BuilderConfig{Enabled: true, BuilderBoostFactor: &factor},
+					},
+				},
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:     "no proposer settings",
+			pubkey:   pubkey1,
+			wantCode: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &mock.Validator{}
+			err := m.SetProposerSettings(ctx, tt.proposerSettings.Clone())
+			require.NoError(t, err)
+			validatorDB := dbtest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{}, false)
+			vs, err := client.NewValidatorService(ctx, &client.Config{
+				Validator: m,
+				DB:        validatorDB,
+			})
+			require.NoError(t, err)
+			s := &Server{
+				validatorService: vs,
+				db:               validatorDB,
+			}
+
+			req := httptest.NewRequest(http.MethodDelete, "/eth/v1/validator/{pubkey}/builder_boost_factor", nil)
+			req.SetPathValue("pubkey", hexutil.Encode(tt.pubkey))
+			w := httptest.NewRecorder()
+			w.Body = &bytes.Buffer{}
+
+			s.DeleteBuilderBoostFactor(w, req)
+			assert.Equal(t, tt.wantCode, w.Code)
+			if tt.proposerSettings != nil {
+				option := s.validatorService.ProposerSettings().ProposeConfig[bytesutil.ToBytes48(pubkey1)]
+				assert.Equal(t, tt.wantCode == http.StatusNoContent, option.BuilderConfig.BuilderBoostFactor == nil)
+			}
+		})
+	}
+}
+
 func TestServer_ListRemoteKeys(t *testing.T) {
 	ctx := context.Background()
 	app := cli.App{}
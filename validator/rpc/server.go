@@ -184,6 +184,9 @@ func (s *Server) InitializeRoutes() error {
 	s.router.HandleFunc("GET /eth/v1/validator/{pubkey}/gas_limit", s.GetGasLimit)
 	s.router.HandleFunc("POST /eth/v1/validator/{pubkey}/gas_limit", s.SetGasLimit)
 	s.router.HandleFunc("DELETE /eth/v1/validator/{pubkey}/gas_limit", s.DeleteGasLimit)
+	s.router.HandleFunc("GET /eth/v1/validator/{pubkey}/builder_boost_factor", s.GetBuilderBoostFactor)
+	s.router.HandleFunc("POST /eth/v1/validator/{pubkey}/builder_boost_factor", s.SetBuilderBoostFactor)
+	s.router.HandleFunc("DELETE /eth/v1/validator/{pubkey}/builder_boost_factor", s.DeleteBuilderBoostFactor)
 	s.router.HandleFunc("GET /eth/v1/validator/{pubkey}/feerecipient", s.ListFeeRecipientByPubkey)
 	s.router.HandleFunc("POST /eth/v1/validator/{pubkey}/feerecipient", s.SetFeeRecipientByPubkey)
 	s.router.HandleFunc("DELETE /eth/v1/validator/{pubkey}/feerecipient", s.DeleteFeeRecipientByPubkey)
@@ -208,6 +211,9 @@ func (s *Server) InitializeRoutes() error {
 	s.router.HandleFunc("GET "+api.WebUrlPrefix+"beacon/validators", s.GetValidators)
 	s.router.HandleFunc("GET "+api.WebUrlPrefix+"beacon/balances", s.GetValidatorBalances)
 	s.router.HandleFunc("GET "+api.WebUrlPrefix+"beacon/peers", s.GetPeers)
+	// duties endpoints
+	s.router.HandleFunc("GET "+api.WebUrlPrefix+"duties/schedule", s.GetDutySchedule)
+	s.router.HandleFunc("GET "+api.WebUrlPrefix+"duties/history", s.GetDutyHistory)
 	// web wallet endpoints
 	s.router.HandleFunc("GET "+api.WebUrlPrefix+"wallet", s.WalletConfig)
 	s.router.HandleFunc("POST "+api.WebUrlPrefix+"wallet/create", s.CreateWallet)
@@ -62,6 +62,19 @@ type SetGasLimitRequest struct {
 	GasLimit string `json:"gas_limit"`
 }
 
+type BuilderBoostFactorMetaData struct {
+	Pubkey             string `json:"pubkey"`
+	BuilderBoostFactor string `json:"builder_boost_factor"`
+}
+
+type GetBuilderBoostFactorResponse struct {
+	Data *BuilderBoostFactorMetaData `json:"data"`
+}
+
+type SetBuilderBoostFactorRequest struct {
+	BuilderBoostFactor string `json:"builder_boost_factor"`
+}
+
 // remote keymanager api
 type ListRemoteKeysResponse struct {
 	Data []*RemoteKey `json:"data"`
@@ -109,6 +122,32 @@ type GraffitiData struct {
 	Graffiti string `json:"graffiti"`
 }
 
+// Duties web api
+type DutyScheduleResponse struct {
+	Data []*ScheduledDuties `json:"data"`
+}
+
+type ScheduledDuties struct {
+	Pubkey              string   `json:"pubkey"`
+	ValidatorIndex      string   `json:"validator_index"`
+	AttesterSlots       []string `json:"attester_slots"`
+	ProposerSlots       []string `json:"proposer_slots"`
+	SyncCommitteeEpochs []string `json:"sync_committee_epochs"`
+}
+
+type DutyHistoryResponse struct {
+	Data []*DutyRecord `json:"data"`
+}
+
+type DutyRecord struct {
+	Pubkey       string `json:"pubkey"`
+	Slot         string `json:"slot"`
+	Duty         string `json:"duty"`
+	Performed    bool   `json:"performed"`
+	MissedReason string `json:"missed_reason,omitempty"`
+	Time         string `json:"time"`
+}
+
 type BeaconStatusResponse struct {
 	BeaconNodeEndpoint     string     `json:"beacon_node_endpoint"`
 	Connected              bool       `json:"connected"`
@@ -125,6 +164,7 @@ const (
 	derivedKeymanagerKind    KeymanagerKind = "DERIVED"
 	importedKeymanagerKind   KeymanagerKind = "IMPORTED"
 	web3signerKeymanagerKind KeymanagerKind = "WEB3SIGNER"
+	thresholdKeymanagerKind  KeymanagerKind = "THRESHOLD"
 )
 
 type CreateWalletRequest struct {